@@ -0,0 +1,97 @@
+// Command admin runs backfill and reconciliation tasks against a live deployment.
+//
+// Every mutating command defaults to -dry-run=true and only prints what it would change;
+// pass -dry-run=false to apply.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/open-builders/giveaway-backend/internal/config"
+	"github.com/open-builders/giveaway-backend/internal/platform/db"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, env *env, args []string) error
+}
+
+var commands = []command{
+	{"enrich-sponsors", "re-fetch sponsor channel title, username and avatar from Telegram", runEnrichSponsors},
+	{"resend-notifications", "retry failed scheduled posts and resend completion notifications of a giveaway", runResendNotifications},
+	{"verify-winners", "check winners and distributed prizes against participants and prize definitions", runVerifyWinners},
+	{"verify-user", "grant or revoke the verification badge shown on public profiles", runVerifyUser},
+	{"deny-wallet", "add or remove a wallet on the payout screening deny-list", runDenyWallet},
+}
+
+// env holds lazily opened dependencies shared by commands.
+type env struct {
+	cfg *config.Config
+	pg  *sql.DB
+}
+
+func main() {
+	log.SetFlags(0)
+	_ = godotenv.Load()
+	_ = godotenv.Overload(".env.local")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == os.Args[1] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("config load: %v", err)
+	}
+	pg, err := db.Open(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("postgres open: %v", err)
+	}
+	defer pg.Close()
+
+	if err := cmd.run(ctx, &env{cfg: cfg, pg: pg}, os.Args[2:]); err != nil {
+		log.Printf("%s: %v", cmd.name, err)
+		stop()
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: admin <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Run 'admin <command> -h' for command flags.")
+}
+
+func dryRunPrefix(dryRun bool) string {
+	if dryRun {
+		return "[dry-run] "
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+func runResendNotifications(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("resend-notifications", flag.ExitOnError)
+	giveawayID := fs.String("giveaway", "", "giveaway id (required)")
+	dryRun := fs.Bool("dry-run", true, "print what would be sent without sending")
+	schedule := fs.Bool("schedule", true, "requeue failed content plan posts")
+	winners := fs.Bool("winners", false, "resend winner DMs")
+	results := fs.Bool("results", false, "repost results to sponsor channels")
+	creator := fs.Bool("creator", false, "resend the completion DM to the creator")
+	_ = fs.Parse(args)
+	if *giveawayID == "" {
+		return errors.New("-giveaway is required")
+	}
+
+	repo := pgrepo.NewGiveawayRepository(e.pg)
+	g, err := repo.GetByID(ctx, *giveawayID)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("giveaway not found")
+	}
+	prefix := dryRunPrefix(*dryRun)
+
+	if *schedule {
+		sched := pgrepo.NewScheduleRepository(e.pg)
+		items, err := sched.ListByGiveaway(ctx, g.ID)
+		if err != nil {
+			return err
+		}
+		for _, it := range items {
+			if it.Status == dg.ScheduleStatusFailed {
+				fmt.Printf("%srequeue %s post %d scheduled at %s (last error: %s)\n", prefix, it.Kind, it.ID, it.ScheduledAt.Format(time.RFC3339), it.LastError)
+			}
+		}
+		if !*dryRun {
+			n, err := sched.RetryFailed(ctx, g.ID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("requeued %d failed posts; the schedule worker delivers them on its next tick\n", n)
+		}
+	}
+
+	if !*winners && !*results && !*creator {
+		return nil
+	}
+	if g.Status != dg.GiveawayStatusCompleted {
+		return fmt.Errorf("giveaway is %s, completion notifications apply to completed giveaways only", g.Status)
+	}
+
+	var ntf *notify.Service
+	if !*dryRun {
+		rdb, err := redisplatform.Open(ctx, e.cfg.RedisAddr, e.cfg.RedisPassword, e.cfg.RedisDB)
+		if err != nil {
+			return fmt.Errorf("redis open: %w", err)
+		}
+		defer rdb.Close()
+		users := usersvc.NewService(pgrepo.NewUserRepository(e.pg), rcache.NewUserCache(rdb, 5*time.Second))
+		ntf = notify.NewService(tg.NewClientFromEnv(), channels.NewService(rdb), e.cfg.WebAppBaseURL, rdb, users)
+	}
+
+	if *results {
+		fmt.Printf("%spost results to %d sponsor channels\n", prefix, len(g.Sponsors))
+		if ntf != nil {
+			if err := ntf.PostScheduled(ctx, g, dg.ScheduleKindResults, ""); err != nil {
+				fmt.Printf("results post failed: %v\n", err)
+			}
+		}
+	}
+	if *winners {
+		sent := 0
+		for i, w := range g.Winners {
+			fmt.Printf("%sDM winner %d (place %d)\n", prefix, w.UserID, w.Place)
+			if ntf == nil {
+				continue
+			}
+			if i > 0 {
+				time.Sleep(150 * time.Millisecond)
+			}
+			if err := ntf.SendWinnerDM(ctx, g, w.UserID); err != nil {
+				fmt.Printf("DM to %d failed: %v\n", w.UserID, err)
+				continue
+			}
+			sent++
+		}
+		if ntf != nil {
+			fmt.Printf("sent %d of %d winner DMs\n", sent, len(g.Winners))
+		}
+	}
+	if *creator {
+		fmt.Printf("%sDM creator %d\n", prefix, g.CreatorID)
+		if ntf != nil {
+			ntf.NotifyCreatorCompleted(ctx, g)
+		}
+	}
+	return nil
+}
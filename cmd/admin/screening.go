@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
+)
+
+func runDenyWallet(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("deny-wallet", flag.ExitOnError)
+	address := fs.String("address", "", "wallet address (friendly or raw)")
+	reason := fs.String("reason", "", "why payouts to this wallet are blocked")
+	remove := fs.Bool("remove", false, "remove the address from the deny-list instead")
+	dryRun := fs.Bool("dry-run", true, "print changes without writing them")
+	_ = fs.Parse(args)
+
+	if *address == "" {
+		return errors.New("-address is required")
+	}
+	raw := tonb.NormalizeAddress(*address)
+	repo := pgrepo.NewScreeningRepository(e.pg)
+	denied, current, err := repo.IsDenied(ctx, raw)
+	if err != nil {
+		return err
+	}
+	if *remove {
+		if !denied {
+			fmt.Printf("%s is not deny-listed\n", raw)
+			return nil
+		}
+		fmt.Printf("%sremove %s (was: %q)\n", dryRunPrefix(*dryRun), raw, current)
+		if *dryRun {
+			return nil
+		}
+		_, err = repo.Allow(ctx, raw)
+		return err
+	}
+	fmt.Printf("%sdeny %s: %q\n", dryRunPrefix(*dryRun), raw, *reason)
+	if *dryRun {
+		return nil
+	}
+	return repo.Deny(ctx, raw, *reason)
+}
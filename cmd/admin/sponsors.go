@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+func runEnrichSponsors(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("enrich-sponsors", flag.ExitOnError)
+	giveawayID := fs.String("giveaway", "", "giveaway id (default: all scheduled, active and pending giveaways)")
+	dryRun := fs.Bool("dry-run", true, "print changes without writing them")
+	delay := fs.Duration("delay", 100*time.Millisecond, "pause between Telegram calls")
+	_ = fs.Parse(args)
+
+	repo := pgrepo.NewGiveawayRepository(e.pg)
+	client := tg.NewClientFromEnv()
+	sponsors, err := repo.ListSponsors(ctx, *giveawayID)
+	if err != nil {
+		return err
+	}
+
+	// Several giveaways usually share a channel; fetch each one once
+	infos := map[int64]*tg.PublicChannelInfo{}
+	changed, failed := 0, 0
+	for _, s := range sponsors {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.ChannelID == 0 {
+			continue
+		}
+		info, ok := infos[s.ChannelID]
+		if !ok {
+			info, err = client.GetPublicChannelInfoByID(ctx, s.ChannelID)
+			if err != nil {
+				fmt.Printf("skip %s sponsor %d (channel %d): %v\n", s.GiveawayID, s.ID, s.ChannelID, err)
+				failed++
+				infos[s.ChannelID] = nil
+				continue
+			}
+			infos[s.ChannelID] = info
+			time.Sleep(*delay)
+		}
+		if info == nil {
+			continue
+		}
+		next := s
+		if info.Title != "" {
+			next.Title = info.Title
+		}
+		next.Username = info.Username
+		if info.ChannelURL != "" {
+			next.URL = info.ChannelURL
+		}
+		if info.AvatarURL != "" {
+			next.AvatarURL = info.AvatarURL
+		}
+		if next == s {
+			continue
+		}
+		fmt.Printf("%supdate %s sponsor %d (channel %d): title %q -> %q, username %q -> %q, url %q -> %q, avatar %q -> %q\n",
+			dryRunPrefix(*dryRun), s.GiveawayID, s.ID, s.ChannelID, s.Title, next.Title, s.Username, next.Username, s.URL, next.URL, s.AvatarURL, next.AvatarURL)
+		if s.TitleOverride != "" || s.AvatarOverride != "" {
+			fmt.Printf("  creator display override kept: title %q, avatar %q\n", s.TitleOverride, s.AvatarOverride)
+		}
+		changed++
+		if *dryRun {
+			continue
+		}
+		if err := repo.UpdateSponsorMetadata(ctx, next); err != nil {
+			return fmt.Errorf("update sponsor %d: %w", s.ID, err)
+		}
+	}
+	fmt.Printf("%s%d sponsors checked, %d changed, %d channels failed\n", dryRunPrefix(*dryRun), len(sponsors), changed, failed)
+	return nil
+}
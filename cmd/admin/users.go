@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+func runVerifyUser(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("verify-user", flag.ExitOnError)
+	userID := fs.Int64("user", 0, "telegram user id")
+	verified := fs.Bool("verified", true, "grant (true) or revoke (false) the badge")
+	dryRun := fs.Bool("dry-run", true, "print changes without writing them")
+	_ = fs.Parse(args)
+
+	if *userID == 0 {
+		return errors.New("-user is required")
+	}
+	repo := pgrepo.NewUserRepository(e.pg)
+	u, err := repo.GetByID(ctx, *userID)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		return fmt.Errorf("user %d not found", *userID)
+	}
+	fmt.Printf("%suser %d (@%s): verified=%t\n", dryRunPrefix(*dryRun), u.ID, u.Username, *verified)
+	if *dryRun {
+		return nil
+	}
+	_, err = repo.SetVerified(ctx, u.ID, *verified)
+	return err
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+func runVerifyWinners(ctx context.Context, e *env, args []string) error {
+	fs := flag.NewFlagSet("verify-winners", flag.ExitOnError)
+	giveawayID := fs.String("giveaway", "", "giveaway id (default: all completed and finished giveaways)")
+	_ = fs.Parse(args)
+
+	issues, err := pgrepo.NewGiveawayRepository(e.pg).VerifyWinnerIntegrity(ctx, *giveawayID)
+	if err != nil {
+		return err
+	}
+	for _, is := range issues {
+		fmt.Printf("%s\t%s\t%s\n", is.GiveawayID, is.Kind, is.Detail)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%d integrity issues found", len(issues))
+	}
+	fmt.Println("no integrity issues found")
+	return nil
+}
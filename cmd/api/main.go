@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
+	"net"
+	"os"
 	"os/signal"
 	"syscall"
 	"time"
@@ -10,19 +13,34 @@ import (
 	"github.com/joho/godotenv"
 	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
 	"github.com/open-builders/giveaway-backend/internal/config"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	appgrpc "github.com/open-builders/giveaway-backend/internal/grpc"
 	apphttp "github.com/open-builders/giveaway-backend/internal/http"
 	"github.com/open-builders/giveaway-backend/internal/platform/db"
+	"github.com/open-builders/giveaway-backend/internal/platform/eventbus"
 	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
 	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/service/analytics"
 	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/service/crm"
+	deletionsvc "github.com/open-builders/giveaway-backend/internal/service/deletion"
+	"github.com/open-builders/giveaway-backend/internal/service/exports"
 	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/housekeeping"
+	"github.com/open-builders/giveaway-backend/internal/service/joinconfirm"
+	ledgersvc "github.com/open-builders/giveaway-backend/internal/service/ledger"
 	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	schedulesvc "github.com/open-builders/giveaway-backend/internal/service/schedule"
+	"github.com/open-builders/giveaway-backend/internal/service/screening"
+	statssvc "github.com/open-builders/giveaway-backend/internal/service/stats"
 	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
 	"github.com/open-builders/giveaway-backend/internal/service/tonbalance"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
 	"github.com/open-builders/giveaway-backend/internal/workers"
 	migfs "github.com/open-builders/giveaway-backend/migrations"
 	"github.com/pressly/goose/v3"
+	grpcgo "google.golang.org/grpc"
 )
 
 func main() {
@@ -33,10 +51,16 @@ func main() {
 	_ = godotenv.Load()                 // loads ".env" if present (does not override existing env)
 	_ = godotenv.Overload(".env.local") // optional: allow .env.local to override
 
+	selftest := flag.Bool("selftest", false, "check bot token, webhook, database, Redis and TonAPI, print a report and exit")
+	flag.Parse()
+
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("config load: %v", err)
 	}
+	if *selftest {
+		os.Exit(runSelftest(ctx, cfg))
+	}
 
 	pg, err := db.Open(ctx, cfg.DatabaseURL)
 	if err != nil {
@@ -60,8 +84,15 @@ func main() {
 		log.Fatalf("redis open: %v", err)
 	}
 	defer rdb.Close()
+	shards, err := redisplatform.OpenShards(ctx, rdb, cfg.RedisShardAddrs, cfg.RedisPassword, cfg.RedisDB)
+	if err != nil {
+		log.Fatalf("redis shards: %v", err)
+	}
+	defer shards.Close()
+
+	files := openStorage(cfg)
 
-	app := apphttp.NewFiberApp(pg, rdb, cfg)
+	app := apphttp.NewFiberApp(pg, rdb, shards, files, cfg)
 
 	// Start background worker for finishing expired giveaways
 	chs := channels.NewService(rdb)
@@ -76,8 +107,18 @@ func main() {
 	urepo := pgrepo.NewUserRepository(pg)
 	ucache := rcache.NewUserCache(rdb, 5*time.Second)
 	usvc := usersvc.NewService(urepo, ucache)
-	notifier := notify.NewService(tgClient, chs, cfg.WebAppBaseURL, rdb, usvc)
-	expSvc = expSvc.WithTelegram(tgClient).WithNotifier(notifier).WithUser(usvc).WithTonBalance(tbs)
+	// Worker messages go through a priority queue so winner DMs overtake bulk broadcasts
+	sendQueue := tg.NewSendQueue(tgClient, tg.SendQueueOptions{RatePerSec: cfg.TelegramSendRate, Size: cfg.TelegramSendQueueSize})
+	go sendQueue.Start(ctx)
+	deletions := pgrepo.NewDeletionRepository(pg)
+	notifier := notify.NewService(sendQueue, chs, cfg.WebAppBaseURL, rdb, usvc).WithChannelPosts(deletions).WithCovers(files)
+	expSvc = expSvc.WithTelegram(sendQueue).WithNotifier(notifier).WithRedis(rdb).WithShards(shards).WithUser(usvc).WithTonBalance(tbs).
+		WithPendingPolicy(time.Duration(cfg.PendingTTLSec)*time.Second, dg.PendingAction(cfg.PendingAction)).
+		WithEscrow(cfg.EscrowWalletAddress).WithUnverifiablePolicy(cfg.UnverifiableRequirements == "pause").
+		WithReputation(cfg.ReputationApprovalThreshold).
+		WithCompletionSLA(time.Duration(cfg.CompletionSLASec)*time.Second, cfg.TelegramAdminID).
+		WithBadges(dg.BadgeThresholds{EndingSoon: time.Duration(cfg.EndingSoonSec) * time.Second, JustStarted: time.Duration(cfg.JustStartedSec) * time.Second}).
+		WithFinishConfirmation(cfg.FinishConfirmMinParticipants, time.Duration(cfg.FinishPreviewLeadSec)*time.Second)
 
 	// Check for completed giveaways with no winners and re-process them on startup
 	// go func() {
@@ -105,10 +146,140 @@ func main() {
 		}
 	}()
 
+	// Start content plan worker (scheduled giveaway posts)
+	schedSvc := schedulesvc.NewService(pgrepo.NewScheduleRepository(pg), expRepo, notifier)
+	go workers.NewScheduleWorker(schedSvc, time.Duration(cfg.ScheduleIntervalSec)*time.Second).Start(ctx)
+
+	// Draw or cancel giveaways left pending past their TTL
+	go workers.NewPendingWorker(expSvc, time.Duration(cfg.PendingIntervalSec)*time.Second).Start(ctx)
+
+	// Re-evaluate bonus tasks so tickets follow what participants did after joining
+	if cfg.BonusRecheckIntervalSec > 0 {
+		go workers.NewBonusWorker(expSvc, time.Duration(cfg.BonusRecheckIntervalSec)*time.Second).Start(ctx)
+	}
+
+	// Draw winner waves of running giveaways
+	go workers.NewWaveWorker(expSvc, time.Duration(cfg.WaveIntervalSec)*time.Second).Start(ctx)
+
+	// Credit escrow deposits so funded giveaways go active, then screen winner wallets before payouts
+	if cfg.EscrowWalletAddress != "" {
+		screener := screening.Chain{screening.NewDenyList(pgrepo.NewScreeningRepository(pg))}
+		if cfg.ScreeningProviderURL != "" {
+			screener = append(screener, screening.NewHTTPProvider(cfg.ScreeningProviderURL, cfg.ScreeningProviderToken))
+		}
+		expSvc.WithScreener(screener)
+		go workers.NewFundingWorker(expSvc, time.Duration(cfg.FundingIntervalSec)*time.Second).Start(ctx)
+	}
+
+	// Score creators and cancel held giveaways that ended before review
+	go workers.NewReputationWorker(expSvc, time.Duration(cfg.ReputationIntervalSec)*time.Second).Start(ctx)
+
+	// Alert admins about giveaways the expiry loop left unfinished past the SLA
+	go workers.NewCompletionSLAWorker(expSvc, time.Duration(cfg.CompletionSLAIntervalSec)*time.Second).Start(ctx)
+
+	// Rebuild the ending soon feed from the database so status changes outside the service show up
+	go workers.NewEndingSoonWorker(expSvc, time.Duration(cfg.EndingSoonSyncIntervalSec)*time.Second).Start(ctx)
+
+	// Serve the most viewed giveaway pages from cache, also right after a deploy
+	if cfg.CacheWarmIntervalSec > 0 {
+		go workers.NewCacheWarmWorker(expSvc, time.Duration(cfg.CacheWarmIntervalSec)*time.Second, cfg.CacheWarmTop).Start(ctx)
+	}
+
+	// Queue giveaways whose creator blocked the bot or whose channels became inaccessible for review
+	go workers.NewDeadGiveawayWorker(expSvc, time.Duration(cfg.DeadGiveawayIntervalSec)*time.Second).Start(ctx)
+
+	// Refund ticket purchases of cancelled giveaways
+	refunds := ledgersvc.NewService(pgrepo.NewLedgerRepository(pg), expRepo).WithRefunder(tgClient)
+	go workers.NewRefundWorker(refunds, time.Duration(cfg.RefundIntervalSec)*time.Second).Start(ctx)
+
+	// Revoke export links, drop Redis keys and remove channel posts of deleted giveaways
+	go workers.NewDeletionWorker(deletionsvc.NewService(deletions, rdb, sendQueue).WithShards(shards), time.Duration(cfg.DeletionIntervalSec)*time.Second).Start(ctx)
+
+	// Post new participants to creator CRM webhooks
+	go workers.NewCRMWebhookWorker(crm.NewService(pgrepo.NewCRMWebhookRepository(pg)), time.Duration(cfg.CRMWebhookIntervalSec)*time.Second).Start(ctx)
+
+	// Post winners announcements to sponsor channels once the creator's delay elapsed
+	go workers.NewResultPostWorker(expSvc, time.Duration(cfg.ResultPostIntervalSec)*time.Second).Start(ctx)
+
+	// Promote reserve winners in place of winners who missed the claim deadline
+	go workers.NewClaimDeadlineWorker(expSvc, time.Duration(cfg.ClaimDeadlineIntervalSec)*time.Second).Start(ctx)
+
+	// Remove orphaned Redis keys (prepared messages, export tokens, channel avatars)
+	go workers.NewHousekeepingWorker(housekeeping.NewService(rdb, expRepo), time.Duration(cfg.HousekeepingIntervalSec)*time.Second).Start(ctx)
+
+	// Recompute cached platform statistics
+	statsSvc := statssvc.NewService(expRepo, rdb, time.Duration(cfg.StatsIntervalSec)*3*time.Second).WithShards(shards)
+	go workers.NewStatsWorker(statsSvc, time.Duration(cfg.StatsIntervalSec)*time.Second).Start(ctx)
+
+	// Deliver participant broadcasts (e.g. cancellation notices)
+	go workers.NewBroadcastWorker(pgrepo.NewBroadcastRepository(pg), notifier, time.Duration(cfg.BroadcastIntervalSec)*time.Second, cfg.BroadcastBatchSize).Start(ctx)
+
+	// Render queued exports into file storage
+	exportSvc := exports.NewService(pgrepo.NewExportJobRepository(pg), expRepo, usvc, files).WithNotifier(notifier)
+	go workers.NewExportWorker(exportSvc, time.Duration(cfg.ExportIntervalSec)*time.Second).Start(ctx)
+
 	// Start Redis stream worker
-	streamWorker := workers.NewRedisStreamWorker(rdb, expRepo)
+	streamWorker := workers.NewRedisStreamWorker(rdb, expSvc)
 	go streamWorker.Start(ctx)
 
+	// Relay domain events from the outbox to the configured bus and in-process subscribers
+	var bus eventbus.Bus
+	if cfg.EventBus != "" {
+		bus, err = eventbus.Open(ctx, eventbus.Options{
+			Kind:        cfg.EventBus,
+			RedisStream: cfg.EventBusRedisStream,
+			NATSURL:     cfg.EventBusNATSURL,
+			NATSSubject: cfg.EventBusNATSSubject,
+		}, rdb)
+		if err != nil {
+			log.Fatalf("event bus: %v", err)
+		}
+		defer bus.Close()
+	} else {
+		log.Printf("events: EVENT_BUS disabled, outbox events are delivered to in-process subscribers only")
+	}
+	joinConfirmations := joinconfirm.NewService(pgrepo.NewJoinConfirmationRepository(pg), expRepo, notifier)
+	go workers.NewOutboxWorker(pgrepo.NewOutboxRepository(pg), bus, time.Duration(cfg.OutboxIntervalSec)*time.Second).
+		Subscribe(joinConfirmations.HandleEvent).Start(ctx)
+
+	// Export funnel events to the analytical store
+	if cfg.AnalyticsSink != "" {
+		sink, err := analytics.NewSink(analytics.Options{
+			Kind:                    cfg.AnalyticsSink,
+			ClickHouseURL:           cfg.AnalyticsClickHouseURL,
+			ClickHouseDatabase:      cfg.AnalyticsClickHouseDatabase,
+			ClickHouseTable:         cfg.AnalyticsClickHouseTable,
+			BigQueryProject:         cfg.AnalyticsBigQueryProject,
+			BigQueryDataset:         cfg.AnalyticsBigQueryDataset,
+			BigQueryTable:           cfg.AnalyticsBigQueryTable,
+			BigQueryCredentialsFile: cfg.AnalyticsBigQueryCredentialsFile,
+		})
+		if err != nil {
+			log.Fatalf("analytics sink: %v", err)
+		}
+		go workers.NewAnalyticsWorker(rdb, sink, time.Duration(cfg.AnalyticsFlushIntervalSec)*time.Second, cfg.AnalyticsBatchSize).Start(ctx)
+	}
+
+	// Internal gRPC API for service-to-service calls
+	var grpcSrv *grpcgo.Server
+	if cfg.GRPCAddr != "" {
+		if cfg.GRPCAuthToken == "" {
+			log.Printf("grpc: GRPC_AUTH_TOKEN is empty, internal API disabled")
+		} else {
+			lis, err := net.Listen("tcp", cfg.GRPCAddr)
+			if err != nil {
+				log.Fatalf("grpc listen: %v", err)
+			}
+			grpcSrv = appgrpc.NewServer(expSvc, cfg.GRPCAuthToken)
+			go func() {
+				log.Printf("gRPC server listening on %s", cfg.GRPCAddr)
+				if err := grpcSrv.Serve(lis); err != nil {
+					log.Printf("grpc serve: %v", err)
+				}
+			}()
+		}
+	}
+
 	go func() {
 		log.Printf("HTTP server (Fiber) listening on %s", cfg.HTTPAddr)
 		if err := app.Listen(cfg.HTTPAddr); err != nil {
@@ -124,5 +295,39 @@ func main() {
 	if err := app.Shutdown(); err != nil {
 		log.Printf("server shutdown: %v", err)
 	}
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
+	}
 	log.Println("server stopped")
 }
+
+// openStorage opens the file storage for exports and avatars, or returns nil when it is
+// disabled or fails to open.
+func openStorage(cfg *config.Config) storage.Storage {
+	if cfg.StorageBackend == "" {
+		return nil
+	}
+	signingKey := cfg.StorageSigningKey
+	if signingKey == "" {
+		signingKey = cfg.TelegramBotToken
+	}
+	st, err := storage.Open(storage.Options{
+		Kind:               cfg.StorageBackend,
+		LocalDir:           cfg.StorageLocalDir,
+		LocalBaseURL:       cfg.StoragePublicBaseURL,
+		LocalSigningKey:    signingKey,
+		S3Endpoint:         cfg.StorageS3Endpoint,
+		S3Region:           cfg.StorageS3Region,
+		S3Bucket:           cfg.StorageS3Bucket,
+		S3AccessKey:        cfg.StorageS3AccessKey,
+		S3SecretKey:        cfg.StorageS3SecretKey,
+		S3PathStyle:        cfg.StorageS3PathStyle,
+		GCSBucket:          cfg.StorageGCSBucket,
+		GCSCredentialsFile: cfg.StorageGCSCredentialsFile,
+	})
+	if err != nil {
+		log.Printf("file storage disabled: %v", err)
+		return nil
+	}
+	return st
+}
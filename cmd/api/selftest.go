@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/config"
+	"github.com/open-builders/giveaway-backend/internal/platform/db"
+	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	"github.com/open-builders/giveaway-backend/internal/service/tonbalance"
+	migfs "github.com/open-builders/giveaway-backend/migrations"
+	"github.com/pressly/goose/v3"
+)
+
+// selftestTables must exist for the API to serve giveaways at all.
+var selftestTables = []string{
+	"users", "giveaways", "giveaway_prizes", "giveaway_sponsors", "giveaway_participants",
+	"giveaway_winners", "giveaway_winner_prizes", "giveaway_requirements", "event_outbox",
+}
+
+// selftestCheck is one line of the self-test report.
+type selftestCheck struct {
+	name   string
+	detail string
+	err    error
+}
+
+// runSelftest checks the bot token, webhook, database schema, Redis and TonAPI, prints a
+// report and returns the process exit code: 1 when any check failed.
+func runSelftest(ctx context.Context, cfg *config.Config) int {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var checks []selftestCheck
+	add := func(name, detail string, err error) {
+		checks = append(checks, selftestCheck{name: name, detail: detail, err: err})
+	}
+
+	client := tg.NewClientFromEnv()
+	if me, err := client.GetMe(ctx); err != nil {
+		add("telegram bot token", "", err)
+	} else {
+		add("telegram bot token", fmt.Sprintf("@%s (id %d)", me.Username, me.ID), nil)
+	}
+	if wh, err := client.GetWebhookInfo(ctx); err != nil {
+		add("telegram webhook", "", err)
+	} else if wh.URL == "" {
+		add("telegram webhook", "", fmt.Errorf("no webhook registered"))
+	} else {
+		detail := fmt.Sprintf("%s, %d pending updates", wh.URL, wh.PendingUpdateCount)
+		if wh.LastErrorMessage != "" {
+			detail += fmt.Sprintf(", last error at %s: %s", time.Unix(wh.LastErrorDate, 0).UTC().Format(time.RFC3339), wh.LastErrorMessage)
+		}
+		add("telegram webhook", detail, nil)
+	}
+
+	if pg, err := db.Open(ctx, cfg.DatabaseURL); err != nil {
+		add("postgres", "", err)
+	} else {
+		defer pg.Close()
+		detail, err := selftestMigrations(pg)
+		add("postgres migrations", detail, err)
+		detail, err = selftestSchema(ctx, pg)
+		add("postgres tables", detail, err)
+	}
+
+	if rdb, err := redisplatform.Open(ctx, cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB); err != nil {
+		add("redis", "", err)
+	} else {
+		defer rdb.Close()
+		detail, err := selftestKeyspaceEvents(ctx, rdb, cfg.RedisKeyspaceEvents)
+		add("redis keyspace notifications", detail, err)
+		if len(cfg.RedisShardAddrs) > 0 {
+			shards, err := redisplatform.OpenShards(ctx, rdb, cfg.RedisShardAddrs, cfg.RedisPassword, cfg.RedisDB)
+			if err == nil {
+				defer shards.Close()
+			}
+			add("redis shards", fmt.Sprintf("%d shards", len(cfg.RedisShardAddrs)), err)
+		}
+	}
+
+	tbs := tonbalance.NewService(cfg.TonAPIBaseURL, cfg.TonAPIToken)
+	add("tonapi", cfg.TonAPIBaseURL, tbs.Ping(ctx))
+
+	failed := 0
+	for _, c := range checks {
+		status, detail := "ok", c.detail
+		if c.err != nil {
+			status, detail = "FAIL", c.err.Error()
+			failed++
+		}
+		if token := os.Getenv("TELEGRAM_BOT_TOKEN"); token != "" {
+			// Bot API errors carry the request URL, which contains the token
+			detail = strings.ReplaceAll(detail, token, "<token>")
+		}
+		if detail != "" {
+			detail = ": " + detail
+		}
+		fmt.Fprintf(os.Stdout, "[%-4s] %s%s\n", status, c.name, detail)
+	}
+	if failed > 0 {
+		fmt.Fprintf(os.Stdout, "selftest: %d of %d checks failed\n", failed, len(checks))
+		return 1
+	}
+	fmt.Fprintf(os.Stdout, "selftest: all %d checks passed\n", len(checks))
+	return 0
+}
+
+// selftestMigrations compares the applied goose version with the newest embedded migration.
+func selftestMigrations(pg *sql.DB) (string, error) {
+	if err := goose.SetDialect("postgres"); err != nil {
+		return "", err
+	}
+	goose.SetBaseFS(migfs.Files)
+	migrations, err := goose.CollectMigrations(".", 0, goose.MaxVersion)
+	if err != nil {
+		return "", err
+	}
+	current, err := goose.GetDBVersion(pg)
+	if err != nil {
+		return "", err
+	}
+	latest := migrations[len(migrations)-1].Version
+	pending := 0
+	for _, m := range migrations {
+		if m.Version > current {
+			pending++
+		}
+	}
+	if pending > 0 {
+		return "", fmt.Errorf("version %d, %d migrations pending up to %d", current, pending, latest)
+	}
+	return fmt.Sprintf("version %d", current), nil
+}
+
+func selftestSchema(ctx context.Context, pg *sql.DB) (string, error) {
+	var missing []string
+	for _, t := range selftestTables {
+		var found sql.NullString
+		if err := pg.QueryRowContext(ctx, `SELECT to_regclass($1)::text`, t).Scan(&found); err != nil {
+			return "", err
+		}
+		if !found.Valid {
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing %s", strings.Join(missing, ", "))
+	}
+	return fmt.Sprintf("%d required tables present", len(selftestTables)), nil
+}
+
+// selftestKeyspaceEvents checks that notify-keyspace-events contains every flag of required.
+func selftestKeyspaceEvents(ctx context.Context, rdb *redisplatform.Client, required string) (string, error) {
+	res, err := rdb.ConfigGet(ctx, "notify-keyspace-events").Result()
+	if err != nil {
+		if required == "" {
+			// Managed Redis often disables CONFIG; nothing is required, so only report it
+			return "CONFIG GET unavailable, not required", nil
+		}
+		return "", err
+	}
+	current := res["notify-keyspace-events"]
+	var missing []string
+	for _, f := range required {
+		// "A" is an alias for the event classes g$lshzxetmd
+		if !strings.ContainsRune(current, f) && !(strings.ContainsRune(current, 'A') && strings.ContainsRune("g$lshzxetmd", f)) {
+			missing = append(missing, string(f))
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("notify-keyspace-events=%q lacks %s (REDIS_KEYSPACE_EVENTS=%q)", current, strings.Join(missing, ""), required)
+	}
+	return fmt.Sprintf("notify-keyspace-events=%q", current), nil
+}
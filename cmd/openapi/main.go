@@ -0,0 +1,56 @@
+// Command openapi writes the OpenAPI document of the mini app API from the typed request and
+// response structs, for client generators such as openapi-typescript or oapi-codegen:
+//
+//	go run ./cmd/openapi -out api/openapi/v1.json
+//
+// Run it from the module root so struct comments become field descriptions. go generate and
+// make openapi do the same; the committed document is checked against the structs by the
+// tests of this command and by make openapi-check.
+package main
+
+//go:generate go run . -root ../.. -out ../../api/openapi/v1.json
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	apphttp "github.com/open-builders/giveaway-backend/internal/http"
+	"github.com/open-builders/giveaway-backend/internal/utils/openapi"
+)
+
+func main() {
+	out := flag.String("out", "", "write the document to this file instead of stdout")
+	root := flag.String("root", ".", "module root to read struct comments from")
+	flag.Parse()
+
+	doc, err := generate(*root)
+	if err != nil {
+		log.Fatalf("build: %v", err)
+	}
+	if *out == "" {
+		if _, err := os.Stdout.Write(doc); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if err := os.WriteFile(*out, doc, 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
+
+// generate builds the document with field descriptions read from the sources under root.
+func generate(root string) ([]byte, error) {
+	spec := apphttp.APISpec()
+	docs, err := openapi.SourceDocs(root)
+	if err != nil {
+		log.Printf("no field descriptions: %v", err)
+	} else {
+		spec.Docs = docs
+	}
+	doc, err := openapi.Build(spec)
+	if err != nil {
+		return nil, err
+	}
+	return append(doc, '\n'), nil
+}
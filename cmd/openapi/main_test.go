@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestCommittedDocument fails when a request or response struct changed without regenerating
+// api/openapi/v1.json.
+func TestCommittedDocument(t *testing.T) {
+	want, err := generate("../..")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+	got, err := os.ReadFile("../../api/openapi/v1.json")
+	if err != nil {
+		t.Fatalf("read committed document: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("api/openapi/v1.json is stale; run make openapi or go generate ./cmd/openapi")
+	}
+}
@@ -0,0 +1,263 @@
+// Command seed fills a development database and Redis with demo data: creators with
+// channels, giveaways in every status, participants and winners.
+//
+// Demo users get ids from a reserved range so a rerun with -reset replaces them
+// without touching real accounts. Never point this at production.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/joho/godotenv"
+	"github.com/open-builders/giveaway-backend/internal/config"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/platform/db"
+	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	// Telegram user ids are far below this range
+	demoUserBase    int64 = 9_000_000_000
+	demoChannelBase int64 = -1009000000000
+)
+
+var (
+	firstNames  = []string{"Alex", "Maria", "Ivan", "Sofia", "Dmitry", "Anna", "Max", "Elena", "Leo", "Nika"}
+	topics      = []string{"Crypto", "Design", "Gaming", "Travel", "Music", "Tech", "Books", "Fitness"}
+	prizeTitles = []string{"Telegram Premium 3 months", "100 USDT", "10 TON", "Hoodie", "Sticker pack", "NFT username", "Gift card"}
+	seedSources = []dg.ParticipantSource{dg.ParticipantSourceAnnouncement, dg.ParticipantSourceInline, dg.ParticipantSourceExplore, dg.ParticipantSourceDirect}
+)
+
+type seeder struct {
+	users     *pgrepo.UserRepository
+	giveaways *pgrepo.GiveawayRepository
+	rdb       *redisplatform.Client
+	rng       *rand.Rand
+	// participant pool ids
+	pool []int64
+}
+
+func main() {
+	scale := flag.Int("scale", 1, "data volume multiplier (1 = 5 creators, 200 participants)")
+	seed := flag.Uint64("seed", 1, "random seed; the same seed yields the same data set")
+	reset := flag.Bool("reset", false, "delete previously seeded demo users and everything they own first")
+	flag.Parse()
+	if *scale < 1 {
+		log.Fatal("-scale must be >= 1")
+	}
+
+	_ = godotenv.Load()
+	_ = godotenv.Overload(".env.local")
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("config load: %v", err)
+	}
+	pg, err := db.Open(ctx, cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("postgres open: %v", err)
+	}
+	defer pg.Close()
+	rdb, err := redisplatform.Open(ctx, cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	if err != nil {
+		log.Fatalf("redis open: %v", err)
+	}
+	defer rdb.Close()
+
+	s := &seeder{
+		users:     pgrepo.NewUserRepository(pg),
+		giveaways: pgrepo.NewGiveawayRepository(pg),
+		rdb:       rdb,
+		rng:       rand.New(rand.NewPCG(*seed, *seed^0x5eed)),
+	}
+	creators := 5 * *scale
+	poolSize := 200 * *scale
+
+	if *reset {
+		if err := s.reset(ctx, creators+poolSize); err != nil {
+			log.Fatalf("reset: %v", err)
+		}
+	}
+	if err := s.run(ctx, creators, poolSize); err != nil {
+		log.Fatalf("seed: %v", err)
+	}
+}
+
+// reset removes demo users; giveaways, participants and winners go with them via ON DELETE CASCADE.
+func (s *seeder) reset(ctx context.Context, n int) error {
+	for i := 0; i < n; i++ {
+		uid := demoUserBase + int64(i)
+		if err := s.users.Delete(ctx, uid); err != nil {
+			return err
+		}
+		if err := s.rdb.Del(ctx, fmt.Sprintf("user:%d:channels", uid)).Err(); err != nil {
+			return err
+		}
+	}
+	log.Printf("removed up to %d demo users", n)
+	return nil
+}
+
+func (s *seeder) run(ctx context.Context, creators, poolSize int) error {
+	// Participants first so creators can be drawn from the same id range without overlap
+	for i := 0; i < poolSize; i++ {
+		uid := demoUserBase + int64(creators+i)
+		if err := s.upsertUser(ctx, uid, "demo_user_"+strconv.Itoa(i)); err != nil {
+			return err
+		}
+		s.pool = append(s.pool, uid)
+	}
+
+	total := 0
+	for c := 0; c < creators; c++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		creatorID := demoUserBase + int64(c)
+		if err := s.upsertUser(ctx, creatorID, "demo_creator_"+strconv.Itoa(c)); err != nil {
+			return err
+		}
+		channels, err := s.createChannels(ctx, creatorID, c)
+		if err != nil {
+			return err
+		}
+		for _, status := range []dg.GiveawayStatus{
+			dg.GiveawayStatusScheduled,
+			dg.GiveawayStatusActive,
+			dg.GiveawayStatusActive,
+			dg.GiveawayStatusPending,
+			dg.GiveawayStatusCompleted,
+			dg.GiveawayStatusCompleted,
+			dg.GiveawayStatusCancelled,
+		} {
+			if err := s.createGiveaway(ctx, creatorID, channels, status); err != nil {
+				return err
+			}
+			total++
+		}
+	}
+	log.Printf("seeded %d creators, %d participants, %d giveaways", creators, poolSize, total)
+	return nil
+}
+
+func (s *seeder) upsertUser(ctx context.Context, id int64, username string) error {
+	now := time.Now().UTC()
+	return s.users.Upsert(ctx, &du.User{
+		ID:        id,
+		Username:  username,
+		FirstName: firstNames[s.rng.IntN(len(firstNames))],
+		IsPremium: s.rng.IntN(4) == 0,
+		Role:      "user",
+		Status:    "active",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+// createChannels registers two channels per creator in Redis the way the bot does when it is added as admin.
+func (s *seeder) createChannels(ctx context.Context, creatorID int64, idx int) ([]dg.ChannelInfo, error) {
+	out := make([]dg.ChannelInfo, 0, 2)
+	for j := 0; j < 2; j++ {
+		id := demoChannelBase - int64(idx*2+j)
+		topic := topics[s.rng.IntN(len(topics))]
+		username := fmt.Sprintf("demo_%s_%d", topic, idx*2+j)
+		ch := dg.ChannelInfo{ID: id, Username: username, Title: fmt.Sprintf("%s Daily #%d", topic, idx*2+j), URL: "https://t.me/" + username}
+		pipe := s.rdb.Pipeline()
+		pipe.Set(ctx, fmt.Sprintf("channel:%d:title", id), ch.Title, 0)
+		pipe.Set(ctx, fmt.Sprintf("channel:%d:username", id), ch.Username, 0)
+		pipe.Set(ctx, fmt.Sprintf("channel:%d:url", id), ch.URL, 0)
+		pipe.SAdd(ctx, fmt.Sprintf("user:%d:channels", creatorID), strconv.FormatInt(id, 10))
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+		out = append(out, ch)
+	}
+	return out, nil
+}
+
+func (s *seeder) createGiveaway(ctx context.Context, creatorID int64, channels []dg.ChannelInfo, status dg.GiveawayStatus) error {
+	now := time.Now().UTC()
+	duration := time.Duration(1+s.rng.IntN(14)) * 24 * time.Hour
+	var start time.Time
+	switch status {
+	case dg.GiveawayStatusScheduled:
+		start = now.Add(time.Duration(1+s.rng.IntN(72)) * time.Hour)
+	case dg.GiveawayStatusActive:
+		start = now.Add(-time.Duration(s.rng.Int64N(int64(duration / 2))))
+	default:
+		start = now.Add(-duration - time.Duration(1+s.rng.IntN(30))*24*time.Hour)
+	}
+	winners := 1 + s.rng.IntN(5)
+	sponsor := channels[s.rng.IntN(len(channels))]
+	g := &dg.Giveaway{
+		ID:              uuid.NewString(),
+		CreatorID:       creatorID,
+		Title:           fmt.Sprintf("%s giveaway", sponsor.Title),
+		Description:     "Demo giveaway generated by cmd/seed.",
+		StartedAt:       start,
+		EndsAt:          start.Add(duration),
+		Duration:        int64(duration.Seconds()),
+		MaxWinnersCount: winners,
+		// Created active so participants can join; the final status is applied below
+		Status:         dg.GiveawayStatusActive,
+		WinnerStrategy: dg.WinnerStrategyRandom,
+		CreatedAt:      start.Add(-time.Hour),
+		UpdatedAt:      now,
+		Sponsors:       []dg.ChannelInfo{sponsor},
+		Requirements: []dg.Requirement{{
+			Type: dg.RequirementTypeSubscription, ChannelID: sponsor.ID, ChannelUsername: sponsor.Username, ChannelTitle: sponsor.Title,
+		}},
+	}
+	for place := 1; place <= winners; place++ {
+		p := place
+		g.Prizes = append(g.Prizes, dg.PrizePlace{Place: &p, Title: prizeTitles[s.rng.IntN(len(prizeTitles))]})
+	}
+	if s.rng.IntN(2) == 0 {
+		g.Prizes = append(g.Prizes, dg.PrizePlace{Title: prizeTitles[s.rng.IntN(len(prizeTitles))], Quantity: 1 + s.rng.IntN(10)})
+	}
+	if err := s.giveaways.Create(ctx, g); err != nil {
+		return err
+	}
+
+	var joined []int64
+	if status != dg.GiveawayStatusScheduled {
+		n := s.rng.IntN(len(s.pool) + 1)
+		for _, i := range s.rng.Perm(len(s.pool))[:n] {
+			uid := s.pool[i]
+			ok, err := s.giveaways.Join(ctx, g.ID, uid, seedSources[s.rng.IntN(len(seedSources))], "")
+			if err != nil {
+				return err
+			}
+			if ok {
+				joined = append(joined, uid)
+			}
+		}
+	}
+
+	switch status {
+	case dg.GiveawayStatusActive:
+		return nil
+	case dg.GiveawayStatusCompleted:
+		s.rng.Shuffle(len(joined), func(i, j int) { joined[i], joined[j] = joined[j], joined[i] })
+		if len(joined) > winners {
+			joined = joined[:winners]
+		}
+		return s.giveaways.FinishWithWinners(ctx, g.ID, joined)
+	default:
+		return s.giveaways.UpdateStatus(ctx, g.ID, status)
+	}
+}
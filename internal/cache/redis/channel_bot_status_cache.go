@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	rplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+)
+
+// ChannelBotStatusEntry stores the bot's membership status in a channel.
+type ChannelBotStatusEntry struct {
+	Status          string    `json:"status"`
+	CanCheckMembers bool      `json:"can_check_members"`
+	FetchedAt       time.Time `json:"fetched_at"`
+}
+
+// ChannelBotStatusCache provides Redis-based caching of getChatMember results for the bot.
+type ChannelBotStatusCache struct {
+	client *rplatform.Client
+	ttl    time.Duration
+}
+
+func NewChannelBotStatusCache(client *rplatform.Client, ttl time.Duration) *ChannelBotStatusCache {
+	return &ChannelBotStatusCache{client: client, ttl: ttl}
+}
+
+func (c *ChannelBotStatusCache) key(chatID int64) string {
+	return fmt.Sprintf("channel:%d:bot_status", chatID)
+}
+
+// Get returns cached entry for a channel ID, or error when missing/failed.
+func (c *ChannelBotStatusCache) Get(ctx context.Context, chatID int64) (*ChannelBotStatusEntry, error) {
+	v, err := c.client.Get(ctx, c.key(chatID)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var e ChannelBotStatusEntry
+	if err := json.Unmarshal(v, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Set stores the status entry with TTL.
+func (c *ChannelBotStatusCache) Set(ctx context.Context, chatID int64, e *ChannelBotStatusEntry) error {
+	if e.FetchedAt.IsZero() {
+		e.FetchedAt = time.Now().UTC()
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return c.client.Set(ctx, c.key(chatID), b, c.ttl).Err()
+}
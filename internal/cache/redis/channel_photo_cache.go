@@ -19,13 +19,27 @@ type ChannelPhotoEntry struct {
 }
 
 // ChannelPhotoCache provides Redis-based short-lived caching for chat photo ids.
+// Entries are fresh for ttl; WithStaleFor keeps them longer so callers can serve a stale
+// entry while refreshing it in the background.
 type ChannelPhotoCache struct {
 	client *rplatform.Client
 	ttl    time.Duration
+	keep   time.Duration
 }
 
 func NewChannelPhotoCache(client *rplatform.Client, ttl time.Duration) *ChannelPhotoCache {
-	return &ChannelPhotoCache{client: client, ttl: ttl}
+	return &ChannelPhotoCache{client: client, ttl: ttl, keep: ttl}
+}
+
+// WithStaleFor keeps entries in Redis for keep after they were fetched (at least ttl).
+func (c *ChannelPhotoCache) WithStaleFor(keep time.Duration) *ChannelPhotoCache {
+	c.keep = max(keep, c.ttl)
+	return c
+}
+
+// Stale reports whether e is older than the cache's freshness ttl.
+func (c *ChannelPhotoCache) Stale(e *ChannelPhotoEntry) bool {
+	return time.Since(e.FetchedAt) > c.ttl
 }
 
 func (c *ChannelPhotoCache) key(chatRef string) string {
@@ -45,7 +59,7 @@ func (c *ChannelPhotoCache) Get(ctx context.Context, chatRef string) (*ChannelPh
 	return &e, nil
 }
 
-// Set stores the photo entry with TTL.
+// Set stores the photo entry until it is no longer served, even stale.
 func (c *ChannelPhotoCache) Set(ctx context.Context, chatRef string, e *ChannelPhotoEntry) error {
 	if e.FetchedAt.IsZero() {
 		e.FetchedAt = time.Now().UTC()
@@ -54,7 +68,7 @@ func (c *ChannelPhotoCache) Set(ctx context.Context, chatRef string, e *ChannelP
 	if err != nil {
 		return err
 	}
-	return c.client.Set(ctx, c.key(chatRef), b, c.ttl).Err()
+	return c.client.Set(ctx, c.key(chatRef), b, c.keep).Err()
 }
 
 // Invalidate removes cached entry for the chat reference.
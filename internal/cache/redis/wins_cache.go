@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	rplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+)
+
+// WinsCache caches pages of a user's win history. All pages of a user live in one hash
+// so a single delete invalidates them.
+type WinsCache struct {
+	client *rplatform.Client
+	ttl    time.Duration
+}
+
+func NewWinsCache(client *rplatform.Client, ttl time.Duration) *WinsCache {
+	return &WinsCache{client: client, ttl: ttl}
+}
+
+func (c *WinsCache) key(userID int64) string { return fmt.Sprintf("user:%d:wins", userID) }
+
+func pageField(limit, offset int) string { return fmt.Sprintf("%d:%d", limit, offset) }
+
+// Get returns a cached page, or error when missing/failed.
+func (c *WinsCache) Get(ctx context.Context, userID int64, limit, offset int) ([]dg.Win, error) {
+	v, err := c.client.HGet(ctx, c.key(userID), pageField(limit, offset)).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	var wins []dg.Win
+	if err := json.Unmarshal(v, &wins); err != nil {
+		return nil, err
+	}
+	return wins, nil
+}
+
+// Set stores a page; the TTL applies to all pages of the user.
+func (c *WinsCache) Set(ctx context.Context, userID int64, limit, offset int, wins []dg.Win) error {
+	b, err := json.Marshal(wins)
+	if err != nil {
+		return err
+	}
+	key := c.key(userID)
+	if err := c.client.HSet(ctx, key, pageField(limit, offset), b).Err(); err != nil {
+		return err
+	}
+	return c.client.Expire(ctx, key, c.ttl).Err()
+}
+
+// Invalidate drops all cached pages of the user.
+func (c *WinsCache) Invalidate(ctx context.Context, userID int64) error {
+	return c.client.Del(ctx, c.key(userID)).Err()
+}
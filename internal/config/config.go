@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config holds application configuration loaded from environment variables.
@@ -13,8 +14,12 @@ type Config struct {
 	RedisAddr     string
 	RedisPassword string
 	RedisDB       int
+	// Redis instances hot keys are spread over, in order; empty keeps them on RedisAddr
+	RedisShardAddrs []string
 	// Public base URL for building links to this backend (e.g., for public avatars)
 	PublicBaseURL string
+	// Base URL of /l/:code short links; defaults to PublicBaseURL
+	ShortLinkBaseURL string
 	// DB migrations
 	DBAutoMigrate bool
 	// CORS settings
@@ -25,6 +30,57 @@ type Config struct {
 	InitDataTTL      int    // TTL in seconds for init-data expiration (0 to skip)
 	// Workers
 	GiveawayExpireIntervalSec int // background worker tick seconds
+	ScheduleIntervalSec       int // content plan worker tick seconds
+	BroadcastIntervalSec      int // participant DM broadcast tick seconds
+	BroadcastBatchSize        int // DMs sent per broadcast tick
+	PendingIntervalSec        int // pending expiry worker tick seconds
+	PendingTTLSec             int // default pending lifetime; 0 keeps pending giveaways forever
+	PendingAction             string
+	BonusRecheckIntervalSec   int // bonus task re-check tick seconds; 0 disables
+	WaveIntervalSec           int // winner wave draw tick seconds
+	StatsIntervalSec          int // platform stats refresh seconds
+	FundingIntervalSec        int // escrow deposit polling seconds
+	// Unverifiable requirements: "pause" passes them with a warning, "enforce" keeps failing them
+	UnverifiableRequirements string
+	// Creator reputation: scores below the threshold hold new giveaways for approval (0 disables)
+	ReputationApprovalThreshold int
+	ReputationIntervalSec       int // reputation recompute tick seconds
+	// Orphaned Redis key cleanup tick seconds
+	HousekeepingIntervalSec int
+	// Completion SLA: alert TelegramAdminID when a giveaway is unfinished this long after ending (0 disables)
+	CompletionSLASec         int
+	CompletionSLAIntervalSec int // SLA check tick seconds
+	// Dead giveaway detection tick seconds (creator blocked the bot, channels inaccessible)
+	DeadGiveawayIntervalSec int
+	// CRM webhook delivery tick seconds
+	CRMWebhookIntervalSec int
+	// Channel result post tick seconds
+	ResultPostIntervalSec int
+	// Claim deadline check tick seconds (reserve winner promotion)
+	ClaimDeadlineIntervalSec int
+	// Finishing giveaways with more participants than this needs a co-admin confirmation; 0 disables
+	FinishConfirmMinParticipants int
+	// Seconds before a pending giveaway auto-resolves that its admins get a finish preview; 0 disables
+	FinishPreviewLeadSec int
+	// List badges: giveaways ending within EndingSoonSec or started less than JustStartedSec ago; 0 disables
+	EndingSoonSec  int
+	JustStartedSec int
+	// Rebuild tick seconds of the Redis set behind the ending soon feed
+	EndingSoonSyncIntervalSec int
+	// Page cache warming of the CacheWarmTop most viewed giveaways every CacheWarmIntervalSec; 0 disables
+	CacheWarmIntervalSec int
+	CacheWarmTop         int
+	// Eligibility acknowledgments at join for prizes restricted by admin compliance rules
+	ComplianceGateEnabled bool
+	// Outbound bot messages per second and queued messages per priority of the send queue
+	TelegramSendRate      int
+	TelegramSendQueueSize int
+	// Keyspace notification flags --selftest requires in Redis' notify-keyspace-events; empty skips the check
+	RedisKeyspaceEvents string
+	// Refund worker tick seconds (ticket purchases of cancelled giveaways)
+	RefundIntervalSec int
+	// Deletion worker tick seconds (cleanup of deleted giveaways)
+	DeletionIntervalSec int
 	// TON Proof
 	TonProofDomain        string // expected domain in proof
 	TonProofPayloadTTLSec int    // TTL for payloads
@@ -32,9 +88,60 @@ type Config struct {
 	TonAPIToken           string // optional TonAPI token (Bearer)
 	// TON Lite client
 	TonLiteConfigURL string // optional global config URL (defaults to https://ton.org/global-config.json)
+	// Escrow prize funding
+	EscrowWalletAddress string // wallet receiving prize deposits; empty disables funding
+	TonWebhookSecret    string // bearer token expected on TonAPI webhook calls
+	// Payout screening (the deny-list table is always consulted)
+	ScreeningProviderURL   string // optional external screening endpoint
+	ScreeningProviderToken string // optional bearer token for the screening endpoint
 	// WebApp
 	WebAppBaseURL string // base URL for webapp, used in notifications buttons
 	CDNURL        string // Base URL for CDN assets
+	// Internal gRPC API (disabled when GRPCAddr is empty)
+	GRPCAddr      string
+	GRPCAuthToken string // shared bearer token required on every call
+	// Audit bundles
+	AuditSigningKey string // Ed25519 seed (hex/base64); audit bundles are disabled when empty
+	// Content moderation
+	ModerationRulesFile string // JSON word/domain/impersonation lists; built-in defaults when empty
+	// Giveaway import
+	ImportMappersFile string // JSON array of extra import field mappers
+	// Join fingerprints
+	FingerprintSecret string // HMAC key for IP + user agent hashes; derived from bot token when empty
+	ProxyHeader       string // header with the client IP when behind a proxy, e.g. X-Forwarded-For
+	// Domain event bus (outbox events only reach in-process subscribers when EventBus is empty)
+	EventBus            string // "redis" or "nats"
+	EventBusRedisStream string
+	EventBusNATSURL     string
+	EventBusNATSSubject string
+	OutboxIntervalSec   int // outbox relay tick seconds
+	// Analytics export (disabled when AnalyticsSink is empty)
+	AnalyticsSink                    string // "clickhouse" or "bigquery"
+	AnalyticsFlushIntervalSec        int
+	AnalyticsBatchSize               int
+	AnalyticsClickHouseURL           string
+	AnalyticsClickHouseDatabase      string
+	AnalyticsClickHouseTable         string
+	AnalyticsBigQueryProject         string
+	AnalyticsBigQueryDataset         string
+	AnalyticsBigQueryTable           string
+	AnalyticsBigQueryCredentialsFile string
+	// File storage for exports and cached media
+	StorageBackend            string // "local", "s3" or "gcs"; "none" sends files inline
+	StorageLocalDir           string
+	StoragePublicBaseURL      string // base URL for signed links to local files; defaults to PublicBaseURL
+	StorageSigningKey         string // HMAC key for local signed links; derived from bot token when empty
+	StorageS3Endpoint         string // e.g. https://fra1.digitaloceanspaces.com; AWS when empty
+	StorageS3Region           string
+	StorageS3Bucket           string
+	StorageS3AccessKey        string
+	StorageS3SecretKey        string
+	StorageS3PathStyle        bool
+	StorageGCSBucket          string
+	StorageGCSCredentialsFile string
+	// Export jobs: worker tick seconds, and the winner count above which export endpoints queue a job
+	ExportIntervalSec    int
+	ExportSyncMaxWinners int
 }
 
 // Load reads environment variables into Config with sane defaults for local dev.
@@ -45,6 +152,7 @@ func Load() (*Config, error) {
 		RedisAddr:          getEnv("REDIS_ADDR", "localhost:6379"),
 		RedisPassword:      getEnv("REDIS_PASSWORD", ""),
 		PublicBaseURL:      getEnv("PUBLIC_BASE_URL", "https://dev-api.giveaway.tools.tg"),
+		ShortLinkBaseURL:   getEnv("SHORT_LINK_BASE_URL", ""),
 		CDNURL:             getEnv("CDN_URL", "https://tg-tools.fra1.cdn.digitaloceanspaces.com"),
 		CORSAllowedOrigins: getEnv("CORS_ALLOWED_ORIGINS", "*"),
 		TelegramBotToken:   getEnv("TELEGRAM_BOT_TOKEN", ""),
@@ -53,11 +161,47 @@ func Load() (*Config, error) {
 			id, _ := strconv.ParseInt(idStr, 10, 64)
 			return id
 		}(),
-		TonProofDomain:   getEnv("TON_PROOF_DOMAIN", ""),
-		TonAPIBaseURL:    getEnv("TONAPI_BASE_URL", "https://tonapi.io"),
-		TonAPIToken:      getEnv("TONAPI_TOKEN", ""),
-		TonLiteConfigURL: getEnv("TON_LITE_CONFIG_URL", "https://ton.org/global-config.json"),
-		WebAppBaseURL:    getEnv("WEBAPP_BASE_URL", ""),
+		TonProofDomain:         getEnv("TON_PROOF_DOMAIN", ""),
+		TonAPIBaseURL:          getEnv("TONAPI_BASE_URL", "https://tonapi.io"),
+		TonAPIToken:            getEnv("TONAPI_TOKEN", ""),
+		TonLiteConfigURL:       getEnv("TON_LITE_CONFIG_URL", "https://ton.org/global-config.json"),
+		EscrowWalletAddress:    getEnv("ESCROW_WALLET_ADDRESS", ""),
+		TonWebhookSecret:       getEnv("TON_WEBHOOK_SECRET", ""),
+		ScreeningProviderURL:   getEnv("SCREENING_PROVIDER_URL", ""),
+		ScreeningProviderToken: getEnv("SCREENING_PROVIDER_TOKEN", ""),
+		WebAppBaseURL:          getEnv("WEBAPP_BASE_URL", ""),
+		AuditSigningKey:        getEnv("AUDIT_SIGNING_KEY", ""),
+		ModerationRulesFile:    getEnv("MODERATION_RULES_FILE", ""),
+		GRPCAddr:               getEnv("GRPC_ADDR", ""),
+		GRPCAuthToken:          getEnv("GRPC_AUTH_TOKEN", ""),
+		EventBus:               getEnv("EVENT_BUS", "redis"),
+		EventBusRedisStream:    getEnv("EVENT_BUS_REDIS_STREAM", "giveaway:events"),
+		EventBusNATSURL:        getEnv("EVENT_BUS_NATS_URL", ""),
+		EventBusNATSSubject:    getEnv("EVENT_BUS_NATS_SUBJECT", "giveaway.events"),
+		PendingAction:          getEnv("PENDING_ACTION", "draw"),
+
+		// Analytics export
+		AnalyticsSink:                    getEnv("ANALYTICS_SINK", ""),
+		AnalyticsClickHouseURL:           getEnv("ANALYTICS_CLICKHOUSE_URL", ""),
+		AnalyticsClickHouseDatabase:      getEnv("ANALYTICS_CLICKHOUSE_DATABASE", ""),
+		AnalyticsClickHouseTable:         getEnv("ANALYTICS_CLICKHOUSE_TABLE", "giveaway_events"),
+		AnalyticsBigQueryProject:         getEnv("ANALYTICS_BIGQUERY_PROJECT", ""),
+		AnalyticsBigQueryDataset:         getEnv("ANALYTICS_BIGQUERY_DATASET", ""),
+		AnalyticsBigQueryTable:           getEnv("ANALYTICS_BIGQUERY_TABLE", "giveaway_events"),
+		AnalyticsBigQueryCredentialsFile: getEnv("ANALYTICS_BIGQUERY_CREDENTIALS_FILE", ""),
+
+		// File storage
+		StorageBackend:            getEnv("STORAGE_BACKEND", "local"),
+		StorageLocalDir:           getEnv("STORAGE_LOCAL_DIR", "./data/storage"),
+		StoragePublicBaseURL:      getEnv("STORAGE_PUBLIC_BASE_URL", ""),
+		StorageSigningKey:         getEnv("STORAGE_SIGNING_KEY", ""),
+		StorageS3Endpoint:         getEnv("STORAGE_S3_ENDPOINT", ""),
+		StorageS3Region:           getEnv("STORAGE_S3_REGION", "us-east-1"),
+		StorageS3Bucket:           getEnv("STORAGE_S3_BUCKET", ""),
+		StorageS3AccessKey:        getEnv("STORAGE_S3_ACCESS_KEY", ""),
+		StorageS3SecretKey:        getEnv("STORAGE_S3_SECRET_KEY", ""),
+		StorageGCSBucket:          getEnv("STORAGE_GCS_BUCKET", ""),
+		StorageGCSCredentialsFile: getEnv("STORAGE_GCS_CREDENTIALS_FILE", ""),
 	}
 	redisDBStr := getEnv("REDIS_DB", "0")
 	dbNum, err := strconv.Atoi(redisDBStr)
@@ -72,6 +216,11 @@ func Load() (*Config, error) {
 		}
 	}
 	cfg.RedisDB = dbNum
+	for _, addr := range strings.Split(getEnv("REDIS_SHARD_ADDRS", ""), ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			cfg.RedisShardAddrs = append(cfg.RedisShardAddrs, addr)
+		}
+	}
 	if ttlStr := getEnv("INIT_DATA_TTL", "86400"); ttlStr != "" { // default 24h
 		if ttl, err := strconv.Atoi(ttlStr); err == nil {
 			cfg.InitDataTTL = ttl
@@ -86,6 +235,277 @@ func Load() (*Config, error) {
 			return nil, fmt.Errorf("invalid GIVEAWAY_EXPIRE_INTERVAL_SEC: %w", err)
 		}
 	}
+	if iv := getEnv("SCHEDULE_INTERVAL_SEC", "30"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ScheduleIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid SCHEDULE_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("BROADCAST_INTERVAL_SEC", "1"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.BroadcastIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid BROADCAST_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("EXPORT_INTERVAL_SEC", "2"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ExportIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid EXPORT_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("EXPORT_SYNC_MAX_WINNERS", "1000"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ExportSyncMaxWinners = n
+		} else {
+			return nil, fmt.Errorf("invalid EXPORT_SYNC_MAX_WINNERS: %w", err)
+		}
+	}
+	if iv := getEnv("BROADCAST_BATCH_SIZE", "20"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.BroadcastBatchSize = n
+		} else {
+			return nil, fmt.Errorf("invalid BROADCAST_BATCH_SIZE: %w", err)
+		}
+	}
+	if iv := getEnv("PENDING_INTERVAL_SEC", "300"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.PendingIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid PENDING_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("PENDING_TTL_SEC", "604800"); iv != "" { // default 7 days
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.PendingTTLSec = n
+		} else {
+			return nil, fmt.Errorf("invalid PENDING_TTL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("BONUS_RECHECK_INTERVAL_SEC", "3600"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.BonusRecheckIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid BONUS_RECHECK_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("WAVE_INTERVAL_SEC", "60"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.WaveIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid WAVE_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("STATS_INTERVAL_SEC", "300"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.StatsIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid STATS_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("FUNDING_INTERVAL_SEC", "60"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.FundingIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid FUNDING_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("REPUTATION_APPROVAL_THRESHOLD", "40"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil && n >= 0 && n <= 100 {
+			cfg.ReputationApprovalThreshold = n
+		} else {
+			return nil, fmt.Errorf("invalid REPUTATION_APPROVAL_THRESHOLD: %q", iv)
+		}
+	}
+	if iv := getEnv("REPUTATION_INTERVAL_SEC", "3600"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ReputationIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid REPUTATION_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("HOUSEKEEPING_INTERVAL_SEC", "3600"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.HousekeepingIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid HOUSEKEEPING_INTERVAL_SEC: %w", err)
+		}
+	}
+	if v := getEnv("COMPLETION_SLA_SEC", "300"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.CompletionSLASec = n
+		} else {
+			return nil, fmt.Errorf("invalid COMPLETION_SLA_SEC: %q", v)
+		}
+	}
+	if iv := getEnv("COMPLETION_SLA_INTERVAL_SEC", "60"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.CompletionSLAIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid COMPLETION_SLA_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("DEAD_GIVEAWAY_INTERVAL_SEC", "600"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.DeadGiveawayIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid DEAD_GIVEAWAY_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("CRM_WEBHOOK_INTERVAL_SEC", "5"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.CRMWebhookIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid CRM_WEBHOOK_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("RESULT_POST_INTERVAL_SEC", "30"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ResultPostIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid RESULT_POST_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("CLAIM_DEADLINE_INTERVAL_SEC", "300"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.ClaimDeadlineIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid CLAIM_DEADLINE_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("FINISH_CONFIRM_MIN_PARTICIPANTS", "1000"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.FinishConfirmMinParticipants = n
+		} else {
+			return nil, fmt.Errorf("invalid FINISH_CONFIRM_MIN_PARTICIPANTS: %w", err)
+		}
+	}
+	if iv := getEnv("FINISH_PREVIEW_LEAD_SEC", "86400"); iv != "" { // default 1 day
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.FinishPreviewLeadSec = n
+		} else {
+			return nil, fmt.Errorf("invalid FINISH_PREVIEW_LEAD_SEC: %w", err)
+		}
+	}
+	if v := getEnv("ENDING_SOON_SEC", "86400"); v != "" { // default 1 day
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.EndingSoonSec = n
+		} else {
+			return nil, fmt.Errorf("invalid ENDING_SOON_SEC: %q", v)
+		}
+	}
+	if v := getEnv("JUST_STARTED_SEC", "3600"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			cfg.JustStartedSec = n
+		} else {
+			return nil, fmt.Errorf("invalid JUST_STARTED_SEC: %q", v)
+		}
+	}
+	if iv := getEnv("ENDING_SOON_SYNC_INTERVAL_SEC", "300"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.EndingSoonSyncIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid ENDING_SOON_SYNC_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("CACHE_WARM_INTERVAL_SEC", "60"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil && n >= 0 {
+			cfg.CacheWarmIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid CACHE_WARM_INTERVAL_SEC: %q", iv)
+		}
+	}
+	if iv := getEnv("CACHE_WARM_TOP", "50"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil && n > 0 {
+			cfg.CacheWarmTop = n
+		} else {
+			return nil, fmt.Errorf("invalid CACHE_WARM_TOP: %q", iv)
+		}
+	}
+	if v := getEnv("COMPLIANCE_GATE_ENABLED", "false"); v != "" {
+		cfg.ComplianceGateEnabled = v == "true" || v == "1" || v == "yes" || v == "on"
+	}
+	if iv := getEnv("TELEGRAM_SEND_RATE", "25"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.TelegramSendRate = n
+		} else {
+			return nil, fmt.Errorf("invalid TELEGRAM_SEND_RATE: %w", err)
+		}
+	}
+	if iv := getEnv("TELEGRAM_SEND_QUEUE_SIZE", "1000"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.TelegramSendQueueSize = n
+		} else {
+			return nil, fmt.Errorf("invalid TELEGRAM_SEND_QUEUE_SIZE: %w", err)
+		}
+	}
+	cfg.RedisKeyspaceEvents = getEnv("REDIS_KEYSPACE_EVENTS", "")
+	if iv := getEnv("REFUND_INTERVAL_SEC", "30"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.RefundIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid REFUND_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("DELETION_INTERVAL_SEC", "30"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.DeletionIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid DELETION_INTERVAL_SEC: %w", err)
+		}
+	}
+	switch cfg.PendingAction {
+	case "draw", "cancel":
+	default:
+		return nil, fmt.Errorf("invalid PENDING_ACTION: %q", cfg.PendingAction)
+	}
+	cfg.FingerprintSecret = getEnv("FINGERPRINT_SECRET", "")
+	cfg.ProxyHeader = getEnv("PROXY_HEADER", "")
+	cfg.ImportMappersFile = getEnv("IMPORT_MAPPERS_FILE", "")
+	cfg.UnverifiableRequirements = getEnv("UNVERIFIABLE_REQUIREMENTS", "pause")
+	switch cfg.UnverifiableRequirements {
+	case "pause", "enforce":
+	default:
+		return nil, fmt.Errorf("invalid UNVERIFIABLE_REQUIREMENTS: %q", cfg.UnverifiableRequirements)
+	}
+	if iv := getEnv("OUTBOX_INTERVAL_SEC", "5"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.OutboxIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid OUTBOX_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("ANALYTICS_FLUSH_INTERVAL_SEC", "10"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.AnalyticsFlushIntervalSec = n
+		} else {
+			return nil, fmt.Errorf("invalid ANALYTICS_FLUSH_INTERVAL_SEC: %w", err)
+		}
+	}
+	if iv := getEnv("ANALYTICS_BATCH_SIZE", "500"); iv != "" {
+		if n, err := strconv.Atoi(iv); err == nil {
+			cfg.AnalyticsBatchSize = n
+		} else {
+			return nil, fmt.Errorf("invalid ANALYTICS_BATCH_SIZE: %w", err)
+		}
+	}
+	if v := os.Getenv("EVENT_BUS"); v == "none" || v == "off" {
+		cfg.EventBus = ""
+	}
+	if v := os.Getenv("STORAGE_BACKEND"); v == "none" || v == "off" {
+		cfg.StorageBackend = ""
+	}
+	if v := getEnv("STORAGE_S3_PATH_STYLE", "false"); v != "" {
+		cfg.StorageS3PathStyle = v == "true" || v == "1" || v == "yes" || v == "on"
+	}
+	if cfg.ShortLinkBaseURL == "" {
+		cfg.ShortLinkBaseURL = cfg.PublicBaseURL
+	}
+	if cfg.StoragePublicBaseURL == "" {
+		cfg.StoragePublicBaseURL = cfg.PublicBaseURL
+	}
 	// DB_AUTO_MIGRATE: if true, app runs migrations on start
 	if v := getEnv("DB_AUTO_MIGRATE", "false"); v != "" {
 		cfg.DBAutoMigrate = v == "true" || v == "1" || v == "yes" || v == "on"
@@ -0,0 +1,24 @@
+package giveaway
+
+import "time"
+
+// BadgeThresholds decide the list badges of running giveaways; a zero threshold disables its badge.
+type BadgeThresholds struct {
+	// EndingSoon marks giveaways ending within this long
+	EndingSoon time.Duration
+	// JustStarted marks giveaways started less than this long ago
+	JustStarted time.Duration
+}
+
+// ApplyBadges sets EndingSoon and JustStarted of g at now. Only active giveaways get badges.
+// Both compare absolute instants, so they hold whatever the viewer's time zone or clock.
+func (g *Giveaway) ApplyBadges(now time.Time, t BadgeThresholds) {
+	g.EndingSoon, g.JustStarted = false, false
+	if g.Status != GiveawayStatusActive {
+		return
+	}
+	left := g.EndsAt.Sub(now)
+	g.EndingSoon = t.EndingSoon > 0 && left > 0 && left <= t.EndingSoon
+	running := now.Sub(g.StartedAt)
+	g.JustStarted = t.JustStarted > 0 && running >= 0 && running < t.JustStarted
+}
@@ -0,0 +1,27 @@
+package giveaway
+
+import "time"
+
+// RequirementBundle is a set of requirements a channel owner publishes, e.g. "subscribe to and
+// boost my channel", for creators to attach to their giveaways by reference.
+type RequirementBundle struct {
+	ID           int64         `json:"id"`
+	OwnerID      int64         `json:"owner_id"`
+	Title        string        `json:"title"`
+	Description  string        `json:"description,omitempty"`
+	Requirements []Requirement `json:"requirements"`
+	// Inactive bundles can no longer be attached; giveaways keep their copies
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BundleStats aggregates the giveaways that attached a bundle.
+type BundleStats struct {
+	BundleID        int64 `json:"bundle_id"`
+	Giveaways       int   `json:"giveaways"`
+	ActiveGiveaways int   `json:"active_giveaways"`
+	// Participants counts joins across those giveaways; UniqueParticipants counts each user once
+	Participants       int `json:"participants"`
+	UniqueParticipants int `json:"unique_participants"`
+}
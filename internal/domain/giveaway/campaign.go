@@ -0,0 +1,40 @@
+package giveaway
+
+import "time"
+
+// Campaign groups giveaways of one creator under a name for shared analytics and a share page.
+type Campaign struct {
+	ID          string             `json:"id"`
+	CreatorID   int64              `json:"creator_id,omitempty"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Giveaways   []CampaignGiveaway `json:"giveaways"`
+	// Participants sums participants over all giveaways; a user in two giveaways counts twice
+	Participants int64     `json:"participants"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CampaignGiveaway is a giveaway as listed inside a campaign.
+type CampaignGiveaway struct {
+	ID                string         `json:"id"`
+	Title             string         `json:"title"`
+	Status            GiveawayStatus `json:"status"`
+	StartedAt         time.Time      `json:"started_at"`
+	EndsAt            time.Time      `json:"ends_at"`
+	ParticipantsCount int            `json:"participants_count"`
+	AddedAt           time.Time      `json:"added_at"`
+}
+
+// CampaignStats rolls up a campaign's giveaways. Reach counts distinct users who joined any
+// giveaway or clicked any of its tracked links.
+type CampaignStats struct {
+	Giveaways          int   `json:"giveaways"`
+	Participants       int64 `json:"participants"`
+	UniqueParticipants int64 `json:"unique_participants"`
+	RepeatParticipants int64 `json:"repeat_participants"`
+	Winners            int64 `json:"winners"`
+	Channels           int   `json:"channels"`
+	LinkClicks         int64 `json:"link_clicks"`
+	Reach              int64 `json:"reach"`
+}
@@ -0,0 +1,30 @@
+package giveaway
+
+import "time"
+
+// ChannelRecord is the canonical record of a channel: what identifies it and who owns it.
+// OwnerID is only set once the owner was confirmed as an admin of the channel.
+type ChannelRecord struct {
+	ID              int64      `json:"id"`
+	Username        string     `json:"username,omitempty"`
+	OwnerID         int64      `json:"owner_id,omitempty"`
+	OwnerVerifiedAt *time.Time `json:"owner_verified_at,omitempty"`
+}
+
+// ChannelCache is display data of a channel copied from Telegram. Each part carries the time it
+// was fetched so readers can tell how old it is; nil times mean it was never fetched.
+type ChannelCache struct {
+	ChannelID            int64      `json:"channel_id"`
+	Title                string     `json:"title,omitempty"`
+	TitleFetchedAt       *time.Time `json:"title_fetched_at,omitempty"`
+	MemberCount          *int       `json:"member_count,omitempty"`
+	MemberCountFetchedAt *time.Time `json:"member_count_fetched_at,omitempty"`
+	// AvatarFetchedAt is when the avatar was last resolved, from ChannelAvatar
+	AvatarFetchedAt *time.Time `json:"avatar_fetched_at,omitempty"`
+}
+
+// StoredChannel is the canonical record of a channel with its cached display data.
+type StoredChannel struct {
+	ChannelRecord
+	Cache ChannelCache `json:"cache"`
+}
@@ -0,0 +1,14 @@
+package giveaway
+
+import "time"
+
+// ChannelAvatar is the last avatar resolved for a channel. It outlives the Redis caches so
+// avatars keep working while Telegram is unreachable. FilePath is empty until getFile ran.
+type ChannelAvatar struct {
+	ChannelID       int64     `json:"channel_id"`
+	Username        string    `json:"username,omitempty"`
+	BigFileID       string    `json:"big_file_id"`
+	BigFileUniqueID string    `json:"big_file_unique_id"`
+	FilePath        string    `json:"file_path,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
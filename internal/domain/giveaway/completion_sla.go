@@ -0,0 +1,29 @@
+package giveaway
+
+import "time"
+
+// CompletionLag is how long after ends_at a giveaway was, or still is not, finished.
+type CompletionLag struct {
+	GiveawayID string         `json:"giveaway_id"`
+	Title      string         `json:"title"`
+	Status     GiveawayStatus `json:"status"`
+	EndsAt     time.Time      `json:"ends_at"`
+	LagSec     int64          `json:"lag_sec"`
+}
+
+// CompletionLagStats summarizes completion lag of giveaways finished since a point in time.
+type CompletionLagStats struct {
+	Finished int     `json:"finished"`
+	Late     int     `json:"late"` // finished later than the SLA
+	P50Sec   float64 `json:"p50_sec"`
+	P95Sec   float64 `json:"p95_sec"`
+	MaxSec   float64 `json:"max_sec"`
+}
+
+// CompletionSLAReport is the completion SLA state: giveaways overdue right now and lag
+// statistics of the last day.
+type CompletionSLAReport struct {
+	SLASec  int64              `json:"sla_sec"`
+	Overdue []CompletionLag    `json:"overdue"`
+	LastDay CompletionLagStats `json:"last_day"`
+}
@@ -0,0 +1,159 @@
+package giveaway
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PrizeKind classifies prizes for compliance rules.
+type PrizeKind string
+
+const (
+	// PrizeKindCrypto covers TON, jettons and other tokens; escrow-funded giveaways always have it
+	PrizeKindCrypto PrizeKind = "crypto"
+	// PrizeKindStars covers Telegram Stars
+	PrizeKindStars PrizeKind = "stars"
+	// PrizeKindPremium covers Telegram Premium subscriptions
+	PrizeKindPremium PrizeKind = "premium"
+)
+
+// PrizeKindList lists the prize kinds in the order they are reported.
+var PrizeKindList = []PrizeKind{PrizeKindCrypto, PrizeKindStars, PrizeKindPremium}
+
+// Valid reports whether k is a known prize kind.
+func (k PrizeKind) Valid() bool {
+	for _, v := range PrizeKindList {
+		if v == k {
+			return true
+		}
+	}
+	return false
+}
+
+// prizeKindWords are the words in prize texts that mark each kind.
+var prizeKindWords = map[PrizeKind][]string{
+	PrizeKindCrypto:  {"ton", "toncoin", "jetton", "jettons", "usdt", "usdc", "btc", "bitcoin", "eth", "ethereum", "notcoin", "crypto", "nft", "nfts"},
+	PrizeKindStars:   {"stars", "⭐"},
+	PrizeKindPremium: {"premium"},
+}
+
+// PrizeKinds returns the kinds of the prizes of g in PrizeKindList order. Prizes are free text,
+// so kinds are recognized by words in the titles and descriptions of prizes and package items;
+// escrowed giveaways pay out in TON and always have the crypto kind.
+func PrizeKinds(g *Giveaway, escrowed bool) []PrizeKind {
+	words := make(map[string]bool)
+	add := func(texts ...string) {
+		for _, t := range texts {
+			for _, w := range strings.FieldsFunc(strings.ToLower(t), func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}) {
+				words[w] = true
+			}
+			if strings.ContainsRune(t, '⭐') {
+				words["⭐"] = true
+			}
+		}
+	}
+	for _, p := range g.Prizes {
+		add(p.Title, p.Description)
+	}
+	for _, pkg := range g.PrizePackages {
+		add(pkg.Label)
+		for _, it := range pkg.Items {
+			add(it.Title, it.Description)
+		}
+	}
+	var kinds []PrizeKind
+	for _, k := range PrizeKindList {
+		found := k == PrizeKindCrypto && escrowed
+		for _, w := range prizeKindWords[k] {
+			if found {
+				break
+			}
+			found = words[w]
+		}
+		if found {
+			kinds = append(kinds, k)
+		}
+	}
+	return kinds
+}
+
+// MaxComplianceNoticeLength bounds the notice of a compliance rule.
+const MaxComplianceNoticeLength = 1000
+
+// ComplianceRule restricts giveaways with prizes of one kind in some jurisdictions. Platform
+// admins configure the rules; users joining such a giveaway first acknowledge they are not
+// residents of the Countries.
+type ComplianceRule struct {
+	PrizeKind PrizeKind `json:"prize_kind"`
+	// Countries are ISO 3166-1 alpha-2 codes, upper case and sorted
+	Countries []string `json:"countries"`
+	// Notice is shown with the acknowledgment; empty uses the default text
+	Notice    string    `json:"notice,omitempty"`
+	UpdatedBy int64     `json:"updated_by,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NormalizeCountries upper-cases, deduplicates and sorts ISO 3166-1 alpha-2 codes.
+func NormalizeCountries(codes []string) ([]string, error) {
+	seen := make(map[string]bool, len(codes))
+	out := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if len(c) != 2 || c[0] < 'A' || c[0] > 'Z' || c[1] < 'A' || c[1] > 'Z' {
+			return nil, errors.New("countries must be ISO 3166-1 alpha-2 codes")
+		}
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// ComplianceGate is the eligibility acknowledgment a user owes before joining a giveaway.
+type ComplianceGate struct {
+	// Required is set when the prizes fall under a rule with restricted countries
+	Required   bool        `json:"required"`
+	PrizeKinds []PrizeKind `json:"prize_kinds,omitempty"`
+	// Countries are the restricted jurisdictions of all matching rules
+	Countries []string `json:"countries,omitempty"`
+	Notices   []string `json:"notices,omitempty"`
+	// AcknowledgedAt is set once the user acknowledged every country listed
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+}
+
+// Pending reports whether the user still has to acknowledge the gate.
+func (g ComplianceGate) Pending() bool { return g.Required && g.AcknowledgedAt == nil }
+
+// ComplianceAck is the audit record of a user acknowledging the compliance gate of a giveaway,
+// with the restrictions shown at the time.
+type ComplianceAck struct {
+	GiveawayID     string      `json:"giveaway_id"`
+	UserID         int64       `json:"user_id"`
+	PrizeKinds     []PrizeKind `json:"prize_kinds"`
+	Countries      []string    `json:"countries"`
+	Notice         string      `json:"notice,omitempty"`
+	IP             string      `json:"ip,omitempty"`
+	UserAgent      string      `json:"user_agent,omitempty"`
+	AcknowledgedAt time.Time   `json:"acknowledged_at"`
+}
+
+// Covers reports whether the acknowledgment names every country of countries.
+func (a ComplianceAck) Covers(countries []string) bool {
+	acked := make(map[string]bool, len(a.Countries))
+	for _, c := range a.Countries {
+		acked[c] = true
+	}
+	for _, c := range countries {
+		if !acked[c] {
+			return false
+		}
+	}
+	return true
+}
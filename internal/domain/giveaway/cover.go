@@ -0,0 +1,50 @@
+package giveaway
+
+import (
+	"strings"
+	"time"
+)
+
+// CoverKind is how a cover is sent to Telegram.
+type CoverKind string
+
+const (
+	// CoverPhoto is a still image sent with sendPhoto
+	CoverPhoto CoverKind = "photo"
+	// CoverGIF and CoverVideo are animations sent with sendAnimation
+	CoverGIF   CoverKind = "gif"
+	CoverVideo CoverKind = "video"
+)
+
+// Cover is a creator-uploaded image or GIF that replaces the default animation in the launch
+// announcement and the prepared inline message. Key names the object in file storage.
+type Cover struct {
+	Key         string    `json:"-"`
+	ContentType string    `json:"content_type"`
+	Kind        CoverKind `json:"kind"`
+	Size        int64     `json:"size"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CoverKindOf returns the kind of a cover with contentType; false when covers cannot have it.
+func CoverKindOf(contentType string) (CoverKind, bool) {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch strings.ToLower(strings.TrimSpace(mediaType)) {
+	case "image/jpeg", "image/png", "image/webp":
+		return CoverPhoto, true
+	case "image/gif":
+		return CoverGIF, true
+	case "video/mp4":
+		return CoverVideo, true
+	}
+	return "", false
+}
+
+// MaxSize is the largest cover of kind k Telegram fetches by URL: 5 MB for photos, 20 MB for
+// animations.
+func (k CoverKind) MaxSize() int64 {
+	if k == CoverPhoto {
+		return 5 << 20
+	}
+	return 20 << 20
+}
@@ -0,0 +1,31 @@
+package giveaway
+
+import "time"
+
+// CreatorActionType is what a creator has to do before a giveaway can move on.
+type CreatorActionType string
+
+const (
+	// CreatorActionSelectWinners: a pending giveaway waits for manually chosen winners
+	CreatorActionSelectWinners CreatorActionType = "select_winners"
+	// CreatorActionFundEscrow: a scheduled giveaway waits for its escrow deposit
+	CreatorActionFundEscrow CreatorActionType = "fund_escrow"
+	// CreatorActionResolveDispute: winners reported an undelivered prize
+	CreatorActionResolveDispute CreatorActionType = "resolve_dispute"
+	// CreatorActionRedrawUnclaimed: winners missed the claim deadline and no reserve is left,
+	// so the creator decides whether to disqualify them
+	CreatorActionRedrawUnclaimed CreatorActionType = "redraw_unclaimed"
+)
+
+// CreatorAction is one giveaway awaiting an action of its creator. Count is the number of
+// affected winners for disputes and unclaimed prizes. Deadline is when the platform acts on
+// its own: the pending policy applies, or an unfunded giveaway expires; nil when nothing happens.
+type CreatorAction struct {
+	GiveawayID string            `json:"giveaway_id"`
+	Title      string            `json:"title"`
+	Status     GiveawayStatus    `json:"status"`
+	Action     CreatorActionType `json:"action"`
+	Count      int               `json:"count,omitempty"`
+	Since      time.Time         `json:"since"`
+	Deadline   *time.Time        `json:"deadline,omitempty"`
+}
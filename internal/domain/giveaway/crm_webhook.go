@@ -0,0 +1,44 @@
+package giveaway
+
+import "time"
+
+// CRMWebhook is a creator endpoint that receives new participants of the creator's giveaways.
+// Secret is returned only when the webhook is created.
+type CRMWebhook struct {
+	ID             string     `json:"id"`
+	CreatorID      int64      `json:"creator_id"`
+	URL            string     `json:"url"`
+	Secret         string     `json:"secret,omitempty"`
+	Delivered      int64      `json:"delivered"`
+	Failures       int        `json:"failures"`
+	OpenUntil      *time.Time `json:"circuit_open_until,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	LastDeliveryAt *time.Time `json:"last_delivery_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+
+	// Delivery cursor, not exposed
+	CursorJoinedAt   time.Time `json:"-"`
+	CursorGiveawayID string    `json:"-"`
+	CursorUserID     int64     `json:"-"`
+}
+
+// CRMParticipant is one participant in a CRM webhook batch. RequirementStatus is "met", or
+// "unverified" when some requirement could not be checked at join time.
+type CRMParticipant struct {
+	GiveawayID        string            `json:"giveaway_id"`
+	UserID            int64             `json:"user_id"`
+	Username          string            `json:"username,omitempty"`
+	Source            ParticipantSource `json:"source"`
+	SourceRef         string            `json:"source_ref,omitempty"`
+	RequirementStatus string            `json:"requirement_status"`
+	Tickets           int               `json:"tickets"`
+	JoinedAt          time.Time         `json:"joined_at"`
+}
+
+// CRMWebhookBatch is the JSON body posted to a CRM webhook.
+type CRMWebhookBatch struct {
+	DeliveryID   string           `json:"delivery_id"`
+	WebhookID    string           `json:"webhook_id"`
+	SentAt       time.Time        `json:"sent_at"`
+	Participants []CRMParticipant `json:"participants"`
+}
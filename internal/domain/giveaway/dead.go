@@ -0,0 +1,17 @@
+package giveaway
+
+// DeadReason explains why a running giveaway can no longer complete normally.
+type DeadReason string
+
+const (
+	DeadCreatorBlockedBot    DeadReason = "creator_blocked_bot"   // notifications to the creator cannot be delivered
+	DeadChannelsInaccessible DeadReason = "channels_inaccessible" // the bot lost access to every requirement channel
+)
+
+// DeadGiveaway is a scheduled or active giveaway found by the dead giveaway detector.
+type DeadGiveaway struct {
+	GiveawayID string
+	Title      string
+	CreatorID  int64
+	Reason     DeadReason
+}
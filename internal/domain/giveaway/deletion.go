@@ -0,0 +1,38 @@
+package giveaway
+
+import "time"
+
+// ChannelPost is a bot message about a giveaway in one of its sponsor channels.
+type ChannelPost struct {
+	ID         int64     `json:"id"`
+	GiveawayID string    `json:"giveaway_id"`
+	ChannelID  int64     `json:"channel_id"`
+	MessageID  int64     `json:"message_id"`
+	Kind       string    `json:"kind"`  // schedule item kind or "results"
+	Media      bool      `json:"media"` // captioned animation or photo rather than text
+	PostedAt   time.Time `json:"posted_at"`
+}
+
+// Deletion tracks the cleanup after a giveaway is deleted. Each step is stamped once done;
+// the deletion completes when all are.
+type Deletion struct {
+	GiveawayID      string     `json:"giveaway_id"`
+	CreatorID       int64      `json:"creator_id"`
+	Title           string     `json:"title"`
+	RequestedAt     time.Time  `json:"requested_at"`
+	TokensRevokedAt *time.Time `json:"tokens_revoked_at,omitempty"`
+	KeysClearedAt   *time.Time `json:"keys_cleared_at,omitempty"`
+	PostsCleanedAt  *time.Time `json:"posts_cleaned_at,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	Attempts        int        `json:"attempts"`
+	LastError       string     `json:"last_error,omitempty"`
+}
+
+// DeletionStep names one cleanup step of a Deletion.
+type DeletionStep string
+
+const (
+	DeletionStepTokens DeletionStep = "tokens" // export download tokens revoked
+	DeletionStepKeys   DeletionStep = "keys"   // per-giveaway Redis keys removed
+	DeletionStepPosts  DeletionStep = "posts"  // channel posts deleted or marked as removed
+)
@@ -0,0 +1,32 @@
+package giveaway
+
+import "time"
+
+// Draw records the inputs and outcome of an automatic winner draw so it can be reproduced.
+// Skipped lists candidates rejected by requirement checks at draw time, in queue order.
+type Draw struct {
+	ID                int64          `json:"id"`
+	GiveawayID        string         `json:"giveaway_id"`
+	Strategy          WinnerStrategy `json:"strategy"`
+	Seed              string         `json:"seed,omitempty"`
+	ParticipantsHash  string         `json:"participants_hash"`
+	ParticipantsCount int            `json:"participants_count"`
+	Skipped           []int64        `json:"skipped"`
+	Winners           []int64        `json:"winners"`
+	CreatedAt         time.Time      `json:"created_at"`
+	// Reserves are drawn after the winners from the same queue; only the creator sees them.
+	Reserves []int64 `json:"-"`
+	// Checks holds per-requirement outcomes evaluated during the draw.
+	Checks []RequirementSnapshot `json:"-"`
+}
+
+// RequirementSnapshot is the outcome of one requirement check for one user at a point in time.
+type RequirementSnapshot struct {
+	UserID           int64           `json:"user_id"`
+	RequirementIndex int             `json:"requirement_index"`
+	RequirementType  RequirementType `json:"requirement_type"`
+	ChannelID        int64           `json:"channel_id,omitempty"`
+	Status           string          `json:"status"`
+	Error            string          `json:"error,omitempty"`
+	CheckedAt        time.Time       `json:"checked_at"`
+}
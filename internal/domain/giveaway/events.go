@@ -0,0 +1,83 @@
+package giveaway
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventType names a domain event published to the event bus.
+type EventType string
+
+const (
+	EventGiveawayCreated   EventType = "giveaway.created"
+	EventParticipantJoined EventType = "giveaway.participant_joined"
+	EventWinnersSelected   EventType = "giveaway.winners_selected"
+	EventPrizeDistributed  EventType = "giveaway.prize_distributed"
+	EventGiveawayCancelled EventType = "giveaway.cancelled"
+	EventWaveDrawn         EventType = "giveaway.wave_drawn"
+)
+
+// EventSchemaVersion is bumped only on breaking payload changes; consumers must ignore unknown fields.
+const EventSchemaVersion = 1
+
+// Event is the envelope stored in the outbox and delivered to the bus unchanged.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        EventType       `json:"type"`
+	Version     int             `json:"version"`
+	AggregateID string          `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// GiveawayCreatedPayload is published once a giveaway is persisted.
+type GiveawayCreatedPayload struct {
+	GiveawayID     string         `json:"giveaway_id"`
+	CreatorID      int64          `json:"creator_id"`
+	Title          string         `json:"title"`
+	Status         GiveawayStatus `json:"status"`
+	StartedAt      time.Time      `json:"started_at"`
+	EndsAt         time.Time      `json:"ends_at"`
+	WinnersCount   int            `json:"winners_count"`
+	WinnerStrategy WinnerStrategy `json:"winner_strategy"`
+}
+
+// ParticipantJoinedPayload is published when a user is added to the participants list.
+type ParticipantJoinedPayload struct {
+	GiveawayID string    `json:"giveaway_id"`
+	UserID     int64     `json:"user_id"`
+	JoinedAt   time.Time `json:"joined_at"`
+}
+
+// WinnersSelectedPayload is published when winners become final. Winners are ordered by place.
+type WinnersSelectedPayload struct {
+	GiveawayID string  `json:"giveaway_id"`
+	DrawID     int64   `json:"draw_id,omitempty"`
+	Manual     bool    `json:"manual"`
+	Winners    []int64 `json:"winners"`
+}
+
+// PrizeDistributedPayload is published per winner once prizes are assigned.
+type PrizeDistributedPayload struct {
+	GiveawayID string        `json:"giveaway_id"`
+	UserID     int64         `json:"user_id"`
+	Place      int           `json:"place"`
+	Prizes     []WinnerPrize `json:"prizes"`
+	TotalUnits int           `json:"total_units"`
+}
+
+// GiveawayCancelledPayload is published when a creator cancels a giveaway.
+type GiveawayCancelledPayload struct {
+	GiveawayID string         `json:"giveaway_id"`
+	From       GiveawayStatus `json:"from"`
+	Reason     string         `json:"reason"`
+	ActorID    int64          `json:"actor_id"`
+}
+
+// WaveDrawnPayload is published when a wave picks its winners. Winners are ordered by place.
+type WaveDrawnPayload struct {
+	GiveawayID string  `json:"giveaway_id"`
+	Wave       int     `json:"wave"`
+	DrawID     int64   `json:"draw_id"`
+	Winners    []int64 `json:"winners"`
+}
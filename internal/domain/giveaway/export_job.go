@@ -0,0 +1,71 @@
+package giveaway
+
+import "time"
+
+// ExportKind selects the CSV an export job renders.
+type ExportKind string
+
+const (
+	// ExportWinners is the winners CSV of export links
+	ExportWinners ExportKind = "winners"
+	// ExportStats is the winners CSV with prize quantities, as served by stats.csv
+	ExportStats ExportKind = "stats"
+)
+
+// ExportJobStatus is the lifecycle state of an export job.
+type ExportJobStatus string
+
+const (
+	ExportJobQueued    ExportJobStatus = "queued"
+	ExportJobRunning   ExportJobStatus = "running"
+	ExportJobDone      ExportJobStatus = "done"
+	ExportJobFailed    ExportJobStatus = "failed"
+	ExportJobCancelled ExportJobStatus = "cancelled"
+)
+
+// ExportJob renders a giveaway export in the background. Processed counts the rows written
+// out of Total; FileKey is the storage object of a finished job.
+type ExportJob struct {
+	ID          string          `json:"id"`
+	GiveawayID  string          `json:"giveaway_id"`
+	RequestedBy int64           `json:"requested_by"`
+	Kind        ExportKind      `json:"kind"`
+	Locale      string          `json:"locale"`
+	Status      ExportJobStatus `json:"status"`
+	Total       int             `json:"total"`
+	Processed   int             `json:"processed"`
+	Error       string          `json:"error,omitempty"`
+	FileKey     string          `json:"-"`
+	CreatedAt   time.Time       `json:"created_at"`
+	StartedAt   *time.Time      `json:"started_at,omitempty"`
+	FinishedAt  *time.Time      `json:"finished_at,omitempty"`
+}
+
+// Finished reports whether the job reached a final state.
+func (j *ExportJob) Finished() bool {
+	switch j.Status {
+	case ExportJobDone, ExportJobFailed, ExportJobCancelled:
+		return true
+	}
+	return false
+}
+
+// Percent is the share of rows written, 0 to 100.
+func (j *ExportJob) Percent() int {
+	switch {
+	case j.Status == ExportJobDone:
+		return 100
+	case j.Total <= 0:
+		return 0
+	}
+	return min(j.Processed*100/j.Total, 99)
+}
+
+// ETA estimates the time left of a running job from its rate so far; 0 when unknown.
+func (j *ExportJob) ETA(now time.Time) time.Duration {
+	if j.Status != ExportJobRunning || j.StartedAt == nil || j.Processed <= 0 || j.Total <= j.Processed {
+		return 0
+	}
+	elapsed := now.Sub(*j.StartedAt)
+	return time.Duration(float64(elapsed) * float64(j.Total-j.Processed) / float64(j.Processed))
+}
@@ -0,0 +1,14 @@
+package giveaway
+
+import "time"
+
+// FingerprintFlag is a participant who joined from a device fingerprint already used by
+// MaxPerFingerprint other accounts of the same giveaway.
+type FingerprintFlag struct {
+	UserID   int64     `json:"user_id"`
+	JoinedAt time.Time `json:"joined_at"`
+	// Accounts counts all participants sharing the fingerprint, including this one
+	Accounts int `json:"accounts"`
+	// Group identifies participants sharing a fingerprint within this response only
+	Group int `json:"group"`
+}
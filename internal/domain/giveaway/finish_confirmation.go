@@ -0,0 +1,43 @@
+package giveaway
+
+import "time"
+
+// FinishTrigger is what asked the giveaway admins to review a finish.
+type FinishTrigger string
+
+const (
+	FinishTriggerPendingExpiry  FinishTrigger = "pending_expiry"  // the pending TTL is about to resolve the giveaway
+	FinishTriggerCreatorConfirm FinishTrigger = "creator_confirm" // the creator confirmed the uploaded winners
+)
+
+// FinishConfirmationStatus is the admins' answer to a finish preview.
+type FinishConfirmationStatus string
+
+const (
+	FinishAwaiting  FinishConfirmationStatus = "awaiting"
+	FinishConfirmed FinishConfirmationStatus = "confirmed"
+	FinishAborted   FinishConfirmationStatus = "aborted"
+)
+
+// FinishConfirmation is a finish preview sent to the giveaway admins: the creator and the human
+// admins of its sponsor channels. When Required, the giveaway is not finished before an admin
+// confirms; an abort always stops the automatic resolution.
+type FinishConfirmation struct {
+	GiveawayID        string                   `json:"giveaway_id"`
+	Trigger           FinishTrigger            `json:"trigger"`
+	Status            FinishConfirmationStatus `json:"status"`
+	Required          bool                     `json:"required"`
+	ParticipantsCount int                      `json:"participants_count"`
+	RequestedAt       time.Time                `json:"requested_at"`
+	DecidedBy         int64                    `json:"decided_by,omitempty"`
+	DecidedAt         *time.Time               `json:"decided_at,omitempty"`
+}
+
+// FinishNowConfirmation is handed out by the first finish-now call; the creator finishes the
+// giveaway by calling again with Token before ExpiresAt.
+type FinishNowConfirmation struct {
+	Token             string    `json:"confirm_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+	ParticipantsCount int       `json:"participants_count"`
+	WinnersCount      int       `json:"winners_count"`
+}
@@ -0,0 +1,35 @@
+package giveaway
+
+import "time"
+
+// FundingStatus tracks the on-chain escrow deposit of a giveaway.
+type FundingStatus string
+
+const (
+	FundingStatusAwaiting FundingStatus = "awaiting"
+	FundingStatusFunded   FundingStatus = "funded"
+	// FundingStatusExpired marks giveaways that ended before the deposit arrived; they are cancelled.
+	FundingStatusExpired FundingStatus = "expired"
+)
+
+// Funding describes the escrow deposit a creator must send before the giveaway goes active.
+// The transfer comment must equal Memo so the deposit can be matched to the giveaway.
+type Funding struct {
+	GiveawayID   string        `json:"giveaway_id"`
+	Address      string        `json:"address"`
+	Memo         string        `json:"memo"`
+	AmountNano   int64         `json:"amount_nano"`
+	ReceivedNano int64         `json:"received_nano"`
+	Status       FundingStatus `json:"status"`
+	FundedAt     *time.Time    `json:"funded_at,omitempty"`
+	Deposits     []Deposit     `json:"deposits,omitempty"`
+}
+
+// Deposit is an incoming escrow transfer credited to a giveaway.
+type Deposit struct {
+	TxHash     string    `json:"tx_hash"`
+	Lt         int64     `json:"lt"`
+	Sender     string    `json:"sender,omitempty"`
+	AmountNano int64     `json:"amount_nano"`
+	ReceivedAt time.Time `json:"received_at"`
+}
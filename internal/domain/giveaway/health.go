@@ -0,0 +1,27 @@
+package giveaway
+
+// HealthStatus is the outcome of one configuration diagnostic.
+type HealthStatus string
+
+const (
+	HealthOK      HealthStatus = "ok"
+	HealthWarning HealthStatus = "warning"
+	HealthError   HealthStatus = "error"
+	HealthSkipped HealthStatus = "skipped" // the check does not apply to this giveaway
+)
+
+// HealthCheck is one checklist entry of a giveaway health report. Message tells the creator
+// what to fix when the status is not ok.
+type HealthCheck struct {
+	Key     string       `json:"key"`
+	Status  HealthStatus `json:"status"`
+	Message string       `json:"message,omitempty"`
+}
+
+// Health is the configuration checklist of a giveaway. OK is false when any check is an error.
+type Health struct {
+	GiveawayID string        `json:"giveaway_id"`
+	OK         bool          `json:"ok"`
+	Warnings   int           `json:"warnings"`
+	Checks     []HealthCheck `json:"checks"`
+}
@@ -0,0 +1,42 @@
+package giveaway
+
+import "time"
+
+// APIKey authenticates a creator's integration calls. Key holds the plain key and is returned
+// only when the key is created.
+type APIKey struct {
+	ID         string     `json:"id"`
+	CreatorID  int64      `json:"creator_id"`
+	Name       string     `json:"name,omitempty"`
+	Prefix     string     `json:"prefix"`
+	Key        string     `json:"key,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// IntegrationParticipant is a new participant item for polling triggers. ID is unique per item
+// so no-code platforms can deduplicate.
+type IntegrationParticipant struct {
+	ID string `json:"id"`
+	CRMParticipant
+}
+
+// IntegrationWinner is a new winner item for polling triggers.
+type IntegrationWinner struct {
+	ID            string    `json:"id"`
+	GiveawayID    string    `json:"giveaway_id"`
+	GiveawayTitle string    `json:"giveaway_title"`
+	Place         int       `json:"place"`
+	UserID        int64     `json:"user_id"`
+	Username      string    `json:"username,omitempty"`
+	Prizes        []string  `json:"prizes"`
+	AssignedAt    time.Time `json:"assigned_at"`
+}
+
+// FeedCursor is a position in a time-ordered feed: items are ordered by (At, GiveawayID, Key),
+// where Key is the user id for participants and the place for winners.
+type FeedCursor struct {
+	At         time.Time
+	GiveawayID string
+	Key        int64
+}
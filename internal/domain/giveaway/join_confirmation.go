@@ -0,0 +1,12 @@
+package giveaway
+
+import "time"
+
+// JoinConfirmation configures the bot message a user receives right after joining a giveaway.
+// It lists the deadline, Rules (or the giveaway's own rules when empty), and a share link when
+// bonus tasks are available.
+type JoinConfirmation struct {
+	Enabled   bool      `json:"enabled"`
+	Rules     string    `json:"rules,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
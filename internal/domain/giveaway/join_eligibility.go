@@ -0,0 +1,53 @@
+package giveaway
+
+import "time"
+
+// JoinBlockerCode names a reason a user cannot join a giveaway right now.
+type JoinBlockerCode string
+
+const (
+	JoinBlockerNotStarted    JoinBlockerCode = "not_started"
+	JoinBlockerEnded         JoinBlockerCode = "ended"
+	JoinBlockerCancelled     JoinBlockerCode = "cancelled"
+	JoinBlockerCreator       JoinBlockerCode = "creator"
+	JoinBlockerManager       JoinBlockerCode = "manager"
+	JoinBlockerBanned        JoinBlockerCode = "banned"
+	JoinBlockerAlreadyJoined JoinBlockerCode = "already_joined"
+	JoinBlockerWindowClosed  JoinBlockerCode = "join_window_closed"
+	JoinBlockerFull          JoinBlockerCode = "full"
+	JoinBlockerRequirements  JoinBlockerCode = "requirements_unmet"
+	JoinBlockerCompliance    JoinBlockerCode = "compliance_ack_required"
+)
+
+// JoinBlocker is one reason a join would be refused, with what the client needs to act on it.
+type JoinBlocker struct {
+	Code JoinBlockerCode `json:"code"`
+	// StartsAt is when a not yet started giveaway starts, when known
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// OpensAt is when the next join window opens
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Requirements lists the unmet requirements of requirements_unmet
+	Requirements []UnmetRequirement `json:"requirements,omitempty"`
+	// Compliance is the acknowledgment owed for compliance_ack_required
+	Compliance *ComplianceGate `json:"compliance,omitempty"`
+}
+
+// UnmetRequirement is a required requirement the user does not meet.
+type UnmetRequirement struct {
+	ID              int64           `json:"id,omitempty"`
+	Type            RequirementType `json:"type"`
+	ChannelID       int64           `json:"channel_id,omitempty"`
+	ChannelUsername string          `json:"channel_username,omitempty"`
+	ChannelTitle    string          `json:"channel_title,omitempty"`
+	ChannelURL      string          `json:"channel_url,omitempty"`
+	// Status is "failed" when not met and "error" when the check could not run
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// JoinEligibility tells a user whether they can join a giveaway and, if not, why.
+type JoinEligibility struct {
+	GiveawayID string        `json:"giveaway_id"`
+	CanJoin    bool          `json:"can_join"`
+	Blockers   []JoinBlocker `json:"blockers"`
+}
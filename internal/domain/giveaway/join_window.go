@@ -0,0 +1,111 @@
+package giveaway
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// JoinWindow is a daily period during which joins are accepted, in the giveaway join timezone.
+type JoinWindow struct {
+	Start string `json:"start"` // "HH:MM"
+	End   string `json:"end"`   // "HH:MM", exclusive; an end before the start spans midnight
+	// Days lists the weekdays the window opens on (0 = Sunday); empty means every day
+	Days []int `json:"days,omitempty"`
+}
+
+// JoinWindowState tells clients whether joins are accepted now and when that changes.
+type JoinWindowState struct {
+	Open     bool       `json:"open"`
+	OpensAt  *time.Time `json:"opens_at,omitempty"`
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+}
+
+// ParseClock parses "HH:MM" into minutes after midnight.
+func ParseClock(s string) (int, error) {
+	if len(s) != 5 || s[2] != ':' {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	h, err1 := strconv.Atoi(s[:2])
+	m, err2 := strconv.Atoi(s[3:])
+	if err1 != nil || err2 != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+type windowSpan struct{ start, end time.Time }
+
+// spans lists the occurrences of windows from the day before now to a week after it.
+func spans(windows []JoinWindow, loc *time.Location, now time.Time) []windowSpan {
+	local := now.In(loc)
+	var out []windowSpan
+	for d := -1; d <= 7; d++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+d, 0, 0, 0, 0, loc)
+		for _, w := range windows {
+			if len(w.Days) > 0 && !containsDay(w.Days, int(day.Weekday())) {
+				continue
+			}
+			sm, err1 := ParseClock(w.Start)
+			em, err2 := ParseClock(w.End)
+			if err1 != nil || err2 != nil || sm == em {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), sm/60, sm%60, 0, 0, loc)
+			end := time.Date(day.Year(), day.Month(), day.Day(), em/60, em%60, 0, 0, loc)
+			if em < sm {
+				end = time.Date(day.Year(), day.Month(), day.Day()+1, em/60, em%60, 0, 0, loc)
+			}
+			out = append(out, windowSpan{start: start, end: end})
+		}
+	}
+	return out
+}
+
+func containsDay(days []int, d int) bool {
+	for _, x := range days {
+		if x == d {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinWindowAt evaluates windows at now. While open, ClosesAt is the end of the open period,
+// counting windows that overlap or touch it. While closed, OpensAt is the next start within a
+// week. Times are UTC.
+func JoinWindowAt(windows []JoinWindow, loc *time.Location, now time.Time) JoinWindowState {
+	all := spans(windows, loc, now)
+	var closes time.Time
+	for _, s := range all {
+		if !s.start.After(now) && s.end.After(now) && s.end.After(closes) {
+			closes = s.end
+		}
+	}
+	if !closes.IsZero() {
+		// Extend through windows that start before the open period ends
+		for extended := true; extended; {
+			extended = false
+			for _, s := range all {
+				if !s.start.After(closes) && s.end.After(closes) {
+					closes = s.end
+					extended = true
+				}
+			}
+		}
+		t := closes.UTC()
+		return JoinWindowState{Open: true, ClosesAt: &t}
+	}
+	var opens time.Time
+	for _, s := range all {
+		if s.start.After(now) && (opens.IsZero() || s.start.Before(opens)) {
+			opens = s.start
+		}
+	}
+	st := JoinWindowState{}
+	if !opens.IsZero() {
+		t := opens.UTC()
+		st.OpensAt = &t
+	}
+	return st
+}
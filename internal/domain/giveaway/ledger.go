@@ -0,0 +1,100 @@
+package giveaway
+
+import (
+	"fmt"
+	"time"
+)
+
+// LedgerAsset is the unit a ledger account is kept in.
+type LedgerAsset string
+
+const (
+	LedgerAssetTicket LedgerAsset = "ticket"
+	LedgerAssetStar   LedgerAsset = "star"
+)
+
+// LedgerSource tells why value moved.
+type LedgerSource string
+
+const (
+	// LedgerSourceBase is the ticket every participant gets on joining
+	LedgerSourceBase LedgerSource = "base"
+	// LedgerSourceTask credits a claimed bonus task; LedgerSourceTaskRevoke takes it back
+	LedgerSourceTask       LedgerSource = "task"
+	LedgerSourceTaskRevoke LedgerSource = "task_revoke"
+	// LedgerSourceBonus is a manual grant by a platform admin
+	LedgerSourceBonus    LedgerSource = "bonus"
+	LedgerSourcePurchase LedgerSource = "purchase"
+	LedgerSourceRefund   LedgerSource = "refund"
+	// LedgerSourceAdjustment corrects a balance, e.g. after reconciliation
+	LedgerSourceAdjustment LedgerSource = "adjustment"
+)
+
+// System accounts are the other side of value entering or leaving the platform. Their
+// balances may go negative and are not kept in the balance table.
+const (
+	// LedgerIssuanceAccount mints tickets
+	LedgerIssuanceAccount = "system:issuance"
+	// LedgerStarsAccount holds Stars paid by users through Telegram
+	LedgerStarsAccount = "system:stars"
+)
+
+// LedgerEntry moves Amount of Asset from Debit to Credit. Every entry is one balanced
+// double-entry transfer; the idempotency key makes retries post it at most once.
+type LedgerEntry struct {
+	ID             int64        `json:"id"`
+	IdempotencyKey string       `json:"idempotency_key"`
+	Asset          LedgerAsset  `json:"asset"`
+	Source         LedgerSource `json:"source"`
+	Debit          string       `json:"debit"`
+	Credit         string       `json:"credit"`
+	Amount         int64        `json:"amount"`
+	GiveawayID     string       `json:"giveaway_id,omitempty"`
+	// ActorID is the user who caused the entry; 0 for the system
+	ActorID   int64     `json:"actor_id,omitempty"`
+	Memo      string    `json:"memo,omitempty"`
+	Ref       string    `json:"ref,omitempty"` // external reference, e.g. the Telegram charge id of a purchase
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LedgerDiscrepancy is a mismatch found by reconciliation. Kind "balance" compares the stored
+// balance of an account with the sum of its entries; kind "tickets" compares a participant's
+// tickets with their ticket account.
+type LedgerDiscrepancy struct {
+	Kind    string      `json:"kind"`
+	Account string      `json:"account"`
+	Asset   LedgerAsset `json:"asset"`
+	// Ledger is the value derived from entries, Actual the stored one
+	Ledger int64 `json:"ledger"`
+	Actual int64 `json:"actual"`
+}
+
+// TicketAccount holds the tickets of one participant of a giveaway.
+func TicketAccount(giveawayID string, userID int64) string {
+	return fmt.Sprintf("tickets:%s:%d", giveawayID, userID)
+}
+
+// StarsAccount holds the Stars credited to a user, e.g. refunds kept on the platform.
+func StarsAccount(userID int64) string {
+	return fmt.Sprintf("stars:%d", userID)
+}
+
+// SalesAccount holds the Stars paid for tickets of a giveaway until the giveaway ends or the
+// purchases are refunded.
+func SalesAccount(giveawayID string) string {
+	return "sales:" + giveawayID
+}
+
+// BaseTicketEntry credits the joining ticket of a participant; there is one per participant.
+func BaseTicketEntry(giveawayID string, userID int64) LedgerEntry {
+	return LedgerEntry{
+		IdempotencyKey: fmt.Sprintf("base:%s:%d", giveawayID, userID),
+		Asset:          LedgerAssetTicket,
+		Source:         LedgerSourceBase,
+		Debit:          LedgerIssuanceAccount,
+		Credit:         TicketAccount(giveawayID, userID),
+		Amount:         1,
+		GiveawayID:     giveawayID,
+		ActorID:        userID,
+	}
+}
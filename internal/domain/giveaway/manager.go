@@ -0,0 +1,17 @@
+package giveaway
+
+import "time"
+
+// MaxManagers bounds the managers of one giveaway.
+const MaxManagers = 10
+
+// Manager is a user the creator lets manage one giveaway without handing it over: managers
+// view winners, export CSVs and upload manual candidates. Everything else stays with the creator.
+type Manager struct {
+	UserID    int64     `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	FirstName string    `json:"first_name,omitempty"`
+	LastName  string    `json:"last_name,omitempty"`
+	AddedBy   int64     `json:"added_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
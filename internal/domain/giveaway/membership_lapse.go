@@ -0,0 +1,13 @@
+package giveaway
+
+import "time"
+
+// MembershipLapse is a participant who left a required channel of a giveaway with continuous
+// membership. They are ineligible unless they re-subscribe before GraceUntil.
+type MembershipLapse struct {
+	GiveawayID string
+	UserID     int64
+	ChannelID  int64
+	LeftAt     time.Time
+	GraceUntil time.Time
+}
@@ -6,6 +6,8 @@ import "time"
 type GiveawayStatus string
 
 const (
+	// Drafts are editable, take no participants and are listed only to their creator until published
+	GiveawayStatusDraft     GiveawayStatus = "draft"
 	GiveawayStatusScheduled GiveawayStatus = "scheduled"
 	GiveawayStatusActive    GiveawayStatus = "active"
 	GiveawayStatusFinished  GiveawayStatus = "finished" // legacy value, kept for backward-compat in reads
@@ -14,6 +16,24 @@ const (
 	GiveawayStatusPending   GiveawayStatus = "pending"
 )
 
+// WinnerStrategy selects how winners are drawn when a giveaway finishes.
+type WinnerStrategy string
+
+const (
+	WinnerStrategyRandom   WinnerStrategy = "random"   // uniform random among eligible participants
+	WinnerStrategyWeighted WinnerStrategy = "weighted" // random weighted by participant tickets
+	WinnerStrategyFirstN   WinnerStrategy = "first_n"  // earliest eligible joiners win
+	WinnerStrategyManual   WinnerStrategy = "manual"   // creator uploads winners while giveaway is pending
+)
+
+// PendingAction decides what happens to a pending giveaway once its pending TTL elapses.
+type PendingAction string
+
+const (
+	PendingActionDraw   PendingAction = "draw"   // draw winners from participants meeting verifiable requirements
+	PendingActionCancel PendingAction = "cancel" // cancel and notify participants
+)
+
 // PrizePlace describes a prize for a specific winning place.
 type PrizePlace struct {
 	// Place is optional: when nil, the prize is unassigned and should be
@@ -21,6 +41,8 @@ type PrizePlace struct {
 	Place       *int   `json:"place,omitempty"`
 	Title       string `json:"title"`
 	Description string `json:"description,omitempty"`
+	// DescriptionHTML is the sanitized rich-text form; Description is its plain text.
+	DescriptionHTML string `json:"description_html,omitempty"`
 	// Quantity applies only to unassigned prizes; defaults to 1 for place-bound.
 	Quantity int `json:"quantity,omitempty"`
 }
@@ -40,13 +62,16 @@ type Giveaway struct {
 	CreatorID         int64          `json:"-"`
 	Title             string         `json:"title"`
 	Description       string         `json:"description"`
+	DescriptionHTML   string         `json:"description_html,omitempty"` // sanitized Telegram HTML; Description is its plain text
 	StartedAt         time.Time      `json:"started_at"`
 	EndsAt            time.Time      `json:"ends_at"`
 	Duration          int64          `json:"duration"`
 	MaxWinnersCount   int            `json:"winners_count"`
 	Status            GiveawayStatus `json:"status"`
+	WinnerStrategy    WinnerStrategy `json:"winner_strategy,omitempty"`
 	CreatedAt         time.Time      `json:"created_at"`
 	UpdatedAt         time.Time      `json:"updated_at"`
+	Version           int64          `json:"version"`
 	Prizes            []PrizePlace   `json:"prizes,omitempty"`
 	Sponsors          []ChannelInfo  `json:"sponsors"`
 	Requirements      []Requirement  `json:"requirements,omitempty"`
@@ -54,18 +79,127 @@ type Giveaway struct {
 	ParticipantsCount int            `json:"participants_count"`
 	// PreparedInlineMessageID stores the ID returned by Telegram savePreparedInlineMessage
 	PreparedInlineMessageID string `json:"-"`
+	// Pending expiry overrides; nil/empty fall back to the server defaults. A TTL of 0 never expires.
+	PendingTTLSec *int          `json:"pending_ttl_sec,omitempty"`
+	PendingAction PendingAction `json:"pending_action,omitempty"`
+	PendingSince  *time.Time    `json:"pending_since,omitempty"`
+	// Funding is set when prizes are escrowed on-chain; the giveaway stays scheduled until funded
+	Funding *Funding `json:"funding,omitempty"`
+	// ModerationFlags holds borderline content found on create; stored for admin review
+	ModerationFlags []ModerationFlag `json:"-"`
+	// Approval is set when the creator's reputation requires admin review before the giveaway starts
+	Approval *Approval `json:"approval,omitempty"`
+	// JoinWindows restrict when joins are accepted, in JoinTimezone; JoinWindow is their state now
+	JoinWindows  []JoinWindow     `json:"join_windows,omitempty"`
+	JoinTimezone string           `json:"join_timezone,omitempty"`
+	JoinWindow   *JoinWindowState `json:"join_window,omitempty"`
+	// MaxPerFingerprint flags joins once this many accounts joined from one device; 0 disables
+	MaxPerFingerprint int `json:"max_accounts_per_fingerprint,omitempty"`
+	// MaxParticipants closes joins once this many users joined; 0 means no cap
+	MaxParticipants int `json:"max_participants,omitempty"`
+	// WinnerOrder is how winners are listed in responses, announcements and exports; empty means by place
+	WinnerOrder WinnerOrder `json:"winner_order,omitempty"`
+	// ReserveWinnersCount alternates are drawn after the winners; ClaimDeadlineSec, when set,
+	// replaces winners who did not claim within that many seconds of winning
+	ReserveWinnersCount int  `json:"reserve_winners_count,omitempty"`
+	ClaimDeadlineSec    *int `json:"claim_deadline_sec,omitempty"`
+	// UnsubscribeGraceSec, when set, requires continuous membership in subscription channels:
+	// participants who leave one are skipped by the draw unless they re-subscribe within it
+	UnsubscribeGraceSec *int `json:"unsubscribe_grace_sec,omitempty"`
+	// AllowDuplicate creates the giveaway even when it repeats one submitted in the last
+	// minutes; DuplicateOf then names that giveaway
+	AllowDuplicate bool   `json:"-"`
+	DuplicateOf    string `json:"-"`
+	// Rules and FAQ are shown on the giveaway page; AnnounceRules also appends the rules to the
+	// channel announcement
+	Rules         string     `json:"rules,omitempty"`
+	FAQ           []FAQEntry `json:"faq,omitempty"`
+	AnnounceRules bool       `json:"announce_rules,omitempty"`
+	// Cover replaces the default launch animation; nil uses the default
+	Cover *Cover `json:"cover,omitempty"`
+	// Translations of the title and description by language (see NormalizeLanguage); Language
+	// names the translation Title and Description were taken from, empty for the original
+	Translations map[string]Translation `json:"translations,omitempty"`
+	Language     string                 `json:"language,omitempty"`
+	// PrizePackages are awarded whole to the winners of their place ranges, on top of Prizes
+	PrizePackages []PrizePackage `json:"prize_packages,omitempty"`
+	// Category and tags from the allowed vocabulary (see Categories and Tags) for feed filters
+	Category Category `json:"category,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	// List badges computed on read; see ApplyBadges
+	EndingSoon  bool `json:"ending_soon,omitempty"`
+	JustStarted bool `json:"just_started,omitempty"`
 }
 
 // WinnerPrize describes a prize assigned to a winner.
 type WinnerPrize struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Quantity    int    `json:"quantity"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	DescriptionHTML string `json:"description_html,omitempty"`
+	Quantity        int    `json:"quantity"`
+	// Package is the label of the prize package the prize came with, empty for single prizes
+	Package string `json:"package,omitempty"`
 }
 
 // Winner represents a winner with place and assigned prizes.
+// TotalUnits is the number of prize units won across all prizes; prizes carry no value, so
+// units of different prizes are simply added up.
 type Winner struct {
-	Place  int           `json:"place"`
-	UserID int64         `json:"user_id"`
-	Prizes []WinnerPrize `json:"prizes,omitempty"`
+	Place      int           `json:"place"`
+	UserID     int64         `json:"user_id"`
+	Prizes     []WinnerPrize `json:"prizes,omitempty"`
+	TotalUnits int           `json:"total_units"`
+	// Source is how the winner arrived at the giveaway; set by ListWinnersWithPrizes
+	Source      ParticipantSource `json:"source,omitempty"`
+	Fulfillment FulfillmentStatus `json:"fulfillment_status,omitempty"`
+	// AssignedAt is when the winner was drawn or loaded; set by ListWinnersWithPrizes
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	// Contact is the winner's delivery contact, nil until they choose one
+	Contact *ContactPreference `json:"contact,omitempty"`
+}
+
+// MergeWinnerPrizes folds duplicate prizes (same title, description and package) into one entry
+// with summed quantity, keeping first-seen order. Quantities below 1 count as 1.
+func MergeWinnerPrizes(prizes []WinnerPrize) []WinnerPrize {
+	if len(prizes) == 0 {
+		return prizes
+	}
+	out := make([]WinnerPrize, 0, len(prizes))
+	idx := make(map[[3]string]int, len(prizes))
+	for _, p := range prizes {
+		if p.Quantity <= 0 {
+			p.Quantity = 1
+		}
+		key := [3]string{p.Title, p.Description, p.Package}
+		if i, ok := idx[key]; ok {
+			out[i].Quantity += p.Quantity
+			continue
+		}
+		idx[key] = len(out)
+		out = append(out, p)
+	}
+	return out
+}
+
+// TotalPrizeQuantity sums quantities of the given prizes.
+func TotalPrizeQuantity(prizes []WinnerPrize) int {
+	total := 0
+	for _, p := range prizes {
+		total += p.Quantity
+	}
+	return total
+}
+
+// Participant is a giveaway entry used by winner selection strategies.
+type Participant struct {
+	UserID   int64     `json:"user_id"`
+	JoinedAt time.Time `json:"joined_at"`
+	Tickets  int       `json:"tickets"`
+}
+
+// Participation is a giveaway as seen in a participant's history.
+type Participation struct {
+	Giveaway
+	JoinedAt time.Time `json:"joined_at"`
+	Won      bool      `json:"won"`
 }
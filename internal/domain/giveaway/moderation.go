@@ -0,0 +1,27 @@
+package giveaway
+
+import "time"
+
+// ModerationStatus is the review state of a flagged giveaway text.
+type ModerationStatus string
+
+const (
+	ModerationPending  ModerationStatus = "pending"
+	ModerationApproved ModerationStatus = "approved" // an admin kept the giveaway
+	ModerationRemoved  ModerationStatus = "removed"  // an admin cancelled the giveaway
+)
+
+// ModerationFlag records borderline content found when a giveaway was created.
+type ModerationFlag struct {
+	ID            int64            `json:"id"`
+	GiveawayID    string           `json:"giveaway_id"`
+	GiveawayTitle string           `json:"giveaway_title,omitempty"`
+	Field         string           `json:"field"`
+	Rule          string           `json:"rule"`
+	Match         string           `json:"match"`
+	Lang          string           `json:"lang,omitempty"`
+	Status        ModerationStatus `json:"status"`
+	ReviewedBy    int64            `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time       `json:"reviewed_at,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
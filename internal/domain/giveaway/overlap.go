@@ -0,0 +1,28 @@
+package giveaway
+
+// OverlapSet is one giveaway in an audience overlap report.
+type OverlapSet struct {
+	GiveawayID   string `json:"giveaway_id"`
+	Title        string `json:"title"`
+	Participants int    `json:"participants"`
+}
+
+// OverlapPair compares the participants of two giveaways. AInB is the share of A's
+// participants who also joined B, in percent.
+type OverlapPair struct {
+	A      string  `json:"a"`
+	B      string  `json:"b"`
+	Shared int     `json:"shared"`
+	AInB   float64 `json:"a_in_b_pct"`
+	BInA   float64 `json:"b_in_a_pct"`
+}
+
+// OverlapReport measures audience reuse across giveaways. When Sampled is set, Shared and
+// Unique are estimates extrapolated from SamplePct percent of users.
+type OverlapReport struct {
+	Giveaways []OverlapSet  `json:"giveaways"`
+	Pairs     []OverlapPair `json:"pairs"`
+	Unique    int           `json:"unique_participants"`
+	Sampled   bool          `json:"sampled"`
+	SamplePct int           `json:"sample_pct"`
+}
@@ -0,0 +1,34 @@
+package giveaway
+
+import "time"
+
+// ImportedParticipant is one NDJSON line of a bulk participant import. JoinedAt defaults to
+// the import time.
+type ImportedParticipant struct {
+	UserID    int64      `json:"user_id"`
+	FirstName string     `json:"first_name,omitempty"`
+	JoinedAt  *time.Time `json:"joined_at,omitempty"`
+}
+
+// ParticipantImportStatus is the state of a bulk participant import.
+type ParticipantImportStatus string
+
+const (
+	ParticipantImportRunning ParticipantImportStatus = "running"
+	ParticipantImportDone    ParticipantImportStatus = "done"
+	ParticipantImportFailed  ParticipantImportStatus = "failed"
+)
+
+// ParticipantImportProgress reports a bulk participant import. Skipped counts users already
+// participating, repeated in the input or being the creator.
+type ParticipantImportProgress struct {
+	GiveawayID string                  `json:"giveaway_id"`
+	Status     ParticipantImportStatus `json:"status"`
+	Read       int                     `json:"read"`
+	Imported   int                     `json:"imported"`
+	Skipped    int                     `json:"skipped"`
+	Invalid    int                     `json:"invalid"`
+	Error      string                  `json:"error,omitempty"`
+	StartedAt  time.Time               `json:"started_at"`
+	UpdatedAt  time.Time               `json:"updated_at"`
+}
@@ -0,0 +1,106 @@
+package giveaway
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParticipantSource tells how a participant arrived at the giveaway before joining.
+type ParticipantSource string
+
+const (
+	ParticipantSourceAnnouncement ParticipantSource = "announcement" // button under the channel announcement post
+	ParticipantSourceInline       ParticipantSource = "inline"       // prepared inline message shared by the creator
+	ParticipantSourceExplore      ParticipantSource = "explore"      // active giveaways feed inside the mini app
+	ParticipantSourceDirect       ParticipantSource = "direct"       // plain startapp link without a source suffix
+	ParticipantSourceUnknown      ParticipantSource = "unknown"
+	// ParticipantSourceImport marks participants bulk-imported by a platform admin. It is not
+	// Valid, so clients cannot claim it when joining.
+	ParticipantSourceImport ParticipantSource = "import"
+	// ParticipantSourceInvite marks joins through an invite link the creator generated; the
+	// link name is the reference. It is only taken from signed startapp payloads, so it is not Valid.
+	ParticipantSourceInvite ParticipantSource = "invite"
+)
+
+// startParamMax is the longest startapp payload Telegram accepts.
+const startParamMax = 64
+
+// Valid reports whether s is a known source.
+func (s ParticipantSource) Valid() bool {
+	switch s {
+	case ParticipantSourceAnnouncement, ParticipantSourceInline, ParticipantSourceExplore, ParticipantSourceDirect, ParticipantSourceUnknown:
+		return true
+	}
+	return false
+}
+
+// StartParam builds the startapp payload for a giveaway link opened from source.
+// Telegram allows up to 64 characters of [A-Za-z0-9_-], which fits a UUID plus suffix.
+func StartParam(giveawayID string, source ParticipantSource) string {
+	if source == "" || source == ParticipantSourceDirect || source == ParticipantSourceUnknown {
+		return giveawayID
+	}
+	return giveawayID + "_" + string(source)
+}
+
+// InviteStartParam builds the startapp payload of the invite link name of a giveaway.
+func InviteStartParam(giveawayID, name string) string {
+	return giveawayID + "_" + name
+}
+
+// ParseStartParam splits a startapp payload into the giveaway id, the source and, for invite
+// links, the link name. Giveaway ids never contain '_', so the id ends at the first one.
+// Payloads without a suffix are direct links.
+func ParseStartParam(param string) (string, ParticipantSource, string) {
+	i := strings.IndexByte(param, '_')
+	if i <= 0 || i == len(param)-1 {
+		return param, ParticipantSourceDirect, ""
+	}
+	if src := ParticipantSource(param[i+1:]); src.Valid() {
+		return param[:i], src, ""
+	}
+	return param[:i], ParticipantSourceInvite, param[i+1:]
+}
+
+// SourceStats counts participants that joined from one source and reference.
+type SourceStats struct {
+	Source       ParticipantSource `json:"source"`
+	Ref          string            `json:"ref,omitempty"`
+	Participants int64             `json:"participants"`
+}
+
+// MaxInviteLinks bounds the invite links of one giveaway.
+const MaxInviteLinks = 50
+
+// InviteLink is a startapp link the creator generated for one source, e.g. a partner channel,
+// with the participants who joined through it.
+type InviteLink struct {
+	Name         string    `json:"name"`
+	StartParam   string    `json:"start_param"`
+	URL          string    `json:"url,omitempty"`
+	Participants int64     `json:"participants"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ValidateInviteName reports why name cannot name an invite link of giveawayID: names use
+// lowercase letters, digits, '-' and '_', must fit the startapp payload and cannot be a
+// built-in source.
+func ValidateInviteName(giveawayID, name string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return errors.New("name may only contain lowercase letters, digits, '-' and '_'")
+		}
+	}
+	if src := ParticipantSource(name); src.Valid() || src == ParticipantSourceImport || src == ParticipantSourceInvite {
+		return errors.New("name is reserved")
+	}
+	if max := startParamMax - len(giveawayID) - 1; len(name) > max {
+		return fmt.Errorf("name must be at most %d characters", max)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package giveaway
+
+import "time"
+
+// PayoutStatus tracks an on-chain prize payout from the escrow wallet.
+type PayoutStatus string
+
+const (
+	PayoutStatusReady    PayoutStatus = "ready"    // screening passed; may be sent
+	PayoutStatusHeld     PayoutStatus = "held"     // flagged or unscreenable; waits for admin review
+	PayoutStatusApproved PayoutStatus = "approved" // released by an admin after review
+	PayoutStatusRejected PayoutStatus = "rejected" // blocked by an admin after review
+)
+
+// ScreeningResult is the outcome of screening a winner wallet before payout.
+type ScreeningResult string
+
+const (
+	ScreeningClear    ScreeningResult = "clear"
+	ScreeningFlagged  ScreeningResult = "flagged"
+	ScreeningError    ScreeningResult = "error"     // provider failed; held rather than paid unscreened
+	ScreeningNoWallet ScreeningResult = "no_wallet" // winner has not linked a wallet
+)
+
+// Payout is a winner's share of an escrow-funded giveaway together with its screening outcome.
+type Payout struct {
+	ID                int64           `json:"id"`
+	GiveawayID        string          `json:"giveaway_id"`
+	UserID            int64           `json:"user_id"`
+	Place             int             `json:"place"`
+	WalletAddress     string          `json:"wallet_address,omitempty"`
+	AmountNano        int64           `json:"amount_nano"`
+	Status            PayoutStatus    `json:"status"`
+	Screening         ScreeningResult `json:"screening_result"`
+	ScreeningReason   string          `json:"screening_reason,omitempty"`
+	ScreeningProvider string          `json:"screening_provider,omitempty"`
+	ScreenedAt        time.Time       `json:"screened_at"`
+	ReviewedBy        int64           `json:"reviewed_by,omitempty"`
+	ReviewedAt        *time.Time      `json:"reviewed_at,omitempty"`
+	ReviewNote        string          `json:"review_note,omitempty"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
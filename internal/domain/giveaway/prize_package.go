@@ -0,0 +1,52 @@
+package giveaway
+
+import "fmt"
+
+// PrizePackage is a bundle of items awarded together to every winner from FromPlace to
+// ToPlace, e.g. places 1–3 each get a hoodie, a mug and 10 TON.
+type PrizePackage struct {
+	Label     string        `json:"label"`
+	FromPlace int           `json:"from_place"`
+	ToPlace   int           `json:"to_place"`
+	Items     []PackageItem `json:"items"`
+}
+
+// PackageItem is one item of a prize package; each winner in the range gets Quantity units.
+type PackageItem struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// DescriptionHTML is the sanitized rich-text form; Description is its plain text.
+	DescriptionHTML string `json:"description_html,omitempty"`
+	Quantity        int    `json:"quantity"`
+}
+
+// Covers reports whether the winner at place gets p.
+func (p PrizePackage) Covers(place int) bool {
+	return place >= p.FromPlace && place <= p.ToPlace
+}
+
+// Places is the number of places out of winners that get p.
+func (p PrizePackage) Places(winners int) int {
+	to := min(p.ToPlace, winners)
+	if to < p.FromPlace {
+		return 0
+	}
+	return to - p.FromPlace + 1
+}
+
+// Units is the number of prize units one winner gets from p.
+func (p PrizePackage) Units() int {
+	n := 0
+	for _, it := range p.Items {
+		n += max(it.Quantity, 1)
+	}
+	return n
+}
+
+// Name labels p with its places for announcements, e.g. "Gold (places 1–3)".
+func (p PrizePackage) Name() string {
+	if p.FromPlace == p.ToPlace {
+		return fmt.Sprintf("%s (place %d)", p.Label, p.FromPlace)
+	}
+	return fmt.Sprintf("%s (places %d–%d)", p.Label, p.FromPlace, p.ToPlace)
+}
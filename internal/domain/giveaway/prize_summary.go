@@ -0,0 +1,42 @@
+package giveaway
+
+// Prize budget warnings; they never block creation.
+const (
+	// PrizeWarningFewerThanWinners: some winners will receive nothing
+	PrizeWarningFewerThanWinners = "fewer_units_than_winners"
+	// PrizeWarningHighPerWinner: the average share looks like a typo
+	PrizeWarningHighPerWinner = "high_units_per_winner"
+)
+
+// HighUnitsPerWinner is the average share above which a prize budget is flagged.
+const HighUnitsPerWinner = 100
+
+// PrizeSummary totals the prize budget for the creation confirmation screen.
+type PrizeSummary struct {
+	TotalUnits int      `json:"total_units"`
+	PerWinner  float64  `json:"per_winner"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+// SummarizePrizes totals prize units, including the packages of the places that get drawn,
+// against the number of winners.
+func SummarizePrizes(prizes []PrizePlace, packages []PrizePackage, winners int) PrizeSummary {
+	var s PrizeSummary
+	for _, p := range prizes {
+		s.TotalUnits += p.Quantity
+	}
+	for _, p := range packages {
+		s.TotalUnits += p.Units() * p.Places(winners)
+	}
+	if winners <= 0 {
+		return s
+	}
+	s.PerWinner = float64(s.TotalUnits) / float64(winners)
+	if s.TotalUnits < winners {
+		s.Warnings = append(s.Warnings, PrizeWarningFewerThanWinners)
+	}
+	if s.PerWinner > HighUnitsPerWinner {
+		s.Warnings = append(s.Warnings, PrizeWarningHighPerWinner)
+	}
+	return s
+}
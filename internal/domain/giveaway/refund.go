@@ -0,0 +1,48 @@
+package giveaway
+
+import "time"
+
+// TicketPurchase is a Telegram Stars payment for extra tickets in a giveaway.
+type TicketPurchase struct {
+	GiveawayID string `json:"giveaway_id"`
+	UserID     int64  `json:"user_id"`
+	// ChargeID is telegram_payment_charge_id from the successful_payment update
+	ChargeID string `json:"charge_id"`
+	Stars    int64  `json:"stars"`
+	Tickets  int64  `json:"tickets"`
+}
+
+// RefundStatus tracks a refund of purchased tickets.
+type RefundStatus string
+
+const (
+	RefundStatusPending RefundStatus = "pending"
+	// RefundStatusRefunded means Telegram returned the Stars to the buyer
+	RefundStatusRefunded RefundStatus = "refunded"
+	// RefundStatusCredited means the refund kept failing and the Stars were credited to the
+	// buyer's ledger account instead
+	RefundStatusCredited RefundStatus = "credited"
+)
+
+// Refund returns one ticket purchase of a cancelled giveaway.
+type Refund struct {
+	ID          int64        `json:"id"`
+	GiveawayID  string       `json:"giveaway_id"`
+	UserID      int64        `json:"user_id"`
+	ChargeID    string       `json:"-"`
+	Stars       int64        `json:"stars"`
+	Tickets     int64        `json:"tickets"`
+	Status      RefundStatus `json:"status"`
+	Attempts    int          `json:"attempts"`
+	LastError   string       `json:"last_error,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+}
+
+// RefundSummary counts the refunds of a giveaway by status.
+type RefundSummary struct {
+	Pending  int   `json:"pending"`
+	Refunded int   `json:"refunded"`
+	Credited int   `json:"credited"`
+	Stars    int64 `json:"stars"`
+}
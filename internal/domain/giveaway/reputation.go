@@ -0,0 +1,44 @@
+package giveaway
+
+import "time"
+
+// ReputationStats is the creator behavior a reputation score is computed from.
+type ReputationStats struct {
+	Giveaways    int `json:"giveaways"`
+	Cancelled    int `json:"cancelled"`
+	Participants int `json:"participants"`
+	Reports      int `json:"reports"`
+	Winners      int `json:"winners"`
+	// Disputes counts winner disputes whose prize is still not delivered
+	Disputes int `json:"disputes"`
+}
+
+// Reputation is a creator's score from 0 (worst) to 100 with the stats behind it.
+type Reputation struct {
+	CreatorID int64           `json:"creator_id"`
+	Score     int             `json:"score"`
+	Stats     ReputationStats `json:"stats"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// ApprovalStatus is the admin review state of a giveaway held for approval.
+type ApprovalStatus string
+
+const (
+	ApprovalAwaiting ApprovalStatus = "awaiting"
+	ApprovalApproved ApprovalStatus = "approved"
+	ApprovalRejected ApprovalStatus = "rejected"
+	ApprovalExpired  ApprovalStatus = "expired" // the giveaway ended before review
+)
+
+// Approval holds a giveaway of a low-reputation creator in scheduled until an admin reviews it.
+type Approval struct {
+	GiveawayID      string         `json:"giveaway_id"`
+	GiveawayTitle   string         `json:"giveaway_title,omitempty"`
+	CreatorID       int64          `json:"creator_id,omitempty"`
+	Status          ApprovalStatus `json:"status"`
+	ReputationScore int            `json:"reputation_score"`
+	ReviewedBy      int64          `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time     `json:"reviewed_at,omitempty"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
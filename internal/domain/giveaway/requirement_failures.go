@@ -0,0 +1,99 @@
+package giveaway
+
+import "math"
+
+// RequirementOutcome is the result of checking one requirement for a user.
+type RequirementOutcome struct {
+	RequirementID int64
+	Type          RequirementType
+	Passed        bool
+}
+
+// RequirementFailureStats tells how often users fail a requirement, or every requirement of
+// a type platform-wide. Of the Checked users, Failed failed a check at least once and
+// Resolved of those passed it later.
+type RequirementFailureStats struct {
+	RequirementID int64           `json:"requirement_id,omitempty"`
+	Type          RequirementType `json:"type"`
+	Name          string          `json:"name,omitempty"` // requirement name, else its channel username
+	Checked       int             `json:"checked"`
+	Failed        int             `json:"failed"`
+	Resolved      int             `json:"resolved"`
+	// FailedPct is Failed as a whole percentage of Checked
+	FailedPct int `json:"failed_pct"`
+	// Summary reads e.g. "43% of users failed the boost requirement"
+	Summary string `json:"summary,omitempty"`
+}
+
+// FailureRate is the share of checked users who failed, 0 without checks.
+func (s RequirementFailureStats) FailureRate() float64 {
+	if s.Checked == 0 {
+		return 0
+	}
+	return float64(s.Failed) / float64(s.Checked)
+}
+
+// FillPct sets FailedPct from the counts.
+func (s *RequirementFailureStats) FillPct() {
+	s.FailedPct = int(math.Round(s.FailureRate() * 100))
+}
+
+// RequirementHintCode names a recommendation shown when creating a giveaway.
+type RequirementHintCode string
+
+const (
+	// A requirement type many users fail platform-wide
+	RequirementHintHighFriction RequirementHintCode = "high_friction"
+	// Required requirements that together turn most users away
+	RequirementHintHighFrictionCombination RequirementHintCode = "high_friction_combination"
+)
+
+// RequirementHint warns a creator about requirements that cost participants.
+type RequirementHint struct {
+	Code  RequirementHintCode `json:"code"`
+	Types []RequirementType   `json:"types"`
+	// FailedPct is the share of users expected to fail, in whole percent
+	FailedPct int    `json:"failed_pct"`
+	Message   string `json:"message,omitempty"`
+}
+
+const (
+	// hintMinChecked is how many users a type needs to be checked against before it counts
+	hintMinChecked = 50
+	// hintTypeRate flags a single type failed by this share of users
+	hintTypeRate = 0.4
+	// hintCombinationRate flags required requirements expected to turn away this share
+	hintCombinationRate = 0.6
+)
+
+// FrictionHints returns hints for the required requirements reqs from the platform-wide
+// failure rates by type. Types with too few checks are left out of every estimate, and the
+// combined estimate treats requirements as independent.
+func FrictionHints(reqs []Requirement, global []RequirementFailureStats) []RequirementHint {
+	rates := make(map[RequirementType]float64, len(global))
+	for _, s := range global {
+		if s.Checked >= hintMinChecked {
+			rates[s.Type] = s.FailureRate()
+		}
+	}
+	var out []RequirementHint
+	var types []RequirementType
+	seen := map[RequirementType]bool{}
+	pass := 1.0
+	for _, r := range reqs {
+		rate, ok := rates[r.Type]
+		if r.IsOptional() || !ok {
+			continue
+		}
+		types = append(types, r.Type)
+		pass *= 1 - rate
+		if rate >= hintTypeRate && !seen[r.Type] {
+			seen[r.Type] = true
+			out = append(out, RequirementHint{Code: RequirementHintHighFriction, Types: []RequirementType{r.Type}, FailedPct: int(math.Round(rate * 100))})
+		}
+	}
+	if len(types) > 1 && 1-pass >= hintCombinationRate {
+		out = append(out, RequirementHint{Code: RequirementHintHighFrictionCombination, Types: types, FailedPct: int(math.Round((1 - pass) * 100))})
+	}
+	return out
+}
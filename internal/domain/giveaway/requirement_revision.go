@@ -0,0 +1,14 @@
+package giveaway
+
+import "time"
+
+// RequirementRevision is the requirement list of a giveaway after one edit. Revision 1 is the
+// list the giveaway was created with.
+type RequirementRevision struct {
+	Revision     int           `json:"revision"`
+	Requirements []Requirement `json:"requirements"`
+	ChangedBy    int64         `json:"changed_by"`
+	// RevokedClaims counts the bonus task claims withdrawn because their task was removed
+	RevokedClaims int       `json:"revoked_claims"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -1,5 +1,7 @@
 package giveaway
 
+import "time"
+
 // RequirementType enumerates allowed requirement kinds.
 type RequirementType string
 
@@ -18,6 +20,7 @@ const (
 // Requirement describes a single requirement entry for a giveaway.
 // For subscription, either ChannelID or ChannelUsername should be provided.
 type Requirement struct {
+	ID              int64           `json:"id,omitempty"` // row id; bonus tasks are claimed by it
 	Type            RequirementType `json:"type"`
 	ChannelID       int64           `json:"channel_id,omitempty"`
 	ChannelUsername string          `json:"channel_username,omitempty"`
@@ -37,4 +40,41 @@ type Requirement struct {
 	// At least one of these fields must be set when type is account_age.
 	AccountAgeMinYear int `json:"account_age_min_year,omitempty"`
 	AccountAgeMaxYear int `json:"account_age_max_year,omitempty"`
+	// BonusTickets > 0 makes the requirement an optional task: it is not enforced on join
+	// and adds this many tickets for weighted draws when met.
+	BonusTickets int `json:"bonus_tickets,omitempty"`
+	// Unverifiable is set when the bot was removed or demoted in the requirement channel,
+	// so membership and boosts can no longer be checked there.
+	Unverifiable       bool   `json:"unverifiable,omitempty"`
+	UnverifiableReason string `json:"unverifiable_reason,omitempty"`
+	// ExistingMembersOnly limits a subscription requirement to users who were channel members
+	// before the giveaway activated; joins observed by the bot after that fail the requirement.
+	ExistingMembersOnly bool `json:"existing_members_only,omitempty"`
+	// MinMemberDays asks subscription members to have been in the channel for this many days,
+	// as far as the bot observed, and to stay members from the giveaway start until the draw.
+	MinMemberDays int `json:"min_member_days,omitempty"`
+	// ChannelStale is set on reads when the channel info was served from memory because
+	// lookups for the channel keep failing.
+	ChannelStale bool `json:"channel_stale,omitempty"`
+	// Position orders requirements within the giveaway, starting at 0.
+	Position int `json:"position"`
+	// Optional requirements are shown but never block joining; they only earn BonusTickets.
+	Optional bool `json:"optional,omitempty"`
+	// BundleID is the sponsored bundle the requirement was attached from, if any.
+	BundleID int64 `json:"bundle_id,omitempty"`
+}
+
+// MemberHistory is the channel membership of a user as observed by the bot.
+type MemberHistory struct {
+	// FirstSeenAt starts the current membership; nil after a leave until the next observed join
+	FirstSeenAt *time.Time
+	LastLeftAt  *time.Time
+	// GiveawayStartedAt is when the giveaway judging the membership started
+	GiveawayStartedAt time.Time
 }
+
+// IsBonus reports whether the requirement is an optional bonus task.
+func (r Requirement) IsBonus() bool { return r.BonusTickets > 0 }
+
+// IsOptional reports whether the requirement is skipped by the join gate and eligibility checks.
+func (r Requirement) IsOptional() bool { return r.Optional || r.IsBonus() }
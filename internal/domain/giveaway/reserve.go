@@ -0,0 +1,29 @@
+package giveaway
+
+import "time"
+
+// ReserveStatus tracks whether a reserve winner is still waiting or took a winner's place.
+type ReserveStatus string
+
+const (
+	ReserveWaiting  ReserveStatus = "reserve"
+	ReservePromoted ReserveStatus = "promoted"
+)
+
+// Reserve is an alternate drawn after the winners, promoted in position order when a winner
+// is disqualified or misses the claim deadline. Reserves are only shown to the creator.
+type Reserve struct {
+	Position int           `json:"position"`
+	UserID   int64         `json:"user_id"`
+	Status   ReserveStatus `json:"status"`
+	// Set once promoted: the place taken over and the winner who lost it
+	PromotedPlace  int        `json:"promoted_place,omitempty"`
+	ReplacedUserID int64      `json:"replaced_user_id,omitempty"`
+	PromotedAt     *time.Time `json:"promoted_at,omitempty"`
+}
+
+// MissedClaim is a winner who did not claim their prize before the giveaway's claim deadline.
+type MissedClaim struct {
+	GiveawayID string
+	UserID     int64
+}
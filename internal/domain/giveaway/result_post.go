@@ -0,0 +1,30 @@
+package giveaway
+
+import "time"
+
+// ResultPostStatus is the delivery state of a winners announcement in one channel.
+type ResultPostStatus string
+
+const (
+	ResultPostWaiting ResultPostStatus = "waiting" // giveaway not completed yet or delay not elapsed
+	ResultPostPosted  ResultPostStatus = "posted"
+	ResultPostFailed  ResultPostStatus = "failed" // gave up after repeated errors
+	ResultPostDeleted ResultPostStatus = "deleted"
+)
+
+// ResultPost is a winners announcement scheduled for a sponsor channel. DueAt is set once the
+// giveaway completed: completion time plus DelaySec.
+type ResultPost struct {
+	GiveawayID   string           `json:"giveaway_id"`
+	ChannelID    int64            `json:"channel_id"`
+	ChannelTitle string           `json:"channel_title,omitempty"`
+	DelaySec     int              `json:"delay_sec"`
+	ImageURL     string           `json:"image_url,omitempty"`
+	Status       ResultPostStatus `json:"status"`
+	MessageID    int64            `json:"message_id,omitempty"`
+	Attempts     int              `json:"attempts,omitempty"`
+	Error        string           `json:"error,omitempty"`
+	DueAt        *time.Time       `json:"due_at,omitempty"`
+	PostedAt     *time.Time       `json:"posted_at,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
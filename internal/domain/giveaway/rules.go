@@ -0,0 +1,7 @@
+package giveaway
+
+// FAQEntry is one question creators answer up front on the giveaway page.
+type FAQEntry struct {
+	Question string `json:"question"`
+	Answer   string `json:"answer"`
+}
@@ -0,0 +1,39 @@
+package giveaway
+
+import "time"
+
+// ScheduleItemKind ties a scheduled post to a point of the giveaway lifecycle.
+type ScheduleItemKind string
+
+const (
+	ScheduleKindLaunch   ScheduleItemKind = "launch"
+	ScheduleKindMidpoint ScheduleItemKind = "midpoint"
+	ScheduleKindLastDay  ScheduleItemKind = "last_day"
+	ScheduleKindResults  ScheduleItemKind = "results"
+	ScheduleKindCustom   ScheduleItemKind = "custom"
+)
+
+// ScheduleItemStatus tracks delivery of a scheduled post.
+type ScheduleItemStatus string
+
+const (
+	ScheduleStatusPending    ScheduleItemStatus = "pending"
+	ScheduleStatusProcessing ScheduleItemStatus = "processing"
+	ScheduleStatusSent       ScheduleItemStatus = "sent"
+	ScheduleStatusFailed     ScheduleItemStatus = "failed"
+	ScheduleStatusCancelled  ScheduleItemStatus = "cancelled"
+)
+
+// ScheduleItem is a single entry of a giveaway content plan.
+type ScheduleItem struct {
+	ID          int64              `json:"id"`
+	GiveawayID  string             `json:"giveaway_id"`
+	Kind        ScheduleItemKind   `json:"kind"`
+	ScheduledAt time.Time          `json:"scheduled_at"`
+	Text        string             `json:"text,omitempty"`
+	Status      ScheduleItemStatus `json:"status"`
+	Attempts    int                `json:"attempts"`
+	LastError   string             `json:"last_error,omitempty"`
+	SentAt      *time.Time         `json:"sent_at,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
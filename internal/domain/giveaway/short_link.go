@@ -0,0 +1,33 @@
+package giveaway
+
+import "time"
+
+// ShortLinkKind tells which part of a giveaway a short link points at.
+type ShortLinkKind string
+
+const (
+	ShortLinkRequirement ShortLinkKind = "requirement"
+	ShortLinkSponsor     ShortLinkKind = "sponsor"
+)
+
+// ShortLink wraps an outbound requirement or sponsor URL so click-throughs can be counted.
+// Position is the index of the requirement or sponsor within the giveaway.
+type ShortLink struct {
+	Code       string        `json:"code"`
+	GiveawayID string        `json:"giveaway_id"`
+	Kind       ShortLinkKind `json:"kind"`
+	Position   int           `json:"position"`
+	URL        string        `json:"url"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// ShortLinkStats aggregates clicks of one short link.
+type ShortLinkStats struct {
+	Code        string        `json:"code"`
+	Kind        ShortLinkKind `json:"kind"`
+	Position    int           `json:"position"`
+	URL         string        `json:"url"`
+	Clicks      int64         `json:"clicks"`
+	UniqueUsers int64         `json:"unique_users"`
+	LastClickAt *time.Time    `json:"last_click_at,omitempty"`
+}
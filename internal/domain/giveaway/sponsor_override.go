@@ -0,0 +1,20 @@
+package giveaway
+
+import "time"
+
+// SponsorOverride is a sponsor whose display title or avatar the creator replaced, e.g. after
+// the channel was renamed. Title and AvatarURL keep the data cached from Telegram so admins
+// can tell an honest correction from impersonation.
+type SponsorOverride struct {
+	GiveawayID string `json:"giveaway_id"`
+	CreatorID  int64  `json:"creator_id"`
+	ChannelID  int64  `json:"channel_id"`
+	Username   string `json:"username,omitempty"`
+	Title      string `json:"title"`
+	AvatarURL  string `json:"avatar_url,omitempty"`
+	// TitleOverride and AvatarOverride are shown instead when set
+	TitleOverride  string    `json:"title_override,omitempty"`
+	AvatarOverride string    `json:"avatar_override,omitempty"`
+	UpdatedBy      int64     `json:"updated_by"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
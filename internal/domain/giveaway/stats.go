@@ -0,0 +1,24 @@
+package giveaway
+
+import "time"
+
+// PlatformStats are the public headline numbers of the platform.
+type PlatformStats struct {
+	TotalGiveaways       int64     `json:"total_giveaways"`
+	ActiveNow            int64     `json:"active_now"`
+	ParticipantsThisWeek int64     `json:"participants_this_week"` // distinct users who joined in the last 7 days
+	PrizesDistributed    int64     `json:"prizes_distributed"`     // prize units assigned to winners
+	ComputedAt           time.Time `json:"computed_at"`
+}
+
+// PlatformBreakdown holds the admin-only detail behind PlatformStats.
+type PlatformBreakdown struct {
+	PlatformStats
+	ByStatus             map[GiveawayStatus]int64    `json:"by_status"`
+	ByStrategy           map[WinnerStrategy]int64    `json:"by_strategy"`
+	JoinsThisWeek        int64                       `json:"joins_this_week"`
+	JoinsBySourceWeek    map[ParticipantSource]int64 `json:"joins_by_source_week"`
+	NewGiveawaysThisWeek int64                       `json:"new_giveaways_this_week"`
+	CreatorsTotal        int64                       `json:"creators_total"`
+	WinnersTotal         int64                       `json:"winners_total"`
+}
@@ -0,0 +1,35 @@
+package giveaway
+
+import "time"
+
+// StatusChange is one entry of a giveaway's status history.
+type StatusChange struct {
+	From      GiveawayStatus `json:"from,omitempty"`
+	To        GiveawayStatus `json:"to"`
+	Reason    string         `json:"reason,omitempty"`
+	ActorID   int64          `json:"actor_id,omitempty"` // 0 for system transitions
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// BroadcastKind names why participants are messaged.
+type BroadcastKind string
+
+const (
+	BroadcastCancelled BroadcastKind = "cancelled"
+	// BroadcastDeadWarning warns participants that a giveaway may be cancelled after review
+	BroadcastDeadWarning BroadcastKind = "dead_warning"
+)
+
+// Broadcast is a DM queued for every participant of a giveaway. LastUserID is the
+// delivery cursor: participants are messaged in user_id order and the cursor moves
+// after each batch, so a restart resumes where it stopped.
+type Broadcast struct {
+	ID         int64
+	GiveawayID string
+	Kind       BroadcastKind
+	Text       string
+	LastUserID int64
+	Sent       int
+	Failed     int
+	CreatedAt  time.Time
+}
@@ -0,0 +1,13 @@
+package giveaway
+
+import "time"
+
+// StatusSummary is the lightweight state of a giveaway shown on list cards.
+// TimeLeftSec is 0 once the giveaway has ended.
+type StatusSummary struct {
+	ID                string         `json:"id"`
+	Status            GiveawayStatus `json:"status"`
+	ParticipantsCount int            `json:"participants_count"`
+	EndsAt            time.Time      `json:"ends_at"`
+	TimeLeftSec       int64          `json:"time_left_sec"`
+}
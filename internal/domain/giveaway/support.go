@@ -0,0 +1,70 @@
+package giveaway
+
+import "time"
+
+// SupportCategory tells what a support ticket is about.
+type SupportCategory string
+
+const (
+	SupportCategoryPrizeNotReceived SupportCategory = "prize_not_received"
+	SupportCategoryWrongPrize       SupportCategory = "wrong_prize"
+	SupportCategoryOther            SupportCategory = "other"
+)
+
+// SupportStatus is the lifecycle state of a support ticket.
+type SupportStatus string
+
+const (
+	// SupportStatusOpen waits for the creator (or an admin once escalated)
+	SupportStatusOpen SupportStatus = "open"
+	// SupportStatusAnswered waits for the participant
+	SupportStatusAnswered SupportStatus = "answered"
+	// SupportStatusEscalated was taken over by a platform admin and waits for them
+	SupportStatusEscalated SupportStatus = "escalated"
+	SupportStatusResolved  SupportStatus = "resolved"
+)
+
+// SupportRole is the side an author of a ticket message is on.
+type SupportRole string
+
+const (
+	SupportRoleParticipant SupportRole = "participant"
+	SupportRoleCreator     SupportRole = "creator"
+	SupportRoleAdmin       SupportRole = "admin"
+)
+
+// SupportTicket is a participant's support request about a giveaway.
+type SupportTicket struct {
+	ID            int64           `json:"id"`
+	GiveawayID    string          `json:"giveaway_id"`
+	GiveawayTitle string          `json:"giveaway_title"`
+	CreatorID     int64           `json:"creator_id"`
+	UserID        int64           `json:"user_id"`
+	Category      SupportCategory `json:"category"`
+	Subject       string          `json:"subject"`
+	Status        SupportStatus   `json:"status"`
+	EscalatedAt   *time.Time      `json:"escalated_at,omitempty"`
+	ResolvedAt    *time.Time      `json:"resolved_at,omitempty"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	// Messages are only loaded for a single ticket
+	Messages []SupportMessage `json:"messages,omitempty"`
+}
+
+// SupportMessage is one message in a ticket thread.
+type SupportMessage struct {
+	ID        int64       `json:"id"`
+	AuthorID  int64       `json:"author_id"`
+	Role      SupportRole `json:"role"`
+	Body      string      `json:"body"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// SupportCounter counts the unresolved tickets of one giveaway for the creator dashboard.
+type SupportCounter struct {
+	GiveawayID    string `json:"giveaway_id"`
+	GiveawayTitle string `json:"giveaway_title"`
+	Unresolved    int    `json:"unresolved"`
+	// AwaitingReply are open tickets waiting for the creator
+	AwaitingReply int `json:"awaiting_reply"`
+}
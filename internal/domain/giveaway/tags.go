@@ -0,0 +1,70 @@
+package giveaway
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Category is the topic of a giveaway the explore feed filters by.
+type Category string
+
+const (
+	CategoryCrypto    Category = "crypto"
+	CategoryGaming    Category = "gaming"
+	CategoryTech      Category = "tech"
+	CategoryArt       Category = "art"
+	CategoryMusic     Category = "music"
+	CategoryEducation Category = "education"
+	CategoryLifestyle Category = "lifestyle"
+	CategorySports    Category = "sports"
+	CategoryOther     Category = "other"
+)
+
+// Categories lists the allowed categories in display order.
+var Categories = []Category{
+	CategoryCrypto, CategoryGaming, CategoryTech, CategoryArt, CategoryMusic,
+	CategoryEducation, CategoryLifestyle, CategorySports, CategoryOther,
+}
+
+// Tags is the allowed tag vocabulary in display order.
+var Tags = []string{
+	"nft", "ton", "airdrop", "defi", "memecoins", "stars", "premium", "gifts", "stickers",
+	"collectibles", "merch", "gadgets", "cash", "esports", "courses", "books", "travel",
+}
+
+// MaxTags bounds the tags of a giveaway.
+const MaxTags = 5
+
+// Valid reports whether c is an allowed category; empty means none.
+func (c Category) Valid() bool {
+	return c == "" || slices.Contains(Categories, c)
+}
+
+// ValidTag reports whether tag is in the vocabulary.
+func ValidTag(tag string) bool {
+	return slices.Contains(Tags, tag)
+}
+
+// NormalizeTags lowercases and trims tags, drops empty and repeated ones and sorts the rest.
+func NormalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t != "" && !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ActiveFilter narrows the list of active giveaways; zero fields match everything.
+type ActiveFilter struct {
+	MinParticipants int
+	Category        Category
+	Tag             string
+}
@@ -0,0 +1,21 @@
+package giveaway
+
+import "time"
+
+// Task is a bonus requirement as seen by one participant.
+type Task struct {
+	ID           int64           `json:"task_id"`
+	Type         RequirementType `json:"type"`
+	Title        string          `json:"title,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	BonusTickets int             `json:"bonus_tickets"`
+	Claimed      bool            `json:"claimed"`
+	ClaimedAt    *time.Time      `json:"claimed_at,omitempty"`
+}
+
+// TaskClaim records a bonus task credited to a participant.
+type TaskClaim struct {
+	RequirementID int64     `json:"task_id"`
+	BonusTickets  int       `json:"bonus_tickets"`
+	ClaimedAt     time.Time `json:"claimed_at"`
+}
@@ -0,0 +1,17 @@
+package giveaway
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Template is a named giveaway configuration a creator reuses to start new giveaways.
+// Config holds the create payload (prizes, requirements, sponsors, texts) as submitted.
+type Template struct {
+	ID        string          `json:"id"`
+	CreatorID int64           `json:"creator_id,omitempty"`
+	Name      string          `json:"name"`
+	Config    json.RawMessage `json:"giveaway"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
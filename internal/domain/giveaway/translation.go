@@ -0,0 +1,72 @@
+package giveaway
+
+import (
+	"sort"
+	"strings"
+)
+
+// Translation is a creator-supplied title and description of a giveaway in one language.
+// Empty fields fall back to the giveaway's own text.
+type Translation struct {
+	Title           string `json:"title,omitempty"`
+	Description     string `json:"description,omitempty"`
+	DescriptionHTML string `json:"description_html,omitempty"`
+}
+
+// NormalizeLanguage reduces a language tag like "pt-BR" or "RU" to its lowercase primary
+// language, the key of Giveaway.Translations.
+func NormalizeLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// ValidLanguage reports whether tag is a two or three letter primary language.
+func ValidLanguage(tag string) bool {
+	if len(tag) < 2 || len(tag) > 3 {
+		return false
+	}
+	for _, r := range tag {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Languages lists the translated languages of g in alphabetical order.
+func (g *Giveaway) Languages() []string {
+	out := make([]string, 0, len(g.Translations))
+	for tag := range g.Translations {
+		out = append(out, tag)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Localized returns a copy of g with the title and description of the first of tags it has
+// a translation for, and Language set to that tag. Without a match g itself is returned.
+func (g *Giveaway) Localized(tags ...string) *Giveaway {
+	if g == nil || len(g.Translations) == 0 {
+		return g
+	}
+	for _, tag := range tags {
+		tag = NormalizeLanguage(tag)
+		t, ok := g.Translations[tag]
+		if !ok {
+			continue
+		}
+		out := *g
+		out.Language = tag
+		if t.Title != "" {
+			out.Title = t.Title
+		}
+		if t.DescriptionHTML != "" {
+			out.Description, out.DescriptionHTML = t.Description, t.DescriptionHTML
+		}
+		return &out
+	}
+	return g
+}
@@ -0,0 +1,44 @@
+package giveaway
+
+import "time"
+
+// UsageMetric names a per-giveaway request counter.
+type UsageMetric string
+
+const (
+	// UsageViews counts giveaway page reads
+	UsageViews UsageMetric = "views"
+	// UsageJoins counts join attempts, successful or not
+	UsageJoins UsageMetric = "joins"
+	// UsageChecks counts requirement checks, including can-join
+	UsageChecks UsageMetric = "checks"
+	// UsageErrors counts tracked requests answered with an error status
+	UsageErrors UsageMetric = "errors"
+)
+
+// MaxUsageHours is how far back usage counters are kept.
+const MaxUsageHours = 7 * 24
+
+// UsageCounts are the request counters of a giveaway over some period. Viewers counts distinct
+// users reading the page, estimated within about 1%.
+type UsageCounts struct {
+	Views   int64 `json:"views"`
+	Viewers int64 `json:"viewers"`
+	Joins   int64 `json:"joins"`
+	Checks  int64 `json:"checks"`
+	Errors  int64 `json:"errors"`
+}
+
+// UsageHour is the usage of one hour, starting at Hour.
+type UsageHour struct {
+	Hour time.Time `json:"hour"`
+	UsageCounts
+}
+
+// Usage is the request activity against a giveaway over the last Hours hours, oldest hour
+// first. Many views per viewer or a high share of errors hint at scraping or bots.
+type Usage struct {
+	Hours  int         `json:"hours"`
+	Totals UsageCounts `json:"totals"`
+	Series []UsageHour `json:"series"`
+}
@@ -0,0 +1,21 @@
+package giveaway
+
+import "time"
+
+// EditPrecondition is the giveaway state an edit was based on, taken from If-Match. A zero
+// Version and UpdatedAt match any state.
+type EditPrecondition struct {
+	Version   int64
+	UpdatedAt time.Time
+}
+
+// IsZero reports whether the edit has no precondition.
+func (p EditPrecondition) IsZero() bool { return p.Version == 0 && p.UpdatedAt.IsZero() }
+
+// Stamp is the stored state of a giveaway row that cached reads are checked against. Edits
+// bump Version and status changes move UpdatedAt.
+type Stamp struct {
+	Version   int64
+	UpdatedAt time.Time
+	Status    GiveawayStatus
+}
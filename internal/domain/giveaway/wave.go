@@ -0,0 +1,28 @@
+package giveaway
+
+import "time"
+
+// WaveStatus tracks a scheduled partial draw.
+type WaveStatus string
+
+const (
+	WaveStatusPending WaveStatus = "pending"
+	WaveStatusDrawn   WaveStatus = "drawn"
+	// WaveStatusSkipped marks waves still pending when the giveaway ended; their
+	// winners are drawn by the final draw instead.
+	WaveStatusSkipped WaveStatus = "skipped"
+)
+
+// Wave draws part of the winners while the giveaway is still running.
+// Winners of earlier waves are excluded from later waves and from the final draw.
+type Wave struct {
+	ID           int64      `json:"id"`
+	GiveawayID   string     `json:"giveaway_id"`
+	Number       int        `json:"number"`
+	DrawAt       time.Time  `json:"draw_at"`
+	WinnersCount int        `json:"winners_count"`
+	Status       WaveStatus `json:"status"`
+	DrawID       int64      `json:"draw_id,omitempty"`
+	DrawnAt      *time.Time `json:"drawn_at,omitempty"`
+	Winners      []int64    `json:"winners,omitempty"`
+}
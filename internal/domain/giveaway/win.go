@@ -0,0 +1,65 @@
+package giveaway
+
+import "time"
+
+// FulfillmentStatus tracks delivery of a winner's prizes.
+type FulfillmentStatus string
+
+const (
+	FulfillmentPending   FulfillmentStatus = "pending"   // won, nothing confirmed yet
+	FulfillmentClaimed   FulfillmentStatus = "claimed"   // winner asked for the prize
+	FulfillmentDelivered FulfillmentStatus = "delivered" // creator confirmed delivery
+)
+
+// ContactMethod is how a winner wants the creator to reach them for prize delivery.
+type ContactMethod string
+
+const (
+	ContactBotDM  ContactMethod = "bot_dm" // message through the bot
+	ContactEmail  ContactMethod = "email"
+	ContactWallet ContactMethod = "wallet" // on-chain transfer only, no message
+)
+
+// ContactPreference is a winner's chosen contact method with its details.
+type ContactPreference struct {
+	Method        ContactMethod `json:"method"`
+	Email         string        `json:"email,omitempty"`
+	WalletAddress string        `json:"wallet_address,omitempty"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// Win is one entry of a user's win history.
+type Win struct {
+	GiveawayID     string            `json:"giveaway_id"`
+	Title          string            `json:"title"`
+	GiveawayStatus GiveawayStatus    `json:"giveaway_status"`
+	Place          int               `json:"place"`
+	WonAt          time.Time         `json:"won_at"`
+	EndsAt         time.Time         `json:"ends_at"`
+	Prizes         []WinnerPrize     `json:"prizes"`
+	TotalUnits     int               `json:"total_units"`
+	Fulfillment    FulfillmentStatus `json:"fulfillment_status"`
+	ClaimedAt      *time.Time        `json:"claimed_at,omitempty"`
+	DeliveredAt    *time.Time        `json:"delivered_at,omitempty"`
+}
+
+// WinnerCheckResult answers whether a user won a giveaway.
+type WinnerCheckResult string
+
+const (
+	WinnerCheckWon      WinnerCheckResult = "won"
+	WinnerCheckNotWon   WinnerCheckResult = "not_won"
+	WinnerCheckNotDrawn WinnerCheckResult = "not_drawn" // no winners drawn yet
+	WinnerCheckHidden   WinnerCheckResult = "hidden"    // the user hides their wins
+)
+
+// WinnerCheck is the public answer to "did this user win?". Place and prizes are only set
+// when the user won.
+type WinnerCheck struct {
+	GiveawayID string            `json:"giveaway_id"`
+	UserID     int64             `json:"user_id"`
+	Status     GiveawayStatus    `json:"status"`
+	Result     WinnerCheckResult `json:"result"`
+	Place      int               `json:"place,omitempty"`
+	Prizes     []WinnerPrize     `json:"prizes,omitempty"`
+}
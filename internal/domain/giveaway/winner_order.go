@@ -0,0 +1,84 @@
+package giveaway
+
+import (
+	"crypto/sha256"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WinnerOrder selects how winners are listed wherever they are displayed.
+type WinnerOrder string
+
+const (
+	WinnerOrderPlace        WinnerOrder = "place"        // by place ascending (default)
+	WinnerOrderAlphabetical WinnerOrder = "alphabetical" // by username, else full name
+	WinnerOrderRandom       WinnerOrder = "random"       // shuffled once per giveaway
+)
+
+// Valid reports whether o is a known order; empty means the default.
+func (o WinnerOrder) Valid() bool {
+	switch o {
+	case "", WinnerOrderPlace, WinnerOrderAlphabetical, WinnerOrderRandom:
+		return true
+	}
+	return false
+}
+
+// WinnerSortName is the alphabetical sort key of a winner.
+func WinnerSortName(username, firstName, lastName string) string {
+	if username != "" {
+		return strings.ToLower(username)
+	}
+	return strings.ToLower(strings.TrimSpace(firstName + " " + lastName))
+}
+
+// OrderWinners returns a copy of winners in display order. name supplies the alphabetical sort key
+// and is called only for WinnerOrderAlphabetical. The random order is derived from giveawayID, so
+// the DTO, announcements and exports agree and it does not change between reads. Ties fall back to place.
+func OrderWinners(winners []Winner, order WinnerOrder, giveawayID string, name func(userID int64) string) []Winner {
+	out := append([]Winner(nil), winners...)
+	byPlace := func(i, j int) bool {
+		if out[i].Place != out[j].Place {
+			return out[i].Place < out[j].Place
+		}
+		return out[i].UserID < out[j].UserID
+	}
+	switch order {
+	case WinnerOrderAlphabetical:
+		if name == nil {
+			break
+		}
+		keys := make(map[int64]string, len(out))
+		for _, w := range out {
+			keys[w.UserID] = name(w.UserID)
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			a, b := keys[out[i].UserID], keys[out[j].UserID]
+			// Winners without a name go last
+			if a != b && (a == "" || b == "") {
+				return b == ""
+			}
+			if a != b {
+				return a < b
+			}
+			return byPlace(i, j)
+		})
+		return out
+	case WinnerOrderRandom:
+		keys := make(map[int64]string, len(out))
+		for _, w := range out {
+			sum := sha256.Sum256([]byte(giveawayID + ":" + strconv.FormatInt(w.UserID, 10)))
+			keys[w.UserID] = string(sum[:])
+		}
+		sort.SliceStable(out, func(i, j int) bool {
+			if a, b := keys[out[i].UserID], keys[out[j].UserID]; a != b {
+				return a < b
+			}
+			return byPlace(i, j)
+		})
+		return out
+	}
+	sort.SliceStable(out, byPlace)
+	return out
+}
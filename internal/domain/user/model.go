@@ -14,6 +14,11 @@ type User struct {
 	Role          string    `json:"role"`   // allowed: "user", "admin"
 	Status        string    `json:"status"` // allowed: "active", "banned"
 	WalletAddress string    `json:"wallet_address,omitempty"`
+	LanguageCode  string    `json:"language_code,omitempty"` // Telegram client language
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
+	// ReputationScore is the creator reputation from 0 to 100; nil until first computed
+	ReputationScore *int `json:"reputation_score,omitempty"`
+	// PreferredLanguage is set in the app settings and wins over LanguageCode
+	PreferredLanguage string `json:"preferred_language,omitempty"`
 }
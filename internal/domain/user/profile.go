@@ -0,0 +1,26 @@
+package user
+
+// Privacy controls what other users see on a public profile.
+type Privacy struct {
+	HideProfile bool `json:"hide_profile"` // only the ID is shown
+	HideWins    bool `json:"hide_wins"`    // win count is omitted
+}
+
+// PublicProfile is the profile card other users can open from winner lists.
+// Fields hidden by the owner's privacy settings are left empty.
+type PublicProfile struct {
+	ID               int64  `json:"id"`
+	Username         string `json:"username,omitempty"`
+	AvatarURL        string `json:"avatar_url,omitempty"`
+	Verified         bool   `json:"verified"`
+	WinsCount        *int   `json:"wins_count,omitempty"`
+	GiveawaysCreated *int   `json:"giveaways_created,omitempty"`
+	Hidden           bool   `json:"hidden,omitempty"`
+	// Privacy is only filled for the profile owner
+	Privacy *Privacy `json:"privacy,omitempty"`
+}
+
+// NotificationPrefs are the optional bot messages a user agreed to receive.
+type NotificationPrefs struct {
+	JoinConfirmations bool `json:"join_confirmations"` // confirmation after joining a giveaway
+}
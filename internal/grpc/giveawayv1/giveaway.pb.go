@@ -0,0 +1,1257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: giveaway/v1/giveaway.proto
+
+package giveawayv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Giveaway struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId         int64                  `protobuf:"varint,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Title             string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	StartedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	WinnersCount      int32                  `protobuf:"varint,7,opt,name=winners_count,json=winnersCount,proto3" json:"winners_count,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	WinnerStrategy    string                 `protobuf:"bytes,9,opt,name=winner_strategy,json=winnerStrategy,proto3" json:"winner_strategy,omitempty"`
+	ParticipantsCount int32                  `protobuf:"varint,10,opt,name=participants_count,json=participantsCount,proto3" json:"participants_count,omitempty"`
+	Prizes            []*Prize               `protobuf:"bytes,11,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	Sponsors          []*Sponsor             `protobuf:"bytes,12,rep,name=sponsors,proto3" json:"sponsors,omitempty"`
+	Requirements      []*Requirement         `protobuf:"bytes,13,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	Winners           []*Winner              `protobuf:"bytes,14,rep,name=winners,proto3" json:"winners,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Giveaway) Reset() {
+	*x = Giveaway{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Giveaway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Giveaway) ProtoMessage() {}
+
+func (x *Giveaway) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Giveaway.ProtoReflect.Descriptor instead.
+func (*Giveaway) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Giveaway) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Giveaway) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *Giveaway) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Giveaway) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Giveaway) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Giveaway) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Giveaway) GetWinnersCount() int32 {
+	if x != nil {
+		return x.WinnersCount
+	}
+	return 0
+}
+
+func (x *Giveaway) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Giveaway) GetWinnerStrategy() string {
+	if x != nil {
+		return x.WinnerStrategy
+	}
+	return ""
+}
+
+func (x *Giveaway) GetParticipantsCount() int32 {
+	if x != nil {
+		return x.ParticipantsCount
+	}
+	return 0
+}
+
+func (x *Giveaway) GetPrizes() []*Prize {
+	if x != nil {
+		return x.Prizes
+	}
+	return nil
+}
+
+func (x *Giveaway) GetSponsors() []*Sponsor {
+	if x != nil {
+		return x.Sponsors
+	}
+	return nil
+}
+
+func (x *Giveaway) GetRequirements() []*Requirement {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *Giveaway) GetWinners() []*Winner {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+type Prize struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// place is 0 for prizes that are distributed among all winners.
+	Place         int32  `protobuf:"varint,1,opt,name=place,proto3" json:"place,omitempty"`
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prize) Reset() {
+	*x = Prize{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prize) ProtoMessage() {}
+
+func (x *Prize) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prize.ProtoReflect.Descriptor instead.
+func (*Prize) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Prize) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *Prize) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Prize) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Prize) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Sponsor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sponsor) Reset() {
+	*x = Sponsor{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sponsor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sponsor) ProtoMessage() {}
+
+func (x *Sponsor) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sponsor.ProtoReflect.Descriptor instead.
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Sponsor) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Sponsor) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Sponsor) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Sponsor) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type Requirement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ChannelId         int64                  `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelUsername   string                 `protobuf:"bytes,3,opt,name=channel_username,json=channelUsername,proto3" json:"channel_username,omitempty"`
+	Title             string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	TonMinBalanceNano int64                  `protobuf:"varint,6,opt,name=ton_min_balance_nano,json=tonMinBalanceNano,proto3" json:"ton_min_balance_nano,omitempty"`
+	JettonAddress     string                 `protobuf:"bytes,7,opt,name=jetton_address,json=jettonAddress,proto3" json:"jetton_address,omitempty"`
+	JettonMinAmount   int64                  `protobuf:"varint,8,opt,name=jetton_min_amount,json=jettonMinAmount,proto3" json:"jetton_min_amount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Requirement) Reset() {
+	*x = Requirement{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Requirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Requirement) ProtoMessage() {}
+
+func (x *Requirement) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Requirement.ProtoReflect.Descriptor instead.
+func (*Requirement) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Requirement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Requirement) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *Requirement) GetChannelUsername() string {
+	if x != nil {
+		return x.ChannelUsername
+	}
+	return ""
+}
+
+func (x *Requirement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Requirement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Requirement) GetTonMinBalanceNano() int64 {
+	if x != nil {
+		return x.TonMinBalanceNano
+	}
+	return 0
+}
+
+func (x *Requirement) GetJettonAddress() string {
+	if x != nil {
+		return x.JettonAddress
+	}
+	return ""
+}
+
+func (x *Requirement) GetJettonMinAmount() int64 {
+	if x != nil {
+		return x.JettonMinAmount
+	}
+	return 0
+}
+
+type WinnerPrize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WinnerPrize) Reset() {
+	*x = WinnerPrize{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WinnerPrize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WinnerPrize) ProtoMessage() {}
+
+func (x *WinnerPrize) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WinnerPrize.ProtoReflect.Descriptor instead.
+func (*WinnerPrize) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WinnerPrize) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WinnerPrize) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WinnerPrize) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Winner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Place         int32                  `protobuf:"varint,1,opt,name=place,proto3" json:"place,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prizes        []*WinnerPrize         `protobuf:"bytes,3,rep,name=prizes,proto3" json:"prizes,omitempty"`
+	TotalUnits    int32                  `protobuf:"varint,4,opt,name=total_units,json=totalUnits,proto3" json:"total_units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Winner) Reset() {
+	*x = Winner{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Winner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Winner) ProtoMessage() {}
+
+func (x *Winner) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Winner.ProtoReflect.Descriptor instead.
+func (*Winner) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Winner) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *Winner) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Winner) GetPrizes() []*WinnerPrize {
+	if x != nil {
+		return x.Prizes
+	}
+	return nil
+}
+
+func (x *Winner) GetTotalUnits() int32 {
+	if x != nil {
+		return x.TotalUnits
+	}
+	return 0
+}
+
+type GetGiveawayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiveawayRequest) Reset() {
+	*x = GetGiveawayRequest{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiveawayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiveawayRequest) ProtoMessage() {}
+
+func (x *GetGiveawayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiveawayRequest.ProtoReflect.Descriptor instead.
+func (*GetGiveawayRequest) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetGiveawayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGiveawayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Giveaway      *Giveaway              `protobuf:"bytes,1,opt,name=giveaway,proto3" json:"giveaway,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiveawayResponse) Reset() {
+	*x = GetGiveawayResponse{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiveawayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiveawayResponse) ProtoMessage() {}
+
+func (x *GetGiveawayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiveawayResponse.ProtoReflect.Descriptor instead.
+func (*GetGiveawayResponse) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetGiveawayResponse) GetGiveaway() *Giveaway {
+	if x != nil {
+		return x.Giveaway
+	}
+	return nil
+}
+
+type ListGiveawaysRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CreatorId int64                  `protobuf:"varint,1,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Limit     int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset    int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// min_participants applies to the active feed only.
+	MinParticipants int32 `protobuf:"varint,4,opt,name=min_participants,json=minParticipants,proto3" json:"min_participants,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListGiveawaysRequest) Reset() {
+	*x = ListGiveawaysRequest{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiveawaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiveawaysRequest) ProtoMessage() {}
+
+func (x *ListGiveawaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiveawaysRequest.ProtoReflect.Descriptor instead.
+func (*ListGiveawaysRequest) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListGiveawaysRequest) GetCreatorId() int64 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *ListGiveawaysRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGiveawaysRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListGiveawaysRequest) GetMinParticipants() int32 {
+	if x != nil {
+		return x.MinParticipants
+	}
+	return 0
+}
+
+type ListGiveawaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Giveaways     []*Giveaway            `protobuf:"bytes,1,rep,name=giveaways,proto3" json:"giveaways,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGiveawaysResponse) Reset() {
+	*x = ListGiveawaysResponse{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGiveawaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGiveawaysResponse) ProtoMessage() {}
+
+func (x *ListGiveawaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiveawaysResponse.ProtoReflect.Descriptor instead.
+func (*ListGiveawaysResponse) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListGiveawaysResponse) GetGiveaways() []*Giveaway {
+	if x != nil {
+		return x.Giveaways
+	}
+	return nil
+}
+
+type FinishGiveawayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// force finishes the giveaway before ends_at.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishGiveawayRequest) Reset() {
+	*x = FinishGiveawayRequest{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishGiveawayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishGiveawayRequest) ProtoMessage() {}
+
+func (x *FinishGiveawayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishGiveawayRequest.ProtoReflect.Descriptor instead.
+func (*FinishGiveawayRequest) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FinishGiveawayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FinishGiveawayRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type FinishGiveawayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishGiveawayResponse) Reset() {
+	*x = FinishGiveawayResponse{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishGiveawayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishGiveawayResponse) ProtoMessage() {}
+
+func (x *FinishGiveawayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishGiveawayResponse.ProtoReflect.Descriptor instead.
+func (*FinishGiveawayResponse) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinishGiveawayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CheckRequirementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiveawayId    string                 `protobuf:"bytes,1,opt,name=giveaway_id,json=giveawayId,proto3" json:"giveaway_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequirementsRequest) Reset() {
+	*x = CheckRequirementsRequest{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequirementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequirementsRequest) ProtoMessage() {}
+
+func (x *CheckRequirementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequirementsRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequirementsRequest) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckRequirementsRequest) GetGiveawayId() string {
+	if x != nil {
+		return x.GiveawayId
+	}
+	return ""
+}
+
+func (x *CheckRequirementsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RequirementResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ChannelId     int64                  `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequirementResult) Reset() {
+	*x = RequirementResult{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequirementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequirementResult) ProtoMessage() {}
+
+func (x *RequirementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequirementResult.ProtoReflect.Descriptor instead.
+func (*RequirementResult) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequirementResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RequirementResult) GetChannelId() int64 {
+	if x != nil {
+		return x.ChannelId
+	}
+	return 0
+}
+
+func (x *RequirementResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RequirementResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CheckRequirementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllMet        bool                   `protobuf:"varint,1,opt,name=all_met,json=allMet,proto3" json:"all_met,omitempty"`
+	Results       []*RequirementResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequirementsResponse) Reset() {
+	*x = CheckRequirementsResponse{}
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequirementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequirementsResponse) ProtoMessage() {}
+
+func (x *CheckRequirementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giveaway_v1_giveaway_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequirementsResponse.ProtoReflect.Descriptor instead.
+func (*CheckRequirementsResponse) Descriptor() ([]byte, []int) {
+	return file_giveaway_v1_giveaway_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckRequirementsResponse) GetAllMet() bool {
+	if x != nil {
+		return x.AllMet
+	}
+	return false
+}
+
+func (x *CheckRequirementsResponse) GetResults() []*RequirementResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_giveaway_v1_giveaway_proto protoreflect.FileDescriptor
+
+var file_giveaway_v1_giveaway_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x69,
+	0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x67, 0x69,
+	0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x04, 0x0a, 0x08, 0x47,
+	0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x73, 0x12,
+	0x30, 0x0a, 0x08, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2d, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x71,
+	0x0a, 0x05, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x22, 0x5d, 0x0a, 0x07, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0xa7, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x6f, 0x6e, 0x5f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x6a, 0x65, 0x74, 0x74, 0x6f,
+	0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6a, 0x65, 0x74, 0x74, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x6a, 0x65, 0x74, 0x74, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6a, 0x65, 0x74, 0x74, 0x6f,
+	0x6e, 0x4d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x0b, 0x57, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x8a, 0x01,
+	0x0a, 0x06, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x7a, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x50, 0x72, 0x69, 0x7a,
+	0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x67, 0x69, 0x76, 0x65, 0x61,
+	0x77, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69, 0x76, 0x65,
+	0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79,
+	0x52, 0x08, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52, 0x09,
+	0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x22, 0x3d, 0x0a, 0x15, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x30, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x54, 0x0a, 0x18, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x76,
+	0x65, 0x61, 0x77, 0x61, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x74, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6e, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x32, 0xfa, 0x02, 0x0a, 0x0f, 0x47, 0x69, 0x76, 0x65, 0x61,
+	0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x12, 0x1f, 0x2e, 0x67, 0x69, 0x76, 0x65,
+	0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61,
+	0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x69, 0x76,
+	0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x76, 0x65,
+	0x61, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x12, 0x21, 0x2e,
+	0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x69,
+	0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x12, 0x22, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47, 0x69, 0x76, 0x65, 0x61,
+	0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x67, 0x69, 0x76,
+	0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x47,
+	0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x69,
+	0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x4f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x2f,
+	0x67, 0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67,
+	0x69, 0x76, 0x65, 0x61, 0x77, 0x61, 0x79, 0x76, 0x31, 0x3b, 0x67, 0x69, 0x76, 0x65, 0x61, 0x77,
+	0x61, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_giveaway_v1_giveaway_proto_rawDescOnce sync.Once
+	file_giveaway_v1_giveaway_proto_rawDescData []byte
+)
+
+func file_giveaway_v1_giveaway_proto_rawDescGZIP() []byte {
+	file_giveaway_v1_giveaway_proto_rawDescOnce.Do(func() {
+		file_giveaway_v1_giveaway_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_giveaway_v1_giveaway_proto_rawDesc), len(file_giveaway_v1_giveaway_proto_rawDesc)))
+	})
+	return file_giveaway_v1_giveaway_proto_rawDescData
+}
+
+var file_giveaway_v1_giveaway_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_giveaway_v1_giveaway_proto_goTypes = []any{
+	(*Giveaway)(nil),                  // 0: giveaway.v1.Giveaway
+	(*Prize)(nil),                     // 1: giveaway.v1.Prize
+	(*Sponsor)(nil),                   // 2: giveaway.v1.Sponsor
+	(*Requirement)(nil),               // 3: giveaway.v1.Requirement
+	(*WinnerPrize)(nil),               // 4: giveaway.v1.WinnerPrize
+	(*Winner)(nil),                    // 5: giveaway.v1.Winner
+	(*GetGiveawayRequest)(nil),        // 6: giveaway.v1.GetGiveawayRequest
+	(*GetGiveawayResponse)(nil),       // 7: giveaway.v1.GetGiveawayResponse
+	(*ListGiveawaysRequest)(nil),      // 8: giveaway.v1.ListGiveawaysRequest
+	(*ListGiveawaysResponse)(nil),     // 9: giveaway.v1.ListGiveawaysResponse
+	(*FinishGiveawayRequest)(nil),     // 10: giveaway.v1.FinishGiveawayRequest
+	(*FinishGiveawayResponse)(nil),    // 11: giveaway.v1.FinishGiveawayResponse
+	(*CheckRequirementsRequest)(nil),  // 12: giveaway.v1.CheckRequirementsRequest
+	(*RequirementResult)(nil),         // 13: giveaway.v1.RequirementResult
+	(*CheckRequirementsResponse)(nil), // 14: giveaway.v1.CheckRequirementsResponse
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+}
+var file_giveaway_v1_giveaway_proto_depIdxs = []int32{
+	15, // 0: giveaway.v1.Giveaway.started_at:type_name -> google.protobuf.Timestamp
+	15, // 1: giveaway.v1.Giveaway.ends_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: giveaway.v1.Giveaway.prizes:type_name -> giveaway.v1.Prize
+	2,  // 3: giveaway.v1.Giveaway.sponsors:type_name -> giveaway.v1.Sponsor
+	3,  // 4: giveaway.v1.Giveaway.requirements:type_name -> giveaway.v1.Requirement
+	5,  // 5: giveaway.v1.Giveaway.winners:type_name -> giveaway.v1.Winner
+	4,  // 6: giveaway.v1.Winner.prizes:type_name -> giveaway.v1.WinnerPrize
+	0,  // 7: giveaway.v1.GetGiveawayResponse.giveaway:type_name -> giveaway.v1.Giveaway
+	0,  // 8: giveaway.v1.ListGiveawaysResponse.giveaways:type_name -> giveaway.v1.Giveaway
+	13, // 9: giveaway.v1.CheckRequirementsResponse.results:type_name -> giveaway.v1.RequirementResult
+	6,  // 10: giveaway.v1.GiveawayService.GetGiveaway:input_type -> giveaway.v1.GetGiveawayRequest
+	8,  // 11: giveaway.v1.GiveawayService.ListGiveaways:input_type -> giveaway.v1.ListGiveawaysRequest
+	10, // 12: giveaway.v1.GiveawayService.FinishGiveaway:input_type -> giveaway.v1.FinishGiveawayRequest
+	12, // 13: giveaway.v1.GiveawayService.CheckRequirements:input_type -> giveaway.v1.CheckRequirementsRequest
+	7,  // 14: giveaway.v1.GiveawayService.GetGiveaway:output_type -> giveaway.v1.GetGiveawayResponse
+	9,  // 15: giveaway.v1.GiveawayService.ListGiveaways:output_type -> giveaway.v1.ListGiveawaysResponse
+	11, // 16: giveaway.v1.GiveawayService.FinishGiveaway:output_type -> giveaway.v1.FinishGiveawayResponse
+	14, // 17: giveaway.v1.GiveawayService.CheckRequirements:output_type -> giveaway.v1.CheckRequirementsResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_giveaway_v1_giveaway_proto_init() }
+func file_giveaway_v1_giveaway_proto_init() {
+	if File_giveaway_v1_giveaway_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_giveaway_v1_giveaway_proto_rawDesc), len(file_giveaway_v1_giveaway_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_giveaway_v1_giveaway_proto_goTypes,
+		DependencyIndexes: file_giveaway_v1_giveaway_proto_depIdxs,
+		MessageInfos:      file_giveaway_v1_giveaway_proto_msgTypes,
+	}.Build()
+	File_giveaway_v1_giveaway_proto = out.File
+	file_giveaway_v1_giveaway_proto_goTypes = nil
+	file_giveaway_v1_giveaway_proto_depIdxs = nil
+}
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: giveaway/v1/giveaway.proto
+
+package giveawayv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GiveawayService_GetGiveaway_FullMethodName       = "/giveaway.v1.GiveawayService/GetGiveaway"
+	GiveawayService_ListGiveaways_FullMethodName     = "/giveaway.v1.GiveawayService/ListGiveaways"
+	GiveawayService_FinishGiveaway_FullMethodName    = "/giveaway.v1.GiveawayService/FinishGiveaway"
+	GiveawayService_CheckRequirements_FullMethodName = "/giveaway.v1.GiveawayService/CheckRequirements"
+)
+
+// GiveawayServiceClient is the client API for GiveawayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GiveawayService exposes core giveaway operations to internal services.
+// Calls are authenticated with a shared bearer token in the "authorization" metadata.
+type GiveawayServiceClient interface {
+	// GetGiveaway returns a giveaway with prizes, sponsors, requirements and winners.
+	GetGiveaway(ctx context.Context, in *GetGiveawayRequest, opts ...grpc.CallOption) (*GetGiveawayResponse, error)
+	// ListGiveaways lists giveaways of a creator or, when creator_id is 0, the active feed.
+	ListGiveaways(ctx context.Context, in *ListGiveawaysRequest, opts ...grpc.CallOption) (*ListGiveawaysResponse, error)
+	// FinishGiveaway draws winners for an active giveaway.
+	FinishGiveaway(ctx context.Context, in *FinishGiveawayRequest, opts ...grpc.CallOption) (*FinishGiveawayResponse, error)
+	// CheckRequirements evaluates giveaway requirements for a user.
+	CheckRequirements(ctx context.Context, in *CheckRequirementsRequest, opts ...grpc.CallOption) (*CheckRequirementsResponse, error)
+}
+
+type giveawayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGiveawayServiceClient(cc grpc.ClientConnInterface) GiveawayServiceClient {
+	return &giveawayServiceClient{cc}
+}
+
+func (c *giveawayServiceClient) GetGiveaway(ctx context.Context, in *GetGiveawayRequest, opts ...grpc.CallOption) (*GetGiveawayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGiveawayResponse)
+	err := c.cc.Invoke(ctx, GiveawayService_GetGiveaway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giveawayServiceClient) ListGiveaways(ctx context.Context, in *ListGiveawaysRequest, opts ...grpc.CallOption) (*ListGiveawaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiveawaysResponse)
+	err := c.cc.Invoke(ctx, GiveawayService_ListGiveaways_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giveawayServiceClient) FinishGiveaway(ctx context.Context, in *FinishGiveawayRequest, opts ...grpc.CallOption) (*FinishGiveawayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FinishGiveawayResponse)
+	err := c.cc.Invoke(ctx, GiveawayService_FinishGiveaway_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giveawayServiceClient) CheckRequirements(ctx context.Context, in *CheckRequirementsRequest, opts ...grpc.CallOption) (*CheckRequirementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckRequirementsResponse)
+	err := c.cc.Invoke(ctx, GiveawayService_CheckRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GiveawayServiceServer is the server API for GiveawayService service.
+// All implementations must embed UnimplementedGiveawayServiceServer
+// for forward compatibility.
+//
+// GiveawayService exposes core giveaway operations to internal services.
+// Calls are authenticated with a shared bearer token in the "authorization" metadata.
+type GiveawayServiceServer interface {
+	// GetGiveaway returns a giveaway with prizes, sponsors, requirements and winners.
+	GetGiveaway(context.Context, *GetGiveawayRequest) (*GetGiveawayResponse, error)
+	// ListGiveaways lists giveaways of a creator or, when creator_id is 0, the active feed.
+	ListGiveaways(context.Context, *ListGiveawaysRequest) (*ListGiveawaysResponse, error)
+	// FinishGiveaway draws winners for an active giveaway.
+	FinishGiveaway(context.Context, *FinishGiveawayRequest) (*FinishGiveawayResponse, error)
+	// CheckRequirements evaluates giveaway requirements for a user.
+	CheckRequirements(context.Context, *CheckRequirementsRequest) (*CheckRequirementsResponse, error)
+	mustEmbedUnimplementedGiveawayServiceServer()
+}
+
+// UnimplementedGiveawayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGiveawayServiceServer struct{}
+
+func (UnimplementedGiveawayServiceServer) GetGiveaway(context.Context, *GetGiveawayRequest) (*GetGiveawayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGiveaway not implemented")
+}
+func (UnimplementedGiveawayServiceServer) ListGiveaways(context.Context, *ListGiveawaysRequest) (*ListGiveawaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGiveaways not implemented")
+}
+func (UnimplementedGiveawayServiceServer) FinishGiveaway(context.Context, *FinishGiveawayRequest) (*FinishGiveawayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishGiveaway not implemented")
+}
+func (UnimplementedGiveawayServiceServer) CheckRequirements(context.Context, *CheckRequirementsRequest) (*CheckRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckRequirements not implemented")
+}
+func (UnimplementedGiveawayServiceServer) mustEmbedUnimplementedGiveawayServiceServer() {}
+func (UnimplementedGiveawayServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGiveawayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GiveawayServiceServer will
+// result in compilation errors.
+type UnsafeGiveawayServiceServer interface {
+	mustEmbedUnimplementedGiveawayServiceServer()
+}
+
+func RegisterGiveawayServiceServer(s grpc.ServiceRegistrar, srv GiveawayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGiveawayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GiveawayService_ServiceDesc, srv)
+}
+
+func _GiveawayService_GetGiveaway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiveawayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiveawayServiceServer).GetGiveaway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiveawayService_GetGiveaway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiveawayServiceServer).GetGiveaway(ctx, req.(*GetGiveawayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiveawayService_ListGiveaways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiveawaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiveawayServiceServer).ListGiveaways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiveawayService_ListGiveaways_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiveawayServiceServer).ListGiveaways(ctx, req.(*ListGiveawaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiveawayService_FinishGiveaway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishGiveawayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiveawayServiceServer).FinishGiveaway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiveawayService_FinishGiveaway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiveawayServiceServer).FinishGiveaway(ctx, req.(*FinishGiveawayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiveawayService_CheckRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiveawayServiceServer).CheckRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiveawayService_CheckRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiveawayServiceServer).CheckRequirements(ctx, req.(*CheckRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GiveawayService_ServiceDesc is the grpc.ServiceDesc for GiveawayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GiveawayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "giveaway.v1.GiveawayService",
+	HandlerType: (*GiveawayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGiveaway",
+			Handler:    _GiveawayService_GetGiveaway_Handler,
+		},
+		{
+			MethodName: "ListGiveaways",
+			Handler:    _GiveawayService_ListGiveaways_Handler,
+		},
+		{
+			MethodName: "FinishGiveaway",
+			Handler:    _GiveawayService_FinishGiveaway_Handler,
+		},
+		{
+			MethodName: "CheckRequirements",
+			Handler:    _GiveawayService_CheckRequirements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "giveaway/v1/giveaway.proto",
+}
@@ -0,0 +1,196 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	grpcgo "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/grpc/giveawayv1"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// Server implements giveawayv1.GiveawayServiceServer on top of the giveaway service.
+type Server struct {
+	giveawayv1.UnimplementedGiveawayServiceServer
+	svc *gsvc.Service
+}
+
+// NewServer builds a gRPC server with the giveaway service registered.
+// Every call must carry "authorization: Bearer <authToken>" metadata.
+func NewServer(svc *gsvc.Service, authToken string) *grpcgo.Server {
+	srv := grpcgo.NewServer(grpcgo.UnaryInterceptor(authInterceptor(authToken)))
+	giveawayv1.RegisterGiveawayServiceServer(srv, &Server{svc: svc})
+	return srv
+}
+
+func authInterceptor(token string) grpcgo.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpcgo.UnaryServerInfo, handler grpcgo.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		got := ""
+		if v := md.Get("authorization"); len(v) > 0 {
+			got = strings.TrimSpace(strings.TrimPrefix(v[0], "Bearer "))
+		}
+		if token == "" || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (s *Server) GetGiveaway(ctx context.Context, req *giveawayv1.GetGiveawayRequest) (*giveawayv1.GetGiveawayResponse, error) {
+	g, err := s.svc.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if g == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &giveawayv1.GetGiveawayResponse{Giveaway: toProto(g)}, nil
+}
+
+func (s *Server) ListGiveaways(ctx context.Context, req *giveawayv1.ListGiveawaysRequest) (*giveawayv1.ListGiveawaysResponse, error) {
+	var (
+		list []dg.Giveaway
+		err  error
+	)
+	if req.GetCreatorId() != 0 {
+		list, err = s.svc.ListByCreator(ctx, req.GetCreatorId(), int(req.GetLimit()), int(req.GetOffset()))
+	} else {
+		list, err = s.svc.ListActive(ctx, int(req.GetLimit()), int(req.GetOffset()), dg.ActiveFilter{MinParticipants: int(req.GetMinParticipants())})
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*giveawayv1.Giveaway, 0, len(list))
+	for i := range list {
+		out = append(out, toProto(&list[i]))
+	}
+	return &giveawayv1.ListGiveawaysResponse{Giveaways: out}, nil
+}
+
+func (s *Server) FinishGiveaway(ctx context.Context, req *giveawayv1.FinishGiveawayRequest) (*giveawayv1.FinishGiveawayResponse, error) {
+	g, err := s.svc.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if g == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if g.Status != dg.GiveawayStatusActive {
+		return nil, status.Error(codes.FailedPrecondition, "giveaway is not active")
+	}
+	if !req.GetForce() && time.Now().Before(g.EndsAt) {
+		return nil, status.Error(codes.FailedPrecondition, "giveaway has not ended yet")
+	}
+	if err := s.svc.FinishOneWithDistribution(ctx, g.ID); err != nil {
+		return nil, toStatus(err)
+	}
+	g, err = s.svc.GetByID(ctx, g.ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if g == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &giveawayv1.FinishGiveawayResponse{Status: string(g.Status)}, nil
+}
+
+func (s *Server) CheckRequirements(ctx context.Context, req *giveawayv1.CheckRequirementsRequest) (*giveawayv1.CheckRequirementsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing user_id")
+	}
+	g, err := s.svc.GetByID(ctx, req.GetGiveawayId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if g == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	resp := &giveawayv1.CheckRequirementsResponse{AllMet: true}
+	checks := s.svc.CheckAllRequirements(ctx, req.GetUserId(), g.Requirements)
+	for i := range g.Requirements {
+		r := &g.Requirements[i]
+		res := checks[i]
+		if res.Status != "success" {
+			resp.AllMet = false
+		}
+		resp.Results = append(resp.Results, &giveawayv1.RequirementResult{
+			Type:      string(r.Type),
+			ChannelId: r.ChannelID,
+			Status:    res.Status,
+			Error:     res.Error,
+		})
+	}
+	return resp, nil
+}
+
+func toStatus(err error) error {
+	switch err.Error() {
+	case "not found":
+		return status.Error(codes.NotFound, err.Error())
+	case "missing id":
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func toProto(g *dg.Giveaway) *giveawayv1.Giveaway {
+	out := &giveawayv1.Giveaway{
+		Id:                g.ID,
+		CreatorId:         g.CreatorID,
+		Title:             g.Title,
+		Description:       g.Description,
+		StartedAt:         timestamppb.New(g.StartedAt),
+		EndsAt:            timestamppb.New(g.EndsAt),
+		WinnersCount:      int32(g.MaxWinnersCount),
+		Status:            string(g.Status),
+		WinnerStrategy:    string(g.WinnerStrategy),
+		ParticipantsCount: int32(g.ParticipantsCount),
+	}
+	for _, p := range g.Prizes {
+		pp := &giveawayv1.Prize{Title: p.Title, Description: p.Description, Quantity: int32(p.Quantity)}
+		if p.Place != nil {
+			pp.Place = int32(*p.Place)
+		}
+		out.Prizes = append(out.Prizes, pp)
+	}
+	for _, sp := range g.Sponsors {
+		out.Sponsors = append(out.Sponsors, &giveawayv1.Sponsor{Id: sp.ID, Username: sp.Username, Title: sp.Title, Url: sp.URL})
+	}
+	for _, r := range g.Requirements {
+		title := r.Title
+		if title == "" {
+			title = r.ChannelTitle
+		}
+		out.Requirements = append(out.Requirements, &giveawayv1.Requirement{
+			Type:              string(r.Type),
+			ChannelId:         r.ChannelID,
+			ChannelUsername:   r.ChannelUsername,
+			Title:             title,
+			Description:       r.Description,
+			TonMinBalanceNano: r.TonMinBalanceNano,
+			JettonAddress:     r.JettonAddress,
+			JettonMinAmount:   r.JettonMinAmount,
+		})
+	}
+	for _, w := range g.Winners {
+		pw := &giveawayv1.Winner{Place: int32(w.Place), UserId: w.UserID, TotalUnits: int32(w.TotalUnits)}
+		for _, p := range w.Prizes {
+			pw.Prizes = append(pw.Prizes, &giveawayv1.WinnerPrize{Title: p.Title, Description: p.Description, Quantity: int32(p.Quantity)})
+		}
+		out.Winners = append(out.Winners, pw)
+	}
+	return out
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"context"
+
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// isPlatformAdmin reports whether the user has the platform admin role.
+func isPlatformAdmin(ctx context.Context, users *usersvc.Service, userID int64) bool {
+	if users == nil || userID == 0 {
+		return false
+	}
+	u, err := users.GetByID(ctx, userID)
+	return err == nil && u != nil && u.Role == "admin"
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// maxCoverBody fits the largest cover (20 MB, see CoverKind.MaxSize) plus the multipart
+	// framing around it
+	maxCoverBody = 20<<20 + 64<<10
+	// maxParticipantImportBody bounds one participant import stream
+	maxParticipantImportBody = 512 << 20
+)
+
+// bodyLimit rejects request bodies over the default limit before any handler reads them. The
+// app streams request bodies so participant imports are not buffered, which also means
+// c.Body() would read any body whole; so chunked bodies, whose length is unknown, are refused
+// too.
+// Only participant imports may stream, bounded by their handler, and cover uploads get a cap
+// of their own.
+func bodyLimit(c *fiber.Ctx) error {
+	n := c.Request().Header.ContentLength()
+	limit := fiber.DefaultBodyLimit
+	switch {
+	case isParticipantImport(c):
+		if n > maxParticipantImportBody {
+			return bodyRejected(c, fiber.StatusRequestEntityTooLarge, "request body too large")
+		}
+		return c.Next()
+	case isCoverUpload(c):
+		limit = maxCoverBody
+	}
+	// -1 is a chunked body; requests without Content-Length or chunks carry none (-2)
+	if n == -1 {
+		return bodyRejected(c, fiber.StatusLengthRequired, "content length required")
+	}
+	if n > limit {
+		return bodyRejected(c, fiber.StatusRequestEntityTooLarge, "request body too large")
+	}
+	return c.Next()
+}
+
+// errBodyTooLarge is returned by a limitedReader once the body goes past its limit.
+var errBodyTooLarge = errors.New("request body too large")
+
+// limitedReader reads up to n bytes and fails with errBodyTooLarge when the body has more,
+// so a cut body is not taken for a complete one the way io.LimitReader would.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		// The body may end right at the limit; only a further byte makes it too large
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, errBodyTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err
+}
+
+// bodyRejected answers without reading the body and closes the connection, since the unread
+// body would otherwise be parsed as the next request.
+func bodyRejected(c *fiber.Ctx, status int, msg string) error {
+	c.Context().SetConnectionClose()
+	return c.Status(status).JSON(fiber.Map{"error": msg})
+}
+
+// isParticipantImport matches POST /api/v1/admin/giveaways/:id/participants/import.
+func isParticipantImport(c *fiber.Ctx) bool {
+	id, ok := giveawaySubpath(c.Path(), "/api/v1/admin/giveaways/", "/participants/import")
+	return ok && id != "" && c.Method() == fiber.MethodPost
+}
+
+// isCoverUpload matches PUT /api/v1/giveaways/:id/cover.
+func isCoverUpload(c *fiber.Ctx) bool {
+	id, ok := giveawaySubpath(c.Path(), "/api/v1/giveaways/", "/cover")
+	return ok && id != "" && c.Method() == fiber.MethodPut
+}
+
+// giveawaySubpath returns the single path segment between prefix and suffix of path.
+func giveawaySubpath(path, prefix, suffix string) (string, bool) {
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) || len(path) < len(prefix)+len(suffix) {
+		return "", false
+	}
+	id := path[len(prefix) : len(path)-len(suffix)]
+	return id, !strings.Contains(id, "/")
+}
@@ -0,0 +1,163 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	bundlesvc "github.com/open-builders/giveaway-backend/internal/service/bundles"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+const (
+	maxBundleRequirements   = 5
+	maxBundleDescriptionLen = 500
+)
+
+// BundleHandlers lets channel owners publish requirement bundles and creators browse them.
+type BundleHandlers struct {
+	service *bundlesvc.Service
+	users   *usersvc.Service
+}
+
+func NewBundleHandlers(svc *bundlesvc.Service, users *usersvc.Service) *BundleHandlers {
+	return &BundleHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers requirement bundle routes.
+func (h *BundleHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/requirement-bundles", h.publish)
+	r.Get("/requirement-bundles", h.browse)
+	r.Get("/me/requirement-bundles", h.mine)
+	r.Get("/requirement-bundles/:id", h.get)
+	r.Get("/requirement-bundles/:id/stats", h.stats)
+	r.Delete("/requirement-bundles/:id", h.deactivate)
+}
+
+type publishBundleReq struct {
+	Title        string                         `json:"title"`
+	Description  string                         `json:"description,omitempty"`
+	Requirements []dto.CreateRequirementRequest `json:"requirements"`
+}
+
+func bundleID(c *fiber.Ctx) (int64, bool) {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	return id, err == nil && id > 0
+}
+
+func bundleError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case "unsupported requirement type", "channel_id is required", "requirements are required", "invalid requirement":
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
+
+// publish stores a bundle of subscription and boost requirements for channels the caller owns.
+func (h *BundleHandlers) publish(c *fiber.Ctx) error {
+	var req publishBundleReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	req.Title = strings.TrimSpace(req.Title)
+	req.Description = strings.TrimSpace(req.Description)
+	v := validate.New(requestLocale(c))
+	v.Required("title", req.Title)
+	v.MaxLen("title", req.Title, maxGiveawayTitleLen)
+	v.MaxLen("description", req.Description, maxBundleDescriptionLen)
+	v.Between("requirements", int64(len(req.Requirements)), 1, maxBundleRequirements)
+	reqs := make([]dg.Requirement, 0, len(req.Requirements))
+	for i, r := range req.Requirements {
+		rq := requirementFromRequest(r)
+		if t, ok := requirements.Lookup(r.Type); ok {
+			t.Validate(v, i, rq)
+		}
+		reqs = append(reqs, rq)
+	}
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	b, err := h.service.Publish(c.Context(), mw.GetUserID(c), req.Title, req.Description, reqs)
+	if err != nil {
+		return bundleError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(b)
+}
+
+// browse lists bundles creators can attach, newest first; ?owner_id= narrows to one owner.
+func (h *BundleHandlers) browse(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	ownerID := queryInt(c, v, "owner_id", 0)
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.Browse(c.Context(), int64(ownerID), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// mine lists the caller's bundles with how many giveaways and participants used each.
+func (h *BundleHandlers) mine(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.Mine(c.Context(), mw.GetUserID(c), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+func (h *BundleHandlers) get(c *fiber.Ctx) error {
+	id, ok := bundleID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid bundle id"})
+	}
+	b, err := h.service.Get(c.Context(), id)
+	if err != nil {
+		return bundleError(c, err)
+	}
+	return c.JSON(b)
+}
+
+// stats reports the usage of a bundle. Access: its owner or platform admins.
+func (h *BundleHandlers) stats(c *fiber.Ctx) error {
+	id, ok := bundleID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid bundle id"})
+	}
+	userID := mw.GetUserID(c)
+	st, err := h.service.Stats(c.Context(), id, userID, isPlatformAdmin(c.Context(), h.users, userID))
+	if err != nil {
+		return bundleError(c, err)
+	}
+	return c.JSON(st)
+}
+
+// deactivate stops new giveaways from attaching a bundle. Access: its owner or platform admins.
+func (h *BundleHandlers) deactivate(c *fiber.Ctx) error {
+	id, ok := bundleID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid bundle id"})
+	}
+	userID := mw.GetUserID(c)
+	if err := h.service.Deactivate(c.Context(), id, userID, isPlatformAdmin(c.Context(), h.users, userID)); err != nil {
+		return bundleError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	campaignsvc "github.com/open-builders/giveaway-backend/internal/service/campaign"
+)
+
+// CampaignHandlers exposes campaigns to their creators and a public share page.
+type CampaignHandlers struct {
+	service *campaignsvc.Service
+}
+
+func NewCampaignHandlers(svc *campaignsvc.Service) *CampaignHandlers {
+	return &CampaignHandlers{service: svc}
+}
+
+func (h *CampaignHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/campaigns", h.create)
+	r.Get("/campaigns", h.list)
+	r.Get("/campaigns/:id", h.get)
+	r.Delete("/campaigns/:id", h.delete)
+	r.Get("/campaigns/:id/stats", h.stats)
+	r.Put("/campaigns/:id/giveaways/:giveaway_id", h.addGiveaway)
+	r.Delete("/campaigns/:id/giveaways/:giveaway_id", h.removeGiveaway)
+}
+
+// RegisterPublicFiber registers the campaign share page (no init-data auth).
+func (h *CampaignHandlers) RegisterPublicFiber(r fiber.Router) {
+	r.Get("/campaigns/:id", h.public)
+}
+
+type createCampaignReq struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func (h *CampaignHandlers) create(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req createCampaignReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	cp, err := h.service.Create(c.Context(), userID, req.Name, req.Description)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(cp)
+}
+
+func (h *CampaignHandlers) list(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	items, err := h.service.List(c.Context(), userID)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	if items == nil {
+		items = []dg.Campaign{}
+	}
+	return c.JSON(fiber.Map{"campaigns": items})
+}
+
+func (h *CampaignHandlers) get(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	cp, err := h.service.Get(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.JSON(cp)
+}
+
+func (h *CampaignHandlers) delete(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.Delete(c.Context(), c.Params("id"), userID); err != nil {
+		return campaignError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *CampaignHandlers) stats(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	st, err := h.service.Stats(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.JSON(st)
+}
+
+func (h *CampaignHandlers) addGiveaway(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	cp, err := h.service.AddGiveaway(c.Context(), c.Params("id"), c.Params("giveaway_id"), userID)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.JSON(cp)
+}
+
+func (h *CampaignHandlers) removeGiveaway(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	cp, err := h.service.RemoveGiveaway(c.Context(), c.Params("id"), c.Params("giveaway_id"), userID)
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.JSON(cp)
+}
+
+func (h *CampaignHandlers) public(c *fiber.Ctx) error {
+	cp, err := h.service.Public(c.Context(), c.Params("id"))
+	if err != nil {
+		return campaignError(c, err)
+	}
+	return c.JSON(cp)
+}
+
+func campaignError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found", "giveaway not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+}
@@ -1,15 +1,24 @@
 package http
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+	chsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
 	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
 )
 
 // ChannelHandlers exposes channel-related endpoints backed by Telegram client.
@@ -17,13 +26,43 @@ type ChannelHandlers struct {
 	tg      *tg.Client
 	avatars *rcache.ChannelAvatarCache
 	photos  *rcache.ChannelPhotoCache
+	files   storage.Storage
+	// Chat-info lookups; the client itself unless WithChatInfo set a breaker
+	chatInfo tg.API
+	// Chats whose photo ids are being refreshed in the background
+	refreshing sync.Map
+	// Connected channels of the current user and cached bot status for the pickers
+	channels  *chsvc.Service
+	botStatus *rcache.ChannelBotStatusCache
 }
 
 func NewChannelHandlers(tgc *tg.Client, avatars *rcache.ChannelAvatarCache, photos *rcache.ChannelPhotoCache) *ChannelHandlers {
-	return &ChannelHandlers{tg: tgc, avatars: avatars, photos: photos}
+	return &ChannelHandlers{tg: tgc, chatInfo: tgc, avatars: avatars, photos: photos}
+}
+
+// WithChatInfo routes chat-info lookups through api, e.g. a tg.ChatInfoBreaker.
+func (h *ChannelHandlers) WithChatInfo(api tg.API) *ChannelHandlers {
+	h.chatInfo = api
+	return h
+}
+
+// WithUserChannels enables GET /channels/me backed by the connected channels in Redis.
+func (h *ChannelHandlers) WithUserChannels(chs *chsvc.Service, botStatus *rcache.ChannelBotStatusCache) *ChannelHandlers {
+	h.channels = chs
+	h.botStatus = botStatus
+	return h
+}
+
+// WithStorage keeps downloaded avatars in file storage and redirects to signed links,
+// so repeated requests skip Telegram entirely.
+func (h *ChannelHandlers) WithStorage(files storage.Storage) *ChannelHandlers {
+	h.files = files
+	return h
 }
 
 func (h *ChannelHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/channels/me", h.listMine)
+	r.Post("/channels/:id/refresh", h.refresh)
 	r.Get("/channels/:username/info", h.getChannelInfo)
 	r.Get("/channels/:chat/membership", h.checkMembership)
 	r.Get("/channels/:chat/boost", h.checkBoost)
@@ -34,9 +73,101 @@ func (h *ChannelHandlers) RegisterPublicFiber(r fiber.Router) {
 	r.Get("/channels/:chat/avatar", h.redirectChannelAvatar)
 }
 
+// botStatusLookups bounds concurrent getChatMember calls for one request.
+const botStatusLookups = 5
+
+// listMine returns the current user's connected channels filtered by ?query= on title or
+// username, with bot status flags, so pickers never call Telegram from the client.
+func (h *ChannelHandlers) listMine(c *fiber.Ctx) error {
+	uid := mw.GetUserID(c)
+	if uid == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.channels == nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "channels service not configured"})
+	}
+	v := validate.New(requestLocale(c))
+	limit := parseLimit(c, v, 20, 50)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	items, err := h.channels.SearchUserChannels(c.Context(), uid, c.Query("query"), limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	out := make([]dto.MyChannel, len(items))
+	sem := make(chan struct{}, botStatusLookups)
+	var wg sync.WaitGroup
+	for i := range items {
+		out[i].Channel = items[i]
+		wg.Add(1)
+		go func(mc *dto.MyChannel) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			h.fillBotStatus(c.Context(), mc)
+		}(&out[i])
+	}
+	wg.Wait()
+	return c.JSON(out)
+}
+
+// fillBotStatus sets the bot flags from cache, asking Telegram on a miss. Failures are not cached.
+func (h *ChannelHandlers) fillBotStatus(ctx context.Context, mc *dto.MyChannel) {
+	mc.BotStatus = "unknown"
+	if h.botStatus != nil {
+		if e, err := h.botStatus.Get(ctx, mc.ID); err == nil && e != nil {
+			mc.BotStatus, mc.CanCheckMembers = e.Status, e.CanCheckMembers
+			mc.BotIsAdmin = e.Status == "administrator" || e.Status == "creator"
+			return
+		}
+	}
+	if h.tg == nil {
+		return
+	}
+	status, can, err := h.tg.GetBotMemberStatus(ctx, strconv.FormatInt(mc.ID, 10))
+	if err != nil {
+		return
+	}
+	mc.BotStatus, mc.CanCheckMembers = status, can
+	mc.BotIsAdmin = status == "administrator" || status == "creator"
+	if h.botStatus != nil {
+		_ = h.botStatus.Set(ctx, mc.ID, &rcache.ChannelBotStatusEntry{Status: status, CanCheckMembers: can})
+	}
+}
+
+// refresh fetches a connected channel's title, username and member count from Telegram now,
+// instead of waiting for the cached values to be updated. Access: users who connected the channel.
+func (h *ChannelHandlers) refresh(c *fiber.Ctx) error {
+	uid := mw.GetUserID(c)
+	if uid == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.channels == nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "channels service not configured"})
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid channel id"})
+	}
+	ch, err := h.channels.Refresh(c.Context(), id, uid)
+	if err != nil {
+		switch msg := err.Error(); {
+		case msg == "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+		case msg == "refreshed recently":
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{"error": msg})
+		case strings.HasPrefix(msg, "telegram: "):
+			return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": msg})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(ch)
+}
+
 func (h *ChannelHandlers) getChannelInfo(c *fiber.Ctx) error {
 	username := c.Params("username")
-	info, err := h.tg.GetPublicChannelInfo(c.Context(), username)
+	info, err := h.chatInfo.GetPublicChannelInfo(c.Context(), username)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -85,30 +216,45 @@ func (h *ChannelHandlers) redirectChannelAvatar(c *fiber.Ctx) error {
 		chatID          int64
 		bigFileID       string
 		bigFileUniqueID string
+		// Last avatar from Postgres, loaded once Telegram fails
+		stored *dg.ChannelAvatar
 	)
 
-	// Try short-lived cache for chat photo identifiers
+	// Cached chat photo identifiers are served right away; stale ones are refreshed in the background
 	if h.photos != nil {
 		if entry, err := h.photos.Get(c.Context(), chatParam); err == nil && entry != nil {
 			chatID = entry.ID
 			bigFileID = entry.BigFileID
 			bigFileUniqueID = entry.BigFileUniqueID
+			if h.photos.Stale(entry) {
+				h.refreshPhoto(chatParam)
+			}
 		}
 	}
 	if chatID == 0 || bigFileID == "" || bigFileUniqueID == "" {
 		// Fallback to Telegram getChat
 		ch, err := h.tg.GetChatRaw(c.Context(), chatParam)
 		if err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
-		}
-		if ch.Photo == nil || ch.Photo.BigFileID == "" || ch.Photo.BigFileUniqueID == "" {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "avatar not set"})
+			if stored = h.lastAvatar(c.Context(), chatParam); stored == nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+			}
+			chatID, bigFileID, bigFileUniqueID = stored.ChannelID, stored.BigFileID, stored.BigFileUniqueID
+		} else {
+			if ch.Photo == nil || ch.Photo.BigFileID == "" || ch.Photo.BigFileUniqueID == "" {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "avatar not set"})
+			}
+			chatID = ch.ID
+			bigFileID = ch.Photo.BigFileID
+			bigFileUniqueID = ch.Photo.BigFileUniqueID
+			h.rememberPhoto(c.Context(), chatParam, &dg.ChannelAvatar{ChannelID: chatID, Username: ch.Username, BigFileID: bigFileID, BigFileUniqueID: bigFileUniqueID})
 		}
-		chatID = ch.ID
-		bigFileID = ch.Photo.BigFileID
-		bigFileUniqueID = ch.Photo.BigFileUniqueID
-		if h.photos != nil {
-			_ = h.photos.Set(c.Context(), chatParam, &rcache.ChannelPhotoEntry{ID: chatID, BigFileID: bigFileID, BigFileUniqueID: bigFileUniqueID})
+	}
+
+	// Avatar objects are keyed by unique file id, so a changed avatar gets a new object
+	storageKey := fmt.Sprintf("avatars/channels/%d/%s.jpg", chatID, bigFileUniqueID)
+	if h.files != nil {
+		if ok, err := h.files.Exists(c.Context(), storageKey); err == nil && ok {
+			return h.redirectStoredAvatar(c, storageKey)
 		}
 	}
 
@@ -125,7 +271,15 @@ func (h *ChannelHandlers) redirectChannelAvatar(c *fiber.Ctx) error {
 		// Cache miss or outdated unique_id: resolve via getFile
 		fp, err := h.tg.GetFilePath(c.Context(), bigFileID)
 		if err != nil {
-			return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": err.Error()})
+			if stored == nil {
+				stored = h.lastAvatar(c.Context(), strconv.FormatInt(chatID, 10))
+			}
+			if stored == nil || stored.BigFileUniqueID != bigFileUniqueID || stored.FilePath == "" {
+				return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": err.Error()})
+			}
+			fp = stored.FilePath
+		} else if h.channels != nil {
+			_ = h.channels.SaveAvatar(c.Context(), &dg.ChannelAvatar{ChannelID: chatID, BigFileID: bigFileID, BigFileUniqueID: bigFileUniqueID, FilePath: fp})
 		}
 		filePath = fp
 		if h.avatars != nil {
@@ -155,6 +309,18 @@ func (h *ChannelHandlers) redirectChannelAvatar(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "avatar not available"})
 	}
 
+	// Telegram always sends Content-Length for files; without it fall back to proxying
+	if h.files != nil && resp.ContentLength >= 0 {
+		err := h.files.Put(c.Context(), storageKey, resp.Body, resp.ContentLength, storage.PutOptions{
+			ContentType:  resp.Header.Get("Content-Type"),
+			CacheControl: "public, max-age=86400",
+		})
+		if err != nil {
+			return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": "failed to store avatar"})
+		}
+		return h.redirectStoredAvatar(c, storageKey)
+	}
+
 	// Set appropriate headers
 	c.Set("Content-Type", resp.Header.Get("Content-Type"))
 	if contentLength := resp.Header.Get("Content-Length"); contentLength != "" {
@@ -171,3 +337,58 @@ func (h *ChannelHandlers) redirectChannelAvatar(c *fiber.Ctx) error {
 
 	return nil
 }
+
+// refreshPhoto refetches the chat photo identifiers for chatRef in the background, once at a time
+// per chat. A removed avatar drops the cached entry; Telegram errors keep the stale one.
+func (h *ChannelHandlers) refreshPhoto(chatRef string) {
+	if _, busy := h.refreshing.LoadOrStore(chatRef, struct{}{}); busy {
+		return
+	}
+	go func() {
+		defer h.refreshing.Delete(chatRef)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		ch, err := h.tg.GetChatRaw(ctx, chatRef)
+		if err != nil {
+			return
+		}
+		if ch.Photo == nil || ch.Photo.BigFileID == "" || ch.Photo.BigFileUniqueID == "" {
+			_ = h.photos.Invalidate(ctx, chatRef)
+			return
+		}
+		h.rememberPhoto(ctx, chatRef, &dg.ChannelAvatar{ChannelID: ch.ID, Username: ch.Username, BigFileID: ch.Photo.BigFileID, BigFileUniqueID: ch.Photo.BigFileUniqueID})
+	}()
+}
+
+// rememberPhoto caches fresh photo identifiers in Redis and keeps them in Postgres as fallback.
+func (h *ChannelHandlers) rememberPhoto(ctx context.Context, chatRef string, a *dg.ChannelAvatar) {
+	if h.photos != nil {
+		_ = h.photos.Set(ctx, chatRef, &rcache.ChannelPhotoEntry{ID: a.ChannelID, BigFileID: a.BigFileID, BigFileUniqueID: a.BigFileUniqueID})
+	}
+	if h.channels != nil {
+		_ = h.channels.SaveAvatar(ctx, a)
+	}
+}
+
+// lastAvatar returns the avatar stored in Postgres for chatRef, or nil.
+func (h *ChannelHandlers) lastAvatar(ctx context.Context, chatRef string) *dg.ChannelAvatar {
+	if h.channels == nil {
+		return nil
+	}
+	a, err := h.channels.LastAvatar(ctx, chatRef)
+	if err != nil {
+		return nil
+	}
+	return a
+}
+
+// redirectStoredAvatar sends the client to a signed storage link. The link outlives the
+// redirect's own cache lifetime so cached redirects never point at expired URLs.
+func (h *ChannelHandlers) redirectStoredAvatar(c *fiber.Ctx, key string) error {
+	url, err := h.files.SignedURL(c.Context(), key, 2*time.Hour)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to sign avatar url"})
+	}
+	c.Set("Cache-Control", "public, max-age=3600")
+	return c.Redirect(url, fiber.StatusFound)
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/compliance"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func complianceError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found", "rule not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// ComplianceHandlers lets platform admins configure the jurisdictions where prize kinds are
+// restricted.
+type ComplianceHandlers struct {
+	service *compliance.Service
+	users   *usersvc.Service
+}
+
+func NewComplianceHandlers(svc *compliance.Service, users *usersvc.Service) *ComplianceHandlers {
+	return &ComplianceHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *ComplianceHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/admin/compliance/rules", h.listRules)
+	r.Put("/admin/compliance/rules/:prize_kind", h.setRule)
+	r.Delete("/admin/compliance/rules/:prize_kind", h.deleteRule)
+}
+
+func (h *ComplianceHandlers) listRules(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	rules, err := h.service.Rules(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"prize_kinds": dg.PrizeKindList, "rules": rules})
+}
+
+func (h *ComplianceHandlers) setRule(c *fiber.Ctx) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	var req dto.ComplianceRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	rule, err := h.service.SetRule(c.Context(), dg.PrizeKind(c.Params("prize_kind")), req.Countries, req.Notice, adminID)
+	if err != nil {
+		return complianceError(c, err)
+	}
+	return c.JSON(rule)
+}
+
+func (h *ComplianceHandlers) deleteRule(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	if err := h.service.DeleteRule(c.Context(), dg.PrizeKind(c.Params("prize_kind"))); err != nil {
+		return complianceError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// complianceGate returns the eligibility acknowledgment the current user owes before joining;
+// required is false when the prizes are not restricted anywhere. Access: any authenticated user.
+func (h *GiveawayHandlersFiber) complianceGate(c *fiber.Ctx) error {
+	requesterID := mw.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.compliance == nil {
+		return c.JSON(dg.ComplianceGate{})
+	}
+	gate, err := h.compliance.GateFor(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return complianceError(c, err)
+	}
+	return c.JSON(gate)
+}
+
+// complianceAcks lists the recorded eligibility acknowledgments of a giveaway, latest first.
+// Access: giveaway owner or platform admin.
+func (h *GiveawayHandlersFiber) complianceAcks(c *fiber.Ctx) error {
+	requesterID := mw.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.compliance == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{"error": "compliance gate is not enabled"})
+	}
+	v := validate.New(requestLocale(c))
+	p := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	admin := isPlatformAdmin(c.Context(), h.users, requesterID)
+	acks, err := h.compliance.Acks(c.Context(), c.Params("id"), requesterID, admin, p.Limit, p.Offset)
+	if err != nil {
+		return complianceError(c, err)
+	}
+	return c.JSON(acks)
+}
+
+// checkCompliance enforces the compliance gate of g on a join: a pending acknowledgment is
+// recorded when the request confirms it and refuses the join otherwise. It reports whether the
+// join may go on; when not, the response is already written.
+func (h *GiveawayHandlersFiber) checkCompliance(c *fiber.Ctx, g *dg.Giveaway, userID int64, acknowledge bool) (bool, error) {
+	if h.compliance == nil {
+		return true, nil
+	}
+	gate, err := h.compliance.Gate(c.Context(), g, userID)
+	if err != nil {
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if !gate.Pending() {
+		return true, nil
+	}
+	if !acknowledge {
+		return false, c.Status(fiber.StatusForbidden).JSON(dto.Error{Error: "compliance acknowledgment required", Compliance: gate})
+	}
+	if _, err := h.compliance.Acknowledge(c.Context(), g, userID, c.IP(), c.Get(fiber.HeaderUserAgent)); err != nil {
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return true, nil
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/crm"
+)
+
+// CRMWebhookHandlers lets creators register endpoints that receive their new participants.
+type CRMWebhookHandlers struct {
+	service *crm.Service
+}
+
+func NewCRMWebhookHandlers(svc *crm.Service) *CRMWebhookHandlers {
+	return &CRMWebhookHandlers{service: svc}
+}
+
+func (h *CRMWebhookHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/integrations/webhooks", h.create)
+	r.Get("/integrations/webhooks", h.list)
+	r.Delete("/integrations/webhooks/:id", h.delete)
+	r.Post("/integrations/webhooks/:id/reset", h.reset)
+}
+
+type createCRMWebhookReq struct {
+	URL string `json:"url"`
+}
+
+func (h *CRMWebhookHandlers) create(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req createCRMWebhookReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	w, err := h.service.Create(c.Context(), userID, req.URL)
+	if err != nil {
+		return crmWebhookError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(w)
+}
+
+func (h *CRMWebhookHandlers) list(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	items, err := h.service.List(c.Context(), userID)
+	if err != nil {
+		return crmWebhookError(c, err)
+	}
+	return c.JSON(fiber.Map{"webhooks": items})
+}
+
+func (h *CRMWebhookHandlers) delete(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.Delete(c.Context(), c.Params("id"), userID); err != nil {
+		return crmWebhookError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// reset closes an open circuit so delivery resumes on the next tick.
+func (h *CRMWebhookHandlers) reset(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.ResetCircuit(c.Context(), c.Params("id"), userID); err != nil {
+		return crmWebhookError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func crmWebhookError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+}
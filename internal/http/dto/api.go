@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// Version is the version of the request and response shapes in this package, published with
+// the generated client. Additions bump the minor version; breaking changes bump the major
+// one together with the /api/vN prefix.
+const Version = "1.0.0"
+
+// Error is the body of failed requests.
+type Error struct {
+	Error string `json:"error"`
+	// Errors lists every failed rule of a rejected payload
+	Errors []validate.FieldError `json:"errors,omitempty"`
+	// DuplicateOf is the giveaway a rejected create repeats
+	DuplicateOf string `json:"duplicate_of,omitempty"`
+	// OpensAt starts the next join window when joining is closed
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Compliance is the acknowledgment a refused join is waiting for
+	Compliance *dg.ComplianceGate `json:"compliance,omitempty"`
+}
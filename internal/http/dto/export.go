@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ExportJob is a background export with its progress.
+type ExportJob struct {
+	ID         string             `json:"id"`
+	GiveawayID string             `json:"giveaway_id"`
+	Kind       dg.ExportKind      `json:"kind"`
+	Locale     string             `json:"locale"`
+	Status     dg.ExportJobStatus `json:"status"`
+	Total      int                `json:"total"`
+	Processed  int                `json:"processed"`
+	Percent    int                `json:"percent"`
+	// ETASeconds estimates the time left of a running job
+	ETASeconds int        `json:"eta_sec,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// URL downloads the CSV of a finished job until ExpiresIn seconds from now
+	URL       string `json:"url,omitempty"`
+	ExpiresIn int    `json:"expires_in,omitempty"`
+}
+
+// NewExportJob maps j as of now; url is its download link, empty before it is done.
+func NewExportJob(j *dg.ExportJob, url string, ttl time.Duration, now time.Time) ExportJob {
+	out := ExportJob{
+		ID:         j.ID,
+		GiveawayID: j.GiveawayID,
+		Kind:       j.Kind,
+		Locale:     j.Locale,
+		Status:     j.Status,
+		Total:      j.Total,
+		Processed:  j.Processed,
+		Percent:    j.Percent(),
+		ETASeconds: int(j.ETA(now).Seconds()),
+		Error:      j.Error,
+		CreatedAt:  j.CreatedAt,
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.FinishedAt,
+	}
+	if url != "" {
+		out.URL, out.ExpiresIn = url, int(ttl.Seconds())
+	}
+	return out
+}
+
+// CreateExportRequest picks the CSV of an export job.
+type CreateExportRequest struct {
+	// Kind is "winners", or "stats" to add prize quantities; defaults to "stats"
+	Kind dg.ExportKind `json:"kind"`
+}
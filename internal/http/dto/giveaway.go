@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// Giveaway is the giveaway view; it leaves out the creator id and carries the caller's role.
+type Giveaway struct {
+	ID                string            `json:"id"`
+	Title             string            `json:"title"`
+	Description       string            `json:"description"`
+	DescriptionHTML   string            `json:"description_html,omitempty"`
+	StartedAt         time.Time         `json:"started_at"`
+	EndsAt            time.Time         `json:"ends_at"`
+	Duration          int64             `json:"duration"`
+	MaxWinnersCount   int               `json:"winners_count"`
+	Status            dg.GiveawayStatus `json:"status"`
+	WinnerStrategy    dg.WinnerStrategy `json:"winner_strategy,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+	Version           int64             `json:"version"`
+	Prizes            []dg.PrizePlace   `json:"prizes,omitempty"`
+	PrizePackages     []dg.PrizePackage `json:"prize_packages,omitempty"`
+	Sponsors          []Sponsor         `json:"sponsors"`
+	Requirements      []Requirement     `json:"requirements,omitempty"`
+	Winners           []Winner          `json:"winners,omitempty"`
+	ParticipantsCount int               `json:"participants_count"`
+	MaxParticipants   int               `json:"max_participants,omitempty"`
+	UserRole          string            `json:"user_role,omitempty"`
+	CanManage         bool              `json:"can_manage,omitempty"`
+	MsgID             string            `json:"msg_id,omitempty"`
+	// Join windows and their current state for countdowns
+	JoinWindows  []dg.JoinWindow     `json:"join_windows,omitempty"`
+	JoinTimezone string              `json:"join_timezone,omitempty"`
+	JoinWindow   *dg.JoinWindowState `json:"join_window,omitempty"`
+	// Prize budget totals for confirmation screens
+	PrizeSummary dg.PrizeSummary `json:"prize_summary"`
+	// Winners are listed in this order
+	WinnerOrder dg.WinnerOrder `json:"winner_order,omitempty"`
+	// Rules and answers to common questions from the creator
+	Rules string        `json:"rules,omitempty"`
+	FAQ   []dg.FAQEntry `json:"faq,omitempty"`
+	// EscrowAmountNano is the escrow a draft asks for once published
+	EscrowAmountNano int64 `json:"escrow_amount_nano,omitempty"`
+	// Cover replaces the default launch animation; served at /api/public/giveaways/:id/cover
+	Cover *dg.Cover `json:"cover,omitempty"`
+	// Language names the translation title and description are in, empty for the original;
+	// Languages lists every translation and Translations carries them for the owner
+	Language     string                    `json:"language,omitempty"`
+	Languages    []string                  `json:"languages,omitempty"`
+	Translations map[string]dg.Translation `json:"translations,omitempty"`
+	// Explore feed topic and tags
+	Category dg.Category `json:"category,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+	// List badges, as in giveaway lists
+	EndingSoon  bool `json:"ending_soon,omitempty"`
+	JustStarted bool `json:"just_started,omitempty"`
+}
+
+// NewGiveaway maps g with its requirements and sponsors. Winners, the caller's role and the
+// message id depend on lookups and are left to the caller.
+func NewGiveaway(g *dg.Giveaway) Giveaway {
+	reqs := make([]Requirement, 0, len(g.Requirements))
+	for _, r := range g.Requirements {
+		reqs = append(reqs, NewRequirement(r))
+	}
+	sponsors := make([]Sponsor, 0, len(g.Sponsors))
+	for _, s := range g.Sponsors {
+		sponsors = append(sponsors, NewSponsor(s))
+	}
+	var escrow int64
+	if g.Status == dg.GiveawayStatusDraft && g.Funding != nil {
+		escrow = g.Funding.AmountNano
+	}
+	return Giveaway{
+		ID:                g.ID,
+		Title:             g.Title,
+		Description:       g.Description,
+		DescriptionHTML:   g.DescriptionHTML,
+		StartedAt:         g.StartedAt,
+		EndsAt:            g.EndsAt,
+		Duration:          g.Duration,
+		MaxWinnersCount:   g.MaxWinnersCount,
+		Status:            g.Status,
+		WinnerStrategy:    g.WinnerStrategy,
+		CreatedAt:         g.CreatedAt,
+		UpdatedAt:         g.UpdatedAt,
+		Version:           g.Version,
+		Prizes:            g.Prizes,
+		PrizePackages:     g.PrizePackages,
+		Sponsors:          sponsors,
+		Requirements:      reqs,
+		Winners:           []Winner{},
+		ParticipantsCount: g.ParticipantsCount,
+		MaxParticipants:   g.MaxParticipants,
+		JoinWindows:       g.JoinWindows,
+		JoinTimezone:      g.JoinTimezone,
+		JoinWindow:        g.JoinWindow,
+		PrizeSummary:      dg.SummarizePrizes(g.Prizes, g.PrizePackages, g.MaxWinnersCount),
+		WinnerOrder:       g.WinnerOrder,
+		Rules:             g.Rules,
+		FAQ:               g.FAQ,
+		EscrowAmountNano:  escrow,
+		Cover:             g.Cover,
+		Language:          g.Language,
+		Languages:         g.Languages(),
+		Category:          g.Category,
+		Tags:              g.Tags,
+		EndingSoon:        g.EndingSoon,
+		JustStarted:       g.JustStarted,
+	}
+}
@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// roundTrip marshals v, decodes it into a fresh T and checks that the decoded value renders the
+// same JSON, so no field is lost or renamed on the way. It returns the decoded value and the
+// JSON object for key assertions.
+func roundTrip[T any](t *testing.T, v T) (T, map[string]json.RawMessage) {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var back T
+	if err := json.Unmarshal(raw, &back); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	again, err := json.Marshal(back)
+	if err != nil {
+		t.Fatalf("marshal again: %v", err)
+	}
+	if !bytes.Equal(raw, again) {
+		t.Fatalf("round trip changed JSON:\n%s\n%s", raw, again)
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		t.Fatalf("decode object: %v", err)
+	}
+	return back, obj
+}
+
+func assertKeys(t *testing.T, obj map[string]json.RawMessage, present, absent []string) {
+	t.Helper()
+	for _, k := range present {
+		if _, ok := obj[k]; !ok {
+			t.Errorf("missing key %q", k)
+		}
+	}
+	for _, k := range absent {
+		if _, ok := obj[k]; ok {
+			t.Errorf("unexpected key %q", k)
+		}
+	}
+}
+
+func TestNewGiveaway(t *testing.T) {
+	start := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	place := 1
+	base := dg.Giveaway{
+		ID:              "g-1",
+		CreatorID:       42,
+		Title:           "Launch",
+		Description:     "Win things",
+		StartedAt:       start,
+		EndsAt:          start.Add(24 * time.Hour),
+		Duration:        86400,
+		MaxWinnersCount: 2,
+		Status:          dg.GiveawayStatusActive,
+		CreatedAt:       start,
+		UpdatedAt:       start,
+		Version:         3,
+		Prizes:          []dg.PrizePlace{{Place: &place, Title: "Gift"}, {Title: "Sticker", Quantity: 3}},
+		Sponsors: []dg.ChannelInfo{
+			{ID: -1001, Username: "demo", Title: "Demo"},
+		},
+		Requirements: []dg.Requirement{
+			{Type: dg.RequirementTypeSubscription, ChannelID: -1001, ChannelUsername: "demo", ChannelTitle: "Demo"},
+		},
+		ParticipantsCount: 7,
+		Funding:           &dg.Funding{AmountNano: 5_000_000_000},
+		Tags:              []string{"tech"},
+	}
+
+	tests := []struct {
+		name       string
+		mutate     func(g *dg.Giveaway)
+		wantEscrow int64
+	}{
+		{name: "active hides escrow"},
+		{
+			name:       "draft shows escrow",
+			mutate:     func(g *dg.Giveaway) { g.Status = dg.GiveawayStatusDraft },
+			wantEscrow: 5_000_000_000,
+		},
+		{
+			name: "no sponsors or requirements",
+			mutate: func(g *dg.Giveaway) {
+				g.Sponsors = nil
+				g.Requirements = nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := base
+			if tt.mutate != nil {
+				tt.mutate(&g)
+			}
+			out := NewGiveaway(&g)
+			if out.ID != g.ID || out.Title != g.Title || out.Status != g.Status || out.Version != g.Version {
+				t.Fatalf("basic fields not mapped: %+v", out)
+			}
+			if out.EscrowAmountNano != tt.wantEscrow {
+				t.Errorf("escrow = %d, want %d", out.EscrowAmountNano, tt.wantEscrow)
+			}
+			if len(out.Sponsors) != len(g.Sponsors) || len(out.Requirements) != len(g.Requirements) {
+				t.Errorf("sponsors %d requirements %d, want %d and %d", len(out.Sponsors), len(out.Requirements), len(g.Sponsors), len(g.Requirements))
+			}
+			if out.Sponsors == nil || out.Winners == nil {
+				t.Errorf("sponsors and winners must be empty slices, not nil")
+			}
+			want := dg.SummarizePrizes(g.Prizes, g.PrizePackages, g.MaxWinnersCount)
+			if !reflect.DeepEqual(out.PrizeSummary, want) {
+				t.Errorf("prize summary = %+v, want %+v", out.PrizeSummary, want)
+			}
+
+			back, obj := roundTrip(t, out)
+			assertKeys(t, obj,
+				[]string{"id", "title", "started_at", "ends_at", "winners_count", "status", "sponsors", "participants_count", "prize_summary"},
+				[]string{"creator_id", "winners", "user_role", "can_manage", "translations"},
+			)
+			if !back.StartedAt.Equal(out.StartedAt) || back.ParticipantsCount != out.ParticipantsCount {
+				t.Errorf("decoded giveaway differs: %+v", back)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package dto
+
+import du "github.com/open-builders/giveaway-backend/internal/domain/user"
+
+// Me is the current user as returned by GET /users/me.
+type Me struct {
+	ID            int64  `json:"id"`
+	FirstName     string `json:"first_name"`
+	LastName      string `json:"last_name"`
+	Username      string `json:"username"`
+	AvatarURL     string `json:"avatar_url"`
+	IsPremium     bool   `json:"is_premium"`
+	Role          string `json:"role"`
+	WalletAddress string `json:"wallet_address"`
+	// Language the app renders in and the one picked in the settings, if any
+	Language          string `json:"language"`
+	PreferredLanguage string `json:"preferred_language,omitempty"`
+}
+
+// Settings are the app settings of the current user.
+type Settings struct {
+	// PreferredLanguage is the picked language, "" when following the Telegram language
+	PreferredLanguage string `json:"preferred_language"`
+	// Language is the language in effect
+	Language string `json:"language"`
+	// Notifications are the optional bot messages the user receives
+	Notifications du.NotificationPrefs `json:"notifications"`
+}
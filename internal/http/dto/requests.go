@@ -0,0 +1,181 @@
+package dto
+
+import dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+
+// CreatePrizeRequest is a prize in a new giveaway.
+type CreatePrizeRequest struct {
+	Place       *int   `json:"place,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Quantity    int    `json:"quantity,omitempty"`
+}
+
+// CreatePrizePackageRequest is a bundle of prizes every winner from from_place to to_place gets.
+type CreatePrizePackageRequest struct {
+	Label     string               `json:"label"`
+	FromPlace int                  `json:"from_place"`
+	ToPlace   int                  `json:"to_place"`
+	Items     []CreatePrizeRequest `json:"items"`
+}
+
+// CreateSponsorRequest names a sponsor channel the creator manages.
+type CreateSponsorRequest struct {
+	ID int64 `json:"id"`
+}
+
+// CreateGiveawayRequest is the body of POST /giveaways.
+type CreateGiveawayRequest struct {
+	Title           string                     `json:"title"`
+	Duration        int64                      `json:"duration"`
+	WinnersCount    int                        `json:"winners_count"`
+	Prizes          []CreatePrizeRequest       `json:"prizes"`
+	Description     string                     `json:"description,omitempty"`
+	MaxParticipants *int                       `json:"max_participants,omitempty"` // joins close once reached
+	Requirements    []CreateRequirementRequest `json:"requirements,omitempty"`
+	Sponsors        []CreateSponsorRequest     `json:"sponsors,omitempty"`
+	// Bundles of prizes awarded whole to every winner of a place range, on top of prizes
+	PrizePackages []CreatePrizePackageRequest `json:"prize_packages,omitempty"`
+	// WinnerStrategy: random (default), weighted, first_n or manual
+	WinnerStrategy string `json:"winner_strategy,omitempty"`
+	// Pending expiry overrides: TTL in seconds (0 never expires) and "draw" or "cancel"
+	PendingTTLSec *int   `json:"pending_ttl_sec,omitempty"`
+	PendingAction string `json:"pending_action,omitempty"`
+	// EscrowAmountNano escrows prizes on-chain: the giveaway stays scheduled until this much TON is deposited
+	EscrowAmountNano int64 `json:"escrow_amount_nano,omitempty"`
+	// Join windows: joins are only accepted inside them, in join_timezone (IANA, default UTC)
+	JoinWindows  []dg.JoinWindow `json:"join_windows,omitempty"`
+	JoinTimezone string          `json:"join_timezone,omitempty"`
+	// Flag joins once this many accounts joined from one device (IP + user agent); 0 disables
+	MaxAccountsPerFingerprint int `json:"max_accounts_per_fingerprint,omitempty"`
+	// How winners are listed: place (default), alphabetical or random
+	WinnerOrder dg.WinnerOrder `json:"winner_order,omitempty"`
+	// Alternates drawn after the winners, and how long winners have to claim before one replaces them
+	ReserveWinnersCount int  `json:"reserve_winners_count,omitempty"`
+	ClaimDeadlineSec    *int `json:"claim_deadline_sec,omitempty"`
+	// Require continuous membership in subscription channels, with this many seconds to re-subscribe
+	UnsubscribeGraceSec *int `json:"unsubscribe_grace_sec,omitempty"`
+	// Create even when this repeats a giveaway submitted in the last 10 minutes
+	AllowDuplicate bool `json:"allow_duplicate,omitempty"`
+	// Sponsored requirement bundles whose requirements are appended after the own ones
+	BundleIDs []int64 `json:"bundle_ids,omitempty"`
+	// Rules and FAQ for the giveaway page; AnnounceRules appends the rules to the announcement
+	Rules         string        `json:"rules,omitempty"`
+	FAQ           []dg.FAQEntry `json:"faq,omitempty"`
+	AnnounceRules bool          `json:"announce_rules,omitempty"`
+	// Bot message sent to users right after they join; off when omitted
+	JoinConfirmation *JoinConfirmationRequest `json:"join_confirmation,omitempty"`
+	// Title and description in other languages by language code, e.g. "ru" or "pt-BR"
+	Translations map[string]TranslationRequest `json:"translations,omitempty"`
+	// Explore feed topic and up to 5 tags from the vocabulary at GET /giveaways/categories
+	Category dg.Category `json:"category,omitempty"`
+	Tags     []string    `json:"tags,omitempty"`
+	// Save as an editable draft that starts when published
+	Draft bool `json:"draft,omitempty"`
+}
+
+// TranslationRequest is the title and description of a giveaway in one language; an empty
+// field falls back to the original.
+type TranslationRequest struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// JoinConfirmationRequest configures the join confirmation message of a giveaway.
+type JoinConfirmationRequest struct {
+	Enabled bool   `json:"enabled"`
+	Rules   string `json:"rules,omitempty"`
+}
+
+// UpdateRequirementsRequest is the full new requirement list of a giveaway.
+type UpdateRequirementsRequest struct {
+	Requirements []CreateRequirementRequest `json:"requirements"`
+}
+
+// CreateRequirementRequest accepts flexible payloads from the client
+// and is normalized into domain.Requirement.
+type CreateRequirementRequest struct {
+	// Existing requirement to keep when editing requirements; ignored when creating
+	ID   int64              `json:"id,omitempty"`
+	Type dg.RequirementType `json:"type"`
+	// Client may send either "username" or "channel_username"
+	Username        string `json:"username,omitempty"`
+	ChannelUsername string `json:"channel_username,omitempty"`
+	ChannelID       int64  `json:"channel_id,omitempty"`
+	AvatarURL       string `json:"avatar_url,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	// On-chain
+	TonMinBalanceNano int64  `json:"ton_min_balance_nano,omitempty"`
+	JettonAddress     string `json:"jetton_address,omitempty"`
+	JettonMinAmount   int64  `json:"jetton_min_amount,omitempty"`
+	// Account age
+	AccountAgeMinYear int `json:"account_age_min_year,omitempty"`
+	AccountAgeMaxYear int `json:"account_age_max_year,omitempty"`
+	// Optional task: extra tickets for weighted draws instead of a join condition
+	BonusTickets int `json:"bonus_tickets,omitempty"`
+	// Subscription only: accept users who were members before the giveaway started
+	ExistingMembersOnly bool `json:"existing_members_only,omitempty"`
+	// Subscription only: minimum membership in days, as far as the bot observed it
+	MinMemberDays int `json:"min_member_days,omitempty"`
+	// Display order; requirements without one keep their order in the payload
+	Position int `json:"position,omitempty"`
+	// Shown to participants but not enforced on join
+	Optional bool `json:"optional,omitempty"`
+}
+
+// JoinRequest is the optional join body; clients report sources Telegram does not tell us about,
+// such as the explore feed.
+type JoinRequest struct {
+	Source    dg.ParticipantSource `json:"source"`
+	SourceRef string               `json:"source_ref"`
+	// AcknowledgeCompliance confirms the user is not a resident of the restricted countries of
+	// the giveaway; required once when a join is refused with "compliance acknowledgment required"
+	AcknowledgeCompliance bool `json:"acknowledge_compliance,omitempty"`
+}
+
+// PrizeContactRequest is how a winner wants to be contacted for prize delivery.
+type PrizeContactRequest struct {
+	Method dg.ContactMethod `json:"method"`
+	// Email is required for the email method
+	Email string `json:"email,omitempty"`
+	// WalletAddress for the wallet method; the linked wallet is used when empty
+	WalletAddress string `json:"wallet_address,omitempty"`
+}
+
+// StatusBatchRequest lists giveaways for status-batch and overlap.
+type StatusBatchRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// SettingsRequest is the body of PATCH /me/settings; fields left out are kept.
+type SettingsRequest struct {
+	// Language for bot messages and localized fields; "" follows the Telegram language again
+	Language *string `json:"language"`
+	// JoinConfirmations turns the bot message sent after joining a giveaway on or off
+	JoinConfirmations *bool `json:"join_confirmations"`
+}
+
+// SaveTemplateRequest is the body of POST and PUT /giveaways/templates.
+type SaveTemplateRequest struct {
+	Name     string                `json:"name"`
+	Giveaway CreateGiveawayRequest `json:"giveaway"`
+}
+
+// AddManagerRequest is the body of POST /giveaways/:id/managers; give the user id or the
+// @username of a user who opened the app before.
+type AddManagerRequest struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// CreateInviteLinkRequest is the body of POST /giveaways/:id/invite-links; name identifies
+// the source in the join breakdown, e.g. a partner channel.
+type CreateInviteLinkRequest struct {
+	Name string `json:"name"`
+}
+
+// ComplianceRuleRequest is the body of PUT /admin/compliance/rules/:prize_kind.
+type ComplianceRuleRequest struct {
+	Countries []string `json:"countries"`
+	Notice    string   `json:"notice"`
+}
@@ -0,0 +1,170 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+// Requirement is a requirement within a giveaway view.
+type Requirement struct {
+	Name        string             `json:"name,omitempty"`
+	Type        dg.RequirementType `json:"type"`
+	Username    string             `json:"username,omitempty"`
+	AvatarURL   string             `json:"avatar_url,omitempty"`
+	URL         string             `json:"url"`
+	ShortURL    string             `json:"short_url,omitempty"`
+	Description string             `json:"description,omitempty"`
+	// Subscription membership limits
+	ExistingMembersOnly bool `json:"existing_members_only,omitempty"`
+	MinMemberDays       int  `json:"min_member_days,omitempty"`
+	// Channel info served from memory while lookups keep failing
+	Stale bool `json:"stale,omitempty"`
+	// Display order and whether the join gate skips it
+	Position int  `json:"position"`
+	Optional bool `json:"optional,omitempty"`
+	// Sponsored bundle the requirement was attached from
+	BundleID int64 `json:"bundle_id,omitempty"`
+	// On-chain fields
+	TonMinBalanceNano int64  `json:"ton_min_balance_nano,omitempty"`
+	JettonAddress     string `json:"jetton_address,omitempty"`
+	JettonMinAmount   int64  `json:"jetton_min_amount,omitempty"`
+	// Jetton metadata enrichment
+	JettonSymbol string `json:"jetton_symbol,omitempty"`
+	JettonImage  string `json:"jetton_image,omitempty"`
+}
+
+func NewRequirement(r dg.Requirement) Requirement {
+	name := r.ChannelTitle
+	if name == "" {
+		name = r.Title
+	}
+	return Requirement{
+		Name:              name,
+		Type:              r.Type,
+		Username:          r.ChannelUsername,
+		AvatarURL:         ChannelAvatarURL(r.AvatarURL, r.ChannelID),
+		Description:       r.Description,
+		TonMinBalanceNano: r.TonMinBalanceNano,
+		JettonAddress:     r.JettonAddress,
+		JettonMinAmount:   r.JettonMinAmount,
+		URL:               RequirementURL(r),
+
+		ExistingMembersOnly: r.ExistingMembersOnly,
+		MinMemberDays:       r.MinMemberDays,
+		Stale:               r.ChannelStale,
+		Position:            r.Position,
+		Optional:            r.IsOptional(),
+		BundleID:            r.BundleID,
+	}
+}
+
+// MyChannel is a connected channel with the bot's status in it.
+type MyChannel struct {
+	channels.Channel
+	// BotStatus is the bot's getChatMember status, or "unknown" when Telegram could not be asked
+	BotStatus       string `json:"bot_status"`
+	BotIsAdmin      bool   `json:"bot_is_admin"`
+	CanCheckMembers bool   `json:"can_check_members"`
+}
+
+// ChatInfo describes the channel of a requirement in check results.
+type ChatInfo struct {
+	Title     string `json:"title"`
+	Username  string `json:"username"`
+	Type      string `json:"type"`
+	AvatarURL string `json:"avatar_url"`
+	URL       string `json:"url"`
+}
+
+// CheckResult is the outcome of checking one requirement for the current user.
+type CheckResult struct {
+	Name              string             `json:"name"`
+	Type              dg.RequirementType `json:"type"`
+	Username          string             `json:"username"`
+	Status            string             `json:"status"`
+	Error             string             `json:"error,omitempty"`
+	Warning           string             `json:"warning,omitempty"`
+	Unverifiable      bool               `json:"unverifiable,omitempty"`
+	Link              string             `json:"url,omitempty"`
+	ChatInfo          ChatInfo           `json:"chat_info"`
+	TonMinBalanceNano int64              `json:"ton_min_balance_nano,omitempty"`
+	JettonAddress     string             `json:"jetton_address,omitempty"`
+	JettonMinAmount   int64              `json:"jetton_min_amount,omitempty"`
+	JettonSymbol      string             `json:"jetton_symbol,omitempty"`
+	JettonImage       string             `json:"jetton_image,omitempty"`
+	BonusTickets      int                `json:"bonus_tickets,omitempty"`
+	// Optional requirements do not count towards all_met
+	Optional bool `json:"optional,omitempty"`
+}
+
+// NewCheckResult maps r as failed; callers fill in the check outcome.
+func NewCheckResult(r dg.Requirement) CheckResult {
+	return CheckResult{
+		Name:              r.ChannelTitle,
+		Type:              r.Type,
+		Username:          r.ChannelUsername,
+		Status:            "failed",
+		Link:              RequirementURL(r),
+		ChatInfo:          ChatInfo{Title: r.ChannelTitle, Username: r.ChannelUsername, AvatarURL: r.AvatarURL, URL: channelURL(r)},
+		TonMinBalanceNano: r.TonMinBalanceNano,
+		JettonAddress:     r.JettonAddress,
+		JettonMinAmount:   r.JettonMinAmount,
+		BonusTickets:      r.BonusTickets,
+		Unverifiable:      r.Unverifiable,
+		Optional:          r.IsOptional(),
+	}
+}
+
+// ApplyChannel fills chat info the requirement did not carry from ch.
+func (it *CheckResult) ApplyChannel(ch *channels.Channel) {
+	if ch == nil {
+		return
+	}
+	if it.ChatInfo.Title == "" {
+		it.ChatInfo.Title = ch.Title
+	}
+	if it.ChatInfo.Username == "" {
+		it.ChatInfo.Username = ch.Username
+	}
+	if it.ChatInfo.AvatarURL == "" {
+		it.ChatInfo.AvatarURL = ch.AvatarURL
+	}
+	if ch.URL != "" {
+		it.ChatInfo.URL = ch.URL
+	}
+}
+
+// RequirementURL is where users fulfil r: the boost page for boosts, else the channel link.
+func RequirementURL(r dg.Requirement) string {
+	if r.Type == dg.RequirementTypeBoost {
+		if r.ChannelUsername != "" {
+			return "https://t.me/boost/" + r.ChannelUsername
+		}
+		return "https://t.me/c/" + strings.TrimPrefix(strconv.FormatInt(r.ChannelID, 10), "-100") + "?boost"
+	}
+	return channelURL(r)
+}
+
+// channelURL prefers the stored channel URL over one built from the username.
+func channelURL(r dg.Requirement) string {
+	if r.ChannelURL != "" {
+		return r.ChannelURL
+	}
+	if r.ChannelUsername != "" {
+		return "https://t.me/" + r.ChannelUsername
+	}
+	return ""
+}
+
+// ChannelAvatarURL falls back to the avatar proxy of channelID, which serves the last known
+// avatar even when Telegram fails, so channel DTOs never carry an empty avatar URL.
+func ChannelAvatarURL(avatarURL string, channelID int64) string {
+	if avatarURL != "" || channelID == 0 {
+		return avatarURL
+	}
+	return tgutils.BuildAvatarURL(strconv.FormatInt(channelID, 10))
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"testing"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+func TestNewRequirement(t *testing.T) {
+	tests := []struct {
+		name string
+		in   dg.Requirement
+		want Requirement
+	}{
+		{
+			name: "subscription by username",
+			in: dg.Requirement{
+				Type: dg.RequirementTypeSubscription, ChannelID: -1001, ChannelUsername: "demo",
+				ChannelTitle: "Demo", AvatarURL: "https://cdn.example/a.png", MinMemberDays: 3, Position: 1,
+			},
+			want: Requirement{
+				Name: "Demo", Type: dg.RequirementTypeSubscription, Username: "demo",
+				AvatarURL: "https://cdn.example/a.png", URL: "https://t.me/demo", MinMemberDays: 3, Position: 1,
+			},
+		},
+		{
+			name: "stored channel url wins and avatar falls back to proxy",
+			in: dg.Requirement{
+				Type: dg.RequirementTypeSubscription, ChannelID: -1002, ChannelTitle: "Private",
+				ChannelURL: "https://t.me/+invite",
+			},
+			want: Requirement{
+				Name: "Private", Type: dg.RequirementTypeSubscription, URL: "https://t.me/+invite",
+				AvatarURL: tgutils.BuildAvatarURL("-1002"),
+			},
+		},
+		{
+			name: "boost by username",
+			in:   dg.Requirement{Type: dg.RequirementTypeBoost, ChannelID: -1001, ChannelUsername: "demo"},
+			want: Requirement{
+				Type: dg.RequirementTypeBoost, Username: "demo", URL: "https://t.me/boost/demo",
+				AvatarURL: tgutils.BuildAvatarURL("-1001"),
+			},
+		},
+		{
+			name: "boost by id",
+			in:   dg.Requirement{Type: dg.RequirementTypeBoost, ChannelID: -1001234},
+			want: Requirement{
+				Type: dg.RequirementTypeBoost, URL: "https://t.me/c/1234?boost",
+				AvatarURL: tgutils.BuildAvatarURL("-1001234"),
+			},
+		},
+		{
+			name: "custom title and bonus task",
+			in:   dg.Requirement{Type: dg.RequirementTypeCustom, Title: "Follow us", Description: "On X", BonusTickets: 2},
+			want: Requirement{Name: "Follow us", Type: dg.RequirementTypeCustom, Description: "On X", Optional: true},
+		},
+		{
+			name: "holdjetton",
+			in:   dg.Requirement{Type: dg.RequirementTypeHoldJetton, JettonAddress: "EQjetton", JettonMinAmount: 10},
+			want: Requirement{Type: dg.RequirementTypeHoldJetton, JettonAddress: "EQjetton", JettonMinAmount: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRequirement(tt.in)
+			if got != tt.want {
+				t.Fatalf("got  %+v\nwant %+v", got, tt.want)
+			}
+			back, obj := roundTrip(t, got)
+			if back != got {
+				t.Fatalf("decoded %+v, want %+v", back, got)
+			}
+			assertKeys(t, obj, []string{"type", "url", "position"}, []string{"channel_id", "bonus_tickets"})
+		})
+	}
+}
+
+func TestNewCheckResult(t *testing.T) {
+	r := dg.Requirement{
+		Type: dg.RequirementTypeSubscription, ChannelUsername: "demo", ChannelTitle: "Demo",
+		BonusTickets: 1, Unverifiable: true,
+	}
+	got := NewCheckResult(r)
+	if got.Status != "failed" || !got.Optional || !got.Unverifiable || got.BonusTickets != 1 {
+		t.Fatalf("unexpected check result %+v", got)
+	}
+	if got.Link != "https://t.me/demo" || got.ChatInfo.URL != "https://t.me/demo" {
+		t.Fatalf("links = %q and %q", got.Link, got.ChatInfo.URL)
+	}
+	back, obj := roundTrip(t, got)
+	if back != got {
+		t.Fatalf("decoded %+v, want %+v", back, got)
+	}
+	assertKeys(t, obj, []string{"name", "status", "chat_info", "url"}, []string{"error", "warning"})
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CreateGiveawayResponse is returned by POST /giveaways.
+type CreateGiveawayResponse struct {
+	ID string `json:"id"`
+	// MsgID is the prepared inline message to share the giveaway with, if one was cached
+	MsgID        string          `json:"msg_id"`
+	Funding      *dg.Funding     `json:"funding,omitempty"`
+	Approval     *dg.Approval    `json:"approval,omitempty"`
+	DuplicateOf  string          `json:"duplicate_of,omitempty"`
+	PrizeSummary dg.PrizeSummary `json:"prize_summary"`
+	// RequirementHints warn about requirements many users fail across giveaways
+	RequirementHints []dg.RequirementHint `json:"requirement_hints,omitempty"`
+}
+
+// PublishGiveawayResponse is returned by POST /giveaways/:id/publish.
+type PublishGiveawayResponse struct {
+	ID        string            `json:"id"`
+	Status    dg.GiveawayStatus `json:"status"`
+	StartedAt time.Time         `json:"started_at"`
+	EndsAt    time.Time         `json:"ends_at"`
+	Funding   *dg.Funding       `json:"funding,omitempty"`
+	Approval  *dg.Approval      `json:"approval,omitempty"`
+}
+
+// ImportGiveawayResponse is returned by POST /giveaways/import once the giveaway is created.
+type ImportGiveawayResponse struct {
+	CreateGiveawayResponse
+	// Channels and fields that could not be imported
+	ImportWarnings []string `json:"import_warnings"`
+}
+
+// CheckRequirementsResponse is the outcome of every requirement for the current user.
+type CheckRequirementsResponse struct {
+	GiveawayID string        `json:"giveaway_id"`
+	Results    []CheckResult `json:"results"`
+	// AllMet is false while a required item fails; optional ones never clear it
+	AllMet  bool `json:"all_met"`
+	Tickets int  `json:"tickets"`
+	// UnderReview explains why a giveaway that may not complete is being reviewed
+	UnderReview dg.DeadReason `json:"under_review,omitempty"`
+}
+
+// WinnerResults lists winners with their prizes.
+type WinnerResults struct {
+	Results []WinnerResult `json:"results"`
+}
+
+// WinsResponse is the win history of the current user.
+type WinsResponse struct {
+	Wins []dg.Win `json:"wins"`
+}
+
+// TasksResponse lists the bonus tasks of a giveaway with the tickets earned so far.
+type TasksResponse struct {
+	Tasks   []dg.Task `json:"tasks"`
+	Tickets int       `json:"tickets"`
+}
+
+// TaskClaimResponse is the claimed task and the tickets after the claim.
+type TaskClaimResponse struct {
+	Task    *dg.Task `json:"task"`
+	Tickets int      `json:"tickets"`
+}
+
+// StatusBatchResponse holds one summary per requested giveaway that exists.
+type StatusBatchResponse struct {
+	Items []dg.StatusSummary `json:"items"`
+}
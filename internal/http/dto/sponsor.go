@@ -0,0 +1,34 @@
+package dto
+
+import dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+
+// Sponsor is a sponsor channel; URL is always present, possibly empty.
+type Sponsor struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+	URL       string `json:"url"`
+	ShortURL  string `json:"short_url,omitempty"`
+	Title     string `json:"title,omitempty"`
+}
+
+func NewSponsor(s dg.ChannelInfo) Sponsor {
+	url := s.URL
+	if url == "" && s.Username != "" {
+		url = "https://t.me/" + s.Username
+	}
+	return Sponsor{
+		ID:        s.ID,
+		Username:  s.Username,
+		AvatarURL: ChannelAvatarURL(s.AvatarURL, s.ID),
+		URL:       url,
+		Title:     s.Title,
+	}
+}
+
+// SponsorOverrideRequest replaces how a sponsor is displayed. An omitted field keeps its
+// current override and an empty one restores the Telegram data.
+type SponsorOverrideRequest struct {
+	Title     *string `json:"title"`
+	AvatarURL *string `json:"avatar_url"`
+}
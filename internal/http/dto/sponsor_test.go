@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"testing"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+func TestNewSponsor(t *testing.T) {
+	tests := []struct {
+		name string
+		in   dg.ChannelInfo
+		want Sponsor
+	}{
+		{
+			name: "url built from username",
+			in:   dg.ChannelInfo{ID: -1001, Username: "demo", Title: "Demo", AvatarURL: "https://cdn.example/a.png"},
+			want: Sponsor{ID: -1001, Username: "demo", Title: "Demo", AvatarURL: "https://cdn.example/a.png", URL: "https://t.me/demo"},
+		},
+		{
+			name: "stored url kept",
+			in:   dg.ChannelInfo{ID: -1002, Username: "demo", URL: "https://t.me/+invite"},
+			want: Sponsor{ID: -1002, Username: "demo", URL: "https://t.me/+invite", AvatarURL: tgutils.BuildAvatarURL("-1002")},
+		},
+		{
+			name: "private channel without url",
+			in:   dg.ChannelInfo{ID: -1003, Title: "Private"},
+			want: Sponsor{ID: -1003, Title: "Private", AvatarURL: tgutils.BuildAvatarURL("-1003")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSponsor(tt.in)
+			if got != tt.want {
+				t.Fatalf("got  %+v\nwant %+v", got, tt.want)
+			}
+			back, obj := roundTrip(t, got)
+			if back != got {
+				t.Fatalf("decoded %+v, want %+v", back, got)
+			}
+			// url is always present so clients can rely on it
+			assertKeys(t, obj, []string{"id", "url"}, []string{"short_url"})
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// Package dto holds the JSON shapes returned by the HTTP handlers and the mappers that build
+// them from domain models, so every endpoint renders giveaways, requirements, sponsors,
+// winners and users the same way.
+package dto
+
+import (
+	"strconv"
+	"strings"
+
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+// UserSummary is the public face of a user in winner and candidate lists.
+type UserSummary struct {
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// NewUserSummary maps u, which may be nil when the user is unknown. The name falls back to
+// the id and the avatar to the Telegram avatar proxy.
+func NewUserSummary(userID int64, u *du.User) UserSummary {
+	out := UserSummary{UserID: userID}
+	if u != nil {
+		out.Username = u.Username
+		out.Name = strings.TrimSpace(u.FirstName + " " + u.LastName)
+		out.AvatarURL = u.AvatarURL
+	}
+	if out.Name == "" {
+		out.Name = strconv.FormatInt(userID, 10)
+	}
+	if out.AvatarURL == "" {
+		out.AvatarURL = tgutils.BuildAvatarURL(strconv.FormatInt(userID, 10))
+	}
+	return out
+}
+
+// Candidate is a user picked for manual winners, with the token kind it was matched by
+// ("username" or "id").
+type Candidate struct {
+	UserID    int64  `json:"user_id"`
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	Source    string `json:"source"`
+}
+
+func NewCandidate(u *du.User, source string) Candidate {
+	s := NewUserSummary(u.ID, u)
+	return Candidate{UserID: s.UserID, Username: s.Username, Name: s.Name, AvatarURL: s.AvatarURL, Source: source}
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"testing"
+
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+func TestNewUserSummary(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int64
+		user *du.User
+		want UserSummary
+	}{
+		{
+			name: "full user",
+			id:   7,
+			user: &du.User{ID: 7, Username: "alice", FirstName: "Alice", LastName: "Smith", AvatarURL: "https://cdn.example/u.png"},
+			want: UserSummary{UserID: 7, Username: "alice", Name: "Alice Smith", AvatarURL: "https://cdn.example/u.png"},
+		},
+		{
+			name: "first name only and proxied avatar",
+			id:   8,
+			user: &du.User{ID: 8, FirstName: "Bob"},
+			want: UserSummary{UserID: 8, Name: "Bob", AvatarURL: tgutils.BuildAvatarURL("8")},
+		},
+		{
+			name: "unknown user falls back to id",
+			id:   9,
+			want: UserSummary{UserID: 9, Name: "9", AvatarURL: tgutils.BuildAvatarURL("9")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewUserSummary(tt.id, tt.user)
+			if got != tt.want {
+				t.Fatalf("got  %+v\nwant %+v", got, tt.want)
+			}
+			back, obj := roundTrip(t, got)
+			if back != got {
+				t.Fatalf("decoded %+v, want %+v", back, got)
+			}
+			assertKeys(t, obj, []string{"user_id", "username", "name", "avatar_url"}, nil)
+		})
+	}
+}
+
+func TestNewCandidate(t *testing.T) {
+	got := NewCandidate(&du.User{ID: 5, Username: "carol", FirstName: "Carol"}, "username")
+	want := Candidate{UserID: 5, Username: "carol", Name: "Carol", AvatarURL: tgutils.BuildAvatarURL("5"), Source: "username"}
+	if got != want {
+		t.Fatalf("got  %+v\nwant %+v", got, want)
+	}
+	back, _ := roundTrip(t, got)
+	if back != got {
+		t.Fatalf("decoded %+v, want %+v", back, got)
+	}
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+)
+
+// Winner is a winner within a giveaway view.
+type Winner struct {
+	UserID    int64            `json:"user_id"`
+	Username  string           `json:"username,omitempty"`
+	Name      string           `json:"name"`
+	AvatarURL string           `json:"avatar_url,omitempty"`
+	Place     int              `json:"place"`
+	Prizes    []dg.WinnerPrize `json:"prizes"`
+	// TotalUnits sums prize units won by the user
+	TotalUnits int `json:"total_units"`
+}
+
+// NewWinner maps w with its user, which may be nil.
+func NewWinner(w dg.Winner, u *du.User) Winner {
+	s := NewUserSummary(w.UserID, u)
+	return Winner{
+		UserID:     w.UserID,
+		Username:   s.Username,
+		Name:       s.Name,
+		AvatarURL:  s.AvatarURL,
+		Place:      w.Place,
+		Prizes:     w.Prizes,
+		TotalUnits: w.TotalUnits,
+	}
+}
+
+// WinnerResult is a winner in winner lists and manual winner previews.
+type WinnerResult struct {
+	UserID    int64            `json:"user_id"`
+	Username  string           `json:"username"`
+	Name      string           `json:"name"`
+	AvatarURL string           `json:"avatar_url"`
+	Source    string           `json:"source"`
+	Place     int              `json:"place"`
+	Prizes    []dg.WinnerPrize `json:"prizes"`
+	// TotalUnits sums prize units won by the user
+	TotalUnits int `json:"total_units"`
+}
+
+// NewWinnerResult joins a listed user with the prizes of their winner row.
+func NewWinnerResult(s UserSummary, source string, w dg.Winner) WinnerResult {
+	return WinnerResult{
+		UserID:     s.UserID,
+		Username:   s.Username,
+		Name:       s.Name,
+		AvatarURL:  s.AvatarURL,
+		Source:     source,
+		Place:      w.Place,
+		Prizes:     w.Prizes,
+		TotalUnits: w.TotalUnits,
+	}
+}
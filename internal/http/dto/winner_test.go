@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"reflect"
+	"testing"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+)
+
+func TestNewWinner(t *testing.T) {
+	prizes := []dg.WinnerPrize{{Title: "Gift", Quantity: 1}, {Title: "Sticker", Quantity: 2, Package: "Pack"}}
+	tests := []struct {
+		name string
+		w    dg.Winner
+		user *du.User
+		want Winner
+	}{
+		{
+			name: "known user",
+			w:    dg.Winner{Place: 1, UserID: 7, Prizes: prizes, TotalUnits: 3, Source: dg.ParticipantSourceInvite},
+			user: &du.User{ID: 7, Username: "alice", FirstName: "Alice", AvatarURL: "https://cdn.example/u.png"},
+			want: Winner{UserID: 7, Username: "alice", Name: "Alice", AvatarURL: "https://cdn.example/u.png", Place: 1, Prizes: prizes, TotalUnits: 3},
+		},
+		{
+			name: "unknown user",
+			w:    dg.Winner{Place: 2, UserID: 9},
+			want: Winner{UserID: 9, Name: "9", AvatarURL: tgutils.BuildAvatarURL("9"), Place: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewWinner(tt.w, tt.user)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got  %+v\nwant %+v", got, tt.want)
+			}
+			back, obj := roundTrip(t, got)
+			if !reflect.DeepEqual(back, got) {
+				t.Fatalf("decoded %+v, want %+v", back, got)
+			}
+			// The winner view carries no join source or delivery contact
+			assertKeys(t, obj, []string{"user_id", "name", "place", "prizes", "total_units"}, []string{"source", "contact"})
+		})
+	}
+}
+
+func TestNewWinnerResult(t *testing.T) {
+	prizes := []dg.WinnerPrize{{Title: "Gift", Quantity: 1}}
+	s := UserSummary{UserID: 7, Username: "alice", Name: "Alice", AvatarURL: "https://cdn.example/u.png"}
+	tests := []struct {
+		name   string
+		source string
+		w      dg.Winner
+		want   WinnerResult
+	}{
+		{
+			name:   "with prizes",
+			source: "id",
+			w:      dg.Winner{Place: 1, UserID: 7, Prizes: prizes, TotalUnits: 1},
+			want:   WinnerResult{UserID: 7, Username: "alice", Name: "Alice", AvatarURL: "https://cdn.example/u.png", Source: "id", Place: 1, Prizes: prizes, TotalUnits: 1},
+		},
+		{
+			// Manual previews join listed users whose winner row was dropped
+			name:   "missing winner row",
+			source: "username",
+			want:   WinnerResult{UserID: 7, Username: "alice", Name: "Alice", AvatarURL: "https://cdn.example/u.png", Source: "username"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewWinnerResult(s, tt.source, tt.w)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got  %+v\nwant %+v", got, tt.want)
+			}
+			_, obj := roundTrip(t, got)
+			assertKeys(t, obj, []string{"user_id", "username", "source", "place", "prizes", "total_units"}, nil)
+
+			list, _ := roundTrip(t, WinnerResults{Results: []WinnerResult{got}})
+			if len(list.Results) != 1 || list.Results[0].UserID != got.UserID {
+				t.Fatalf("decoded list %+v", list)
+			}
+		})
+	}
+}
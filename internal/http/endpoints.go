@@ -0,0 +1,55 @@
+package http
+
+import (
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/utils/openapi"
+)
+
+var (
+	pageParams = []openapi.Param{
+		{Name: "limit", In: "query", Type: "integer", Description: "page size, 1-100"},
+		{Name: "offset", In: "query", Type: "integer", Description: "items to skip, up to 100000"},
+	}
+	taskParams = []openapi.Param{{Name: "task_id", In: "path", Type: "integer"}}
+)
+
+// endpoints lists the routes the mini app client is generated from, with the typed bodies
+// their handlers encode. Update it together with the handler when one of these changes.
+var endpoints = []openapi.Endpoint{
+	// Users
+	{Method: "GET", Path: "/users/me", Tag: "users", OperationID: "getMe", Summary: "Current user, stored from init-data", Response: dto.Me{}},
+	{Method: "PATCH", Path: "/me/settings", Tag: "users", OperationID: "updateSettings", Summary: "Change app settings", Request: dto.SettingsRequest{}, Response: dto.Settings{}},
+	{Method: "GET", Path: "/users/:id/public", Tag: "users", OperationID: "getPublicProfile", Summary: "Profile card honoring privacy settings", Params: []openapi.Param{{Name: "id", In: "path", Type: "integer"}}, Response: du.PublicProfile{}},
+	{Method: "GET", Path: "/me/wins", Tag: "users", OperationID: "listMyWins", Summary: "Win history of the current user", Params: pageParams, Response: dto.WinsResponse{}},
+	{Method: "GET", Path: "/channels/me", Tag: "channels", OperationID: "listMyChannels", Summary: "Connected channels with the bot status",
+		Params:   []openapi.Param{{Name: "query", In: "query", Description: "filter on title or username"}, {Name: "limit", In: "query", Type: "integer", Description: "1-50"}},
+		Response: []dto.MyChannel{}},
+	// Giveaways
+	{Method: "POST", Path: "/giveaways", Tag: "giveaways", OperationID: "createGiveaway", Summary: "Create a giveaway", Request: dto.CreateGiveawayRequest{}, Response: dto.CreateGiveawayResponse{}, Status: 201},
+	{Method: "GET", Path: "/giveaways", Tag: "giveaways", OperationID: "listActiveGiveaways", Summary: "Active giveaways",
+		Params:   append([]openapi.Param{{Name: "min_participants", In: "query", Type: "integer"}}, pageParams...),
+		Response: []dg.Giveaway{}},
+	{Method: "GET", Path: "/giveaways/:id", Tag: "giveaways", OperationID: "getGiveaway", Summary: "Giveaway with the caller's role", Response: dto.Giveaway{}},
+	{Method: "GET", Path: "/giveaways/me/all", Tag: "giveaways", OperationID: "listMyGiveaways", Summary: "Giveaways created by the current user", Params: pageParams, Response: []dg.Giveaway{}},
+	{Method: "GET", Path: "/giveaways/me/participating", Tag: "giveaways", OperationID: "listParticipating", Summary: "Joined giveaways without results yet", Params: pageParams, Response: []dg.Participation{}},
+	{Method: "GET", Path: "/giveaways/me/participated/finished", Tag: "giveaways", OperationID: "listParticipatedFinished", Summary: "Joined giveaways that finished", Params: pageParams, Response: []dg.Participation{}},
+	{Method: "POST", Path: "/giveaways/status-batch", Tag: "giveaways", OperationID: "statusBatch", Summary: "Status of up to 100 giveaways", Request: dto.StatusBatchRequest{}, Response: dto.StatusBatchResponse{}},
+	{Method: "GET", Path: "/giveaways/:id/check-requirements", Tag: "giveaways", OperationID: "checkRequirements", Summary: "Check every requirement for the current user", Response: dto.CheckRequirementsResponse{}},
+	{Method: "POST", Path: "/giveaways/:id/join", Tag: "giveaways", OperationID: "joinGiveaway", Summary: "Join once the required items are met", Request: dto.JoinRequest{}, OptionalBody: true},
+	{Method: "GET", Path: "/giveaways/:id/tasks", Tag: "giveaways", OperationID: "listTasks", Summary: "Bonus tasks and tickets earned", Response: dto.TasksResponse{}},
+	{Method: "POST", Path: "/giveaways/:id/tasks/:task_id/claim", Tag: "giveaways", OperationID: "claimTask", Summary: "Claim a completed bonus task", Params: taskParams, Response: dto.TaskClaimResponse{}},
+	{Method: "GET", Path: "/giveaways/:id/list-loaded-winners", Tag: "giveaways", OperationID: "listWinners", Summary: "Winners with their prizes", Response: dto.WinnerResults{}},
+}
+
+// APISpec describes the typed part of the API for client generation; see cmd/openapi.
+func APISpec() openapi.Spec {
+	return openapi.Spec{
+		Title:     "Giveaway Tool API",
+		Version:   dto.Version,
+		Server:    "/api/v1",
+		Endpoints: endpoints,
+		Error:     dto.Error{},
+	}
+}
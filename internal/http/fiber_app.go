@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"database/sql"
+	"log"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -10,27 +11,55 @@ import (
 
 	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
 	"github.com/open-builders/giveaway-backend/internal/config"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
 	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
 	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
 	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/service/analytics"
+	"github.com/open-builders/giveaway-backend/internal/service/audit"
+	bundlesvc "github.com/open-builders/giveaway-backend/internal/service/bundles"
+	campaignsvc "github.com/open-builders/giveaway-backend/internal/service/campaign"
 	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/service/compliance"
+	"github.com/open-builders/giveaway-backend/internal/service/crm"
+	"github.com/open-builders/giveaway-backend/internal/service/exports"
 	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/housekeeping"
+	"github.com/open-builders/giveaway-backend/internal/service/integrations"
+	"github.com/open-builders/giveaway-backend/internal/service/joinconfirm"
+	ledgersvc "github.com/open-builders/giveaway-backend/internal/service/ledger"
+	"github.com/open-builders/giveaway-backend/internal/service/moderation"
 	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	schedulesvc "github.com/open-builders/giveaway-backend/internal/service/schedule"
+	"github.com/open-builders/giveaway-backend/internal/service/shortlink"
+	statssvc "github.com/open-builders/giveaway-backend/internal/service/stats"
+	supportsvc "github.com/open-builders/giveaway-backend/internal/service/support"
 	"github.com/open-builders/giveaway-backend/internal/service/telegram"
+	"github.com/open-builders/giveaway-backend/internal/service/templates"
 	"github.com/open-builders/giveaway-backend/internal/service/tonbalance"
 	"github.com/open-builders/giveaway-backend/internal/service/tonproof"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/importer"
 )
 
 // NewFiberApp builds a Fiber application with routes and middlewares wired.
-func NewFiberApp(pg *sql.DB, rdb *redisp.Client, cfg *config.Config) *fiber.App {
-	app := fiber.New()
+// files may be nil when file storage is disabled.
+func NewFiberApp(pg *sql.DB, rdb *redisp.Client, shards *redisp.Shards, files storage.Storage, cfg *config.Config) *fiber.App {
+	// Behind a proxy, client IPs (used for join fingerprints) come from ProxyHeader
+	// StreamRequestBody lets bulk imports read bodies over the default limit as a stream;
+	// multipart forms are parsed on use, after bodyLimit checked their size
+	app := fiber.New(fiber.Config{ProxyHeader: cfg.ProxyHeader, EnableIPValidation: true, StreamRequestBody: true, DisablePreParseMultipartForm: true})
+
+	// Only participant imports may stream; every other body has a known, bounded length
+	app.Use(bodyLimit)
 
 	// CORS for frontends
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: cfg.CORSAllowedOrigins,
-		AllowHeaders: "Origin, Content-Type, Accept, Authorization, X-Telegram-Init-Data",
-		AllowMethods: "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		AllowOrigins:  cfg.CORSAllowedOrigins,
+		AllowHeaders:  "Origin, Content-Type, Accept, Authorization, X-Telegram-Init-Data, If-Match",
+		AllowMethods:  "GET,POST,PUT,PATCH,DELETE,OPTIONS",
+		ExposeHeaders: "ETag",
 	}))
 
 	// Liveness probe: process is up and Fiber is serving
@@ -59,6 +88,15 @@ func NewFiberApp(pg *sql.DB, rdb *redisp.Client, cfg *config.Config) *fiber.App
 		} else {
 			deps["redis"] = fiber.Map{"ok": true}
 		}
+		// Shards only hold caches and counters, so a missing one is reported but keeps the
+		// instance ready
+		if shards.Len() > 1 {
+			if down := shards.Down(ctx); len(down) > 0 {
+				deps["redis_shards"] = fiber.Map{"ok": false, "down": down}
+			} else {
+				deps["redis_shards"] = fiber.Map{"ok": true}
+			}
+		}
 
 		status := fiber.StatusOK
 		if !ready {
@@ -71,7 +109,7 @@ func NewFiberApp(pg *sql.DB, rdb *redisp.Client, cfg *config.Config) *fiber.App
 	repo := pgrepo.NewUserRepository(pg)
 	cache := rcache.NewUserCache(rdb, 5*time.Second)
 	us := usersvc.NewService(repo, cache)
-	chs := channels.NewService(rdb)
+	chs := channels.NewService(rdb).WithAvatarStore(pgrepo.NewChannelAvatarRepository(pg))
 	uh := NewUserHandlersFiber(us, chs)
 	// TON Proof service (local verification). Handlers require Telegram init-data auth.
 	tps := tonproof.NewService(rdb, cfg.TonProofDomain, cfg.TonProofPayloadTTLSec)
@@ -80,42 +118,153 @@ func NewFiberApp(pg *sql.DB, rdb *redisp.Client, cfg *config.Config) *fiber.App
 	// Giveaway domain deps
 	gRepo := pgrepo.NewGiveawayRepository(pg)
 	tgClient := telegram.NewClientFromEnv()
+	chs.WithStore(pgrepo.NewChannelRepository(pg), tgClient)
 	// Prime bot info in Redis on startup (best-effort)
 	{
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		_ = tgClient.SetBotMe(ctx, rdb)
 		cancel()
 	}
-	notifier := notify.NewService(tgClient, chs, cfg.WebAppBaseURL, rdb, us)
+	notifier := notify.NewService(tgClient, chs, cfg.WebAppBaseURL, rdb, us).WithChannelPosts(pgrepo.NewDeletionRepository(pg))
 	// TON balance via TonAPI
 	tbs := tonbalance.NewService(cfg.TonAPIBaseURL, cfg.TonAPIToken).WithCache(rdb, 0)
-	gs := gsvc.NewService(gRepo, chs).WithTelegram(tgClient).WithNotifier(notifier).WithRedis(rdb).WithUser(us).WithTonBalance(tbs)
-	gh := NewGiveawayHandlersFiber(gs, chs, tgClient, us, tbs, rdb)
+	gs := gsvc.NewService(gRepo, chs).WithTelegram(tgClient).WithNotifier(notifier).WithRedis(rdb).WithShards(shards).WithUser(us).WithTonBalance(tbs).
+		WithWinsCache(rcache.NewWinsCache(rdb, 2*time.Minute)).WithEscrow(cfg.EscrowWalletAddress).
+		WithUnverifiablePolicy(cfg.UnverifiableRequirements == "pause").WithReputation(cfg.ReputationApprovalThreshold).
+		WithCompletionSLA(time.Duration(cfg.CompletionSLASec)*time.Second, cfg.TelegramAdminID).
+		WithBadges(dg.BadgeThresholds{EndingSoon: time.Duration(cfg.EndingSoonSec) * time.Second, JustStarted: time.Duration(cfg.JustStartedSec) * time.Second}).
+		WithFinishConfirmation(cfg.FinishConfirmMinParticipants, time.Duration(cfg.FinishPreviewLeadSec)*time.Second)
+	// Join fingerprints are keyed by their own secret or the bot token
+	fingerprintSecret := cfg.FingerprintSecret
+	if fingerprintSecret == "" {
+		fingerprintSecret = cfg.TelegramBotToken
+	}
+	gs.WithFingerprintSecret(fingerprintSecret)
+	// Content filter for giveaway texts; a broken rules file falls back to the built-in lists
+	rules, err := moderation.LoadRules(cfg.ModerationRulesFile)
+	if err != nil {
+		log.Printf("moderation rules: %v; using defaults", err)
+		rules = moderation.DefaultRules
+	}
+	if filter, err := moderation.NewFilter(rules); err == nil {
+		gs.WithModeration(filter)
+	} else {
+		log.Printf("moderation disabled: %v", err)
+	}
+	links := shortlink.NewService(pgrepo.NewShortLinkRepository(pg), gRepo, cfg.ShortLinkBaseURL)
+	if cfg.AnalyticsSink != "" {
+		rec := analytics.NewRecorder(rdb)
+		gs.WithAnalytics(rec)
+		links.WithAnalytics(rec)
+	}
+	bundles := bundlesvc.NewService(pgrepo.NewBundleRepository(pg), chs)
+	joins := joinconfirm.NewService(pgrepo.NewJoinConfirmationRepository(pg), gRepo, notifier)
+	gh := NewGiveawayHandlersFiber(gs, chs, tgClient, us, tbs, rdb).WithShortLinks(links).WithBundles(bundles).WithJoinConfirmations(joins)
+	gh.WithTemplates(templates.NewService(pgrepo.NewTemplateRepository(pg)))
+	// Compliance gate for restricted prizes; off unless enabled
+	var cmh *ComplianceHandlers
+	if cfg.ComplianceGateEnabled {
+		cs := compliance.NewService(pgrepo.NewComplianceRepository(pg), gRepo)
+		gh.WithCompliance(cs)
+		cmh = NewComplianceHandlers(cs, us)
+	}
+	lh := NewShortLinkHandlers(links)
+	// Import formats; a broken mappers file leaves only the built-in ones
+	if mappers, err := importer.LoadMappers(cfg.ImportMappersFile); err == nil {
+		gh.WithImportMappers(mappers)
+	} else {
+		log.Printf("import mappers: %v; using built-in formats", err)
+	}
+	if signer, err := audit.NewSigner(cfg.AuditSigningKey); err == nil {
+		gh.WithAuditSigner(signer)
+	} else {
+		log.Printf("audit bundles disabled: %v", err)
+	}
+	// Exports, covers and avatars go through file storage; responses fall back to inline bodies without it
+	if files != nil {
+		gs.WithCovers(files)
+		notifier.WithCovers(files)
+		gh.WithStorage(files).WithExports(exports.NewService(pgrepo.NewExportJobRepository(pg), gRepo, us, files), cfg.ExportSyncMaxWinners)
+	}
+	sh := NewScheduleHandlers(schedulesvc.NewService(pgrepo.NewScheduleRepository(pg), gRepo, notifier))
+	sth := NewStatsHandlers(statssvc.NewService(gRepo, rdb, time.Duration(cfg.StatsIntervalSec)*3*time.Second).WithShards(shards), us)
+	fh := NewFundingHandlers(gs, us, cfg.TonWebhookSecret)
+	mh := NewModerationHandlers(gs, us)
+	rh := NewReputationHandlers(gs, us)
+	slh := NewSLAHandlers(gs, us)
+	pih := NewParticipantImportHandlers(gs, us)
+	hkh := NewHousekeepingHandlers(housekeeping.NewService(rdb, gRepo), us)
+	rdh := NewRedisHandlers(shards, us)
+	cph := NewCampaignHandlers(campaignsvc.NewService(pgrepo.NewCampaignRepository(pg), gRepo))
+	crh := NewCRMWebhookHandlers(crm.NewService(pgrepo.NewCRMWebhookRepository(pg)))
+	intSvc := integrations.NewService(pgrepo.NewIntegrationRepository(pg))
+	ih := NewIntegrationHandlers(intSvc)
+	ldh := NewLedgerHandlers(ledgersvc.NewService(pgrepo.NewLedgerRepository(pg), gRepo), us)
+	bh := NewBundleHandlers(bundles, us)
+	suh := NewSupportHandlers(supportsvc.NewService(pgrepo.NewSupportRepository(pg), gRepo, notifier), us)
 
 	// API groups
 	ttl := time.Duration(cfg.InitDataTTL) * time.Second
 	api := app.Group("/api")
-	v1 := api.Group("/v1", mw.InitDataMiddleware(cfg.TelegramBotToken, ttl))
+	v1 := api.Group("/v1", mw.InitDataMiddleware(cfg.TelegramBotToken, ttl), mw.PreferredLanguageMiddleware(us.PreferredLanguage))
 
 	// Protected endpoints (require InitData middleware)
 	uh.RegisterFiber(v1)
 	gh.RegisterFiber(v1)
+	sh.RegisterFiber(v1)
 	tph.RegisterFiber(v1)
+	lh.RegisterFiber(v1)
+	sth.RegisterFiber(v1)
+	fh.RegisterFiber(v1)
+	mh.RegisterFiber(v1)
+	rh.RegisterFiber(v1)
+	cph.RegisterFiber(v1)
+	hkh.RegisterFiber(v1)
+	rdh.RegisterFiber(v1)
+	slh.RegisterFiber(v1)
+	pih.RegisterFiber(v1)
+	crh.RegisterFiber(v1)
+	ih.RegisterFiber(v1)
+	ldh.RegisterFiber(v1)
+	suh.RegisterFiber(v1)
+	bh.RegisterFiber(v1)
+	if cmh != nil {
+		cmh.RegisterFiber(v1)
+	}
 
 	// Channel handlers - split between protected and public
 	avatarCache := rcache.NewChannelAvatarCache(rdb, 24*time.Hour)
-	// Short-lived cache for getChat photo identifiers to reduce Telegram calls
-	photoCache := rcache.NewChannelPhotoCache(rdb, 10*time.Minute)
-	ch := NewChannelHandlers(tgClient, avatarCache, photoCache)
+	// Cache for getChat photo identifiers to reduce Telegram calls; entries older than 10 minutes
+	// are still served while they are refreshed in the background
+	photoCache := rcache.NewChannelPhotoCache(rdb, 10*time.Minute).WithStaleFor(7 * 24 * time.Hour)
+	// Chat-info lookups stop calling Telegram for chats that keep failing and serve stale info
+	chatInfo := telegram.NewChatInfoBreaker(tgClient)
+	ch := NewChannelHandlers(tgClient, avatarCache, photoCache).WithChatInfo(chatInfo)
+	ch.WithUserChannels(chs, rcache.NewChannelBotStatusCache(rdb, 10*time.Minute))
+	if files != nil {
+		ch.WithStorage(files)
+	}
 	ch.RegisterFiber(v1) // Protected: info, membership, boost
 
-	rq := NewRequirementsHandlers(tgClient, us, tbs, chs)
+	rq := NewRequirementsHandlers(tgClient, us, tbs, chs).WithChatInfo(chatInfo)
 	rq.RegisterFiber(v1)
 
 	// Public endpoints (no init-data required)
 	v1public := api.Group("/public")
-	ch.RegisterPublicFiber(v1public) // Public: avatar only
-	gh.RegisterPublicFiber(v1public) // Public: giveaways export by token
+	ch.RegisterPublicFiber(v1public)  // Public: avatar only
+	gh.RegisterPublicFiber(v1public)  // Public: giveaways export by token
+	sth.RegisterPublicFiber(v1public) // Public: platform stats
+	fh.RegisterPublicFiber(v1public)  // Public: TonAPI deposit webhook
+	cph.RegisterPublicFiber(v1public) // Public: campaign share page
+	if local, ok := files.(*storage.Local); ok {
+		NewFileHandlers(local).RegisterPublicFiber(v1public) // Public: signed local files
+	}
+
+	// Polling triggers for no-code platforms, authenticated by creator API keys
+	ih.RegisterAPIKeyFiber(api.Group("/integrations", mw.APIKeyMiddleware(intSvc.Authenticate)))
+
+	// Short link redirects live at the root so links stay short
+	lh.RegisterRedirect(app)
 
 	return app
 }
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+)
+
+// FileHandlers serves objects of the local storage backend through signed links.
+// S3 and GCS links point at the bucket directly and never reach the API.
+type FileHandlers struct {
+	local *storage.Local
+}
+
+func NewFileHandlers(local *storage.Local) *FileHandlers {
+	return &FileHandlers{local: local}
+}
+
+// RegisterPublicFiber registers the signed download route (no init-data auth).
+func (h *FileHandlers) RegisterPublicFiber(r fiber.Router) {
+	r.Get("/files/*", h.download)
+}
+
+func (h *FileHandlers) download(c *fiber.Ctx) error {
+	path, opts, err := h.local.Resolve(c.Params("*"), c.Query("expires"), c.Query("sig"))
+	if errors.Is(err, storage.ErrNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	}
+	if opts.ContentDisposition != "" {
+		c.Set(fiber.HeaderContentDisposition, opts.ContentDisposition)
+	}
+	if opts.CacheControl != "" {
+		c.Set(fiber.HeaderCacheControl, opts.CacheControl)
+	}
+	if err := c.SendFile(path); err != nil {
+		return err
+	}
+	if opts.ContentType != "" {
+		c.Set(fiber.HeaderContentType, opts.ContentType)
+	}
+	return nil
+}
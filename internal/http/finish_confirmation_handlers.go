@@ -0,0 +1,89 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+// finishConfirmationError maps finish confirmation service errors to HTTP statuses.
+func finishConfirmationError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case "no confirmation awaiting", "co-admin confirmation required", "awaiting confirmation":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// finishConfirmation returns the finish preview of a giveaway. Access: creator and sponsor channel admins.
+func (h *GiveawayHandlersFiber) finishConfirmation(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	fc, err := h.service.FinishConfirmation(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		return finishConfirmationError(c, err)
+	}
+	return c.JSON(fc)
+}
+
+// confirmFinish confirms the awaiting finish preview.
+func (h *GiveawayHandlersFiber) confirmFinish(c *fiber.Ctx) error {
+	return h.decideFinish(c, true)
+}
+
+// abortFinish aborts the awaiting finish preview; the giveaway stays pending.
+func (h *GiveawayHandlersFiber) abortFinish(c *fiber.Ctx) error {
+	return h.decideFinish(c, false)
+}
+
+func (h *GiveawayHandlersFiber) decideFinish(c *fiber.Ctx, confirm bool) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	fc, err := h.service.DecideFinish(c.Context(), c.Params("id"), userID, confirm)
+	if err != nil {
+		return finishConfirmationError(c, err)
+	}
+	return c.JSON(fc)
+}
+
+type finishNowReq struct {
+	ConfirmToken string `json:"confirm_token"`
+}
+
+// finishNow ends an active giveaway early. The first call answers 202 with a confirm_token;
+// repeating the call with that token ends the giveaway and answers 202 without a body, since
+// the expiry worker draws the winners afterwards. Access: creator.
+func (h *GiveawayHandlersFiber) finishNow(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req finishNowReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	fc, err := h.service.FinishNow(c.Context(), c.Params("id"), userID, req.ConfirmToken)
+	if err != nil {
+		switch err.Error() {
+		case "not active", "invalid confirmation":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "confirmation unavailable":
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+		}
+		return finishConfirmationError(c, err)
+	}
+	if fc != nil {
+		return c.Status(fiber.StatusAccepted).JSON(fc)
+	}
+	return c.SendStatus(fiber.StatusAccepted)
+}
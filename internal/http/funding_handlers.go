@@ -0,0 +1,153 @@
+package http
+
+import (
+	"crypto/subtle"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// FundingHandlers exposes escrow prize funding: deposit instructions for creators,
+// the TonAPI webhook confirming incoming transfers and payout review for platform admins.
+type FundingHandlers struct {
+	service *gsvc.Service
+	users   *usersvc.Service
+	secret  string
+}
+
+func NewFundingHandlers(svc *gsvc.Service, users *usersvc.Service, webhookSecret string) *FundingHandlers {
+	return &FundingHandlers{service: svc, users: users, secret: webhookSecret}
+}
+
+// RegisterFiber registers creator and admin routes.
+func (h *FundingHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/giveaways/:id/funding", h.funding)
+	r.Get("/admin/payouts", h.listPayouts)
+	r.Post("/admin/payouts/:id/approve", h.approvePayout)
+	r.Post("/admin/payouts/:id/reject", h.rejectPayout)
+}
+
+// RegisterPublicFiber registers the webhook route (no init-data auth; bearer secret instead).
+func (h *FundingHandlers) RegisterPublicFiber(r fiber.Router) {
+	r.Post("/ton/webhook", h.webhook)
+}
+
+// funding returns the escrow address, memo and deposit progress. Access: creator only.
+func (h *FundingHandlers) funding(c *fiber.Ctx) error {
+	f, err := h.service.GetFunding(c.Context(), c.Params("id"), mw.GetUserID(c))
+	if err != nil {
+		switch err.Error() {
+		case "not found", "not escrow funded":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(f)
+}
+
+// tonWebhookReq is the TonAPI account transaction notification.
+type tonWebhookReq struct {
+	EventType string `json:"event_type"`
+	AccountID string `json:"account_id"`
+	Lt        int64  `json:"lt"`
+	TxHash    string `json:"tx_hash"`
+}
+
+// webhook credits the reported escrow transaction. The payload is only a hint: the
+// transaction is fetched from TonAPI before anything is recorded.
+func (h *FundingHandlers) webhook(c *fiber.Ctx) error {
+	if h.secret == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "webhook disabled"})
+	}
+	token := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.secret)) != 1 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req tonWebhookReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	if err := h.service.HandleEscrowTransaction(c.Context(), req.TxHash); err != nil {
+		switch err.Error() {
+		case "missing tx_hash":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		case "escrow funding unavailable":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		// Let TonAPI retry when the lookup or the write failed
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// listPayouts lists payouts by status and giveaway, e.g. ?status=held for the review queue. Access: platform admins.
+func (h *FundingHandlers) listPayouts(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	status := queryEnum(c, v, "status", "", dg.PayoutStatusReady, dg.PayoutStatusHeld, dg.PayoutStatusApproved, dg.PayoutStatusRejected)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListPayouts(c.Context(), c.Query("giveaway_id"), status, pg.Limit, pg.Offset)
+	if err != nil {
+		if err.Error() == "invalid status" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if list == nil {
+		list = []dg.Payout{}
+	}
+	return c.JSON(list)
+}
+
+type reviewPayoutReq struct {
+	Note string `json:"note"`
+}
+
+func (h *FundingHandlers) approvePayout(c *fiber.Ctx) error { return h.reviewPayout(c, true) }
+
+func (h *FundingHandlers) rejectPayout(c *fiber.Ctx) error { return h.reviewPayout(c, false) }
+
+// reviewPayout releases or blocks a held payout; rejecting requires a note. Access: platform admins.
+func (h *FundingHandlers) reviewPayout(c *fiber.Ctx, approve bool) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+	var req reviewPayoutReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	p, err := h.service.ReviewPayout(c.Context(), id, adminID, approve, req.Note)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "not held":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "missing note", "note too long":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(p)
+}
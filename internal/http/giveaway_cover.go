@@ -0,0 +1,98 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+// inlineCoverTypes maps cover kinds to the InlineQueryResult types of prepared messages.
+var inlineCoverTypes = map[dg.CoverKind]string{
+	dg.CoverPhoto: "photo",
+	dg.CoverGIF:   "gif",
+	dg.CoverVideo: "mpeg4_gif",
+}
+
+func coverError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "giveaway already finished":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	case "unsupported cover type":
+		return c.Status(fiber.StatusUnsupportedMediaType).JSON(fiber.Map{"error": msg})
+	case "storage not configured":
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// uploadCover replaces the default launch animation with the image or GIF in the multipart
+// field "file". Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) uploadCover(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "file is required"})
+	}
+	f, err := file.Open()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	defer f.Close()
+	cover, err := h.service.SetCover(c.Context(), c.Params("id"), requesterID, file.Header.Get(fiber.HeaderContentType), f, file.Size)
+	if err != nil {
+		return coverError(c, err)
+	}
+	h.dropPreparedMessage(c, c.Params("id"))
+	return c.JSON(cover)
+}
+
+// deleteCover restores the default launch animation. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) deleteCover(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.RemoveCover(c.Context(), c.Params("id"), requesterID); err != nil {
+		return coverError(c, err)
+	}
+	h.dropPreparedMessage(c, c.Params("id"))
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// cover redirects to a short-lived link to the cover of a giveaway, so pages can use this
+// route as an image source. Public.
+func (h *GiveawayHandlersFiber) cover(c *fiber.Ctx) error {
+	g, err := h.service.GetByID(c.Context(), c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if g == nil || g.Cover == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	url, err := h.service.CoverURL(c.Context(), g)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if url == "" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	// Shorter than the link lifetime so cached redirects never point at an expired link
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Redirect(url, fiber.StatusFound)
+}
+
+// dropPreparedMessage forgets the cached prepared message of a giveaway so the next one
+// shows its current cover.
+func (h *GiveawayHandlersFiber) dropPreparedMessage(c *fiber.Ctx, id string) {
+	if h.rdb != nil {
+		_ = h.rdb.Del(c.Context(), preparedMessageKey(id)).Err()
+	}
+}
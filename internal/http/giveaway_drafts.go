@@ -0,0 +1,106 @@
+package http
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func draftError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "not a draft", "version conflict", "awaiting approval":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// updateDraft replaces a draft with a full create payload under If-Match. Access: creator only.
+func (h *GiveawayHandlersFiber) updateDraft(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.CreateGiveawayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	validateCreate(v, &req)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	g, err := h.giveawayFromReq(c, req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	id := c.Params("id")
+	if err := h.edit(c, func() error { return h.service.UpdateDraft(c.Context(), id, &g) }); err != nil {
+		return draftError(c, err)
+	}
+	if req.JoinConfirmation != nil && h.joins != nil {
+		jc := dg.JoinConfirmation{Enabled: req.JoinConfirmation.Enabled, Rules: req.JoinConfirmation.Rules}
+		if err := h.joins.Set(c.Context(), id, requesterID, jc); err != nil {
+			log.Printf("giveaway %s: join confirmation: %v", id, err)
+		}
+	}
+	saved, err := h.service.GetByID(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if saved == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	return c.JSON(dto.NewGiveaway(saved))
+}
+
+// publish starts a draft now for its duration under If-Match. Access: creator only.
+func (h *GiveawayHandlersFiber) publish(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var g *dg.Giveaway
+	err := h.edit(c, func() error {
+		var err error
+		g, err = h.service.Publish(c.Context(), c.Params("id"), requesterID)
+		return err
+	})
+	if err != nil {
+		return draftError(c, err)
+	}
+	return c.JSON(dto.PublishGiveawayResponse{
+		ID:        g.ID,
+		Status:    g.Status,
+		StartedAt: g.StartedAt,
+		EndsAt:    g.EndsAt,
+		Funding:   g.Funding,
+		Approval:  g.Approval,
+	})
+}
+
+// listDrafts returns the current user's drafts, most recently edited first.
+func (h *GiveawayHandlersFiber) listDrafts(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListDrafts(c.Context(), userID, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+// redraw replays the recorded draw (dry run only) so owners can prove the winner set.
+func (h *GiveawayHandlersFiber) redraw(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	dryRun := c.QueryBool("dry_run", true)
+	res, err := h.service.Redraw(c.Context(), c.Params("id"), userID, dryRun)
+	if err != nil {
+		switch err.Error() {
+		case "not found", "no draw recorded":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(res)
+}
+
+// fairness exposes draw metadata; the seed is revealed once the giveaway is completed.
+func (h *GiveawayHandlersFiber) fairness(c *fiber.Ctx) error {
+	info, err := h.service.Fairness(c.Context(), c.Params("id"))
+	if err != nil {
+		switch err.Error() {
+		case "not found", "no draw recorded":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(info)
+}
+
+// auditBundle returns a signed JSON bundle describing the giveaway and its draw.
+// Access: giveaway owner or platform admin.
+func (h *GiveawayHandlersFiber) auditBundle(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.audit == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "audit signing not configured"})
+	}
+	g, err := h.service.GetByID(c.Context(), c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if g == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	if g.CreatorID != userID && !isPlatformAdmin(c.Context(), h.users, userID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	bundle, err := h.service.AuditBundle(c.Context(), g.ID, h.audit.PseudonymKey())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	// Sign the exact bytes that are sent so verifiers can check them as-is
+	raw, err := json.Marshal(bundle)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{
+		"bundle":    json.RawMessage(raw),
+		"signature": h.audit.Sign(raw),
+	})
+}
+
+// auditPublicKey exposes the key used to sign audit bundles.
+func (h *GiveawayHandlersFiber) auditPublicKey(c *fiber.Ctx) error {
+	if h.audit == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "audit signing not configured"})
+	}
+	return c.JSON(fiber.Map{"alg": "Ed25519", "key_id": h.audit.KeyID(), "public_key": h.audit.PublicKey()})
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/exports"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// WithExports renders exports as background jobs. Export endpoints queue a job instead of
+// answering inline when a giveaway has more than syncMax winners; 0 always answers inline.
+func (h *GiveawayHandlersFiber) WithExports(svc *exports.Service, syncMax int) *GiveawayHandlersFiber {
+	h.exports = svc
+	h.exportSyncMax = syncMax
+	return h
+}
+
+func exportError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "already finished":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	case "storage not configured":
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
+
+// exportJobResponse maps j with a download link once it is done.
+func (h *GiveawayHandlersFiber) exportJobResponse(c *fiber.Ctx, j *dg.ExportJob) (dto.ExportJob, error) {
+	url, err := h.exports.DownloadURL(c.Context(), j, exportLinkTTL)
+	if err != nil {
+		return dto.ExportJob{}, err
+	}
+	return dto.NewExportJob(j, url, exportLinkTTL, time.Now()), nil
+}
+
+// queueLargeExport queues a job for exports of more than exportSyncMax winners and answers
+// 202 with it. It reports whether it answered.
+func (h *GiveawayHandlersFiber) queueLargeExport(c *fiber.Ctx, g *dg.Giveaway, winners int, kind dg.ExportKind, loc *i18n.Locale) (bool, error) {
+	if h.exports == nil || h.exportSyncMax <= 0 || winners <= h.exportSyncMax {
+		return false, nil
+	}
+	j, err := h.exports.Create(c.Context(), g.ID, middleware.GetUserID(c), kind, loc.Tag)
+	if err != nil {
+		return true, exportError(c, err)
+	}
+	resp, err := h.exportJobResponse(c, j)
+	if err != nil {
+		return true, exportError(c, err)
+	}
+	return true, c.Status(fiber.StatusAccepted).JSON(resp)
+}
+
+// createExport queues a background export of the winners CSV in the ?locale= language.
+// Access: creator and managers.
+func (h *GiveawayHandlersFiber) createExport(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.exports == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "exports not configured"})
+	}
+	var req dto.CreateExportRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	if req.Kind == "" {
+		req.Kind = dg.ExportStats
+	}
+	v := validate.New(requestLocale(c))
+	v.Check(req.Kind == dg.ExportWinners || req.Kind == dg.ExportStats, "kind", "one_of", "winners, stats")
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	// Only the creator and managers get past Create, so the requester's language is the default
+	loc, err := h.exportLocale(c, requesterID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	j, err := h.exports.Create(c.Context(), c.Params("id"), requesterID, req.Kind, loc.Tag)
+	if err != nil {
+		return exportError(c, err)
+	}
+	resp, err := h.exportJobResponse(c, j)
+	if err != nil {
+		return exportError(c, err)
+	}
+	return c.Status(fiber.StatusAccepted).JSON(resp)
+}
+
+// listExports lists the latest export jobs of a giveaway, newest first.
+// Access: creator and managers.
+func (h *GiveawayHandlersFiber) listExports(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.exports == nil {
+		return c.JSON([]dto.ExportJob{})
+	}
+	jobs, err := h.exports.List(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return exportError(c, err)
+	}
+	out := make([]dto.ExportJob, 0, len(jobs))
+	for i := range jobs {
+		resp, err := h.exportJobResponse(c, &jobs[i])
+		if err != nil {
+			return exportError(c, err)
+		}
+		out = append(out, resp)
+	}
+	return c.JSON(out)
+}
+
+// getExport returns the progress of an export job, with a download link once it is done.
+// Access: creator and managers.
+func (h *GiveawayHandlersFiber) getExport(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.exports == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	j, err := h.exports.Get(c.Context(), c.Params("id"), c.Params("job_id"), requesterID)
+	if err != nil {
+		return exportError(c, err)
+	}
+	resp, err := h.exportJobResponse(c, j)
+	if err != nil {
+		return exportError(c, err)
+	}
+	return c.JSON(resp)
+}
+
+// cancelExport stops a queued or running export job.
+// Access: creator and managers.
+func (h *GiveawayHandlersFiber) cancelExport(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.exports == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	j, err := h.exports.Cancel(c.Context(), c.Params("id"), c.Params("job_id"), requesterID)
+	if err != nil {
+		return exportError(c, err)
+	}
+	resp, err := h.exportJobResponse(c, j)
+	if err != nil {
+		return exportError(c, err)
+	}
+	return c.JSON(resp)
+}
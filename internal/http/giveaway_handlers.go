@@ -2,62 +2,208 @@ package http
 
 import (
 	"bytes"
-	"encoding/csv"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"math"
+	"log"
+	"net/mail"
+	"os"
 	"strconv"
 	"strings"
 	"time"
-	"unicode/utf8"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 
 	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
 	"github.com/open-builders/giveaway-backend/internal/http/middleware"
 	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
-	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+	"github.com/open-builders/giveaway-backend/internal/service/audit"
+	bundlesvc "github.com/open-builders/giveaway-backend/internal/service/bundles"
 	chsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/service/compliance"
+	"github.com/open-builders/giveaway-backend/internal/service/exports"
 	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/joinconfirm"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+	"github.com/open-builders/giveaway-backend/internal/service/shortlink"
 	tgsvc "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	"github.com/open-builders/giveaway-backend/internal/service/templates"
 	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+	"github.com/open-builders/giveaway-backend/internal/utils/importer"
 	"github.com/open-builders/giveaway-backend/internal/utils/random"
-	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+	"github.com/open-builders/giveaway-backend/internal/utils/richtext"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
 )
 
 // GiveawayHandlersFiber provides Fiber endpoints for giveaways.
 type GiveawayHandlersFiber struct {
-	service  *gsvc.Service
-	channels *chsvc.Service
-	telegram *tgsvc.Client
-	users    *usersvc.Service
-	ton      *tonb.Service
-	rdb      *redisp.Client
+	service   *gsvc.Service
+	channels  *chsvc.Service
+	telegram  *tgsvc.Client
+	users     *usersvc.Service
+	ton       *tonb.Service
+	rdb       *redisp.Client
+	audit     *audit.Signer
+	files     storage.Storage
+	links     *shortlink.Service
+	bundles   *bundlesvc.Service
+	joins     *joinconfirm.Service
+	exports   *exports.Service
+	templates *templates.Service
+	// Eligibility acknowledgments for restricted prizes; nil when the gate is off
+	compliance *compliance.Service
+	// Winner count above which exports are queued as jobs
+	exportSyncMax int
+	// Import mappers by format name
+	importers map[string]importer.Mapper
 }
 
 func NewGiveawayHandlersFiber(svc *gsvc.Service, chs *chsvc.Service, tg *tgsvc.Client, users *usersvc.Service, ton *tonb.Service, rdb *redisp.Client) *GiveawayHandlersFiber {
-	return &GiveawayHandlersFiber{service: svc, channels: chs, telegram: tg, users: users, ton: ton, rdb: rdb}
+	return &GiveawayHandlersFiber{service: svc, channels: chs, telegram: tg, users: users, ton: ton, rdb: rdb, importers: importer.Builtin()}
+}
+
+// WithImportMappers replaces the formats accepted by giveaway import.
+func (h *GiveawayHandlersFiber) WithImportMappers(m map[string]importer.Mapper) *GiveawayHandlersFiber {
+	h.importers = m
+	return h
+}
+
+// WithStorage hands exports out through file storage links instead of inline responses.
+func (h *GiveawayHandlersFiber) WithStorage(files storage.Storage) *GiveawayHandlersFiber {
+	h.files = files
+	return h
+}
+
+// WithShortLinks adds tracked short URLs to requirement and sponsor links.
+func (h *GiveawayHandlersFiber) WithShortLinks(links *shortlink.Service) *GiveawayHandlersFiber {
+	h.links = links
+	return h
+}
+
+// WithBundles lets creators attach sponsored requirement bundles.
+func (h *GiveawayHandlersFiber) WithBundles(b *bundlesvc.Service) *GiveawayHandlersFiber {
+	h.bundles = b
+	return h
+}
+
+// WithCompliance makes users acknowledge the restricted jurisdictions of a giveaway's prizes
+// before joining.
+func (h *GiveawayHandlersFiber) WithCompliance(cs *compliance.Service) *GiveawayHandlersFiber {
+	h.compliance = cs
+	return h
+}
+
+// WithJoinConfirmations lets creators configure the message sent to users after they join.
+func (h *GiveawayHandlersFiber) WithJoinConfirmations(j *joinconfirm.Service) *GiveawayHandlersFiber {
+	h.joins = j
+	return h
+}
+
+// WithAuditSigner enables signed audit bundles.
+func (h *GiveawayHandlersFiber) WithAuditSigner(s *audit.Signer) *GiveawayHandlersFiber {
+	h.audit = s
+	return h
 }
 
 func (h *GiveawayHandlersFiber) RegisterFiber(r fiber.Router) {
 	r.Post("/giveaways", h.create)
-	r.Get("/giveaways/:id", h.getByID)
+	r.Post("/giveaways/import", h.importGiveaway)
+	r.Get("/giveaways/import/formats", h.importFormats)
+	r.Post("/giveaways/templates", h.createTemplate)
+	r.Get("/giveaways/templates", h.listTemplates)
+	r.Get("/giveaways/templates/:template_id", h.getTemplate)
+	r.Put("/giveaways/templates/:template_id", h.updateTemplate)
+	r.Delete("/giveaways/templates/:template_id", h.deleteTemplate)
+	r.Post("/giveaways/from-template/:template_id", h.createFromTemplate)
+	r.Get("/giveaways/ending-soon", h.listEndingSoon)
+	r.Get("/giveaways/categories", h.listCategories)
+	r.Get("/giveaways/:id", h.tracked(dg.UsageViews, h.getByID))
+	r.Put("/giveaways/:id", h.updateDraft)
+	r.Post("/giveaways/:id/publish", h.publish)
+	r.Put("/giveaways/:id/requirements", h.updateRequirements)
+	r.Get("/giveaways/:id/requirements/revisions", h.requirementRevisions)
 	r.Post("/giveaways/:id/prepare-message", h.prepareInlineMessage)
+	r.Delete("/giveaways/:id/prepare-message", h.resetInlineMessage)
+	r.Put("/giveaways/:id/cover", h.uploadCover)
+	r.Delete("/giveaways/:id/cover", h.deleteCover)
+	r.Patch("/giveaways/:id/sponsors/:channel_id", h.updateSponsor)
+	r.Get("/giveaways/:id/managers", h.listManagers)
+	r.Post("/giveaways/:id/managers", h.addManager)
+	r.Delete("/giveaways/:id/managers/:user_id", h.removeManager)
 	r.Get("/giveaways/:id/list-loaded-winners", h.listWinnersWithPrizes)
 	r.Get("/giveaways/:id/stats.csv", h.exportWinnersCSV)
 	r.Get("/giveaways/:id/export-link", h.generateExportLink)
+	r.Post("/giveaways/:id/exports", h.createExport)
+	r.Get("/giveaways/:id/exports", h.listExports)
+	r.Get("/giveaways/:id/exports/:job_id", h.getExport)
+	r.Delete("/giveaways/:id/exports/:job_id", h.cancelExport)
 	r.Delete("/giveaways/:id/loaded-winners", h.clearLoadedWinners)
-	r.Get("/giveaways/:id/check-requirements", h.checkRequirements)
+	r.Get("/giveaways/:id/check-requirements", h.tracked(dg.UsageChecks, h.checkRequirements))
+	r.Get("/giveaways/:id/health", h.health)
+	r.Post("/giveaways/:id/redraw", h.redraw)
+	r.Get("/giveaways/:id/fairness", h.fairness)
+	r.Get("/giveaways/:id/audit-bundle", h.auditBundle)
+	r.Get("/giveaways/:id/usage", h.usage)
 	r.Get("/users/:creator_id/giveaways", h.listByCreator)
 	r.Get("/giveaways", h.listActive)
+	r.Post("/giveaways/status-batch", h.statusBatch)
+	r.Post("/giveaways/overlap", h.participantOverlap)
 	r.Get("/users/:creator_id/giveaways/finished", h.listFinishedByCreator)
 	// Current user convenience endpoints
 	r.Get("/giveaways/me/all", h.listMineAll)
+	r.Get("/giveaways/me/drafts", h.listDrafts)
+	r.Get("/giveaways/me/managed", h.listManaged)
+	r.Get("/giveaways/me/pending-actions", h.pendingActions)
+	r.Get("/giveaways/me/participating", h.listParticipating)
+	r.Get("/giveaways/me/participated/finished", h.listParticipatedFinished)
+	r.Get("/me/wins", h.listMyWins)
+	r.Post("/giveaways/:id/my-prize/claim", h.claimPrize)
+	r.Post("/giveaways/:id/my-prize/contact", h.setPrizeContact)
+	r.Post("/giveaways/:id/winners/:user_id/delivered", h.markPrizeDelivered)
 	r.Patch("/giveaways/:id/status", h.updateStatus)
+	r.Post("/giveaways/:id/cancel", h.cancel)
+	r.Get("/giveaways/:id/status-history", h.statusHistory)
+	r.Put("/giveaways/:id/pending-policy", h.setPendingPolicy)
+	r.Put("/giveaways/:id/winner-order", h.setWinnerOrder)
+	r.Get("/giveaways/:id/reserves", h.listReserves)
+	r.Get("/giveaways/:id/finish-confirmation", h.finishConfirmation)
+	r.Post("/giveaways/:id/finish-confirmation/confirm", h.confirmFinish)
+	r.Post("/giveaways/:id/finish-confirmation/abort", h.abortFinish)
+	r.Post("/giveaways/:id/finish-now", h.finishNow)
+	r.Post("/giveaways/:id/winners/:user_id/disqualify", h.disqualifyWinner)
+	r.Get("/giveaways/:id/waves", h.listWaves)
+	r.Put("/giveaways/:id/waves", h.setWaves)
 	r.Delete("/giveaways/:id", h.delete)
-	r.Post("/giveaways/:id/join", h.join)
+	r.Post("/giveaways/:id/join", h.tracked(dg.UsageJoins, h.join))
+	r.Get("/giveaways/:id/can-join", h.tracked(dg.UsageChecks, h.canJoin))
+	r.Get("/giveaways/:id/compliance", h.complianceGate)
+	r.Get("/giveaways/:id/compliance/acks", h.complianceAcks)
+	r.Get("/giveaways/:id/sources", h.sourceBreakdown)
+	r.Get("/giveaways/:id/invite-links", h.listInviteLinks)
+	r.Post("/giveaways/:id/invite-links", h.createInviteLink)
+	r.Delete("/giveaways/:id/invite-links/:name", h.deleteInviteLink)
+	r.Get("/giveaways/:id/requirement-failures", h.requirementFailures)
+	r.Get("/requirements/failure-rates", h.requirementFailureRates)
+	r.Get("/giveaways/:id/fingerprint-flags", h.fingerprintFlags)
+	r.Get("/giveaways/:id/notification-preview", h.notificationPreview)
+	// Winners posts in sponsor channels after completion
+	r.Put("/giveaways/:id/result-posts", h.setResultPosts)
+	r.Get("/giveaways/:id/result-posts", h.listResultPosts)
+	r.Patch("/giveaways/:id/result-posts/:channel_id", h.editResultPost)
+	r.Delete("/giveaways/:id/result-posts/:channel_id", h.deleteResultPost)
+	r.Get("/giveaways/:id/join-confirmation", h.joinConfirmation)
+	r.Put("/giveaways/:id/join-confirmation", h.setJoinConfirmation)
+	r.Get("/giveaways/:id/tasks", h.listTasks)
+	r.Post("/giveaways/:id/tasks/:task_id/claim", h.claimTask)
 	// Manual winners upload (now returns preview-style response)
 	r.Post("/giveaways/:id/manual-candidates", h.uploadManualCandidates)
 	r.Get("/prizes/templates", h.listPrizeTemplates)
@@ -66,71 +212,77 @@ func (h *GiveawayHandlersFiber) RegisterFiber(r fiber.Router) {
 // RegisterPublicFiber registers public routes (no init-data auth).
 func (h *GiveawayHandlersFiber) RegisterPublicFiber(r fiber.Router) {
 	r.Get("/giveaways/export/:token", h.downloadExportCSV)
-}
-
-type createPrizeReq struct {
-	Place       *int   `json:"place,omitempty"`
-	Title       string `json:"title"`
-	Description string `json:"description,omitempty"`
-	Quantity    int    `json:"quantity,omitempty"`
-}
-
-type createSponsorReq struct {
-	ID int64 `json:"id"`
-}
-
-type createGiveawayReq struct {
-	Title           string                 `json:"title"`
-	Duration        int64                  `json:"duration"`
-	WinnersCount    int                    `json:"winners_count"`
-	Prizes          []createPrizeReq       `json:"prizes"`
-	Description     string                 `json:"description,omitempty"`
-	MaxParticipants *int                   `json:"max_participants,omitempty"`
-	Requirements    []createRequirementReq `json:"requirements,omitempty"`
-	Sponsors        []createSponsorReq     `json:"sponsors,omitempty"`
-}
-
-// createRequirementReq accepts flexible payloads from the client
-// and is normalized into domain.Requirement.
-type createRequirementReq struct {
-	Type dg.RequirementType `json:"type"`
-	// Client may send either "username" or "channel_username"
-	Username        string `json:"username,omitempty"`
-	ChannelUsername string `json:"channel_username,omitempty"`
-	ChannelID       int64  `json:"channel_id,omitempty"`
-	AvatarURL       string `json:"avatar_url,omitempty"`
-	Name            string `json:"name,omitempty"`
-	Description     string `json:"description,omitempty"`
-	// On-chain
-	TonMinBalanceNano int64  `json:"ton_min_balance_nano,omitempty"`
-	JettonAddress     string `json:"jetton_address,omitempty"`
-	JettonMinAmount   int64  `json:"jetton_min_amount,omitempty"`
-	// Account age
-	AccountAgeMinYear int `json:"account_age_min_year,omitempty"`
-	AccountAgeMaxYear int `json:"account_age_max_year,omitempty"`
+	r.Get("/audit/public-key", h.auditPublicKey)
+	r.Get("/giveaways/:id/winners/check", h.checkWinner)
+	r.Get("/giveaways/:id/cover", h.cover)
 }
 
 // create handles creation of a new giveaway.
 func (h *GiveawayHandlersFiber) create(c *fiber.Ctx) error {
-	var req createGiveawayReq
+	var req dto.CreateGiveawayRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
 	}
+	return h.createFromReq(c, req, nil)
+}
 
-	// Validate negative values
-	if req.Duration < 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "duration cannot be negative"})
+// createFromReq validates and creates a giveaway. Imports pass their warnings, which turns the
+// 201 response into an ImportGiveawayResponse.
+func (h *GiveawayHandlersFiber) createFromReq(c *fiber.Ctx, req dto.CreateGiveawayRequest, importWarnings []string) error {
+	// Report every payload problem at once, in the caller's language
+	v := validate.New(requestLocale(c))
+	validateCreate(v, &req)
+	if !v.OK() {
+		return validationFailed(c, v)
 	}
-	if req.WinnersCount < 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "winners_count cannot be negative"})
+
+	g, err := h.giveawayFromReq(c, req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if req.Draft {
+		g.Status = dg.GiveawayStatusDraft
 	}
 
-	// Validate maximum duration (2 months = 60 days = 5184000 seconds)
-	const maxDurationSeconds = 60 * 24 * 60 * 60 // 60 days in seconds
-	if req.Duration > maxDurationSeconds {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "duration cannot exceed 2 months (60 days)"})
+	id, err := h.service.Create(c.Context(), &g)
+	if err != nil {
+		var dup *gsvc.DuplicateError
+		if errors.As(err, &dup) {
+			return c.Status(fiber.StatusConflict).JSON(dto.Error{Error: err.Error(), DuplicateOf: dup.ExistingID})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
+	if req.JoinConfirmation != nil && h.joins != nil {
+		jc := dg.JoinConfirmation{Enabled: req.JoinConfirmation.Enabled, Rules: req.JoinConfirmation.Rules}
+		if err := h.joins.Set(c.Context(), id, g.CreatorID, jc); err != nil {
+			log.Printf("giveaway %s: join confirmation: %v", id, err)
+		}
+	}
+	// Include prepared inline message id from Redis cache in create response (creator only)
+	msgID := ""
+	if h.rdb != nil {
+		if v, e := h.rdb.Get(c.Context(), preparedMessageKey(id)).Result(); e == nil {
+			msgID = v
+		}
+	}
+	resp := dto.CreateGiveawayResponse{
+		ID:               id,
+		MsgID:            msgID,
+		Funding:          g.Funding,
+		Approval:         g.Approval,
+		DuplicateOf:      g.DuplicateOf,
+		PrizeSummary:     dg.SummarizePrizes(g.Prizes, g.PrizePackages, g.MaxWinnersCount),
+		RequirementHints: withHintMessages(requestLocale(c), h.service.RequirementHints(c.Context(), &g)),
+	}
+	if importWarnings != nil {
+		return c.Status(fiber.StatusCreated).JSON(dto.ImportGiveawayResponse{CreateGiveawayResponse: resp, ImportWarnings: importWarnings})
+	}
+	return c.Status(fiber.StatusCreated).JSON(resp)
+}
 
+// giveawayFromReq builds a giveaway of the caller from a validated create payload, enriching
+// requirements, bundles and sponsors. It starts now and runs for the requested duration.
+func (h *GiveawayHandlersFiber) giveawayFromReq(c *fiber.Ctx, req dto.CreateGiveawayRequest) (dg.Giveaway, error) {
 	// Build domain model
 	now := time.Now().UTC()
 	g := dg.Giveaway{
@@ -140,136 +292,65 @@ func (h *GiveawayHandlersFiber) create(c *fiber.Ctx) error {
 		EndsAt:          now.Add(time.Duration(req.Duration) * time.Second),
 		Duration:        req.Duration,
 		MaxWinnersCount: req.WinnersCount,
+		WinnerStrategy:  dg.WinnerStrategy(req.WinnerStrategy),
+		PendingTTLSec:   req.PendingTTLSec,
+		PendingAction:   dg.PendingAction(req.PendingAction),
+		JoinWindows:     req.JoinWindows,
+		JoinTimezone:    req.JoinTimezone,
 		CreatedAt:       now,
 		UpdatedAt:       now,
 	}
 
 	// Force creator from Telegram init-data context
 	g.CreatorID = middleware.GetUserID(c)
+	g.MaxPerFingerprint = req.MaxAccountsPerFingerprint
+	if req.MaxParticipants != nil {
+		g.MaxParticipants = *req.MaxParticipants
+	}
+	g.WinnerOrder = req.WinnerOrder
+	g.ReserveWinnersCount = req.ReserveWinnersCount
+	g.ClaimDeadlineSec = req.ClaimDeadlineSec
+	g.UnsubscribeGraceSec = req.UnsubscribeGraceSec
+	g.AllowDuplicate = req.AllowDuplicate
+	g.Rules = req.Rules
+	g.FAQ = req.FAQ
+	g.AnnounceRules = req.AnnounceRules
+	g.Category = req.Category
+	g.Tags = req.Tags
+	for tag, t := range req.Translations {
+		if g.Translations == nil {
+			g.Translations = make(map[string]dg.Translation, len(req.Translations))
+		}
+		g.Translations[tag] = dg.Translation{Title: t.Title, Description: t.Description}
+	}
 
-	if utf8.RuneCountInString(g.Title) > 100 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Giveaway title too long (max 100 characters)"})
+	if req.EscrowAmountNano > 0 {
+		g.Funding = &dg.Funding{AmountNano: req.EscrowAmountNano}
 	}
 
-	// Map and enrich requirements first (independent of prizes)
-	for _, r := range req.Requirements {
-		switch r.Type {
-		case dg.RequirementTypeSubscription:
-			channelID := r.ChannelID
-			reqEntry := dg.Requirement{Type: dg.RequirementTypeSubscription}
-			if r.Name != "" {
-				reqEntry.ChannelTitle = r.Name
-			}
-			if r.Description != "" {
-				reqEntry.Description = r.Description
-			}
-			// Try Telegram enrichment
-			if h.telegram != nil && channelID != 0 {
-				ch, err := h.channels.GetByID(c.Context(), channelID, middleware.GetUserID(c))
-				if err != nil {
-					return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
-				}
-				if ch != nil {
-					reqEntry.ChannelID = ch.ID
-					reqEntry.ChannelUsername = ch.Username
-					reqEntry.ChannelTitle = ch.Title
-					reqEntry.ChannelURL = ch.URL
-					reqEntry.AvatarURL = ch.AvatarURL
-				}
-				if reqEntry.ChannelURL == "" {
-					reqEntry.ChannelURL = "https://t.me/" + reqEntry.ChannelUsername
-				}
-			} else {
-				// No telegram client: store what we have
-				reqEntry.ChannelUsername = r.ChannelUsername
-				if r.ChannelID != 0 {
-					reqEntry.ChannelID = r.ChannelID
-				}
-				if r.AvatarURL != "" {
-					reqEntry.AvatarURL = r.AvatarURL
-				}
-			}
-			g.Requirements = append(g.Requirements, reqEntry)
-		case dg.RequirementTypeBoost:
-			channelID := r.ChannelID
-			reqEntry := dg.Requirement{Type: dg.RequirementTypeBoost}
-			if r.Name != "" {
-				reqEntry.ChannelTitle = r.Name
-			}
-			if r.Description != "" {
-				reqEntry.Description = r.Description
-			}
-			// Try Telegram enrichment
-			if h.telegram != nil && channelID != 0 {
-				ch, err := h.channels.GetByID(c.Context(), channelID, middleware.GetUserID(c))
-				if err != nil {
-					return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
-				}
-				if ch != nil {
-					reqEntry.ChannelID = ch.ID
-					reqEntry.ChannelUsername = ch.Username
-					reqEntry.ChannelTitle = ch.Title
-					// reqEntry.ChannelURL = "https://t.me/boost?c=" + strconv.FormatInt(ch.ID, 10)
-					if r.ChannelUsername != "" {
-						reqEntry.ChannelURL = "https://t.me/boost/" + ch.Username
-					} else {
-						reqEntry.ChannelURL = "https://t.me/c/" + strings.TrimPrefix(strconv.FormatInt(ch.ID, 10), "-100") + "?boost"
-					}
-					reqEntry.AvatarURL = ch.AvatarURL
-				}
-			} else {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid requirement"})
-			}
-			g.Requirements = append(g.Requirements, reqEntry)
-		case dg.RequirementTypeCustom:
-			g.Requirements = append(g.Requirements, dg.Requirement{Type: dg.RequirementTypeCustom, ChannelTitle: r.Name, Description: r.Description})
-		case dg.RequirementTypePremium:
-			// No extra fields required; carry optional name/description for UI
-			g.Requirements = append(g.Requirements, dg.Requirement{Type: dg.RequirementTypePremium, Title: r.Name, Description: r.Description})
-		case dg.RequirementTypeHoldTON:
-			if r.TonMinBalanceNano < 0 {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "ton_min_balance_nano cannot be negative"})
-			}
-			g.Requirements = append(g.Requirements, dg.Requirement{Type: dg.RequirementTypeHoldTON, TonMinBalanceNano: r.TonMinBalanceNano, Title: r.Name, Description: r.Description})
-		case dg.RequirementTypeHoldJetton:
-			if r.JettonMinAmount < 0 {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "jetton_min_amount cannot be negative"})
-			}
-			g.Requirements = append(g.Requirements, dg.Requirement{Type: dg.RequirementTypeHoldJetton, JettonAddress: r.JettonAddress, JettonMinAmount: r.JettonMinAmount, Title: r.Name, Description: r.Description})
-		case dg.RequirementTypeAccountAge:
-			// At least one of min or max year must be specified
-			if r.AccountAgeMinYear <= 0 && r.AccountAgeMaxYear <= 0 {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "at least one of account_age_min_year or account_age_max_year must be specified"})
-			}
-			// Validate that min <= max if both are specified
-			if r.AccountAgeMinYear > 0 && r.AccountAgeMaxYear > 0 && r.AccountAgeMinYear > r.AccountAgeMaxYear {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "account_age_min_year cannot be greater than account_age_max_year"})
-			}
-			g.Requirements = append(g.Requirements, dg.Requirement{
-				Type:              dg.RequirementTypeAccountAge,
-				AccountAgeMinYear: r.AccountAgeMinYear,
-				AccountAgeMaxYear: r.AccountAgeMaxYear,
-				Title:             r.Name,
-				Description:       r.Description,
-			})
+	// Map and enrich requirements first (independent of prizes), in the order the creator chose
+	reqs, err := h.requirementsFromReq(c, g.CreatorID, req.Requirements)
+	if err != nil {
+		return g, err
+	}
+	g.Requirements = reqs
+	if len(req.BundleIDs) > 0 {
+		if h.bundles == nil {
+			return g, errors.New("requirement bundles are not available")
 		}
+		reqs, err := h.bundles.Attach(c.Context(), g.Requirements, req.BundleIDs)
+		if err != nil {
+			return g, err
+		}
+		g.Requirements = reqs
 	}
 
 	// Map prizes
 	for _, p := range req.Prizes {
-		if p.Quantity < 0 {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "prize quantity cannot be negative"})
-		}
 		qty := p.Quantity
 		if qty <= 0 {
 			qty = 1
 		}
-
-		// check if price title > 20 characters, if yes, return error (count runes, not bytes)
-		if utf8.RuneCountInString(p.Title) > 20 {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Prize title too long (max 20 characters)"})
-		}
-
 		g.Prizes = append(g.Prizes, dg.PrizePlace{
 			// Ignore incoming place and store as NULL → all prizes are loose
 			Place:       nil,
@@ -279,6 +360,14 @@ func (h *GiveawayHandlersFiber) create(c *fiber.Ctx) error {
 		})
 	}
 
+	for _, p := range req.PrizePackages {
+		pkg := dg.PrizePackage{Label: p.Label, FromPlace: p.FromPlace, ToPlace: p.ToPlace}
+		for _, it := range p.Items {
+			pkg.Items = append(pkg.Items, dg.PackageItem{Title: strings.TrimSpace(it.Title), Description: it.Description, Quantity: max(it.Quantity, 1)})
+		}
+		g.PrizePackages = append(g.PrizePackages, pkg)
+	}
+
 	// Map sponsors: берем из Redis (channels service) по channel_id и сохраняем полные данные в БД
 	for _, s := range req.Sponsors {
 		if s.ID == 0 {
@@ -288,7 +377,7 @@ func (h *GiveawayHandlersFiber) create(c *fiber.Ctx) error {
 		if h.channels != nil {
 			ch, err := h.channels.GetByID(c.Context(), s.ID, middleware.GetUserID(c))
 			if err != nil {
-				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+				return g, err
 			}
 			if ch != nil {
 				var url string
@@ -307,23 +396,42 @@ func (h *GiveawayHandlersFiber) create(c *fiber.Ctx) error {
 		// Если в Redis нет — сохраняем хотя бы id, остальное можно дозаполнить позже
 		g.Sponsors = append(g.Sponsors, dg.ChannelInfo{ID: s.ID})
 	}
+	return g, nil
+}
 
-	id, err := h.service.Create(c.Context(), &g)
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+// checkWinner lets anyone verify whether a user won, without listing other winners.
+func (h *GiveawayHandlersFiber) checkWinner(c *fiber.Ctx) error {
+	userID, err := strconv.ParseInt(c.Query("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id"})
 	}
-	// Include prepared inline message id from Redis cache in create response (creator only)
-	msgID := ""
-	if h.rdb != nil {
-		if v, e := h.rdb.Get(c.Context(), "giveaway:"+id+":prepared_inline_message_id").Result(); e == nil {
-			msgID = v
+	res, err := h.service.CheckWinner(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
 		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"id": id, "msg_id": msgID})
+	c.Set(fiber.HeaderCacheControl, "public, max-age=60")
+	return c.JSON(res)
 }
 
+// preparedMessageMargin is how long before Telegram's expiration_date a cached prepared
+// message is regenerated, so clients never receive an ID that expires while sharing.
+const preparedMessageMargin = 5 * time.Minute
+
+// preparedMessageFallbackTTL caches prepared messages when Telegram returns no expiration_date.
+const preparedMessageFallbackTTL = 50 * time.Minute
+
+func preparedMessageKey(id string) string { return "giveaway:" + id + ":prepared_inline_message_id" }
+
+func exportTokensKey(id string) string { return "giveaway:" + id + ":export_tokens" }
+
 // prepareInlineMessage prepares (or returns cached) prepared inline message for a giveaway.
-// Access: only giveaway owner. Caches result in Redis for 50 minutes.
+// Access: only giveaway owner. The ID is cached until shortly before Telegram expires it.
+// A client whose share failed with "message not found" passes the ID as ?failed=; the
+// cached ID is replaced only when it still matches, so a retry that arrives after the
+// refresh gets the new ID instead of regenerating again.
 func (h *GiveawayHandlersFiber) prepareInlineMessage(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
@@ -349,39 +457,94 @@ func (h *GiveawayHandlersFiber) prepareInlineMessage(c *fiber.Ctx) error {
 	if h.rdb == nil || h.telegram == nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "service not configured"})
 	}
-	cacheKey := "giveaway:" + id + ":prepared_inline_message_id"
+	cacheKey := preparedMessageKey(id)
 	if v, err := h.rdb.Get(c.Context(), cacheKey).Result(); err == nil && v != "" {
-		return c.JSON(fiber.Map{"msg_id": v, "cached": true})
+		if failed := c.Query("failed"); failed == "" || failed != v {
+			resp := fiber.Map{"msg_id": v, "cached": true}
+			if ttl, err := h.rdb.TTL(c.Context(), cacheKey).Result(); err == nil && ttl > 0 {
+				resp["expires_at"] = time.Now().UTC().Add(ttl + preparedMessageMargin).Truncate(time.Second)
+			}
+			return c.JSON(resp)
+		}
 	}
 	// Build startapp URL via bot username
 	startURL := ""
 	if me, err := h.telegram.GetBotMe(c.Context(), h.rdb); err == nil && me != nil && me.Username != "" {
-		startURL = fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, g.ID)
+		startURL = fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, dg.StartParam(g.ID, dg.ParticipantSourceInline))
 	}
 	// Build the same text as in NotifyStarted
 	text := buildStartMessageForPrepare(g)
-	// Use the same GIF as announcement
-	// const startedGIF = "https://cdn.giveaway.tools.tg/assets/Started.gif"
-	// get file_id from config via client
-	startedGIF := h.telegram.Media["giveaway_started"]
-
-	// Use GIF as thumbnail fallback to satisfy Bot API requirements
-	msgID, err := h.telegram.SavePreparedInlineMessageGif(c.Context(), g.CreatorID, startedGIF, startedGIF, text, "Open Giveaway", startURL)
+	// Use the same media as the announcement: the creator's cover, else the default GIF
+	coverURL, err := h.service.CoverURL(c.Context(), g)
+	if err != nil {
+		log.Printf("giveaway %s: sign cover: %v", g.ID, err)
+	}
+	var msgID string
+	var expiresAt time.Time
+	if coverURL != "" {
+		msgID, expiresAt, err = h.telegram.SavePreparedInlineMessageMedia(c.Context(), g.CreatorID, inlineCoverTypes[g.Cover.Kind], coverURL, text, "Open Giveaway", startURL)
+	} else {
+		// const startedGIF = "https://cdn.giveaway.tools.tg/assets/Started.gif"
+		// get file_id from config via client
+		startedGIF := h.telegram.Media["giveaway_started"]
+		// Use GIF as thumbnail fallback to satisfy Bot API requirements
+		msgID, expiresAt, err = h.telegram.SavePreparedInlineMessageGif(c.Context(), g.CreatorID, startedGIF, startedGIF, text, "Open Giveaway", startURL)
+	}
 	if err != nil || msgID == "" {
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 		}
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "failed to prepare message"})
 	}
-	// Cache for 50 minutes
-	_ = h.rdb.SetEx(c.Context(), cacheKey, msgID, 50*time.Minute).Err()
-	return c.JSON(fiber.Map{"msg_id": msgID, "cached": false})
+	ttl := preparedMessageFallbackTTL
+	if !expiresAt.IsZero() {
+		ttl = time.Until(expiresAt) - preparedMessageMargin
+	}
+	resp := fiber.Map{"msg_id": msgID, "cached": false}
+	if !expiresAt.IsZero() {
+		resp["expires_at"] = expiresAt
+	}
+	// Messages expiring within the margin are returned but not cached
+	if ttl > 0 {
+		_ = h.rdb.SetEx(c.Context(), cacheKey, msgID, ttl).Err()
+	}
+	return c.JSON(resp)
+}
+
+// resetInlineMessage drops the cached prepared message so the next prepare-message call
+// creates a new one. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) resetInlineMessage(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	g, err := h.service.GetByID(c.Context(), c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if g == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	if g.CreatorID != requesterID {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	if h.rdb == nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "service not configured"})
+	}
+	if err := h.rdb.Del(c.Context(), preparedMessageKey(g.ID)).Err(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
 // buildStartMessageForPrepare replicates the start message format used in notifications.
 func buildStartMessageForPrepare(g *dg.Giveaway) string {
 	var b strings.Builder
 	b.WriteString("🎁 Giveaway is live!\n\n")
+	if g.DescriptionHTML != "" {
+		b.WriteString(richtext.Truncate(g.DescriptionHTML, notify.DescriptionPreviewLimit))
+		b.WriteString("\n\n")
+	}
 	b.WriteString("Details:\n")
 	// Subscribe line from sponsors
 	subs := collectSponsorsUsernamesForPrepare(g)
@@ -410,6 +573,7 @@ func buildStartMessageForPrepare(g *dg.Giveaway) string {
 		b.WriteString(req)
 		b.WriteString("\n")
 	}
+	b.WriteString(notify.RulesBlock(g))
 	b.WriteString("Participants can now join this giveaway. Good luck!")
 	return b.String()
 }
@@ -430,10 +594,13 @@ func collectSponsorsUsernamesForPrepare(g *dg.Giveaway) string {
 }
 
 func collectPrizeTitlesForPrepare(g *dg.Giveaway) string {
-	if g == nil || len(g.Prizes) == 0 {
+	if g == nil || len(g.Prizes)+len(g.PrizePackages) == 0 {
 		return ""
 	}
-	titles := make([]string, 0, len(g.Prizes))
+	titles := make([]string, 0, len(g.PrizePackages)+len(g.Prizes))
+	for _, p := range g.PrizePackages {
+		titles = append(titles, p.Name())
+	}
 	for _, p := range g.Prizes {
 		if p.Title != "" {
 			titles = append(titles, p.Title)
@@ -448,66 +615,8 @@ func buildRequirementsBlockForPrepare(g *dg.Giveaway) string {
 	}
 	var b strings.Builder
 	for _, r := range g.Requirements {
-		switch r.Type {
-		case dg.RequirementTypeSubscription:
-			if r.ChannelUsername != "" {
-				b.WriteString("• Subscribe to @")
-				b.WriteString(r.ChannelUsername)
-			} else if r.ChannelTitle != "" {
-				b.WriteString("• Subscribe to ")
-				b.WriteString(r.ChannelTitle)
-			} else {
-				b.WriteString("• Subscribe to the channel")
-			}
-			b.WriteString("\n")
-		case dg.RequirementTypeBoost:
-			if r.ChannelUsername != "" {
-				b.WriteString("• Boost @")
-				b.WriteString(r.ChannelUsername)
-			} else {
-				b.WriteString("• Boost the channel")
-			}
-			b.WriteString("\n")
-		case dg.RequirementTypeHoldTON:
-			if r.TonMinBalanceNano > 0 {
-				tons := float64(r.TonMinBalanceNano) / 1_000_000_000
-				rounded := math.Round(tons*1000) / 1000
-				// format without trailing zeros; up to 3 decimals after rounding
-				tonsStr := strconv.FormatFloat(rounded, 'f', -1, 64)
-				b.WriteString(fmt.Sprintf("• Minimum TON balance: %s TON\n", tonsStr))
-			}
-		case dg.RequirementTypeHoldJetton:
-			if r.JettonAddress != "" {
-				if r.JettonMinAmount > 0 {
-					b.WriteString(fmt.Sprintf("• Hold jetton %s ≥ %d\n", r.JettonAddress, r.JettonMinAmount))
-				} else {
-					b.WriteString(fmt.Sprintf("• Hold jetton %s\n", r.JettonAddress))
-				}
-			}
-		case dg.RequirementTypeCustom:
-			if r.Title != "" || r.Description != "" {
-				b.WriteString("• ")
-				if r.Title != "" {
-					b.WriteString(r.Title)
-					if r.Description != "" {
-						b.WriteString(": ")
-						b.WriteString(r.Description)
-					}
-				} else {
-					b.WriteString(r.Description)
-				}
-				b.WriteString("\n")
-			}
-		case dg.RequirementTypePremium:
-			b.WriteString("• Telegram Premium user\n")
-		case dg.RequirementTypeAccountAge:
-			if r.AccountAgeMinYear > 0 && r.AccountAgeMaxYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered between %d and %d\n", r.AccountAgeMaxYear, r.AccountAgeMinYear))
-			} else if r.AccountAgeMinYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered in %d or earlier\n", r.AccountAgeMinYear))
-			} else if r.AccountAgeMaxYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered in %d or later\n", r.AccountAgeMaxYear))
-			}
+		if line := requirements.RenderText(r); line != "" {
+			b.WriteString("• " + line + "\n")
 		}
 	}
 	return b.String()
@@ -515,13 +624,17 @@ func buildRequirementsBlockForPrepare(g *dg.Giveaway) string {
 
 func (h *GiveawayHandlersFiber) getByID(c *fiber.Ctx) error {
 	id := c.Params("id")
-	g, err := h.service.GetByID(c.Context(), id)
+	g, err := h.service.GetByIDCached(c.Context(), id)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	if g == nil {
+	// Drafts exist only for their creator
+	if g == nil || (g.Status == dg.GiveawayStatusDraft && g.CreatorID != middleware.GetUserID(c)) {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
+	markTracked(c, g.ID)
+	h.service.RecordView(c.Context(), g.ID, middleware.GetUserID(c))
+	h.service.ApplyBadges(g)
 	// compute user role
 	var userRole string
 	if uid := middleware.GetUserID(c); uid != 0 {
@@ -529,258 +642,669 @@ func (h *GiveawayHandlersFiber) getByID(c *fiber.Ctx) error {
 			userRole = role
 		}
 	}
-	// Build DTO without creator_id but with user_role
-	type requirementDTO struct {
-		Name        string             `json:"name,omitempty"`
-		Type        dg.RequirementType `json:"type"`
-		Username    string             `json:"username,omitempty"`
-		AvatarURL   string             `json:"avatar_url,omitempty"`
-		URL         string             `json:"url"`
-		Description string             `json:"description,omitempty"`
-		// On-chain fields
-		TonMinBalanceNano int64  `json:"ton_min_balance_nano,omitempty"`
-		JettonAddress     string `json:"jetton_address,omitempty"`
-		JettonMinAmount   int64  `json:"jetton_min_amount,omitempty"`
-		// Jetton metadata enrichment
-		JettonSymbol string `json:"jetton_symbol,omitempty"`
-		JettonImage  string `json:"jetton_image,omitempty"`
-	}
-
-	type sponsorDTO struct {
-		ID        int64  `json:"id"`
-		Username  string `json:"username,omitempty"`
-		AvatarURL string `json:"avatar_url,omitempty"`
-		URL       string `json:"url"`
-		Title     string `json:"title,omitempty"`
-	}
-
-	type winnerDTO struct {
-		UserID    int64            `json:"user_id"`
-		Username  string           `json:"username,omitempty"`
-		Name      string           `json:"name"`
-		AvatarURL string           `json:"avatar_url,omitempty"`
-		Place     int              `json:"place"`
-		Prizes    []dg.WinnerPrize `json:"prizes"`
-	}
-
-	type GiveawayDTO struct {
-		ID                string            `json:"id"`
-		Title             string            `json:"title"`
-		Description       string            `json:"description"`
-		StartedAt         time.Time         `json:"started_at"`
-		EndsAt            time.Time         `json:"ends_at"`
-		Duration          int64             `json:"duration"`
-		MaxWinnersCount   int               `json:"winners_count"`
-		Status            dg.GiveawayStatus `json:"status"`
-		CreatedAt         time.Time         `json:"created_at"`
-		UpdatedAt         time.Time         `json:"updated_at"`
-		Prizes            []dg.PrizePlace   `json:"prizes,omitempty"`
-		Sponsors          []sponsorDTO      `json:"sponsors"`
-		Requirements      []requirementDTO  `json:"requirements,omitempty"`
-		Winners           []winnerDTO       `json:"winners,omitempty"`
-		ParticipantsCount int               `json:"participants_count"`
-		UserRole          string            `json:"user_role,omitempty"`
-		MsgID             string            `json:"msg_id,omitempty"`
-	}
-	// Map requirements to requested API shape
-	reqs := make([]requirementDTO, 0, len(g.Requirements))
-	for _, r := range g.Requirements {
-		name := r.ChannelTitle
-		if name == "" {
-			name = r.Title
-		}
-		// Build URL for requirement: prefer stored ChannelURL, else build from username
-		reqURL := r.ChannelURL
-		if reqURL == "" && r.ChannelUsername != "" {
-			reqURL = "https://t.me/" + r.ChannelUsername
+	out := dto.NewGiveaway(requestGiveawayLanguage(c, g))
+	out.UserRole = userRole
+	out.CanManage = h.service.CanManage(c.Context(), g, middleware.GetUserID(c))
+	if userRole == "owner" {
+		out.Translations = g.Translations
+	}
+	setVersionETag(c, g.Version)
+	for i, r := range g.Requirements {
+		if r.Type == dg.RequirementTypeHoldJetton && r.JettonAddress != "" && h.ton != nil {
+			if meta, err := h.ton.GetJettonMeta(c.Context(), r.JettonAddress); err == nil && meta != nil {
+				out.Requirements[i].JettonSymbol = meta.Symbol
+				out.Requirements[i].JettonImage = meta.Image
+			}
 		}
+	}
 
-		if r.Type == dg.RequirementTypeBoost {
-			if r.ChannelUsername != "" {
-				reqURL = "https://t.me/boost/" + r.ChannelUsername
-			} else {
-				reqURL = "https://t.me/c/" + strings.TrimPrefix(strconv.FormatInt(r.ChannelID, 10), "-100") + "?boost"
+	// Tracked short URLs; url keeps the original so t.me links still open natively
+	reqs, sponsors := out.Requirements, out.Sponsors
+	if h.links != nil {
+		targets := make([]shortlink.Target, 0, len(reqs)+len(sponsors))
+		for i, r := range reqs {
+			targets = append(targets, shortlink.Target{Kind: dg.ShortLinkRequirement, Position: i, URL: r.URL})
+		}
+		for i, sp := range sponsors {
+			targets = append(targets, shortlink.Target{Kind: dg.ShortLinkSponsor, Position: i, URL: sp.URL})
+		}
+		wrapped := h.links.Wrap(c.Context(), g.ID, targets, middleware.GetUserID(c))
+		for i := range reqs {
+			if wrapped[i] != reqs[i].URL {
+				reqs[i].ShortURL = wrapped[i]
+			}
+		}
+		for i := range sponsors {
+			if w := wrapped[len(reqs)+i]; w != sponsors[i].URL {
+				sponsors[i].ShortURL = w
 			}
 		}
+	}
+
+	// Enrich winners if any
+	for _, w := range h.orderWinners(c.Context(), g, g.Winners) {
+		out.Winners = append(out.Winners, dto.NewWinner(w, h.lookupUser(c.Context(), w.UserID)))
+	}
 
-		it := requirementDTO{
-			Name:              name,
-			Type:              r.Type,
-			Username:          r.ChannelUsername,
-			AvatarURL:         r.AvatarURL,
-			Description:       r.Description,
-			TonMinBalanceNano: r.TonMinBalanceNano,
-			JettonAddress:     r.JettonAddress,
-			JettonMinAmount:   r.JettonMinAmount,
-			URL:               reqURL,
+	// Only owner sees msg_id
+	if userRole == "owner" && h.rdb != nil {
+		if v, e := h.rdb.Get(c.Context(), preparedMessageKey(g.ID)).Result(); e == nil {
+			out.MsgID = v
 		}
-		if r.Type == dg.RequirementTypeHoldJetton && r.JettonAddress != "" && h.ton != nil {
-			if meta, err := h.ton.GetJettonMeta(c.Context(), r.JettonAddress); err == nil && meta != nil {
-				it.JettonSymbol = meta.Symbol
-				it.JettonImage = meta.Image
-			}
+	}
+	return c.JSON(out)
+}
+
+func (h *GiveawayHandlersFiber) listByCreator(c *fiber.Ctx) error {
+	creatorID, err := c.ParamsInt("creator_id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid creator_id"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListByCreator(c.Context(), int64(creatorID), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+type updateStatusReq struct {
+	Status dg.GiveawayStatus `json:"status"`
+}
+
+// updateStatus moves a giveaway to another status under If-Match. Access: creator only.
+func (h *GiveawayHandlersFiber) updateStatus(c *fiber.Ctx) error {
+	id := c.Params("id")
+	var body updateStatusReq
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	if err := h.edit(c, func() error { return h.service.UpdateStatus(c.Context(), id, body.Status) }); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
 		}
-		reqs = append(reqs, it)
+		if err.Error() == "not funded" || err.Error() == "awaiting approval" || err.Error() == "awaiting confirmation" || err.Error() == "version conflict" {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
 
-	// Map sponsors with URL always present (may be empty string)
-	sponsors := make([]sponsorDTO, 0, len(g.Sponsors))
-	for _, s := range g.Sponsors {
-		url := s.URL
-		if url == "" && s.Username != "" {
-			url = "https://t.me/" + s.Username
-		}
-		sponsors = append(sponsors, sponsorDTO{
-			ID:        s.ID,
-			Username:  s.Username,
-			AvatarURL: s.AvatarURL,
-			URL:       url,
-			Title:     s.Title,
-		})
+type cancelReq struct {
+	Reason string `json:"reason"`
+}
+
+// cancel cancels a giveaway with a reason and queues a DM to every participant. Access: creator only.
+func (h *GiveawayHandlersFiber) cancel(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
+	var body cancelReq
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	if err := h.edit(c, func() error { return h.service.Cancel(c.Context(), c.Params("id"), requesterID, body.Reason) }); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "already cancelled", "winners already drawn", "transition not allowed", "version conflict":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
 
-	// Enrich winners if any
-	enrichedWinners := make([]winnerDTO, 0, len(g.Winners))
-	for _, w := range g.Winners {
-		var username, name, avatar string
+// statusHistory lists status changes with reasons. Access: creator only.
+func (h *GiveawayHandlersFiber) statusHistory(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	items, err := h.service.ListStatusHistory(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	if items == nil {
+		items = []dg.StatusChange{}
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+type pendingPolicyReq struct {
+	TTLSec *int             `json:"ttl_sec"`
+	Action dg.PendingAction `json:"action"`
+}
+
+// setPendingPolicy overrides how long the giveaway may stay pending and what happens afterwards.
+// Omitted fields restore the server defaults. Access: creator only.
+func (h *GiveawayHandlersFiber) setPendingPolicy(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body pendingPolicyReq
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	err := h.edit(c, func() error {
+		return h.service.SetPendingPolicy(c.Context(), c.Params("id"), requesterID, body.TTLSec, body.Action)
+	})
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "version conflict":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+type winnerOrderReq struct {
+	Order dg.WinnerOrder `json:"order"`
+}
+
+// setWinnerOrder changes how winners are listed in responses, announcements and exports.
+// An empty order restores ordering by place. Access: creator only.
+func (h *GiveawayHandlersFiber) setWinnerOrder(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body winnerOrderReq
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	if err := h.edit(c, func() error { return h.service.SetWinnerOrder(c.Context(), c.Params("id"), requesterID, body.Order) }); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "version conflict":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// lookupUser returns the user or nil when unknown or users are not configured.
+func (h *GiveawayHandlersFiber) lookupUser(ctx context.Context, userID int64) *du.User {
+	if h.users == nil {
+		return nil
+	}
+	u, err := h.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil
+	}
+	return u
+}
+
+// orderWinners lists winners in the giveaway's display order.
+func (h *GiveawayHandlersFiber) orderWinners(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) []dg.Winner {
+	return dg.OrderWinners(winners, g.WinnerOrder, g.ID, func(userID int64) string {
 		if h.users != nil {
-			if usr, uerr := h.users.GetByID(c.Context(), w.UserID); uerr == nil && usr != nil {
-				username = usr.Username
-				name = strings.TrimSpace(strings.TrimSpace(usr.FirstName + " " + usr.LastName))
-				avatar = usr.AvatarURL
+			if u, err := h.users.GetByID(ctx, userID); err == nil && u != nil {
+				return dg.WinnerSortName(u.Username, u.FirstName, u.LastName)
 			}
 		}
-		if name == "" {
-			name = strconv.FormatInt(w.UserID, 10)
+		return ""
+	})
+}
+
+// listMyWins returns the current user's win history with prizes and fulfillment state.
+func (h *GiveawayHandlersFiber) listMyWins(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	wins, err := h.service.ListMyWins(c.Context(), userID, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(dto.WinsResponse{Wins: wins})
+}
+
+func (h *GiveawayHandlersFiber) claimPrize(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.ClaimPrize(c.Context(), c.Params("id"), userID); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "not winner":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "not completed", "already claimed":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// setPrizeContact records how the winner wants to be contacted for delivery.
+// Access: winners of a completed giveaway, until delivery.
+func (h *GiveawayHandlersFiber) setPrizeContact(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.PrizeContactRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	req.Email = strings.TrimSpace(req.Email)
+	req.WalletAddress = strings.TrimSpace(req.WalletAddress)
+	v := validate.New(requestLocale(c))
+	switch req.Method {
+	case dg.ContactBotDM, dg.ContactWallet:
+	case dg.ContactEmail:
+		v.Required("email", req.Email)
+		if req.Email != "" {
+			addr, err := mail.ParseAddress(req.Email)
+			v.Check(err == nil && addr.Address == req.Email, "email", "email")
 		}
-		if avatar == "" {
-			avatar = tgutils.BuildAvatarURL(strconv.FormatInt(w.UserID, 10))
+	default:
+		v.Add("method", "one_of", strings.Join([]string{string(dg.ContactBotDM), string(dg.ContactEmail), string(dg.ContactWallet)}, ", "))
+	}
+	v.MaxLen("email", req.Email, 254)
+	v.MaxLen("wallet_address", req.WalletAddress, 128)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	contact, err := h.service.SetPrizeContact(c.Context(), c.Params("id"), userID, dg.ContactPreference{Method: req.Method, Email: req.Email, WalletAddress: req.WalletAddress})
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "not winner":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "not completed", "already delivered":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "wallet required":
+			v.Add("wallet_address", "required")
+			return validationFailed(c, v)
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 		}
-		enrichedWinners = append(enrichedWinners, winnerDTO{
-			UserID:    w.UserID,
-			Username:  username,
-			Name:      name,
-			AvatarURL: avatar,
-			Place:     w.Place,
-			Prizes:    w.Prizes,
-		})
 	}
+	return c.JSON(contact)
+}
 
-	dto := GiveawayDTO{
-		ID:                g.ID,
-		Title:             g.Title,
-		Description:       g.Description,
-		StartedAt:         g.StartedAt,
-		EndsAt:            g.EndsAt,
-		Duration:          g.Duration,
-		MaxWinnersCount:   g.MaxWinnersCount,
-		Status:            g.Status,
-		CreatedAt:         g.CreatedAt,
-		UpdatedAt:         g.UpdatedAt,
-		Prizes:            g.Prizes,
-		Sponsors:          sponsors,
-		Requirements:      reqs,
-		Winners:           enrichedWinners,
-		ParticipantsCount: g.ParticipantsCount,
-		UserRole:          userRole,
+func (h *GiveawayHandlersFiber) markPrizeDelivered(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	// Only owner sees msg_id
-	if userRole == "owner" && h.rdb != nil {
-		if v, e := h.rdb.Get(c.Context(), "giveaway:"+g.ID+":prepared_inline_message_id").Result(); e == nil {
-			dto.MsgID = v
+	winnerID, err := strconv.ParseInt(c.Params("user_id"), 10, 64)
+	if err != nil || winnerID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id"})
+	}
+	if err := h.service.MarkPrizeDelivered(c.Context(), c.Params("id"), requesterID, winnerID); err != nil {
+		switch err.Error() {
+		case "not found", "not winner":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "already delivered":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 		}
 	}
-	return c.JSON(dto)
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (h *GiveawayHandlersFiber) listByCreator(c *fiber.Ctx) error {
-	creatorID, err := c.ParamsInt("creator_id")
+type waveReq struct {
+	DrawAt       time.Time `json:"draw_at"`
+	WinnersCount int       `json:"winners_count"`
+}
+
+type setWavesReq struct {
+	Waves []waveReq `json:"waves"`
+}
+
+func (h *GiveawayHandlersFiber) listWaves(c *fiber.Ctx) error {
+	waves, err := h.service.ListWaves(c.Context(), c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid creator_id"})
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if waves == nil {
+		waves = []dg.Wave{}
+	}
+	return c.JSON(fiber.Map{"waves": waves})
+}
+
+// setWaves replaces the schedule of partial draws; an empty list removes it.
+func (h *GiveawayHandlersFiber) setWaves(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body setWavesReq
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	in := make([]gsvc.WaveInput, 0, len(body.Waves))
+	for _, w := range body.Waves {
+		in = append(in, gsvc.WaveInput{DrawAt: w.DrawAt, WinnersCount: w.WinnersCount})
+	}
+	var waves []dg.Wave
+	err := h.edit(c, func() error {
+		var err error
+		waves, err = h.service.SetWaves(c.Context(), c.Params("id"), requesterID, in)
+		return err
+	})
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "giveaway already ended", "waves already started", "version conflict":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	if waves == nil {
+		waves = []dg.Wave{}
+	}
+	return c.JSON(fiber.Map{"waves": waves})
+}
+
+func (h *GiveawayHandlersFiber) delete(c *fiber.Ctx) error {
+	id := c.Params("id")
+	// requester from middleware
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.Delete(c.Context(), id, requesterID); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// requirementsAllMet checks all giveaway requirements for the current user and
+// returns true only if every requirement is satisfied.
+func (h *GiveawayHandlersFiber) requirementsAllMet(c *fiber.Ctx, g *dg.Giveaway) bool {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return false
+	}
+	return h.service.CheckRequirements(c.Context(), userID, g.Requirements)
+}
+
+// joinSource attributes a join. A startapp payload for this giveaway is signed as part of
+// init-data and wins over the source reported by the client. Invite links carry their name
+// as the reference.
+func joinSource(c *fiber.Ctx, id string, req dto.JoinRequest) (dg.ParticipantSource, string) {
+	if p := middleware.GetStartParam(c); p != "" {
+		if gid, src, name := dg.ParseStartParam(p); gid == id {
+			if src == dg.ParticipantSourceInvite {
+				return src, name
+			}
+			return src, req.SourceRef
+		}
+	}
+	if req.Source.Valid() {
+		return req.Source, req.SourceRef
+	}
+	return dg.ParticipantSourceUnknown, req.SourceRef
+}
+
+func (h *GiveawayHandlersFiber) join(c *fiber.Ctx) error {
+	id := c.Params("id")
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.JoinRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+	// Ensure all requirements are satisfied before joining
+	g, err := h.service.GetByID(c.Context(), id)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if g == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	markTracked(c, g.ID)
+	// Outside join windows, tell the client when to retry before running requirement checks
+	if err := gsvc.CheckJoinWindow(g, time.Now()); err != nil {
+		return joinWindowClosed(c, err)
+	}
+	if !h.requirementsAllMet(c, g) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "requirements not satisfied"})
+	}
+	if ok, err := h.checkCompliance(c, g, requesterID, req.AcknowledgeCompliance); !ok {
+		return err
+	}
+	source, ref := joinSource(c, id, req)
+	// Only links the creator generated count as invites
+	if source == dg.ParticipantSourceInvite && !h.service.HasInviteLink(c.Context(), id, ref) {
+		source, ref = dg.ParticipantSourceDirect, ""
+	}
+	client := gsvc.JoinClient{IP: c.IP(), UserAgent: c.Get(fiber.HeaderUserAgent)}
+	if err := h.service.Join(c.Context(), id, requesterID, source, ref, client); err != nil {
+		var closed *gsvc.JoinWindowClosedError
+		if errors.As(err, &closed) {
+			return joinWindowClosed(c, err)
+		}
+		switch err.Error() {
+		case "banned", "requirements not satisfied":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "giveaway is full":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// canJoin lists what keeps the current user from joining, so the client can show a precise
+// call to action before calling join. Access: any authenticated user.
+func (h *GiveawayHandlersFiber) canJoin(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	res, err := h.service.CanJoin(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	markTracked(c, res.GiveawayID)
+	if h.compliance != nil {
+		gate, err := h.compliance.GateFor(c.Context(), c.Params("id"), requesterID)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+		if gate.Pending() {
+			res.Blockers = append(res.Blockers, dg.JoinBlocker{Code: dg.JoinBlockerCompliance, Compliance: gate})
+			res.CanJoin = false
+		}
+	}
+	return c.JSON(res)
+}
+
+// joinWindowClosed responds 403 with the next window start as opens_at.
+func joinWindowClosed(c *fiber.Ctx, err error) error {
+	resp := dto.Error{Error: err.Error()}
+	var closed *gsvc.JoinWindowClosedError
+	if errors.As(err, &closed) {
+		resp.OpensAt = closed.OpensAt
+	}
+	return c.Status(fiber.StatusForbidden).JSON(resp)
+}
+
+// fingerprintFlags lists joins over the accounts-per-fingerprint cap. Access: creator only.
+func (h *GiveawayHandlersFiber) fingerprintFlags(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	flags, err := h.service.ListFingerprintFlags(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 	}
-	limit := c.QueryInt("limit", 100)
-	offset := c.QueryInt("offset", 0)
-	list, err := h.service.ListByCreator(c.Context(), int64(creatorID), limit, offset)
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	if flags == nil {
+		flags = []dg.FingerprintFlag{}
 	}
-	return c.JSON(list)
-}
-
-type updateStatusReq struct {
-	Status dg.GiveawayStatus `json:"status"`
+	return c.JSON(fiber.Map{"flags": flags})
 }
 
-func (h *GiveawayHandlersFiber) updateStatus(c *fiber.Ctx) error {
-	id := c.Params("id")
-	var body updateStatusReq
-	if err := c.BodyParser(&body); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+// notificationPreview renders the start, finish and winner messages for proofreading. Access: creator only.
+func (h *GiveawayHandlersFiber) notificationPreview(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	if err := h.service.UpdateStatus(c.Context(), id, body.Status); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	preview, err := h.service.NotificationPreview(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 	}
-	return c.SendStatus(fiber.StatusNoContent)
+	return c.JSON(preview)
 }
 
-func (h *GiveawayHandlersFiber) delete(c *fiber.Ctx) error {
-	id := c.Params("id")
-	// requester from middleware
+// sourceBreakdown returns how many participants joined from each source. Access: creator only.
+func (h *GiveawayHandlersFiber) sourceBreakdown(c *fiber.Ctx) error {
 	requesterID := middleware.GetUserID(c)
 	if requesterID == 0 {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	if err := h.service.Delete(c.Context(), id, requesterID); err != nil {
+	stats, err := h.service.SourceBreakdown(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
 		switch err.Error() {
 		case "not found":
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 		case "forbidden":
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
 		default:
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 		}
 	}
-	return c.SendStatus(fiber.StatusNoContent)
+	var total int64
+	for _, s := range stats {
+		total += s.Participants
+	}
+	if stats == nil {
+		stats = []dg.SourceStats{}
+	}
+	return c.JSON(fiber.Map{"total": total, "sources": stats})
 }
 
-// requirementsAllMet checks all giveaway requirements for the current user and
-// returns true only if every requirement is satisfied.
-func (h *GiveawayHandlersFiber) requirementsAllMet(c *fiber.Ctx, g *dg.Giveaway) bool {
+func (h *GiveawayHandlersFiber) listTasks(c *fiber.Ctx) error {
 	userID := middleware.GetUserID(c)
 	if userID == 0 {
-		return false
-	}
-	return h.service.CheckRequirements(c.Context(), userID, g.Requirements)
-}
-
-func (h *GiveawayHandlersFiber) join(c *fiber.Ctx) error {
-	id := c.Params("id")
-	requesterID := middleware.GetUserID(c)
-	if requesterID == 0 {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	// Ensure all requirements are satisfied before joining
-	g, err := h.service.GetByID(c.Context(), id)
+	tasks, tickets, err := h.service.ListTasks(c.Context(), c.Params("id"), userID)
 	if err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		}
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	if g == nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	return c.JSON(dto.TasksResponse{Tasks: tasks, Tickets: tickets})
+}
+
+func (h *GiveawayHandlersFiber) claimTask(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	if !h.requirementsAllMet(c, g) {
-		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "requirements not satisfied"})
+	taskID, err := strconv.ParseInt(c.Params("task_id"), 10, 64)
+	if err != nil || taskID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid task_id"})
 	}
-	if err := h.service.Join(c.Context(), id, requesterID); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	task, tickets, err := h.service.ClaimTask(c.Context(), c.Params("id"), userID, taskID)
+	if err != nil {
+		switch err.Error() {
+		case "not found", "task not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "not participant":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "giveaway not active", "task not completed":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "check failed":
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
 	}
-	return c.SendStatus(fiber.StatusNoContent)
+	return c.JSON(dto.TaskClaimResponse{Task: task, Tickets: tickets})
 }
 
+// uploadManualCandidates stores the winners of a pending giveaway from a list of user ids
+// and @usernames. Access: creator and managers.
 func (h *GiveawayHandlersFiber) uploadManualCandidates(c *fiber.Ctx) error {
 	// Auth required; use giveaway id to filter by participants
 	id := c.Params("id")
-	creatorID := middleware.GetUserID(c)
-	if creatorID == 0 {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
 
@@ -792,6 +1316,9 @@ func (h *GiveawayHandlersFiber) uploadManualCandidates(c *fiber.Ctx) error {
 	if g == nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
+	if !h.service.CanManage(c.Context(), g, requesterID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
 
 	var content []byte
 	if file, err := c.FormFile("file"); err == nil && file != nil {
@@ -815,59 +1342,39 @@ func (h *GiveawayHandlersFiber) uploadManualCandidates(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "no candidates"})
 	}
 
-	type previewItem struct {
-		UserID    int64  `json:"user_id"`
-		Username  string `json:"username"`
-		Name      string `json:"name"`
-		AvatarURL string `json:"avatar_url"`
-		Source    string `json:"source"`
-	}
-
-	out := make([]previewItem, 0, len(tokens))
+	out := make([]dto.Candidate, 0, len(tokens))
 	seen := make(map[int64]struct{})
 	for _, t := range tokens {
 		t = strings.TrimSpace(t)
-		if t == "" {
+		if t == "" || h.users == nil {
 			continue
 		}
+		var (
+			usr    *du.User
+			source = "id"
+		)
 		if strings.HasPrefix(t, "@") {
-			uname := strings.TrimPrefix(t, "@")
-			if h.users != nil {
-				if usr, uerr := h.users.GetByUsername(c.Context(), uname); uerr == nil && usr != nil {
-					// keep only participants or winners
-					if role, rerr := h.service.GetUserRole(c.Context(), g, usr.ID); rerr == nil && (role == "participant" || role == "winner") {
-						if _, ok := seen[usr.ID]; ok {
-							continue
-						}
-						seen[usr.ID] = struct{}{}
-						fullName := strings.TrimSpace(strings.TrimSpace(usr.FirstName + " " + usr.LastName))
-						avatar := tgutils.BuildAvatarURL(strconv.FormatInt(usr.ID, 10))
-						out = append(out, previewItem{UserID: usr.ID, Username: usr.Username, Name: fullName, AvatarURL: avatar, Source: "username"})
-					}
-				}
-			}
+			usr, _ = h.users.GetByUsername(c.Context(), strings.TrimPrefix(t, "@"))
+			source = "username"
+		} else if uid, err := strconv.ParseInt(t, 10, 64); err == nil {
+			// Check user exists and participated
+			usr, _ = h.users.GetByID(c.Context(), uid)
+		}
+		if usr == nil {
 			continue
 		}
-		uid, err := strconv.ParseInt(t, 10, 64)
-		if err != nil {
+		// keep only participants or winners, never the creator or a manager
+		if h.service.CanManage(c.Context(), g, usr.ID) {
 			continue
 		}
-		// Check user exists and participated
-		var username, name, avatar string
-		if h.users != nil {
-			if usr, uerr := h.users.GetByID(c.Context(), uid); uerr == nil && usr != nil {
-				if role, rerr := h.service.GetUserRole(c.Context(), g, uid); rerr == nil && (role == "participant" || role == "winner") {
-					if _, ok := seen[uid]; ok {
-						continue
-					}
-					seen[uid] = struct{}{}
-					username = usr.Username
-					name = strings.TrimSpace(strings.TrimSpace(usr.FirstName + " " + usr.LastName))
-					avatar = tgutils.BuildAvatarURL(strconv.FormatInt(uid, 10))
-					out = append(out, previewItem{UserID: uid, Username: username, Name: name, AvatarURL: avatar, Source: "id"})
-				}
-			}
+		if ok, rerr := h.service.IsEntrant(c.Context(), g.ID, usr.ID); rerr != nil || !ok {
+			continue
+		}
+		if _, ok := seen[usr.ID]; ok {
+			continue
 		}
+		seen[usr.ID] = struct{}{}
+		out = append(out, dto.NewCandidate(usr, source))
 	}
 
 	// Enforce max winners limit from giveaway settings
@@ -884,7 +1391,7 @@ func (h *GiveawayHandlersFiber) uploadManualCandidates(c *fiber.Ctx) error {
 	for _, it := range out {
 		winnerIDs = append(winnerIDs, it.UserID)
 	}
-	if err := h.service.SetManualWinners(c.Context(), id, creatorID, winnerIDs); err != nil {
+	if err := h.service.SetManualWinners(c.Context(), id, requesterID, winnerIDs); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 
@@ -893,31 +1400,17 @@ func (h *GiveawayHandlersFiber) uploadManualCandidates(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	// Build map user_id -> prizes for response join
-	prizeByUser := make(map[int64][]dg.WinnerPrize, len(winners))
+	// Build map user_id -> winner for response join
+	winnerByUser := make(map[int64]dg.Winner, len(winners))
 	for _, w := range winners {
-		prizeByUser[w.UserID] = w.Prizes
-	}
-	type respItem struct {
-		UserID    int64            `json:"user_id"`
-		Username  string           `json:"username"`
-		Name      string           `json:"name"`
-		AvatarURL string           `json:"avatar_url"`
-		Source    string           `json:"source"`
-		Prizes    []dg.WinnerPrize `json:"prizes"`
+		winnerByUser[w.UserID] = w
 	}
-	resp := make([]respItem, 0, len(out))
+	resp := make([]dto.WinnerResult, 0, len(out))
 	for _, it := range out {
-		resp = append(resp, respItem{
-			UserID:    it.UserID,
-			Username:  it.Username,
-			Name:      it.Name,
-			AvatarURL: it.AvatarURL,
-			Source:    it.Source,
-			Prizes:    prizeByUser[it.UserID],
-		})
+		s := dto.UserSummary{UserID: it.UserID, Username: it.Username, Name: it.Name, AvatarURL: it.AvatarURL}
+		resp = append(resp, dto.NewWinnerResult(s, it.Source, winnerByUser[it.UserID]))
 	}
-	return c.JSON(fiber.Map{"results": resp})
+	return c.JSON(dto.WinnerResults{Results: resp})
 }
 
 func (h *GiveawayHandlersFiber) listFinishedByCreator(c *fiber.Ctx) error {
@@ -925,9 +1418,12 @@ func (h *GiveawayHandlersFiber) listFinishedByCreator(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid creator_id"})
 	}
-	limit := c.QueryInt("limit", 100)
-	offset := c.QueryInt("offset", 0)
-	list, err := h.service.ListFinishedByCreator(c.Context(), int64(creatorID), limit, offset)
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListFinishedByCreator(c.Context(), int64(creatorID), pg.Limit, pg.Offset)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -967,42 +1463,18 @@ func (h *GiveawayHandlersFiber) listWinnersWithPrizes(c *fiber.Ctx) error {
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
+	winners = h.orderWinners(c.Context(), g, winners)
 	// Build same response format as uploadManualCandidates
-	type respItem struct {
-		UserID    int64            `json:"user_id"`
-		Username  string           `json:"username"`
-		Name      string           `json:"name"`
-		AvatarURL string           `json:"avatar_url"`
-		Source    string           `json:"source"`
-		Prizes    []dg.WinnerPrize `json:"prizes"`
-	}
-	resp := make([]respItem, 0, len(winners))
+	resp := make([]dto.WinnerResult, 0, len(winners))
 	for _, w := range winners {
-		var username, name, avatar string
-		if h.users != nil {
-			if usr, uerr := h.users.GetByID(c.Context(), w.UserID); uerr == nil && usr != nil {
-				username = usr.Username
-				name = strings.TrimSpace(strings.TrimSpace(usr.FirstName + " " + usr.LastName))
-				avatar = usr.AvatarURL
-				if avatar == "" {
-					avatar = tgutils.BuildAvatarURL(strconv.FormatInt(w.UserID, 10))
-				}
-			}
-		}
-		resp = append(resp, respItem{
-			UserID:    w.UserID,
-			Username:  username,
-			Name:      name,
-			AvatarURL: avatar,
-			Source:    "id",
-			Prizes:    w.Prizes,
-		})
+		resp = append(resp, dto.NewWinnerResult(dto.NewUserSummary(w.UserID, h.lookupUser(c.Context(), w.UserID)), "id", w))
 	}
-	return c.JSON(fiber.Map{"results": resp})
+	return c.JSON(dto.WinnerResults{Results: resp})
 }
 
-// exportWinnersCSV streams a CSV file with winners and their prizes.
-// Access: only giveaway creator with admin role.
+// exportWinnersCSV returns a CSV file with winners and their prizes, redirecting to
+// file storage when it is configured. Giveaways with many winners get a queued export job.
+// Access: giveaway creator and managers.
 func (h *GiveawayHandlersFiber) exportWinnersCSV(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
@@ -1021,7 +1493,7 @@ func (h *GiveawayHandlersFiber) exportWinnersCSV(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
 	}
 
-	// Verify ownership
+	// Verify the requester manages the giveaway
 	g, err := h.service.GetByID(c.Context(), id)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
@@ -1029,70 +1501,43 @@ func (h *GiveawayHandlersFiber) exportWinnersCSV(c *fiber.Ctx) error {
 	if g == nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
-	if g.CreatorID != requesterID {
+	if !h.service.CanManage(c.Context(), g, requesterID) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
 	}
+	loc, err := h.exportLocale(c, requesterID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
 	// Fetch winners with prizes
 	winners, err := h.service.ListWinnersWithPrizes(c.Context(), id)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
-	// Build CSV
-	var buf bytes.Buffer
-	// UTF-8 BOM for Excel compatibility with Cyrillic
-	_, _ = buf.Write([]byte{0xEF, 0xBB, 0xBF})
-	writer := csv.NewWriter(&buf)
-	_ = writer.Write([]string{"place", "user_id", "username", "first_name", "last_name", "wallet_address", "prize_title", "prize_description", "prize_quantity"})
-	for _, w := range winners {
-		var username, firstName, lastName, wallet string
-		if h.users != nil {
-			if usr, uerr := h.users.GetByID(c.Context(), w.UserID); uerr == nil && usr != nil {
-				username = usr.Username
-				firstName = usr.FirstName
-				lastName = usr.LastName
-				wallet = usr.WalletAddress
-			}
-		}
-		if len(w.Prizes) == 0 {
-			_ = writer.Write([]string{
-				strconv.Itoa(w.Place),
-				strconv.FormatInt(w.UserID, 10),
-				username,
-				firstName,
-				lastName,
-				wallet,
-				"",
-				"",
-				"",
-			})
-			continue
+	if queued, err := h.queueLargeExport(c, g, len(winners), dg.ExportStats, loc); queued {
+		return err
+	}
+	winners = h.orderWinners(c.Context(), g, winners)
+	if h.files != nil {
+		url, err := h.storeWinnersCSV(c.Context(), g, winners, true, loc)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to store export"})
 		}
-		for _, p := range w.Prizes {
-			_ = writer.Write([]string{
-				strconv.Itoa(w.Place),
-				strconv.FormatInt(w.UserID, 10),
-				username,
-				firstName,
-				lastName,
-				wallet,
-				p.Title,
-				p.Description,
-				strconv.Itoa(p.Quantity),
-			})
-		}
-	}
-	writer.Flush()
-	if err := writer.Error(); err != nil {
+		return c.Redirect(url, fiber.StatusFound)
+	}
+	var buf bytes.Buffer
+	if err := h.writeWinnersCSV(c.Context(), &buf, g, winners, true, loc); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
-	filename := fmt.Sprintf("giveaway_%s_winners.csv", id)
 	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
-	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Set(fiber.HeaderContentDisposition, exports.FileDisposition(id))
 	return c.Send(buf.Bytes())
 }
 
-// generateExportLink creates a short-lived token in Redis and returns a public URL to download CSV without auth.
-// Access: only giveaway creator with admin role.
+// generateExportLink returns a short-lived public URL to download CSV without auth. With file
+// storage the export is uploaded right away and the URL is a signed storage link; otherwise a
+// token is stored in Redis and the CSV is rendered on download. Giveaways with many winners
+// get a queued export job.
+// Access: giveaway creator and managers.
 func (h *GiveawayHandlersFiber) generateExportLink(c *fiber.Ctx) error {
 	id := c.Params("id")
 	if id == "" {
@@ -1109,7 +1554,7 @@ func (h *GiveawayHandlersFiber) generateExportLink(c *fiber.Ctx) error {
 	if err != nil || usr == nil {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
 	}
-	// Validate ownership
+	// Validate the requester manages the giveaway
 	g, err := h.service.GetByID(c.Context(), id)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
@@ -1117,20 +1562,43 @@ func (h *GiveawayHandlersFiber) generateExportLink(c *fiber.Ctx) error {
 	if g == nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
-	if g.CreatorID != requesterID {
+	if !h.service.CanManage(c.Context(), g, requesterID) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
 	}
+	loc, err := h.exportLocale(c, requesterID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if h.files != nil {
+		winners, err := h.service.ListWinnersWithPrizes(c.Context(), id)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if queued, err := h.queueLargeExport(c, g, len(winners), dg.ExportWinners, loc); queued {
+			return err
+		}
+		winners = h.orderWinners(c.Context(), g, winners)
+		url, err := h.storeWinnersCSV(c.Context(), g, winners, false, loc)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to store export"})
+		}
+		return c.JSON(fiber.Map{"url": url, "expires_in": int(exportLinkTTL.Seconds())})
+	}
 	if h.rdb == nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "redis not configured"})
 	}
 	token := uuid.NewString()
 	key := "export:giveaway:" + token
-	ttl := 2 * time.Minute
-	if err := h.rdb.SetEx(c.Context(), key, id, ttl).Err(); err != nil {
+	// The per-giveaway index lets deletion revoke outstanding tokens
+	pipe := h.rdb.TxPipeline()
+	pipe.SetEx(c.Context(), key, id, exportLinkTTL)
+	pipe.SAdd(c.Context(), exportTokensKey(id), token)
+	pipe.Expire(c.Context(), exportTokensKey(id), exportLinkTTL)
+	if _, err := pipe.Exec(c.Context()); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to store token"})
 	}
-	publicURL := c.BaseURL() + "/api/public/giveaways/export/" + token
-	return c.JSON(fiber.Map{"url": publicURL, "expires_in": int(ttl.Seconds())})
+	publicURL := c.BaseURL() + "/api/public/giveaways/export/" + token + "?locale=" + loc.Tag
+	return c.JSON(fiber.Map{"url": publicURL, "expires_in": int(exportLinkTTL.Seconds())})
 }
 
 // downloadExportCSV validates token (no auth), generates CSV and returns it, then invalidates token.
@@ -1158,63 +1626,93 @@ func (h *GiveawayHandlersFiber) downloadExportCSV(c *fiber.Ctx) error {
 	if g == nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
+	loc, err := h.exportLocale(c, g.CreatorID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
 	// Fetch winners and build CSV (reuse logic)
 	winners, err := h.service.ListWinnersWithPrizes(c.Context(), id)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
+	winners = h.orderWinners(c.Context(), g, winners)
 	var buf bytes.Buffer
-	_, _ = buf.Write([]byte{0xEF, 0xBB, 0xBF})
-	writer := csv.NewWriter(&buf)
-	_ = writer.Write([]string{"place", "user_id", "username", "first_name", "last_name", "wallet_address", "prize_title", "prize_description"})
-	for _, w := range winners {
-		var username, firstName, lastName, wallet string
-		if h.users != nil {
-			if usr, uerr := h.users.GetByID(c.Context(), w.UserID); uerr == nil && usr != nil {
-				username = usr.Username
-				firstName = usr.FirstName
-				lastName = usr.LastName
-				wallet = usr.WalletAddress
-			}
-		}
-		if len(w.Prizes) == 0 {
-			_ = writer.Write([]string{
-				strconv.Itoa(w.Place),
-				strconv.FormatInt(w.UserID, 10),
-				username,
-				firstName,
-				lastName,
-				wallet,
-				"",
-				"",
-			})
-			continue
-		}
-		for _, p := range w.Prizes {
-			_ = writer.Write([]string{
-				strconv.Itoa(w.Place),
-				strconv.FormatInt(w.UserID, 10),
-				username,
-				firstName,
-				lastName,
-				wallet,
-				p.Title,
-				p.Description,
-			})
-		}
-	}
-	writer.Flush()
-	if err := writer.Error(); err != nil {
+	if err := h.writeWinnersCSV(c.Context(), &buf, g, winners, false, loc); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
-	filename := fmt.Sprintf("giveaway_%s_winners.csv", id)
 	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
-	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"%s\"", filename))
+	c.Set(fiber.HeaderContentDisposition, exports.FileDisposition(id))
 	// Allow direct download in Telegram Web
 	c.Set("Access-Control-Allow-Origin", "https://web.telegram.org")
 	return c.Send(buf.Bytes())
 }
 
+// exportLinkTTL bounds how long export download links stay valid.
+const exportLinkTTL = 2 * time.Minute
+
+// exportLocale resolves the export locale from ?locale=, falling back to the picked language
+// of userID, their Telegram language and then English. An unsupported explicit locale is
+// an error.
+func (h *GiveawayHandlersFiber) exportLocale(c *fiber.Ctx, userID int64) (*i18n.Locale, error) {
+	if tag := c.Query("locale"); tag != "" {
+		loc, ok := i18n.Lookup(tag)
+		if !ok {
+			return nil, errors.New("unsupported locale")
+		}
+		return loc, nil
+	}
+	if h.users != nil {
+		return h.users.Locale(c.Context(), userID), nil
+	}
+	return i18n.Match(), nil
+}
+
+// writeWinnersCSV renders the winners of g with their prizes and requirement checks; see
+// exports.WriteWinnersCSV.
+func (h *GiveawayHandlersFiber) writeWinnersCSV(ctx context.Context, out io.Writer, g *dg.Giveaway, winners []dg.Winner, withQuantity bool, loc *i18n.Locale) error {
+	snapshots, err := h.service.WinnerRequirementSnapshots(ctx, g.ID, winners)
+	if err != nil {
+		return err
+	}
+	checks := exports.NewRequirementChecks(g.Requirements, snapshots)
+	return exports.WriteWinnersCSV(out, winners, withQuantity, loc, checks, func(userID int64) *du.User { return h.lookupUser(ctx, userID) }, nil)
+}
+
+// storeWinnersCSV renders the export into a temp file, uploads it and returns a signed download URL.
+// Each giveaway has a single export object that is overwritten on every request.
+func (h *GiveawayHandlersFiber) storeWinnersCSV(ctx context.Context, g *dg.Giveaway, winners []dg.Winner, withQuantity bool, loc *i18n.Locale) (string, error) {
+	id := g.ID
+	tmp, err := os.CreateTemp("", "giveaway-export-*.csv")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	if err := h.writeWinnersCSV(ctx, tmp, g, winners, withQuantity, loc); err != nil {
+		return "", err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	key := exports.FileKey(id, dg.ExportWinners)
+	if withQuantity {
+		key = exports.FileKey(id, dg.ExportStats)
+	}
+	err = h.files.Put(ctx, key, tmp, size, storage.PutOptions{
+		ContentType:        "text/csv; charset=utf-8",
+		ContentDisposition: exports.FileDisposition(id),
+		CacheControl:       "private, no-store",
+	})
+	if err != nil {
+		return "", err
+	}
+	return h.files.SignedURL(ctx, key, exportLinkTTL)
+}
+
 // clearLoadedWinners deletes loaded winners and their prizes; only creator and only if pending.
 func (h *GiveawayHandlersFiber) clearLoadedWinners(c *fiber.Ctx) error {
 	id := c.Params("id")
@@ -1243,32 +1741,173 @@ func (h *GiveawayHandlersFiber) clearLoadedWinners(c *fiber.Ctx) error {
 }
 
 func (h *GiveawayHandlersFiber) listActive(c *fiber.Ctx) error {
-	limit := c.QueryInt("limit", 20)
-	offset := c.QueryInt("offset", 0)
-	minParticipants := c.QueryInt("min_participants", 0)
-	list, err := h.service.ListActive(c.Context(), limit, offset, minParticipants)
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	minParticipants := queryInt(c, v, "min_participants", 0)
+	v.NotNegative("min_participants", int64(minParticipants))
+	category := queryEnum(c, v, "category", "", dg.Categories...)
+	tag := queryEnum(c, v, "tag", "", dg.Tags...)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListActive(c.Context(), pg.Limit, pg.Offset, dg.ActiveFilter{MinParticipants: minParticipants, Category: category, Tag: tag})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// listCategories returns the categories and tag vocabulary giveaways and the feed filter use.
+func (h *GiveawayHandlersFiber) listCategories(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"categories": dg.Categories, "tags": dg.Tags, "max_tags": dg.MaxTags})
+}
+
+// listEndingSoon is the explore feed's ending soon tab: active giveaways soonest end first.
+func (h *GiveawayHandlersFiber) listEndingSoon(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListEndingSoon(c.Context(), pg.Limit, pg.Offset)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 	return c.JSON(list)
 }
 
-// listMineAll returns all giveaways created by the current user (any status).
+// listMineAll returns all giveaways created by the current user (any status but draft; see listDrafts).
 func (h *GiveawayHandlersFiber) listMineAll(c *fiber.Ctx) error {
 	// user id from Telegram init-data middleware
 	userID := middleware.GetUserID(c)
 	if userID == 0 {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
 	}
-	limit := c.QueryInt("limit", 100)
-	offset := c.QueryInt("offset", 0)
-	list, err := h.service.ListByCreator(c.Context(), userID, limit, offset)
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListByCreator(c.Context(), userID, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// pendingActions returns the current user's giveaways that wait for them, with the action
+// and the deadline after which the platform acts on its own.
+func (h *GiveawayHandlersFiber) pendingActions(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	list, err := h.service.PendingActions(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// listParticipating returns giveaways the current user joined that have no results yet.
+func (h *GiveawayHandlersFiber) listParticipating(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListParticipating(c.Context(), userID, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// listParticipatedFinished returns completed giveaways the current user joined.
+func (h *GiveawayHandlersFiber) listParticipatedFinished(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListParticipatedFinished(c.Context(), userID, pg.Limit, pg.Offset)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
 	}
 	return c.JSON(list)
 }
 
+// statusBatch returns status, participant count and time left for up to 100 giveaways,
+// so list screens can refresh all cards in one request.
+func (h *GiveawayHandlersFiber) statusBatch(c *fiber.Ctx) error {
+	var req dto.StatusBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	items, err := h.service.StatusBatch(c.Context(), req.IDs)
+	if err != nil {
+		switch err.Error() {
+		case "missing ids", "too many ids":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(dto.StatusBatchResponse{Items: items})
+}
+
+// participantOverlap compares the participants of 2 to 5 giveaways. Access: creator of all of them.
+func (h *GiveawayHandlersFiber) participantOverlap(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.StatusBatchRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	report, err := h.service.ParticipantOverlap(c.Context(), req.IDs, requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(report)
+}
+
+// health returns the configuration checklist of a giveaway. Access: creator only.
+func (h *GiveawayHandlersFiber) health(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	res, err := h.service.Health(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(res)
+}
+
 // checkRequirements verifies whether the current user satisfies each requirement of a giveaway.
 // Returns detailed results and overall all_met flag.
 func (h *GiveawayHandlersFiber) checkRequirements(c *fiber.Ctx) error {
@@ -1289,108 +1928,58 @@ func (h *GiveawayHandlersFiber) checkRequirements(c *fiber.Ctx) error {
 	if g == nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
 	}
+	markTracked(c, g.ID)
 
-	type chatInfo struct {
-		Title     string `json:"title"`
-		Username  string `json:"username"`
-		Type      string `json:"type"`
-		AvatarURL string `json:"avatar_url"`
-		URL       string `json:"url"`
-	}
-	type item struct {
-		Name              string             `json:"name"`
-		Type              dg.RequirementType `json:"type"`
-		Username          string             `json:"username"`
-		Status            string             `json:"status"`
-		Error             string             `json:"error,omitempty"`
-		Link              string             `json:"url,omitempty"`
-		ChatInfo          chatInfo           `json:"chat_info"`
-		TonMinBalanceNano int64              `json:"ton_min_balance_nano,omitempty"`
-		JettonAddress     string             `json:"jetton_address,omitempty"`
-		JettonMinAmount   int64              `json:"jetton_min_amount,omitempty"`
-		JettonSymbol      string             `json:"jetton_symbol,omitempty"`
-		JettonImage       string             `json:"jetton_image,omitempty"`
-	}
-
-	results := make([]item, 0, len(g.Requirements))
+	results := make([]dto.CheckResult, len(g.Requirements))
 	allMet := true
-
-	for _, rqm := range g.Requirements {
-		// Build channel URL: prefer stored ChannelURL, else from username
-		channelURL := rqm.ChannelURL
-		if channelURL == "" && rqm.ChannelUsername != "" {
-			channelURL = "https://t.me/" + rqm.ChannelUsername
-		}
-		it := item{
-			Name:              rqm.ChannelTitle,
-			Type:              rqm.Type,
-			Username:          rqm.ChannelUsername,
-			Status:            "failed",
-			ChatInfo:          chatInfo{Title: rqm.ChannelTitle, Username: rqm.ChannelUsername, AvatarURL: rqm.AvatarURL, URL: channelURL},
-			TonMinBalanceNano: rqm.TonMinBalanceNano,
-			JettonAddress:     rqm.JettonAddress,
-			JettonMinAmount:   rqm.JettonMinAmount,
-		}
-		if rqm.ChannelUsername != "" {
-			it.Link = "https://t.me/" + rqm.ChannelUsername
-		}
-		// Best-effort chat info enrichment (type, avatar/title fallback)
-		// Prefer username; fallback to id
-		if h.channels != nil {
-			var info *channels.Channel
-
-			if inf, e := h.channels.GetByID(c.Context(), rqm.ChannelID); e == nil {
-				info = inf
-			}
-
-			if rqm.ChannelUsername != "" {
-				it.ChatInfo.URL = "https://t.me/" + rqm.ChannelUsername
-			}
-
-			if info != nil {
-				// it.ChatInfo.Type = info.Type
-				if it.ChatInfo.Title == "" {
-					it.ChatInfo.Title = info.Title
-				}
-				if it.ChatInfo.Username == "" {
-					it.ChatInfo.Username = info.Username
+	tickets := 1
+
+	// Best-effort enrichment runs next to the checks; both keep the order of the requirements
+	var enrich errgroup.Group
+	enrich.SetLimit(4)
+	for i, rqm := range g.Requirements {
+		results[i] = dto.NewCheckResult(rqm)
+		it := &results[i]
+		enrich.Go(func() error {
+			// Chat info (type, avatar/title fallback)
+			if h.channels != nil {
+				if info, e := h.channels.GetByID(c.Context(), rqm.ChannelID); e == nil {
+					it.ApplyChannel(info)
 				}
-				if it.ChatInfo.AvatarURL == "" {
-					it.ChatInfo.AvatarURL = info.AvatarURL
-				}
-				if info.URL != "" {
-					it.ChatInfo.URL = info.URL
+			}
+			// Jetton metadata if applicable
+			if rqm.Type == dg.RequirementTypeHoldJetton && rqm.JettonAddress != "" {
+				if meta, err := h.ton.GetJettonMeta(c.Context(), rqm.JettonAddress); err == nil && meta != nil {
+					it.JettonSymbol = meta.Symbol
+					it.JettonImage = meta.Image
 				}
 			}
-		}
-		// Perform requirement check via shared helper
-		res := h.service.CheckSingleRequirement(c.Context(), userID, &rqm)
-		// Map result
+			return nil
+		})
+	}
+	checks := h.service.CheckAllRequirements(c.Context(), userID, g.Requirements)
+	_ = enrich.Wait()
+
+	for i, rqm := range g.Requirements {
+		res := checks[i]
+		it := &results[i]
 		it.Status = res.Status
 		it.Error = res.Error
-		// Enrich jetton metadata if applicable
-		if rqm.Type == dg.RequirementTypeHoldJetton && rqm.JettonAddress != "" {
-			if meta, err := h.ton.GetJettonMeta(c.Context(), rqm.JettonAddress); err == nil && meta != nil {
-				it.JettonSymbol = meta.Symbol
-				it.JettonImage = meta.Image
-			}
-		} else if rqm.Type == dg.RequirementTypeBoost {
-			if rqm.ChannelUsername != "" {
-				it.Link = "https://t.me/boost/" + rqm.ChannelUsername
-			} else {
-				it.Link = "https://t.me/c/" + strings.TrimPrefix(strconv.FormatInt(rqm.ChannelID, 10), "-100") + "?boost"
-			}
-		}
-
-		results = append(results, it)
-		if res.Error != "" || res.Status != "success" {
+		it.Warning = res.Warning
+		met := res.Error == "" && res.Status == "success"
+		switch {
+		case rqm.IsBonus() && met:
+			tickets += rqm.BonusTickets
+		case !rqm.IsOptional() && !met:
+			// Optional requirements and bonus tasks never block joining
 			allMet = false
 		}
 	}
 
-	return c.JSON(fiber.Map{
-		"giveaway_id": id,
-		"results":     results,
-		"all_met":     allMet,
-	})
+	h.service.RecordRequirementChecks(c.Context(), id, userID, g.Requirements, checks)
+
+	resp := dto.CheckRequirementsResponse{GiveawayID: id, Results: results, AllMet: allMet, Tickets: tickets}
+	// Explain failing checks of a giveaway that can no longer complete instead of failing opaquely
+	resp.UnderReview = h.service.DeadReviewReason(c.Context(), id)
+	return c.JSON(resp)
 }
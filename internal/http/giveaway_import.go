@@ -0,0 +1,102 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/importer"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// importFormats lists the mapper names accepted by importGiveaway.
+func (h *GiveawayHandlersFiber) importFormats(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"formats": importer.Names(h.importers)})
+}
+
+// importGiveaway translates a JSON or CSV definition exported from another bot, picked by
+// ?format=, into a create payload. With ?dry_run=true it only reports the translation;
+// otherwise a valid definition is created like POST /giveaways.
+func (h *GiveawayHandlersFiber) importGiveaway(c *fiber.Ctx) error {
+	uid := mw.GetUserID(c)
+	if uid == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	format := c.Query("format", "generic")
+	m, ok := h.importers[format]
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "unknown format", "formats": importer.Names(h.importers)})
+	}
+	parse := importer.ParseJSON
+	if strings.Contains(c.Get(fiber.HeaderContentType), "csv") {
+		parse = importer.ParseCSV
+	}
+	def, err := parse(m, c.Body(), time.Now().UTC())
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	req := dto.CreateGiveawayRequest{
+		Title:        def.Title,
+		Description:  def.Description,
+		Duration:     def.Duration,
+		WinnersCount: def.WinnersCount,
+	}
+	for _, p := range def.Prizes {
+		req.Prizes = append(req.Prizes, dto.CreatePrizeRequest{Title: p.Title, Description: p.Description, Quantity: p.Quantity})
+	}
+	warnings := def.Warnings
+	for _, ch := range def.Channels {
+		r, warning := h.importChannel(c, uid, ch)
+		if warning != "" {
+			warnings = append(warnings, warning)
+			continue
+		}
+		req.Requirements = append(req.Requirements, r)
+	}
+	if warnings == nil {
+		warnings = []string{}
+	}
+
+	v := validate.New(requestLocale(c))
+	validateCreate(v, &req)
+	if !v.OK() {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": v.Error(), "errors": v.List(), "warnings": warnings, "giveaway": req})
+	}
+	if c.QueryBool("dry_run") {
+		return c.JSON(fiber.Map{"giveaway": req, "warnings": warnings})
+	}
+	return h.createFromReq(c, req, warnings)
+}
+
+// importChannel turns an imported channel into a subscription requirement. Channels the
+// creator manages are linked by id; other public channels are kept by username, and private
+// channels the creator does not manage are skipped with a warning.
+func (h *GiveawayHandlersFiber) importChannel(c *fiber.Ctx, uid int64, ch string) (dto.CreateRequirementRequest, string) {
+	r := dto.CreateRequirementRequest{Type: dg.RequirementTypeSubscription}
+	if id, err := strconv.ParseInt(ch, 10, 64); err == nil {
+		if h.channels != nil {
+			if _, err := h.channels.GetByID(c.Context(), id, uid); err == nil {
+				r.ChannelID = id
+				return r, ""
+			}
+		}
+		return r, "skipped channel " + ch + ": add the bot to it and import again"
+	}
+	if h.channels != nil {
+		if owned, err := h.channels.ListUserChannels(c.Context(), uid); err == nil {
+			for _, o := range owned {
+				if strings.EqualFold(o.Username, ch) {
+					r.ChannelID = o.ID
+					return r, ""
+				}
+			}
+		}
+	}
+	r.ChannelUsername = ch
+	return r, ""
+}
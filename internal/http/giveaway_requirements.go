@@ -0,0 +1,100 @@
+package http
+
+import (
+	"sort"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// requirementsFromReq maps and enriches submitted requirements in the order the creator chose.
+// Unknown types are left out.
+func (h *GiveawayHandlersFiber) requirementsFromReq(c *fiber.Ctx, creatorID int64, reqs []dto.CreateRequirementRequest) ([]dg.Requirement, error) {
+	sort.SliceStable(reqs, func(i, j int) bool { return reqs[i].Position < reqs[j].Position })
+	var env requirements.Env
+	if h.telegram != nil {
+		env = requirements.Env{Channels: h.channels, CreatorID: creatorID}
+	}
+	out := make([]dg.Requirement, 0, len(reqs))
+	for _, r := range reqs {
+		t, ok := requirements.Lookup(r.Type)
+		if !ok {
+			continue
+		}
+		rq := requirementFromRequest(r)
+		if err := t.Enrich(c.Context(), env, &rq); err != nil {
+			return nil, err
+		}
+		rq.ID = r.ID
+		rq.BonusTickets = r.BonusTickets
+		rq.Optional = r.Optional
+		rq.Position = len(out)
+		out = append(out, rq)
+	}
+	return out, nil
+}
+
+func requirementsError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "giveaway ended", "version conflict":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// updateRequirements replaces the requirements of a giveaway that has not ended under If-Match.
+// Access: creator only.
+func (h *GiveawayHandlersFiber) updateRequirements(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.UpdateRequirementsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	for i := range req.Requirements {
+		validateRequirement(v, &req.Requirements[i], i)
+	}
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	reqs, err := h.requirementsFromReq(c, requesterID, req.Requirements)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	var rev *dg.RequirementRevision
+	err = h.edit(c, func() error {
+		var err error
+		rev, err = h.service.UpdateRequirements(c.Context(), c.Params("id"), requesterID, reqs)
+		return err
+	})
+	if err != nil {
+		return requirementsError(c, err)
+	}
+	return c.JSON(rev)
+}
+
+// requirementRevisions lists the requirement revisions of a giveaway, newest first.
+// Access: creator only.
+func (h *GiveawayHandlersFiber) requirementRevisions(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	list, err := h.service.RequirementRevisions(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return requirementsError(c, err)
+	}
+	return c.JSON(list)
+}
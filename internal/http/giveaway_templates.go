@@ -0,0 +1,173 @@
+package http
+
+import (
+	"encoding/json"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/templates"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// WithTemplates lets creators save giveaway configurations and create giveaways from them.
+func (h *GiveawayHandlersFiber) WithTemplates(svc *templates.Service) *GiveawayHandlersFiber {
+	h.templates = svc
+	return h
+}
+
+func templateError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "name taken":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// templateConfig encodes a validated giveaway for storage. allow_duplicate is a per-request
+// choice and is not saved.
+func templateConfig(req dto.CreateGiveawayRequest) (json.RawMessage, error) {
+	req.AllowDuplicate = false
+	return json.Marshal(req)
+}
+
+// createTemplate saves a giveaway configuration under a name.
+// Access: any authenticated user; the template belongs to them.
+func (h *GiveawayHandlersFiber) createTemplate(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "templates not configured"})
+	}
+	var req dto.SaveTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	validateCreate(v, &req.Giveaway)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	config, err := templateConfig(req.Giveaway)
+	if err != nil {
+		return templateError(c, err)
+	}
+	t, err := h.templates.Create(c.Context(), userID, req.Name, config)
+	if err != nil {
+		return templateError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(t)
+}
+
+// listTemplates lists the caller's templates by name.
+func (h *GiveawayHandlersFiber) listTemplates(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.JSON([]any{})
+	}
+	items, err := h.templates.List(c.Context(), userID)
+	if err != nil {
+		return templateError(c, err)
+	}
+	return c.JSON(items)
+}
+
+// getTemplate returns one of the caller's templates.
+// Access: template creator only.
+func (h *GiveawayHandlersFiber) getTemplate(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	t, err := h.templates.Get(c.Context(), c.Params("template_id"), userID)
+	if err != nil {
+		return templateError(c, err)
+	}
+	return c.JSON(t)
+}
+
+// updateTemplate replaces the name and configuration of a template.
+// Access: template creator only.
+func (h *GiveawayHandlersFiber) updateTemplate(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	var req dto.SaveTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	validateCreate(v, &req.Giveaway)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	config, err := templateConfig(req.Giveaway)
+	if err != nil {
+		return templateError(c, err)
+	}
+	t, err := h.templates.Update(c.Context(), c.Params("template_id"), userID, req.Name, config)
+	if err != nil {
+		return templateError(c, err)
+	}
+	return c.JSON(t)
+}
+
+// deleteTemplate removes a template.
+// Access: template creator only.
+func (h *GiveawayHandlersFiber) deleteTemplate(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	if err := h.templates.Delete(c.Context(), c.Params("template_id"), userID); err != nil {
+		return templateError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// createFromTemplate creates a giveaway from a saved template. An optional JSON body in the
+// shape of POST /giveaways overrides the fields it sets, e.g. a new title or duration.
+// Access: template creator only.
+func (h *GiveawayHandlersFiber) createFromTemplate(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if h.templates == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	t, err := h.templates.Get(c.Context(), c.Params("template_id"), userID)
+	if err != nil {
+		return templateError(c, err)
+	}
+	var req dto.CreateGiveawayRequest
+	if err := json.Unmarshal(t.Config, &req); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "invalid template"})
+	}
+	if len(c.Body()) > 0 {
+		if err := json.Unmarshal(c.Body(), &req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	return h.createFromReq(c, req, nil)
+}
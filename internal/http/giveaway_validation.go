@@ -0,0 +1,231 @@
+package http
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/joinconfirm"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// Payload limits checked before a giveaway is built; the service re-checks what it relies on.
+const (
+	maxGiveawayTitleLen   = 100
+	maxPrizeTitleLen      = 20
+	minDurationSeconds    = 5 * 60
+	maxDurationSeconds    = 60 * 24 * 60 * 60 // 2 months
+	maxBonusTicketsPerReq = 10
+	maxAccountsPerDevice  = 100
+	maxBundlesPerGiveaway = 5
+	maxRulesLen           = 2000
+	maxFAQEntries         = 10
+	maxFAQQuestionLen     = 200
+	maxFAQAnswerLen       = 1000
+	maxTranslations       = 10
+	maxPrizePackages      = 10
+	maxPackageItems       = 10
+	maxPackageLabelLen    = 32
+	maxSponsorTitleLen    = 128 // longest channel title Telegram allows
+	// Totals above this many units per winner are rejected as typos
+	maxPrizeUnitsPerWinner = 1000
+)
+
+// requestLocale picks the message locale from ?locale=, the language the caller picked in
+// the settings, their Telegram language, then Accept-Language, falling back to English.
+func requestLocale(c *fiber.Ctx) *i18n.Locale {
+	preferred, _ := c.Locals(mw.PreferredLanguageCtxParam).(string)
+	lang, _ := c.Locals(mw.LanguageCodeCtxParam).(string)
+	accept := c.Get(fiber.HeaderAcceptLanguage)
+	if i := strings.IndexAny(accept, ",;"); i >= 0 {
+		accept = accept[:i]
+	}
+	return i18n.Match(c.Query("locale"), preferred, lang, accept)
+}
+
+// requestGiveawayLanguage returns g with the title and description of the ?lang= translation,
+// falling back to the requester's preferred and Telegram languages.
+func requestGiveawayLanguage(c *fiber.Ctx, g *dg.Giveaway) *dg.Giveaway {
+	preferred, _ := c.Locals(mw.PreferredLanguageCtxParam).(string)
+	lang, _ := c.Locals(mw.LanguageCodeCtxParam).(string)
+	return g.Localized(c.Query("lang"), preferred, lang)
+}
+
+// validationFailed responds 400 with every failed rule; "error" keeps the first message for
+// clients that read a single error.
+func validationFailed(c *fiber.Ctx, v *validate.Errors) error {
+	return c.Status(fiber.StatusBadRequest).JSON(dto.Error{Error: v.Error(), Errors: v.List()})
+}
+
+// validateCreate checks a create payload before the giveaway is built.
+func validateCreate(v *validate.Errors, req *dto.CreateGiveawayRequest) {
+	v.Required("title", strings.TrimSpace(req.Title))
+	v.MaxLen("title", req.Title, maxGiveawayTitleLen)
+	switch {
+	case req.Duration < 0:
+		v.Add("duration", "not_negative")
+	case req.Duration < minDurationSeconds:
+		v.Add("duration", "duration_min")
+	case req.Duration > maxDurationSeconds:
+		v.Add("duration", "duration_max")
+	}
+	v.Min("winners_count", int64(req.WinnersCount), 1)
+	v.NotNegative("escrow_amount_nano", req.EscrowAmountNano)
+	v.Between("max_accounts_per_fingerprint", int64(req.MaxAccountsPerFingerprint), 0, maxAccountsPerDevice)
+	if req.MaxParticipants != nil {
+		v.Min("max_participants", int64(*req.MaxParticipants), int64(max(req.WinnersCount, 1)))
+	}
+	units := 0
+	for i := range req.Prizes {
+		validatePrize(v, "prizes", &req.Prizes[i], i)
+		units += max(req.Prizes[i].Quantity, 1)
+	}
+	units += validatePrizePackages(v, req)
+	if req.WinnersCount > 0 {
+		v.Check(units <= req.WinnersCount*maxPrizeUnitsPerWinner, "prizes", "prize_units_max", maxPrizeUnitsPerWinner)
+	}
+	for i := range req.Requirements {
+		validateRequirement(v, &req.Requirements[i], i)
+	}
+	v.Max("bundle_ids", int64(len(req.BundleIDs)), maxBundlesPerGiveaway)
+	req.Rules = strings.TrimSpace(req.Rules)
+	v.MaxLen("rules", req.Rules, maxRulesLen)
+	v.Check(!req.AnnounceRules || req.Rules != "", "announce_rules", "requires", "rules")
+	v.Max("faq", int64(len(req.FAQ)), maxFAQEntries)
+	for i := range req.FAQ {
+		q := &req.FAQ[i]
+		q.Question, q.Answer = strings.TrimSpace(q.Question), strings.TrimSpace(q.Answer)
+		v.Required(validate.Field("faq", i, "question"), q.Question)
+		v.MaxLen(validate.Field("faq", i, "question"), q.Question, maxFAQQuestionLen)
+		v.Required(validate.Field("faq", i, "answer"), q.Answer)
+		v.MaxLen(validate.Field("faq", i, "answer"), q.Answer, maxFAQAnswerLen)
+	}
+	if req.JoinConfirmation != nil {
+		req.JoinConfirmation.Rules = strings.TrimSpace(req.JoinConfirmation.Rules)
+		v.MaxLen("join_confirmation.rules", req.JoinConfirmation.Rules, joinconfirm.MaxRulesLength)
+	}
+	validateTranslations(v, req)
+	validateTags(v, req)
+}
+
+// validateTags checks the category and tags of a create payload against the vocabulary,
+// normalizing the tags.
+func validateTags(v *validate.Errors, req *dto.CreateGiveawayRequest) {
+	if !req.Category.Valid() {
+		names := make([]string, len(dg.Categories))
+		for i, c := range dg.Categories {
+			names[i] = string(c)
+		}
+		v.Add("category", "one_of", strings.Join(names, ", "))
+	}
+	req.Tags = dg.NormalizeTags(req.Tags)
+	v.Max("tags", int64(len(req.Tags)), dg.MaxTags)
+	for i, t := range req.Tags {
+		v.Check(dg.ValidTag(t), fmt.Sprintf("tags[%d]", i), "one_of", strings.Join(dg.Tags, ", "))
+	}
+}
+
+// validateTranslations checks the translations of a create payload and keys them by their
+// normalized language code.
+func validateTranslations(v *validate.Errors, req *dto.CreateGiveawayRequest) {
+	if len(req.Translations) == 0 {
+		return
+	}
+	v.Max("translations", int64(len(req.Translations)), maxTranslations)
+	keys := make([]string, 0, len(req.Translations))
+	for k := range req.Translations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make(map[string]dto.TranslationRequest, len(keys))
+	for _, k := range keys {
+		field := "translations." + k
+		t := req.Translations[k]
+		t.Title, t.Description = strings.TrimSpace(t.Title), strings.TrimSpace(t.Description)
+		tag := dg.NormalizeLanguage(k)
+		if !dg.ValidLanguage(tag) {
+			v.Add(field, "language")
+			continue
+		}
+		if _, dup := out[tag]; dup {
+			v.Add(field, "duplicate")
+			continue
+		}
+		v.Check(t.Title != "" || t.Description != "", field, "required")
+		v.MaxLen(field+".title", t.Title, maxGiveawayTitleLen)
+		out[tag] = t
+	}
+	req.Translations = out
+}
+
+func validatePrize(v *validate.Errors, list string, p *dto.CreatePrizeRequest, i int) {
+	v.MaxLen(validate.Field(list, i, "title"), p.Title, maxPrizeTitleLen)
+	v.NotNegative(validate.Field(list, i, "quantity"), int64(p.Quantity))
+}
+
+// validatePrizePackages checks the prize packages of a create payload and returns the prize
+// units they hand out to the drawn places.
+func validatePrizePackages(v *validate.Errors, req *dto.CreateGiveawayRequest) int {
+	v.Max("prize_packages", int64(len(req.PrizePackages)), maxPrizePackages)
+	units := 0
+	for i := range req.PrizePackages {
+		p := &req.PrizePackages[i]
+		p.Label = strings.TrimSpace(p.Label)
+		v.Required(validate.Field("prize_packages", i, "label"), p.Label)
+		v.MaxLen(validate.Field("prize_packages", i, "label"), p.Label, maxPackageLabelLen)
+		v.Min(validate.Field("prize_packages", i, "from_place"), int64(p.FromPlace), 1)
+		v.Between(validate.Field("prize_packages", i, "to_place"), int64(p.ToPlace), int64(max(p.FromPlace, 1)), int64(max(req.WinnersCount, 1)))
+		for j := range i {
+			q := req.PrizePackages[j]
+			v.Check(p.ToPlace < q.FromPlace || p.FromPlace > q.ToPlace, validate.Field("prize_packages", i, "from_place"), "places_overlap", j)
+		}
+		list := fmt.Sprintf("prize_packages[%d].items", i)
+		v.Min(list, int64(len(p.Items)), 1)
+		v.Max(list, int64(len(p.Items)), maxPackageItems)
+		perWinner := 0
+		for k := range p.Items {
+			v.Required(validate.Field(list, k, "title"), strings.TrimSpace(p.Items[k].Title))
+			validatePrize(v, list, &p.Items[k], k)
+			perWinner += max(p.Items[k].Quantity, 1)
+		}
+		if p.FromPlace >= 1 && p.ToPlace >= p.FromPlace {
+			units += perWinner * (p.ToPlace - p.FromPlace + 1)
+		}
+	}
+	return units
+}
+
+func validateRequirement(v *validate.Errors, r *dto.CreateRequirementRequest, i int) {
+	v.Between(validate.Field("requirements", i, "bonus_tickets"), int64(r.BonusTickets), 0, maxBonusTicketsPerReq)
+	v.NotNegative(validate.Field("requirements", i, "position"), int64(r.Position))
+	if t, ok := requirements.Lookup(r.Type); ok {
+		t.Validate(v, i, requirementFromRequest(*r))
+	}
+}
+
+// requirementFromRequest copies a submitted requirement for its type's module; the display
+// name goes to Title and enrichment moves it where the type keeps it.
+func requirementFromRequest(r dto.CreateRequirementRequest) dg.Requirement {
+	return dg.Requirement{
+		Type:                r.Type,
+		ChannelID:           r.ChannelID,
+		ChannelUsername:     r.ChannelUsername,
+		AvatarURL:           r.AvatarURL,
+		Title:               r.Name,
+		Description:         r.Description,
+		TonMinBalanceNano:   r.TonMinBalanceNano,
+		JettonAddress:       r.JettonAddress,
+		JettonMinAmount:     r.JettonMinAmount,
+		AccountAgeMinYear:   r.AccountAgeMinYear,
+		AccountAgeMaxYear:   r.AccountAgeMaxYear,
+		ExistingMembersOnly: r.ExistingMembersOnly,
+		MinMemberDays:       r.MinMemberDays,
+	}
+}
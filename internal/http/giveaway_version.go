@@ -0,0 +1,49 @@
+package http
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+// ifMatch reads If-Match: the version as sent in ETag ("3" or W/"3") or the giveaway's
+// updated_at in RFC 3339. A missing header or "*" matches any state.
+func ifMatch(c *fiber.Ctx) (dg.EditPrecondition, error) {
+	raw := strings.TrimSpace(c.Get(fiber.HeaderIfMatch))
+	if raw == "" || raw == "*" {
+		return dg.EditPrecondition{}, nil
+	}
+	raw = strings.Trim(strings.TrimPrefix(raw, "W/"), `"`)
+	if v, err := strconv.ParseInt(raw, 10, 64); err == nil && v > 0 {
+		return dg.EditPrecondition{Version: v}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+		return dg.EditPrecondition{UpdatedAt: t}, nil
+	}
+	return dg.EditPrecondition{}, errors.New("invalid If-Match")
+}
+
+func setVersionETag(c *fiber.Ctx, version int64) {
+	c.Set(fiber.HeaderETag, `"`+strconv.FormatInt(version, 10)+`"`)
+}
+
+// edit runs a change of giveaway :id under the request's If-Match and sets the new ETag.
+// Only the creator may edit. A stale If-Match fails with "version conflict".
+func (h *GiveawayHandlersFiber) edit(c *fiber.Ctx, fn func() error) error {
+	pre, err := ifMatch(c)
+	if err != nil {
+		return err
+	}
+	version, err := h.service.Edit(c.Context(), c.Params("id"), middleware.GetUserID(c), pre, fn)
+	if err != nil {
+		return err
+	}
+	setVersionETag(c, version)
+	return nil
+}
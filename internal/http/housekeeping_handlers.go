@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/housekeeping"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// HousekeepingHandlers lets platform admins inspect and trigger Redis key cleanup.
+type HousekeepingHandlers struct {
+	service *housekeeping.Service
+	users   *usersvc.Service
+}
+
+func NewHousekeepingHandlers(svc *housekeeping.Service, users *usersvc.Service) *HousekeepingHandlers {
+	return &HousekeepingHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *HousekeepingHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/admin/housekeeping", h.stats)
+	r.Post("/admin/housekeeping/run", h.run)
+}
+
+func (h *HousekeepingHandlers) stats(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	st, err := h.service.Stats(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(st)
+}
+
+func (h *HousekeepingHandlers) run(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	res, err := h.service.Run(c.Context())
+	if err != nil {
+		if err.Error() == "already running" {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(res)
+}
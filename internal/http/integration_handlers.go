@@ -0,0 +1,112 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/integrations"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// IntegrationHandlers manages API keys and serves polling triggers for no-code platforms
+// such as Zapier and Make.
+type IntegrationHandlers struct {
+	service *integrations.Service
+}
+
+func NewIntegrationHandlers(svc *integrations.Service) *IntegrationHandlers {
+	return &IntegrationHandlers{service: svc}
+}
+
+// RegisterFiber registers API key management for the signed-in creator.
+func (h *IntegrationHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/integrations/api-keys", h.createKey)
+	r.Get("/integrations/api-keys", h.listKeys)
+	r.Delete("/integrations/api-keys/:id", h.deleteKey)
+}
+
+// RegisterAPIKeyFiber registers polling triggers on a router authenticated by API key.
+func (h *IntegrationHandlers) RegisterAPIKeyFiber(r fiber.Router) {
+	r.Get("/new-participants", h.newParticipants)
+	r.Get("/new-winners", h.newWinners)
+}
+
+type createAPIKeyReq struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (h *IntegrationHandlers) createKey(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req createAPIKeyReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+		}
+	}
+	k, err := h.service.CreateAPIKey(c.Context(), userID, req.Name)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusCreated).JSON(k)
+}
+
+func (h *IntegrationHandlers) listKeys(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	items, err := h.service.ListAPIKeys(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"api_keys": items})
+}
+
+func (h *IntegrationHandlers) deleteKey(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.DeleteAPIKey(c.Context(), c.Params("id"), userID); err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *IntegrationHandlers) newParticipants(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	limit := parseLimit(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	page, err := h.service.NewParticipants(c.Context(), middleware.GetUserID(c), c.Query("since"), limit)
+	if err != nil {
+		return integrationFeedError(c, err)
+	}
+	return c.JSON(page)
+}
+
+func (h *IntegrationHandlers) newWinners(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	limit := parseLimit(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	page, err := h.service.NewWinners(c.Context(), middleware.GetUserID(c), c.Query("since"), limit)
+	if err != nil {
+		return integrationFeedError(c, err)
+	}
+	return c.JSON(page)
+}
+
+func integrationFeedError(c *fiber.Ctx, err error) error {
+	if err.Error() == "invalid since" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func inviteLinkError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found", "invite link not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "too many invite links":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// listInviteLinks returns the invite links of a giveaway with the joins each drove, most
+// joins first. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) listInviteLinks(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	links, err := h.service.ListInviteLinks(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return inviteLinkError(c, err)
+	}
+	return c.JSON(links)
+}
+
+// createInviteLink generates the startapp link of one source. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) createInviteLink(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.CreateInviteLinkRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	v.Required("name", strings.TrimSpace(req.Name))
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	link, err := h.service.CreateInviteLink(c.Context(), c.Params("id"), requesterID, req.Name)
+	if err != nil {
+		return inviteLinkError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(link)
+}
+
+// deleteInviteLink removes an invite link; recorded joins keep their attribution.
+// Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) deleteInviteLink(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.DeleteInviteLink(c.Context(), c.Params("id"), requesterID, c.Params("name")); err != nil {
+		return inviteLinkError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+func joinConfirmationError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); {
+	case msg == "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case msg == "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case msg == "version conflict", msg == "giveaway ended":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	case strings.HasPrefix(msg, "rules"), msg == "invalid If-Match":
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
+
+// joinConfirmation returns the join confirmation settings. Access: creator only.
+func (h *GiveawayHandlersFiber) joinConfirmation(c *fiber.Ctx) error {
+	if h.joins == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{"error": "join confirmations are not available"})
+	}
+	jc, err := h.joins.Get(c.Context(), c.Params("id"), middleware.GetUserID(c))
+	if err != nil {
+		return joinConfirmationError(c, err)
+	}
+	return c.JSON(jc)
+}
+
+// setJoinConfirmation replaces the join confirmation settings under If-Match. Access: creator only.
+func (h *GiveawayHandlersFiber) setJoinConfirmation(c *fiber.Ctx) error {
+	if h.joins == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{"error": "join confirmations are not available"})
+	}
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body dto.JoinConfirmationRequest
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	jc := dg.JoinConfirmation{Enabled: body.Enabled, Rules: body.Rules}
+	if err := h.edit(c, func() error { return h.joins.Set(c.Context(), c.Params("id"), requesterID, jc) }); err != nil {
+		return joinConfirmationError(c, err)
+	}
+	saved, err := h.joins.Get(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return joinConfirmationError(c, err)
+	}
+	return c.JSON(saved)
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	ledgersvc "github.com/open-builders/giveaway-backend/internal/service/ledger"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// LedgerHandlers exposes the ticket ledger to giveaway creators and platform admins.
+type LedgerHandlers struct {
+	service *ledgersvc.Service
+	users   *usersvc.Service
+}
+
+func NewLedgerHandlers(svc *ledgersvc.Service, users *usersvc.Service) *LedgerHandlers {
+	return &LedgerHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers ledger routes.
+func (h *LedgerHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/giveaways/:id/ledger", h.giveawayEntries)
+	r.Get("/giveaways/:id/refunds", h.giveawayRefunds)
+	r.Get("/me/refunds", h.myRefunds)
+	r.Get("/admin/ledger/accounts/:account", h.accountEntries)
+	r.Post("/admin/ledger/grants", h.grant)
+	r.Get("/admin/ledger/reconcile", h.reconcile)
+}
+
+// giveawayEntries lists every ticket movement of a giveaway, newest first. Access: creator or platform admins.
+func (h *LedgerHandlers) giveawayEntries(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	userID := mw.GetUserID(c)
+	admin := isPlatformAdmin(c.Context(), h.users, userID)
+	list, err := h.service.GiveawayEntries(c.Context(), c.Params("id"), userID, admin, pg.Limit, pg.Offset)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// giveawayRefunds reports refunds of purchased tickets after a cancellation. Access: creator or platform admins.
+func (h *LedgerHandlers) giveawayRefunds(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	userID := mw.GetUserID(c)
+	admin := isPlatformAdmin(c.Context(), h.users, userID)
+	sum, list, err := h.service.GiveawayRefunds(c.Context(), c.Params("id"), userID, admin, pg.Limit, pg.Offset)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"summary": sum, "refunds": list})
+}
+
+// myRefunds lists refunds owed to the current user, newest first.
+func (h *LedgerHandlers) myRefunds(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.MyRefunds(c.Context(), mw.GetUserID(c), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// accountEntries lists the entries of one account, e.g. tickets:<giveaway>:<user>. Access: platform admins.
+func (h *LedgerHandlers) accountEntries(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.AccountEntries(c.Context(), c.Params("account"), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// grant adds or deducts tickets of a participant. The Idempotency-Key header may stand in for
+// idempotency_key in the body. Access: platform admins.
+func (h *LedgerHandlers) grant(c *fiber.Ctx) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	var req ledgersvc.Grant
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = c.Get("Idempotency-Key")
+	}
+	res, err := h.service.GrantTickets(c.Context(), adminID, req)
+	if err != nil {
+		switch {
+		case err.Error() == "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case err.Error() == "giveaway not active", err.Error() == "not participant", err.Error() == "insufficient balance":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case strings.HasPrefix(err.Error(), "idempotency_key"), strings.HasPrefix(err.Error(), "tickets"), strings.HasPrefix(err.Error(), "memo"):
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	status := fiber.StatusCreated
+	if !res.Posted {
+		// Replayed key: nothing changed
+		status = fiber.StatusOK
+	}
+	return c.Status(status).JSON(res)
+}
+
+// reconcile reports balances that disagree with the ledger entries. Access: platform admins.
+func (h *LedgerHandlers) reconcile(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	list, err := h.service.Reconcile(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"ok": len(list) == 0, "discrepancies": list})
+}
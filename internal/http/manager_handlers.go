@@ -0,0 +1,106 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func managerError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found", "manager not found", "user not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case "too many managers":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// listManagers returns the managers of a giveaway. Access: creator and managers.
+func (h *GiveawayHandlersFiber) listManagers(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	list, err := h.service.ListManagers(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		return managerError(c, err)
+	}
+	return c.JSON(list)
+}
+
+// addManager grants a user the manager role and returns the managers. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) addManager(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req dto.AddManagerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	req.Username = strings.TrimPrefix(strings.TrimSpace(req.Username), "@")
+	v := validate.New(requestLocale(c))
+	v.Check(req.UserID != 0 || req.Username != "", "user_id", "required")
+	v.NotNegative("user_id", req.UserID)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	userID := req.UserID
+	if userID == 0 {
+		if h.users == nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "user service not configured"})
+		}
+		u, err := h.users.GetByUsername(c.Context(), req.Username)
+		if err != nil || u == nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "user not found"})
+		}
+		userID = u.ID
+	}
+	list, err := h.service.AddManager(c.Context(), c.Params("id"), requesterID, userID)
+	if err != nil {
+		return managerError(c, err)
+	}
+	return c.JSON(list)
+}
+
+// removeManager revokes the manager role. Access: giveaway owner, or the manager stepping down.
+func (h *GiveawayHandlersFiber) removeManager(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	userID, err := strconv.ParseInt(c.Params("user_id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id"})
+	}
+	if err := h.service.RemoveManager(c.Context(), c.Params("id"), requesterID, userID); err != nil {
+		return managerError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// listManaged returns the published giveaways the current user manages, newest first.
+func (h *GiveawayHandlersFiber) listManaged(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 100, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListManagedBy(c.Context(), userID, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
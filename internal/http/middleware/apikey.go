@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"context"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// APIKeyMiddleware authenticates integration calls by an API key and stores the key owner as the
+// user id, so handlers read it with GetUserID. The key is taken from the "X-API-Key" header or,
+// for platforms that cannot set headers, the "api_key" query parameter.
+func APIKeyMiddleware(resolve func(ctx context.Context, key string) (int64, error)) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get("X-API-Key")
+		if key == "" {
+			key = c.Query("api_key")
+		}
+		if key == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "missing api key"})
+		}
+		userID, err := resolve(c.Context(), key)
+		if err != nil {
+			log.Printf("api key lookup: %v", err)
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "internal error"})
+		}
+		if userID == 0 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "invalid api key"})
+		}
+		c.Locals(UserIdCtxParam, userID)
+		return c.Next()
+	}
+}
@@ -17,6 +17,7 @@ const (
 	UserPicCtxParam      = "photo_url"
 	IsPremiumCtxParam    = "is_premium"
 	LanguageCodeCtxParam = "language_code"
+	StartParamCtxParam   = "start_param"
 )
 
 // InitDataMiddleware validates Telegram Mini Apps init-data and stores parsed fields in context.
@@ -65,11 +66,20 @@ func InitDataMiddleware(token string, expIn time.Duration) fiber.Handler {
 			c.Locals(IsPremiumCtxParam, parsed.User.IsPremium)
 			c.Locals(LanguageCodeCtxParam, parsed.User.LanguageCode)
 		}
+		if parsed.StartParam != "" {
+			c.Locals(StartParamCtxParam, parsed.StartParam)
+		}
 
 		return c.Next()
 	}
 }
 
+// GetStartParam returns the startapp payload the mini app was opened with, if any.
+func GetStartParam(c *fiber.Ctx) string {
+	v, _ := c.Locals(StartParamCtxParam).(string)
+	return v
+}
+
 // GetUserID returns the Telegram user id from context locals, supporting multiple stored types.
 func GetUserID(c *fiber.Ctx) int64 {
 	v := c.Locals(UserIdCtxParam)
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PreferredLanguageCtxParam holds the language the user picked in the app settings.
+const PreferredLanguageCtxParam = "preferred_language"
+
+// PreferredLanguageMiddleware stores the picked language of the authenticated user, so it
+// overrides the Telegram client language when responses are localized. Runs after the
+// init-data middleware; anonymous requests pass through unchanged.
+func PreferredLanguageMiddleware(resolve func(ctx context.Context, userID int64) string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if id := GetUserID(c); id != 0 {
+			if tag := resolve(c.Context(), id); tag != "" {
+				c.Locals(PreferredLanguageCtxParam, tag)
+			}
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,132 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// ModerationHandlers exposes the review queue of flagged giveaway content to platform admins.
+type ModerationHandlers struct {
+	service *gsvc.Service
+	users   *usersvc.Service
+}
+
+func NewModerationHandlers(svc *gsvc.Service, users *usersvc.Service) *ModerationHandlers {
+	return &ModerationHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *ModerationHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/admin/moderation", h.list)
+	r.Post("/admin/moderation/:id/approve", h.approve)
+	r.Post("/admin/moderation/:id/remove", h.remove)
+	r.Get("/admin/sponsor-overrides", h.listSponsorOverrides)
+	r.Delete("/admin/sponsor-overrides/:giveaway_id/:channel_id", h.clearSponsorOverride)
+}
+
+// list returns flags, pending ones by default (?status=approved|removed for history).
+func (h *ModerationHandlers) list(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	status := queryEnum(c, v, "status", dg.ModerationPending, dg.ModerationPending, dg.ModerationApproved, dg.ModerationRemoved)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	flags, err := h.service.ListModerationFlags(c.Context(), status, pg.Limit, pg.Offset)
+	if err != nil {
+		if err.Error() == "invalid status" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if flags == nil {
+		flags = []dg.ModerationFlag{}
+	}
+	return c.JSON(flags)
+}
+
+// listSponsorOverrides lists sponsors whose display the creator replaced, with the Telegram
+// data next to the override, latest change first.
+func (h *ModerationHandlers) listSponsorOverrides(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ListSponsorOverrides(c.Context(), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// clearSponsorOverride restores the Telegram title and avatar of a sponsor.
+func (h *ModerationHandlers) clearSponsorOverride(c *fiber.Ctx) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	channelID, err := strconv.ParseInt(c.Params("channel_id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid channel_id"})
+	}
+	if err := h.service.ClearSponsorOverride(c.Context(), c.Params("giveaway_id"), channelID, adminID); err != nil {
+		if err.Error() == "sponsor not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+type removeFlaggedReq struct {
+	Reason string `json:"reason"`
+}
+
+// approve keeps the giveaway and closes its pending flags.
+func (h *ModerationHandlers) approve(c *fiber.Ctx) error {
+	return h.resolve(c, false, "")
+}
+
+// remove cancels the giveaway with an optional reason shown to participants.
+func (h *ModerationHandlers) remove(c *fiber.Ctx) error {
+	var req removeFlaggedReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	return h.resolve(c, true, req.Reason)
+}
+
+func (h *ModerationHandlers) resolve(c *fiber.Ctx, remove bool, reason string) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	if err := h.service.ResolveModeration(c.Context(), c.Params("id"), adminID, remove, reason); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "transition not allowed", "nothing to review":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "missing id", "reason too long":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// ParticipantImportHandlers lets platform admins bulk-import participants migrated from other platforms.
+type ParticipantImportHandlers struct {
+	service *gsvc.Service
+	users   *usersvc.Service
+}
+
+func NewParticipantImportHandlers(svc *gsvc.Service, users *usersvc.Service) *ParticipantImportHandlers {
+	return &ParticipantImportHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *ParticipantImportHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/admin/giveaways/:id/participants/import", h.importParticipants)
+	r.Get("/admin/giveaways/:id/participants/import", h.progress)
+}
+
+// importParticipants reads an NDJSON body, one {"user_id", "first_name", "joined_at"} object per line.
+// Large bodies are streamed instead of buffered, up to maxParticipantImportBody; a chunked body
+// going past it fails the import with 413.
+func (h *ParticipantImportHandlers) importParticipants(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	var body io.Reader
+	if s := c.Context().RequestBodyStream(); s != nil {
+		body = &limitedReader{r: s, n: maxParticipantImportBody}
+	} else {
+		body = bytes.NewReader(c.Body())
+	}
+	p, err := h.service.ImportParticipants(c.Context(), c.Params("id"), body)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "giveaway is not running", "import in progress":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		}
+		if errors.Is(err, errBodyTooLarge) {
+			c.Context().SetConnectionClose()
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(p)
+		}
+		if p != nil {
+			// Batches stored before the failure are kept; report how far the import got
+			return c.Status(fiber.StatusBadRequest).JSON(p)
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(p)
+}
+
+func (h *ParticipantImportHandlers) progress(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	p, err := h.service.ParticipantImportProgress(c.Context(), c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if p == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	return c.JSON(p)
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// Paging limits of list endpoints; larger values are rejected instead of reaching SQL.
+const (
+	maxPageLimit  = 100
+	maxPageOffset = 100000
+)
+
+// page is a validated ?limit=&offset= pair.
+type page struct {
+	Limit  int
+	Offset int
+}
+
+// parsePage reads ?limit= (default def, 1..max) and ?offset= (default 0, 0..maxPageOffset),
+// recording failures in v.
+func parsePage(c *fiber.Ctx, v *validate.Errors, def, max int) page {
+	p := page{Limit: parseLimit(c, v, def, max), Offset: queryInt(c, v, "offset", 0)}
+	v.Between("offset", int64(p.Offset), 0, maxPageOffset)
+	return p
+}
+
+// parseLimit reads ?limit= (default def, 1..max) for endpoints paged by cursor.
+func parseLimit(c *fiber.Ctx, v *validate.Errors, def, max int) int {
+	limit := queryInt(c, v, "limit", def)
+	v.Between("limit", int64(limit), 1, int64(max))
+	return limit
+}
+
+// queryInt reads an integer query parameter, def when absent.
+func queryInt(c *fiber.Ctx, v *validate.Errors, name string, def int) int {
+	raw := strings.TrimSpace(c.Query(name))
+	if raw == "" {
+		return def
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		v.Add(name, "integer")
+		return def
+	}
+	return n
+}
+
+// queryEnum reads a query parameter that must be one of allowed, def when absent.
+func queryEnum[T ~string](c *fiber.Ctx, v *validate.Errors, name string, def T, allowed ...T) T {
+	raw := strings.TrimSpace(c.Query(name))
+	if raw == "" {
+		return def
+	}
+	for _, a := range allowed {
+		if string(a) == raw {
+			return a
+		}
+	}
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	v.Add(name, "one_of", strings.Join(names, ", "))
+	return def
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// RedisHandlers lets platform admins check the health of the Redis shards.
+type RedisHandlers struct {
+	shards *redisp.Shards
+	users  *usersvc.Service
+}
+
+func NewRedisHandlers(shards *redisp.Shards, users *usersvc.Service) *RedisHandlers {
+	return &RedisHandlers{shards: shards, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *RedisHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/admin/redis/shards", h.shardStats)
+}
+
+// shardStats reports latency, key count, memory and connection pool of every shard.
+func (h *RedisHandlers) shardStats(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	stats := h.shards.Stats(c.Context())
+	healthy := 0
+	for _, st := range stats {
+		if st.OK {
+			healthy++
+		}
+	}
+	return c.JSON(fiber.Map{"shards": stats, "healthy": healthy})
+}
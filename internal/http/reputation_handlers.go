@@ -0,0 +1,188 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// ReputationHandlers exposes giveaway reports and prize disputes to users, and creator
+// reputation plus the approval queue of held giveaways to platform admins.
+type ReputationHandlers struct {
+	service *gsvc.Service
+	users   *usersvc.Service
+}
+
+func NewReputationHandlers(svc *gsvc.Service, users *usersvc.Service) *ReputationHandlers {
+	return &ReputationHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers user routes and admin-only routes.
+func (h *ReputationHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/giveaways/:id/report", h.report)
+	r.Post("/giveaways/:id/my-prize/dispute", h.dispute)
+	r.Get("/admin/reputation", h.list)
+	r.Get("/admin/users/:id/reputation", h.get)
+	r.Get("/admin/approvals", h.listApprovals)
+	r.Post("/admin/approvals/:id/approve", h.approve)
+	r.Post("/admin/approvals/:id/reject", h.reject)
+}
+
+type reportReq struct {
+	Reason string `json:"reason"`
+}
+
+// report flags a giveaway as abusive; each user can report a giveaway once.
+func (h *ReputationHandlers) report(c *fiber.Ctx) error {
+	userID := mw.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req reportReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	if err := h.service.ReportGiveaway(c.Context(), c.Params("id"), userID, req.Reason); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "cannot report own giveaway":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "already reported":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "missing id", "reason too long":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// dispute lets a winner flag a claimed prize that was not delivered.
+func (h *ReputationHandlers) dispute(c *fiber.Ctx) error {
+	userID := mw.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	if err := h.service.DisputePrize(c.Context(), c.Params("id"), userID); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "not winner":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		case "not claimed", "already delivered", "already disputed":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "missing id":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// list returns scored creators, lowest reputation first.
+func (h *ReputationHandlers) list(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	reps, err := h.service.ListReputations(c.Context(), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if reps == nil {
+		reps = []dg.Reputation{}
+	}
+	return c.JSON(reps)
+}
+
+// get recomputes and returns the reputation of one creator.
+func (h *ReputationHandlers) get(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	creatorID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || creatorID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+	rep, err := h.service.RefreshReputation(c.Context(), creatorID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(rep)
+}
+
+// listApprovals returns held giveaways, awaiting ones by default (?status=approved|rejected|expired).
+func (h *ReputationHandlers) listApprovals(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 50, maxPageLimit)
+	status := queryEnum(c, v, "status", dg.ApprovalAwaiting, dg.ApprovalAwaiting, dg.ApprovalApproved, dg.ApprovalRejected, dg.ApprovalExpired)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	items, err := h.service.ListApprovals(c.Context(), status, pg.Limit, pg.Offset)
+	if err != nil {
+		if err.Error() == "invalid status" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if items == nil {
+		items = []dg.Approval{}
+	}
+	return c.JSON(items)
+}
+
+type rejectApprovalReq struct {
+	Reason string `json:"reason"`
+}
+
+// approve starts a held giveaway.
+func (h *ReputationHandlers) approve(c *fiber.Ctx) error {
+	return h.review(c, true, "")
+}
+
+// reject cancels a held giveaway with an optional reason shown to participants.
+func (h *ReputationHandlers) reject(c *fiber.Ctx) error {
+	var req rejectApprovalReq
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+		}
+	}
+	return h.review(c, false, req.Reason)
+}
+
+func (h *ReputationHandlers) review(c *fiber.Ctx, approve bool, reason string) error {
+	adminID := mw.GetUserID(c)
+	if !isPlatformAdmin(c.Context(), h.users, adminID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	if err := h.service.ReviewApproval(c.Context(), c.Params("id"), adminID, approve, reason); err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "transition not allowed", "nothing to review":
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+		case "missing id", "reason too long":
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
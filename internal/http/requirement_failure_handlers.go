@@ -0,0 +1,73 @@
+package http
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+)
+
+// requirementName is the localized name of a requirement type.
+func requirementName(loc *i18n.Locale, t dg.RequirementType) string {
+	return loc.T("requirement." + string(t))
+}
+
+// withFailureSummaries fills the summary line of each stats entry in loc.
+func withFailureSummaries(loc *i18n.Locale, stats []dg.RequirementFailureStats) []dg.RequirementFailureStats {
+	for i := range stats {
+		s := &stats[i]
+		if s.Checked == 0 {
+			continue
+		}
+		s.Summary = fmt.Sprintf(loc.T("analytics.requirement_failed"), s.FailedPct, requirementName(loc, s.Type))
+	}
+	return stats
+}
+
+// withHintMessages fills the message of each hint in loc.
+func withHintMessages(loc *i18n.Locale, hints []dg.RequirementHint) []dg.RequirementHint {
+	for i := range hints {
+		h := &hints[i]
+		names := make([]string, len(h.Types))
+		for j, t := range h.Types {
+			names[j] = requirementName(loc, t)
+		}
+		h.Message = fmt.Sprintf(loc.T("analytics.hint_"+string(h.Code)), h.FailedPct, strings.Join(names, ", "))
+	}
+	return hints
+}
+
+// requirementFailures returns how often users failed each requirement of a giveaway.
+// Access: creator only.
+func (h *GiveawayHandlersFiber) requirementFailures(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	stats, err := h.service.RequirementFailures(c.Context(), c.Params("id"), requesterID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(fiber.Map{"requirements": withFailureSummaries(requestLocale(c), stats)})
+}
+
+// requirementFailureRates returns the platform-wide failure rates by requirement type, for
+// creators choosing requirements. Access: any authenticated user.
+func (h *GiveawayHandlersFiber) requirementFailureRates(c *fiber.Ctx) error {
+	stats, err := h.service.GlobalRequirementFailures(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(fiber.Map{"types": withFailureSummaries(requestLocale(c), stats)})
+}
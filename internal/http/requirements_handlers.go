@@ -19,10 +19,18 @@ type RequirementsHandlers struct {
 	users    *usersvc.Service
 	channels *channelsvc.Service
 	ton      *tonb.Service
+	// Chat-info lookups; the client itself unless WithChatInfo set a breaker
+	chatInfo tgsvc.API
 }
 
 func NewRequirementsHandlers(tg *tgsvc.Client, users *usersvc.Service, ton *tonb.Service, channels *channelsvc.Service) *RequirementsHandlers {
-	return &RequirementsHandlers{telegram: tg, users: users, ton: ton, channels: channels}
+	return &RequirementsHandlers{telegram: tg, chatInfo: tg, users: users, ton: ton, channels: channels}
+}
+
+// WithChatInfo routes chat-info lookups through api, e.g. a tgsvc.ChatInfoBreaker.
+func (h *RequirementsHandlers) WithChatInfo(api tgsvc.API) *RequirementsHandlers {
+	h.chatInfo = api
+	return h
 }
 
 func (h *RequirementsHandlers) RegisterFiber(r fiber.Router) {
@@ -54,7 +62,9 @@ type checkBulkItem struct {
 	Username string `json:"username"`
 	Ok       bool   `json:"ok"`
 	Error    string `json:"error,omitempty"`
-	Channel  struct {
+	// Stale marks channel info served from memory while the chat keeps failing
+	Stale   bool `json:"stale,omitempty"`
+	Channel struct {
 		ID        int64  `json:"id"`
 		Type      string `json:"type"`
 		Title     string `json:"title"`
@@ -91,9 +101,10 @@ func (h *RequirementsHandlers) checkBotMembershipBulk(c *fiber.Ctx) error {
 			chat = "@" + chat
 		}
 		// Fetch channel public info
-		ch, errInfo := h.telegram.GetPublicChannelInfo(c.Context(), chat)
+		ch, errInfo := h.chatInfo.GetPublicChannelInfo(c.Context(), chat)
 		item := checkBulkItem{Username: uname}
 		if errInfo == nil && ch != nil {
+			item.Stale = ch.Stale
 			item.Channel.ID = ch.ID
 			item.Channel.Type = ch.Type
 			item.Channel.Title = ch.Title
@@ -130,7 +141,8 @@ func (h *RequirementsHandlers) checkBotMembershipBulk(c *fiber.Ctx) error {
 		}
 		item := checkBulkItem{Username: ""}
 
-		if ch, errInfo := h.telegram.GetPublicChannelInfoByID(c.Context(), intID); errInfo == nil && ch != nil {
+		if ch, errInfo := h.chatInfo.GetPublicChannelInfoByID(c.Context(), intID); errInfo == nil && ch != nil {
+			item.Stale = ch.Stale
 			item.Channel.ID = ch.ID
 			item.Channel.Type = ch.Type
 			item.Channel.Title = ch.Title
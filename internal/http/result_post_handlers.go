@@ -0,0 +1,99 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+)
+
+type setResultPostsReq struct {
+	ChannelIDs []int64 `json:"channel_ids"`
+	DelaySec   int     `json:"delay_sec"`
+	ImageURL   string  `json:"image_url"`
+}
+
+type editResultPostReq struct {
+	Caption string `json:"caption"`
+}
+
+// resultPostError maps result post service errors to HTTP statuses.
+func resultPostError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	case "not posted", "giveaway is cancelled":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+}
+
+// setResultPosts schedules the winners announcement in sponsor channels. Access: creator only.
+func (h *GiveawayHandlersFiber) setResultPosts(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req setResultPostsReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	posts, err := h.service.SetResultPosts(c.Context(), c.Params("id"), userID, req.ChannelIDs, req.DelaySec, req.ImageURL)
+	if err != nil {
+		return resultPostError(c, err)
+	}
+	return c.JSON(fiber.Map{"result_posts": posts})
+}
+
+// listResultPosts returns the scheduled and delivered announcements per channel. Access: creator only.
+func (h *GiveawayHandlersFiber) listResultPosts(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	posts, err := h.service.ResultPosts(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		return resultPostError(c, err)
+	}
+	return c.JSON(fiber.Map{"result_posts": posts})
+}
+
+// editResultPost replaces the caption of a posted announcement; an empty caption re-renders winners.
+func (h *GiveawayHandlersFiber) editResultPost(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	channelID, err := strconv.ParseInt(c.Params("channel_id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid channel_id"})
+	}
+	var req editResultPostReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	post, err := h.service.EditResultPost(c.Context(), c.Params("id"), userID, channelID, req.Caption)
+	if err != nil {
+		return resultPostError(c, err)
+	}
+	return c.JSON(post)
+}
+
+// deleteResultPost deletes a posted announcement from the channel or cancels a scheduled one.
+func (h *GiveawayHandlersFiber) deleteResultPost(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	channelID, err := strconv.ParseInt(c.Params("channel_id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid channel_id"})
+	}
+	if err := h.service.DeleteResultPost(c.Context(), c.Params("id"), userID, channelID); err != nil {
+		return resultPostError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
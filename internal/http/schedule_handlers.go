@@ -0,0 +1,100 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	schedulesvc "github.com/open-builders/giveaway-backend/internal/service/schedule"
+)
+
+// ScheduleHandlers exposes the giveaway content plan to its creator.
+type ScheduleHandlers struct {
+	service *schedulesvc.Service
+}
+
+func NewScheduleHandlers(svc *schedulesvc.Service) *ScheduleHandlers {
+	return &ScheduleHandlers{service: svc}
+}
+
+func (h *ScheduleHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/giveaways/:id/schedule", h.list)
+	r.Put("/giveaways/:id/schedule", h.replace)
+	r.Delete("/giveaways/:id/schedule/:item_id", h.cancel)
+}
+
+type scheduleItemReq struct {
+	Kind        string     `json:"kind"`
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	Text        string     `json:"text,omitempty"`
+}
+
+type replaceScheduleReq struct {
+	Items []scheduleItemReq `json:"items"`
+}
+
+func (h *ScheduleHandlers) list(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	items, err := h.service.List(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		return scheduleError(c, err)
+	}
+	if items == nil {
+		items = []dg.ScheduleItem{}
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+func (h *ScheduleHandlers) replace(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var req replaceScheduleReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	in := make([]schedulesvc.ItemInput, 0, len(req.Items))
+	for _, it := range req.Items {
+		in = append(in, schedulesvc.ItemInput{Kind: dg.ScheduleItemKind(it.Kind), ScheduledAt: it.ScheduledAt, Text: it.Text})
+	}
+	items, err := h.service.Replace(c.Context(), c.Params("id"), userID, in)
+	if err != nil {
+		return scheduleError(c, err)
+	}
+	if items == nil {
+		items = []dg.ScheduleItem{}
+	}
+	return c.JSON(fiber.Map{"items": items})
+}
+
+func (h *ScheduleHandlers) cancel(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	itemID, err := strconv.ParseInt(c.Params("item_id"), 10, 64)
+	if err != nil || itemID <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid item_id"})
+	}
+	if err := h.service.Cancel(c.Context(), c.Params("id"), userID, itemID); err != nil {
+		return scheduleError(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func scheduleError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+}
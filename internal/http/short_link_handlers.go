@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/service/shortlink"
+)
+
+// ShortLinkHandlers redirects short links and reports their click-throughs.
+type ShortLinkHandlers struct {
+	service *shortlink.Service
+}
+
+func NewShortLinkHandlers(svc *shortlink.Service) *ShortLinkHandlers {
+	return &ShortLinkHandlers{service: svc}
+}
+
+func (h *ShortLinkHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/giveaways/:id/links", h.stats)
+}
+
+// RegisterRedirect registers the public /l/:code redirect on the app root.
+func (h *ShortLinkHandlers) RegisterRedirect(r fiber.Router) {
+	r.Get("/l/:code", h.redirect)
+}
+
+// redirect sends the client to the wrapped URL. The optional u query parameter
+// carries the user id embedded when the link was issued and is used for attribution only.
+func (h *ShortLinkHandlers) redirect(c *fiber.Ctx) error {
+	userID := int64(c.QueryInt("u", 0))
+	target, err := h.service.Resolve(c.Context(), c.Params("code"), userID)
+	if err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	// Never cache so every click reaches the counter
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Redirect(target, fiber.StatusFound)
+}
+
+// stats returns click counts per requirement and sponsor link.
+// Access: only giveaway creator.
+func (h *ShortLinkHandlers) stats(c *fiber.Ctx) error {
+	userID := middleware.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	stats, err := h.service.Stats(c.Context(), c.Params("id"), userID)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	if stats == nil {
+		stats = []dg.ShortLinkStats{}
+	}
+	return c.JSON(fiber.Map{"links": stats})
+}
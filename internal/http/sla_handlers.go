@@ -0,0 +1,35 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// SLAHandlers exposes giveaway completion lag to platform admins.
+type SLAHandlers struct {
+	service *gsvc.Service
+	users   *usersvc.Service
+}
+
+func NewSLAHandlers(svc *gsvc.Service, users *usersvc.Service) *SLAHandlers {
+	return &SLAHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *SLAHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/admin/completion-sla", h.report)
+}
+
+func (h *SLAHandlers) report(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	rep, err := h.service.CompletionSLAReport(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(rep)
+}
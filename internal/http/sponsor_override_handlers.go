@@ -0,0 +1,51 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func sponsorOverrideError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); msg {
+	case "not found", "sponsor not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case "forbidden":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+}
+
+// updateSponsor overrides the display title and avatar of a sponsor channel, e.g. after it was
+// renamed. The override is flagged for admins. Access: only giveaway owner.
+func (h *GiveawayHandlersFiber) updateSponsor(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	channelID, err := strconv.ParseInt(c.Params("channel_id"), 10, 64)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid channel_id"})
+	}
+	var req dto.SponsorOverrideRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	v := validate.New(requestLocale(c))
+	v.Check(req.Title != nil || req.AvatarURL != nil, "title", "required")
+	if req.Title != nil {
+		v.MaxLen("title", *req.Title, maxSponsorTitleLen)
+	}
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	s, err := h.service.SetSponsorOverride(c.Context(), c.Params("id"), requesterID, channelID, req.Title, req.AvatarURL)
+	if err != nil {
+		return sponsorOverrideError(c, err)
+	}
+	return c.JSON(dto.NewSponsor(*s))
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	statssvc "github.com/open-builders/giveaway-backend/internal/service/stats"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+)
+
+// StatsHandlers exposes platform statistics.
+type StatsHandlers struct {
+	service *statssvc.Service
+	users   *usersvc.Service
+}
+
+func NewStatsHandlers(svc *statssvc.Service, users *usersvc.Service) *StatsHandlers {
+	return &StatsHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers admin-only routes.
+func (h *StatsHandlers) RegisterFiber(r fiber.Router) {
+	r.Get("/stats/platform/details", h.details)
+}
+
+// RegisterPublicFiber registers routes for landing pages (no init-data auth).
+func (h *StatsHandlers) RegisterPublicFiber(r fiber.Router) {
+	r.Get("/stats/platform", h.platform)
+}
+
+func (h *StatsHandlers) platform(c *fiber.Ctx) error {
+	s, err := h.service.Platform(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	c.Set(fiber.HeaderCacheControl, "public, max-age=60")
+	return c.JSON(s)
+}
+
+func (h *StatsHandlers) details(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	b, err := h.service.Breakdown(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(b)
+}
@@ -0,0 +1,206 @@
+package http
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
+	supportsvc "github.com/open-builders/giveaway-backend/internal/service/support"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// SupportHandlers exposes support tickets to participants, creators and platform admins.
+type SupportHandlers struct {
+	service *supportsvc.Service
+	users   *usersvc.Service
+}
+
+func NewSupportHandlers(svc *supportsvc.Service, users *usersvc.Service) *SupportHandlers {
+	return &SupportHandlers{service: svc, users: users}
+}
+
+// RegisterFiber registers support ticket routes.
+func (h *SupportHandlers) RegisterFiber(r fiber.Router) {
+	r.Post("/giveaways/:id/support-tickets", h.open)
+	r.Get("/giveaways/:id/support-tickets", h.giveawayTickets)
+	r.Get("/me/support-tickets", h.myTickets)
+	r.Get("/me/support-tickets/counters", h.counters)
+	r.Get("/support-tickets/:id", h.get)
+	r.Post("/support-tickets/:id/messages", h.reply)
+	r.Post("/support-tickets/:id/escalate", h.escalate)
+	r.Post("/support-tickets/:id/resolve", h.resolve)
+	r.Get("/admin/support-tickets", h.queue)
+}
+
+var supportStatuses = []dg.SupportStatus{dg.SupportStatusOpen, dg.SupportStatusAnswered, dg.SupportStatusEscalated, dg.SupportStatusResolved}
+
+func (h *SupportHandlers) viewer(c *fiber.Ctx) supportsvc.Viewer {
+	userID := mw.GetUserID(c)
+	return supportsvc.Viewer{UserID: userID, Admin: isPlatformAdmin(c.Context(), h.users, userID)}
+}
+
+// supportError maps service errors to HTTP statuses.
+func supportError(c *fiber.Ctx, err error) error {
+	switch msg := err.Error(); {
+	case msg == "not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": msg})
+	case msg == "forbidden", msg == "not participant", msg == "creator cannot open tickets":
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": msg})
+	case msg == "ticket already open", msg == "ticket escalated", msg == "not escalatable", msg == "already resolved":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": msg})
+	case msg == "invalid category", strings.HasPrefix(msg, "subject"), strings.HasPrefix(msg, "message"):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": msg})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
+
+func ticketID(c *fiber.Ctx) (int64, bool) {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	return id, err == nil && id > 0
+}
+
+type openTicketReq struct {
+	Category dg.SupportCategory `json:"category"`
+	Subject  string             `json:"subject"`
+	Message  string             `json:"message"`
+}
+
+// open starts a ticket about a giveaway. Access: participants.
+func (h *SupportHandlers) open(c *fiber.Ctx) error {
+	var req openTicketReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	t, err := h.service.Open(c.Context(), c.Params("id"), mw.GetUserID(c), supportsvc.OpenInput{
+		Category: req.Category,
+		Subject:  req.Subject,
+		Message:  req.Message,
+	})
+	if err != nil {
+		return supportError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(t)
+}
+
+// giveawayTickets lists the tickets of a giveaway, longest waiting first. Access: creator or platform admins.
+func (h *SupportHandlers) giveawayTickets(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	status := queryEnum(c, v, "status", "", supportStatuses...)
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.ForGiveaway(c.Context(), c.Params("id"), h.viewer(c), status, pg.Limit, pg.Offset)
+	if err != nil {
+		return supportError(c, err)
+	}
+	return c.JSON(list)
+}
+
+// myTickets lists the tickets the current user opened, most recently updated first.
+func (h *SupportHandlers) myTickets(c *fiber.Ctx) error {
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.Mine(c.Context(), mw.GetUserID(c), pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
+
+// counters counts unresolved tickets per giveaway of the current user for the creator dashboard.
+func (h *SupportHandlers) counters(c *fiber.Ctx) error {
+	list, err := h.service.Counters(c.Context(), mw.GetUserID(c))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	unresolved, awaiting := 0, 0
+	for _, ct := range list {
+		unresolved += ct.Unresolved
+		awaiting += ct.AwaitingReply
+	}
+	return c.JSON(fiber.Map{"unresolved": unresolved, "awaiting_reply": awaiting, "giveaways": list})
+}
+
+// get returns a ticket with its messages. Access: the participant, the creator or platform admins.
+func (h *SupportHandlers) get(c *fiber.Ctx) error {
+	id, ok := ticketID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid ticket id"})
+	}
+	t, err := h.service.Get(c.Context(), id, h.viewer(c))
+	if err != nil {
+		return supportError(c, err)
+	}
+	return c.JSON(t)
+}
+
+type replyTicketReq struct {
+	Message string `json:"message"`
+}
+
+// reply adds a message to a ticket and notifies the other side. Access: the participant, the creator or platform admins.
+func (h *SupportHandlers) reply(c *fiber.Ctx) error {
+	id, ok := ticketID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid ticket id"})
+	}
+	var req replyTicketReq
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid body"})
+	}
+	m, err := h.service.Reply(c.Context(), id, h.viewer(c), req.Message)
+	if err != nil {
+		return supportError(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(m)
+}
+
+// escalate hands a ticket over to platform admins. Access: platform admins.
+func (h *SupportHandlers) escalate(c *fiber.Ctx) error {
+	id, ok := ticketID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid ticket id"})
+	}
+	if err := h.service.Escalate(c.Context(), id, h.viewer(c)); err != nil {
+		return supportError(c, err)
+	}
+	return c.JSON(fiber.Map{"ok": true})
+}
+
+// resolve closes a ticket. Access: the participant, the creator (unless escalated) or platform admins.
+func (h *SupportHandlers) resolve(c *fiber.Ctx) error {
+	id, ok := ticketID(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid ticket id"})
+	}
+	if err := h.service.Resolve(c.Context(), id, h.viewer(c)); err != nil {
+		return supportError(c, err)
+	}
+	return c.JSON(fiber.Map{"ok": true})
+}
+
+// queue lists unresolved tickets of every giveaway, longest waiting first. Access: platform admins.
+func (h *SupportHandlers) queue(c *fiber.Ctx) error {
+	if !isPlatformAdmin(c.Context(), h.users, mw.GetUserID(c)) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+	}
+	v := validate.New(requestLocale(c))
+	status := queryEnum(c, v, "status", "", dg.SupportStatusOpen, dg.SupportStatusAnswered, dg.SupportStatusEscalated)
+	pg := parsePage(c, v, 50, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	list, err := h.service.Queue(c.Context(), status, pg.Limit, pg.Offset)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(list)
+}
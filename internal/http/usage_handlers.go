@@ -0,0 +1,74 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/http/middleware"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// usageGiveawayKey holds the id of the giveaway a tracked request was found to be about.
+const usageGiveawayKey = "usage_giveaway"
+
+// markTracked tells tracked that the giveaway of the request exists. Handlers call it right
+// after loading the giveaway.
+func markTracked(c *fiber.Ctx, id string) {
+	c.Locals(usageGiveawayKey, id)
+}
+
+// tracked counts the calls of a giveaway route under metric for the usage metrics, and those
+// answered with an error status as errors. Only calls the handler marked with markTracked are
+// counted, so probing random or malformed ids creates no counters.
+func (h *GiveawayHandlersFiber) tracked(metric dg.UsageMetric, next fiber.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := next(c)
+		id, _ := c.Locals(usageGiveawayKey).(string)
+		if id == "" {
+			return err
+		}
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			var fe *fiber.Error
+			if errors.As(err, &fe) {
+				status = fe.Code
+			}
+		}
+		h.service.RecordUsage(c.Context(), id, metric, middleware.GetUserID(c), status >= fiber.StatusBadRequest)
+		return err
+	}
+}
+
+// usage returns the hourly request counters of a giveaway: page views and distinct viewers,
+// join attempts, requirement checks and errors, over ?hours= (default 24, up to 168).
+// Access: giveaway owner or platform admin.
+func (h *GiveawayHandlersFiber) usage(c *fiber.Ctx) error {
+	requesterID := middleware.GetUserID(c)
+	if requesterID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	v := validate.New(requestLocale(c))
+	hours := queryInt(c, v, "hours", 24)
+	v.Between("hours", int64(hours), 1, dg.MaxUsageHours)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	admin := isPlatformAdmin(c.Context(), h.users, requesterID)
+	u, err := h.service.Usage(c.Context(), c.Params("id"), requesterID, admin, hours)
+	if err != nil {
+		switch err.Error() {
+		case "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+		case "forbidden":
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "forbidden"})
+		case "redis not configured":
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	return c.JSON(u)
+}
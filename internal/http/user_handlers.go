@@ -2,14 +2,18 @@ package http
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 
 	domain "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/http/dto"
 	mw "github.com/open-builders/giveaway-backend/internal/http/middleware"
 	chsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
 )
 
 // UserHandlersFiber wires Fiber endpoints to the UserService.
@@ -30,12 +34,18 @@ func (h *UserHandlersFiber) RegisterFiber(r fiber.Router) {
 	// r.Get("/users/:id", h.getUserByID)
 	// r.Delete("/users/:id", h.deleteUser)
 	r.Get("/users/me/channels", h.listUserChannels)
+	r.Put("/users/me/privacy", h.setPrivacy)
+	r.Patch("/me/settings", h.updateSettings)
+	r.Get("/users/:id/public", h.getPublicProfile)
 }
 
 func (h *UserHandlersFiber) listUsers(c *fiber.Ctx) error {
-	limit, _ := strconv.Atoi(c.Query("limit"))
-	offset, _ := strconv.Atoi(c.Query("offset"))
-	users, err := h.service.List(c.Context(), limit, offset)
+	v := validate.New(requestLocale(c))
+	pg := parsePage(c, v, 20, maxPageLimit)
+	if !v.OK() {
+		return validationFailed(c, v)
+	}
+	users, err := h.service.List(c.Context(), pg.Limit, pg.Offset)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
@@ -96,12 +106,15 @@ func (h *UserHandlersFiber) getMe(c *fiber.Ctx) error {
 	username, _ := c.Locals(mw.UsernameCtxParam).(string)
 	photoURL, _ := c.Locals(mw.UserPicCtxParam).(string)
 	isPremium, _ := c.Locals(mw.IsPremiumCtxParam).(bool)
+	languageCode, _ := c.Locals(mw.LanguageCodeCtxParam).(string)
 	// Load existing user to preserve wallet and role if present
 	walletAddress := ""
 	role := "user"
 	currentAvatar := ""
 	currentIsPremium := false
+	preferredLanguage := ""
 	if u, err := h.service.GetByID(c.Context(), userID); err == nil && u != nil {
+		preferredLanguage = u.PreferredLanguage
 		if u.Role != "" {
 			role = u.Role
 		}
@@ -136,22 +149,14 @@ func (h *UserHandlersFiber) getMe(c *fiber.Ctx) error {
 		Role:          role,
 		Status:        "active",
 		WalletAddress: walletAddress,
+		LanguageCode:  languageCode,
+
+		PreferredLanguage: preferredLanguage,
 	}); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
-	type meResponse struct {
-		ID            int64  `json:"id"`
-		FirstName     string `json:"first_name"`
-		LastName      string `json:"last_name"`
-		Username      string `json:"username"`
-		AvatarURL     string `json:"avatar_url"`
-		IsPremium     bool   `json:"is_premium"`
-		Role          string `json:"role"`
-		WalletAddress string `json:"wallet_address"`
-	}
-
-	return c.JSON(meResponse{
+	return c.JSON(dto.Me{
 		ID:            userID,
 		FirstName:     firstName,
 		LastName:      lastName,
@@ -160,6 +165,9 @@ func (h *UserHandlersFiber) getMe(c *fiber.Ctx) error {
 		IsPremium:     effectivePremium,
 		Role:          role,
 		WalletAddress: walletAddress,
+
+		Language:          i18n.Match(preferredLanguage, languageCode).Tag,
+		PreferredLanguage: preferredLanguage,
 	})
 }
 
@@ -193,4 +201,81 @@ func (h *UserHandlersFiber) listUserChannels(c *fiber.Ctx) error {
 	return c.JSON(items)
 }
 
+// getPublicProfile returns the profile card of any user, honoring their privacy settings.
+func (h *UserHandlersFiber) getPublicProfile(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil || id <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid id"})
+	}
+	p, err := h.service.GetPublicProfile(c.Context(), id, mw.GetUserID(c))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if p == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "not found"})
+	}
+	return c.JSON(p)
+}
+
+func (h *UserHandlersFiber) setPrivacy(c *fiber.Ctx) error {
+	userID := mw.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body domain.Privacy
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	if err := h.service.SetPrivacy(c.Context(), userID, body); err != nil {
+		if err.Error() == "not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(body)
+}
+
+// updateSettings changes the caller's app settings; fields left out of the body are kept.
+func (h *UserHandlersFiber) updateSettings(c *fiber.Ctx) error {
+	userID := mw.GetUserID(c)
+	if userID == 0 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	var body dto.SettingsRequest
+	if err := c.BodyParser(&body); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid json"})
+	}
+	preferred := h.service.PreferredLanguage(c.Context(), userID)
+	if body.Language != nil {
+		tag, err := h.service.SetLanguage(c.Context(), userID, strings.TrimSpace(*body.Language))
+		switch {
+		case err == nil:
+			preferred = tag
+		case err.Error() == "unsupported language":
+			v := validate.New(requestLocale(c))
+			v.Add("language", "one_of", strings.Join(i18n.Tags(), ", "))
+			return validationFailed(c, v)
+		case err.Error() == "not found":
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	prefs, err := h.service.NotificationPrefs(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	if body.JoinConfirmations != nil && *body.JoinConfirmations != prefs.JoinConfirmations {
+		prefs.JoinConfirmations = *body.JoinConfirmations
+		if err := h.service.SetNotificationPrefs(c.Context(), userID, prefs); err != nil {
+			if err.Error() == "not found" {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	telegramLang, _ := c.Locals(mw.LanguageCodeCtxParam).(string)
+	return c.JSON(dto.Settings{PreferredLanguage: preferred, Language: i18n.Match(preferred, telegramLang).Tag, Notifications: prefs})
+}
+
 // TON Proof-related functionality has been moved to dedicated public handlers.
@@ -0,0 +1,39 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+)
+
+// Bus delivers domain events to external consumers. Publish must return only after
+// the broker accepted the event; the outbox retries anything that returned an error.
+type Bus interface {
+	Publish(ctx context.Context, e dg.Event) error
+	Close() error
+}
+
+// Options selects and configures a bus implementation.
+type Options struct {
+	Kind        string // "redis" or "nats"
+	RedisStream string // stream key for the redis bus
+	NATSURL     string
+	NATSSubject string // subject prefix; the event type is appended
+}
+
+// Open returns the bus selected by opts.Kind.
+func Open(ctx context.Context, opts Options, rdb *redisplatform.Client) (Bus, error) {
+	switch opts.Kind {
+	case "redis":
+		if rdb == nil {
+			return nil, fmt.Errorf("redis event bus requires a redis client")
+		}
+		return NewRedisStreams(rdb, opts.RedisStream), nil
+	case "nats":
+		return OpenNATS(ctx, opts.NATSURL, opts.NATSSubject)
+	default:
+		return nil, fmt.Errorf("unsupported event bus %q", opts.Kind)
+	}
+}
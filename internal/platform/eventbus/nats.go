@@ -0,0 +1,57 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const defaultSubject = "giveaway.events"
+
+// NATS publishes the JSON envelope to "<subject>.<event type>". The event id is sent as
+// Nats-Msg-Id so JetStream streams bound to the subject deduplicate redeliveries.
+type NATS struct {
+	nc      *nats.Conn
+	subject string
+}
+
+// OpenNATS connects to the server at url.
+func OpenNATS(ctx context.Context, url, subject string) (*NATS, error) {
+	if url == "" {
+		return nil, fmt.Errorf("empty nats url")
+	}
+	if subject == "" {
+		subject = defaultSubject
+	}
+	nc, err := nats.Connect(url, nats.Name("giveaway-backend"), nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, err
+	}
+	if err := nc.FlushWithContext(ctx); err != nil {
+		nc.Close()
+		return nil, err
+	}
+	return &NATS{nc: nc, subject: subject}, nil
+}
+
+func (b *NATS) Publish(ctx context.Context, e dg.Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	msg := nats.NewMsg(b.subject + "." + string(e.Type))
+	msg.Header.Set(nats.MsgIdHdr, e.ID)
+	msg.Data = body
+	if err := b.nc.PublishMsg(msg); err != nil {
+		return err
+	}
+	// Round-trip to the server so a successful return means the message left the client buffer
+	return b.nc.FlushWithContext(ctx)
+}
+
+func (b *NATS) Close() error {
+	return b.nc.Drain()
+}
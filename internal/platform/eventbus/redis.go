@@ -0,0 +1,50 @@
+package eventbus
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultStream = "giveaway:events"
+	// streamMaxLen caps the stream approximately; consumers are expected to keep up.
+	streamMaxLen = 100000
+)
+
+// RedisStreams appends events to a Redis stream, one entry per event.
+// Envelope fields are flattened so consumers can filter without decoding the payload.
+type RedisStreams struct {
+	rdb    *redisplatform.Client
+	stream string
+}
+
+func NewRedisStreams(rdb *redisplatform.Client, stream string) *RedisStreams {
+	if stream == "" {
+		stream = defaultStream
+	}
+	return &RedisStreams{rdb: rdb, stream: stream}
+}
+
+func (b *RedisStreams) Publish(ctx context.Context, e dg.Event) error {
+	return b.rdb.XAdd(ctx, &go_redis.XAddArgs{
+		Stream: b.stream,
+		MaxLen: streamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"id":           e.ID,
+			"type":         string(e.Type),
+			"version":      strconv.Itoa(e.Version),
+			"aggregate_id": e.AggregateID,
+			"occurred_at":  e.OccurredAt.UTC().Format(time.RFC3339Nano),
+			"payload":      string(e.Payload),
+		},
+	}).Err()
+}
+
+// Close is a no-op: the redis client is shared and closed by its owner.
+func (b *RedisStreams) Close() error { return nil }
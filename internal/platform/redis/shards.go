@@ -0,0 +1,164 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Shards spreads hot keys over independent Redis instances. A key always maps to the same
+// shard; adding a shard at the end moves only about 1/n of the keys. Keys containing a
+// {hash tag} are placed by the tag alone, so related keys can share a shard.
+type Shards struct {
+	clients []*Client
+	addrs   []string
+	// owned clients are closed by Close; the primary passed to OpenShards is not
+	owned []*Client
+}
+
+// ShardStats is the health of one shard.
+type ShardStats struct {
+	Index     int     `json:"index"`
+	Addr      string  `json:"addr"`
+	OK        bool    `json:"ok"`
+	Error     string  `json:"error,omitempty"`
+	LatencyMs float64 `json:"latency_ms"`
+	Keys      int64   `json:"keys"`
+	// UsedMemory is Redis' used_memory in bytes
+	UsedMemory int64 `json:"used_memory"`
+	// Connection pool of this process
+	PoolHits     uint32 `json:"pool_hits"`
+	PoolMisses   uint32 `json:"pool_misses"`
+	PoolTimeouts uint32 `json:"pool_timeouts"`
+	TotalConns   uint32 `json:"total_conns"`
+	IdleConns    uint32 `json:"idle_conns"`
+}
+
+// OpenShards connects to the shard addresses, in order, and pings each. Without addresses
+// the primary client is the only shard.
+func OpenShards(ctx context.Context, primary *Client, addrs []string, password string, db int) (*Shards, error) {
+	if len(addrs) == 0 {
+		return &Shards{clients: []*Client{primary}, addrs: []string{primary.Options().Addr}}, nil
+	}
+	s := &Shards{}
+	for _, addr := range addrs {
+		c, err := Open(ctx, addr, password, db)
+		if err != nil {
+			_ = s.Close()
+			return nil, fmt.Errorf("redis shard %s: %w", addr, err)
+		}
+		s.clients = append(s.clients, c)
+		s.addrs = append(s.addrs, addr)
+		s.owned = append(s.owned, c)
+	}
+	return s, nil
+}
+
+// Len returns the number of shards.
+func (s *Shards) Len() int { return len(s.clients) }
+
+// Clients returns every shard, in order.
+func (s *Shards) Clients() []*Client { return s.clients }
+
+// For returns the shard holding key.
+func (s *Shards) For(key string) *Client {
+	return s.clients[s.index(key)]
+}
+
+func (s *Shards) index(key string) int {
+	if len(s.clients) == 1 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(hashTag(key)))
+	return jumpHash(h.Sum64(), len(s.clients))
+}
+
+// hashTag returns the part of key between the first { and the following }, or key itself
+// when there is no non-empty tag, as Redis Cluster does.
+func hashTag(key string) string {
+	start := strings.IndexByte(key, '{')
+	if start < 0 {
+		return key
+	}
+	end := strings.IndexByte(key[start+1:], '}')
+	if end <= 0 {
+		return key
+	}
+	return key[start+1 : start+1+end]
+}
+
+// jumpHash is Lamping and Veach's jump consistent hash.
+func jumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// Stats pings every shard and reports its latency, key count, memory use and the
+// connection pool of this process. Unreachable shards report OK false with the error.
+func (s *Shards) Stats(ctx context.Context) []ShardStats {
+	out := make([]ShardStats, len(s.clients))
+	for i, c := range s.clients {
+		st := ShardStats{Index: i, Addr: s.addrs[i]}
+		ps := c.PoolStats()
+		st.PoolHits, st.PoolMisses, st.PoolTimeouts = ps.Hits, ps.Misses, ps.Timeouts
+		st.TotalConns, st.IdleConns = ps.TotalConns, ps.IdleConns
+		start := time.Now()
+		if err := c.Ping(ctx).Err(); err != nil {
+			st.Error = err.Error()
+			out[i] = st
+			continue
+		}
+		st.LatencyMs = float64(time.Since(start).Microseconds()) / 1000
+		st.OK = true
+		if n, err := c.DBSize(ctx).Result(); err == nil {
+			st.Keys = n
+		}
+		if info, err := c.Info(ctx, "memory").Result(); err == nil {
+			st.UsedMemory = infoInt(info, "used_memory")
+		}
+		out[i] = st
+	}
+	return out
+}
+
+// Down pings every shard and returns the addresses that did not answer.
+func (s *Shards) Down(ctx context.Context) []string {
+	var down []string
+	for i, c := range s.clients {
+		if err := c.Ping(ctx).Err(); err != nil {
+			down = append(down, s.addrs[i])
+		}
+	}
+	return down
+}
+
+// infoInt reads an integer field of an INFO reply.
+func infoInt(info, field string) int64 {
+	for _, line := range strings.Split(info, "\n") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(line), field+":"); ok {
+			n, _ := strconv.ParseInt(v, 10, 64)
+			return n
+		}
+	}
+	return 0
+}
+
+// Close closes the shard connections opened by OpenShards.
+func (s *Shards) Close() error {
+	var first error
+	for _, c := range s.owned {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
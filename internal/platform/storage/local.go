@@ -0,0 +1,182 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalRoute is where the API serves signed local files.
+const LocalRoute = "/api/public/files/"
+
+// Local stores objects on disk and signs download links with HMAC. The API serves
+// them itself, so it is meant for development and single-instance deployments.
+type Local struct {
+	dir     string
+	baseURL string
+	key     []byte
+}
+
+// NewLocal stores objects under dir; signingKey authenticates download links.
+func NewLocal(dir, baseURL, signingKey string) (*Local, error) {
+	if dir == "" {
+		return nil, errors.New("local storage directory is required")
+	}
+	if signingKey == "" {
+		return nil, errors.New("local storage signing key is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create storage dir: %w", err)
+	}
+	sum := sha256.Sum256([]byte("giveaway-storage:" + signingKey))
+	return &Local{dir: dir, baseURL: strings.TrimRight(baseURL, "/"), key: sum[:]}, nil
+}
+
+func (l *Local) Name() string { return "local" }
+
+func (l *Local) Put(ctx context.Context, key string, body io.Reader, size int64, opts PutOptions) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// Write to a temp file and rename so readers never see a partial object
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	n, err := io.Copy(tmp, body)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	if size >= 0 && n != size {
+		return fmt.Errorf("storage: wrote %d bytes, expected %d", n, size)
+	}
+	if err := l.writeMeta(key, opts); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (l *Local) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (l *Local) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *Local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Remove(l.metaPath(path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (l *Local) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if _, err := l.path(key); err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(time.Now().Add(clampTTL(ttl)).Unix(), 10)
+	q := url.Values{"expires": {expires}, "sig": {l.sign(key, expires)}}
+	return l.baseURL + LocalRoute + (&url.URL{Path: key}).EscapedPath() + "?" + q.Encode(), nil
+}
+
+// Resolve checks a signed link and returns the file path and stored headers for key.
+func (l *Local) Resolve(key, expires, sig string) (string, PutOptions, error) {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		return "", PutOptions{}, errors.New("link expired")
+	}
+	if !hmac.Equal([]byte(sig), []byte(l.sign(key, expires))) {
+		return "", PutOptions{}, errors.New("invalid signature")
+	}
+	path, err := l.path(key)
+	if err != nil {
+		return "", PutOptions{}, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", PutOptions{}, ErrNotFound
+		}
+		return "", PutOptions{}, err
+	}
+	var opts PutOptions
+	if raw, err := os.ReadFile(l.metaPath(path)); err == nil {
+		_ = json.Unmarshal(raw, &opts)
+	}
+	return path, opts, nil
+}
+
+func (l *Local) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, l.key)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path maps key into dir, rejecting keys that would escape it.
+func (l *Local) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if key == "" || clean == "/" || clean[1:] != key || strings.HasPrefix(filepath.Base(clean), ".") {
+		return "", fmt.Errorf("storage: invalid key %q", key)
+	}
+	return filepath.Join(l.dir, filepath.FromSlash(key)), nil
+}
+
+// metaPath keeps response headers next to the object in a hidden sidecar file.
+func (l *Local) metaPath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".meta")
+}
+
+func (l *Local) writeMeta(key string, opts PutOptions) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	raw, err := json.Marshal(opts)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(l.metaPath(path), raw, 0o644)
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrNotFound is returned when an object does not exist.
+var ErrNotFound = errors.New("storage: object not found")
+
+// MaxURLTTL is the longest lifetime S3 and GCS accept for pre-signed URLs.
+const MaxURLTTL = 7 * 24 * time.Hour
+
+// Storage keeps generated files (exports, cached media) out of process memory and
+// hands them to clients through short-lived signed URLs instead of inline responses.
+type Storage interface {
+	// Name identifies the backend in logs.
+	Name() string
+	// Put uploads size bytes from body under key, replacing any existing object.
+	Put(ctx context.Context, key string, body io.Reader, size int64, opts PutOptions) error
+	// Open streams an object; ErrNotFound when it does not exist.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists reports whether key is present.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete removes key; deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that allows anonymous GET of key until ttl elapses.
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// PutOptions are response headers stored with the object and served on download.
+type PutOptions struct {
+	ContentType        string
+	ContentDisposition string
+	CacheControl       string
+}
+
+// Options selects and configures a storage implementation.
+type Options struct {
+	Kind string // "local", "s3" or "gcs"
+
+	LocalDir        string
+	LocalBaseURL    string // public base URL of this API; signed local links point at /api/public/files
+	LocalSigningKey string
+
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3PathStyle bool
+
+	GCSBucket          string
+	GCSCredentialsFile string
+}
+
+// Open returns the storage selected by opts.Kind.
+func Open(opts Options) (Storage, error) {
+	switch opts.Kind {
+	case "local":
+		return NewLocal(opts.LocalDir, opts.LocalBaseURL, opts.LocalSigningKey)
+	case "s3":
+		return NewS3(opts.S3Endpoint, opts.S3Region, opts.S3Bucket, opts.S3AccessKey, opts.S3SecretKey, opts.S3PathStyle)
+	case "gcs":
+		return NewGCS(opts.GCSBucket, opts.GCSCredentialsFile)
+	default:
+		return nil, fmt.Errorf("unsupported storage backend %q", opts.Kind)
+	}
+}
+
+func clampTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return 15 * time.Minute
+	}
+	if ttl > MaxURLTTL {
+		return MaxURLTTL
+	}
+	return ttl
+}
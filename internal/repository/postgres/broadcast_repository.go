@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// BroadcastRepository reads and advances the participant DM queue.
+type BroadcastRepository struct {
+	db *sql.DB
+}
+
+func NewBroadcastRepository(db *sql.DB) *BroadcastRepository { return &BroadcastRepository{db: db} }
+
+// enqueueBroadcast queues text for every participant of a giveaway within tx.
+func enqueueBroadcast(ctx context.Context, tx *sql.Tx, id string, kind dg.BroadcastKind, text string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO giveaway_broadcasts (giveaway_id, kind, text) VALUES ($1,$2,$3)`, id, string(kind), text)
+	return err
+}
+
+// NextPending returns the oldest unfinished broadcast or nil when the queue is empty.
+func (r *BroadcastRepository) NextPending(ctx context.Context) (*dg.Broadcast, error) {
+	const q = `
+        SELECT id, giveaway_id, kind, text, last_user_id, sent, failed, created_at
+        FROM giveaway_broadcasts
+        WHERE finished_at IS NULL
+        ORDER BY id ASC
+        LIMIT 1`
+	var b dg.Broadcast
+	err := r.db.QueryRowContext(ctx, q).Scan(&b.ID, &b.GiveawayID, &b.Kind, &b.Text, &b.LastUserID, &b.Sent, &b.Failed, &b.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ParticipantsAfter returns up to limit participant ids greater than afterUserID in ascending order.
+func (r *BroadcastRepository) ParticipantsAfter(ctx context.Context, giveawayID string, afterUserID int64, limit int) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT user_id FROM giveaway_participants WHERE giveaway_id=$1 AND user_id>$2 ORDER BY user_id ASC LIMIT $3`, giveawayID, afterUserID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var uid int64
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, rows.Err()
+}
+
+// Advance moves the delivery cursor and adds the batch counters.
+func (r *BroadcastRepository) Advance(ctx context.Context, id, lastUserID int64, sent, failed int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_broadcasts SET last_user_id=$2, sent=sent+$3, failed=failed+$4 WHERE id=$1`, id, lastUserID, sent, failed)
+	return err
+}
+
+// Finish marks a broadcast as delivered to every participant.
+func (r *BroadcastRepository) Finish(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_broadcasts SET finished_at=now() WHERE id=$1`, id)
+	return err
+}
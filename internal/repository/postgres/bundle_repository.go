@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// BundleRepository persists sponsored requirement bundles and their usage.
+type BundleRepository struct {
+	db *sql.DB
+}
+
+func NewBundleRepository(db *sql.DB) *BundleRepository { return &BundleRepository{db: db} }
+
+const bundleColumns = `id, owner_id, title, description, requirements, active, created_at, updated_at`
+
+func scanBundle(row interface{ Scan(...any) error }) (dg.RequirementBundle, error) {
+	var b dg.RequirementBundle
+	var reqs []byte
+	if err := row.Scan(&b.ID, &b.OwnerID, &b.Title, &b.Description, &reqs, &b.Active, &b.CreatedAt, &b.UpdatedAt); err != nil {
+		return b, err
+	}
+	err := json.Unmarshal(reqs, &b.Requirements)
+	return b, err
+}
+
+func (r *BundleRepository) list(ctx context.Context, q string, args ...any) ([]dg.RequirementBundle, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.RequirementBundle, 0)
+	for rows.Next() {
+		b, err := scanBundle(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// Create stores a bundle and sets its ID and timestamps.
+func (r *BundleRepository) Create(ctx context.Context, b *dg.RequirementBundle) error {
+	reqs, err := json.Marshal(b.Requirements)
+	if err != nil {
+		return err
+	}
+	return r.db.QueryRowContext(ctx, `
+        INSERT INTO requirement_bundles (owner_id, title, description, requirements)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, active, created_at, updated_at`, b.OwnerID, b.Title, b.Description, reqs).
+		Scan(&b.ID, &b.Active, &b.CreatedAt, &b.UpdatedAt)
+}
+
+// GetByID returns a bundle, or nil when it does not exist.
+func (r *BundleRepository) GetByID(ctx context.Context, id int64) (*dg.RequirementBundle, error) {
+	b, err := scanBundle(r.db.QueryRowContext(ctx, `SELECT `+bundleColumns+` FROM requirement_bundles WHERE id=$1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// GetActive returns the active bundles among ids, in no particular order.
+func (r *BundleRepository) GetActive(ctx context.Context, ids []int64) ([]dg.RequirementBundle, error) {
+	return r.list(ctx, `SELECT `+bundleColumns+` FROM requirement_bundles WHERE id = ANY($1) AND active`, pq.Array(ids))
+}
+
+// ListActive returns active bundles, newest first, optionally of one owner.
+func (r *BundleRepository) ListActive(ctx context.Context, ownerID int64, limit, offset int) ([]dg.RequirementBundle, error) {
+	return r.list(ctx, `
+        SELECT `+bundleColumns+` FROM requirement_bundles
+        WHERE active AND ($1 = 0 OR owner_id = $1)
+        ORDER BY id DESC LIMIT $2 OFFSET $3`, ownerID, limit, offset)
+}
+
+// ListByOwner returns the bundles of an owner, active or not, newest first.
+func (r *BundleRepository) ListByOwner(ctx context.Context, ownerID int64, limit, offset int) ([]dg.RequirementBundle, error) {
+	return r.list(ctx, `
+        SELECT `+bundleColumns+` FROM requirement_bundles
+        WHERE owner_id = $1
+        ORDER BY id DESC LIMIT $2 OFFSET $3`, ownerID, limit, offset)
+}
+
+// Deactivate stops a bundle from being attached. Returns false when it was already inactive.
+func (r *BundleRepository) Deactivate(ctx context.Context, id int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE requirement_bundles SET active = FALSE, updated_at = now() WHERE id=$1 AND active`, id)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Stats aggregates the giveaways that attached each of the bundles and their participants.
+// Bundles nobody attached yet are reported with zero counts.
+func (r *BundleRepository) Stats(ctx context.Context, ids []int64) (map[int64]dg.BundleStats, error) {
+	out := make(map[int64]dg.BundleStats, len(ids))
+	for _, id := range ids {
+		out[id] = dg.BundleStats{BundleID: id}
+	}
+	rows, err := r.db.QueryContext(ctx, `
+        WITH used AS (
+            SELECT DISTINCT gr.bundle_id, gr.giveaway_id FROM giveaway_requirements gr WHERE gr.bundle_id = ANY($1)
+        )
+        SELECT u.bundle_id,
+               COUNT(DISTINCT u.giveaway_id),
+               COUNT(DISTINCT u.giveaway_id) FILTER (WHERE g.status = 'active'),
+               COUNT(p.user_id),
+               COUNT(DISTINCT p.user_id)
+        FROM used u
+        JOIN giveaways g ON g.id = u.giveaway_id
+        LEFT JOIN giveaway_participants p ON p.giveaway_id = u.giveaway_id
+        GROUP BY u.bundle_id`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var st dg.BundleStats
+		if err := rows.Scan(&st.BundleID, &st.Giveaways, &st.ActiveGiveaways, &st.Participants, &st.UniqueParticipants); err != nil {
+			return nil, err
+		}
+		out[st.BundleID] = st
+	}
+	return out, rows.Err()
+}
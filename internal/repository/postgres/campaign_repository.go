@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CampaignRepository persists campaigns and rolls up their giveaways.
+type CampaignRepository struct {
+	db *sql.DB
+}
+
+func NewCampaignRepository(db *sql.DB) *CampaignRepository { return &CampaignRepository{db: db} }
+
+// Create inserts a campaign without giveaways.
+func (r *CampaignRepository) Create(ctx context.Context, c *dg.Campaign) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO campaigns (id, creator_id, name, description, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+		c.ID, c.CreatorID, c.Name, c.Description, c.CreatedAt, c.UpdatedAt)
+	return err
+}
+
+// GetByID loads a campaign with its giveaways, or nil when it does not exist.
+func (r *CampaignRepository) GetByID(ctx context.Context, id string) (*dg.Campaign, error) {
+	var c dg.Campaign
+	err := r.db.QueryRowContext(ctx, `SELECT id, creator_id, name, description, created_at, updated_at FROM campaigns WHERE id=$1`, id).
+		Scan(&c.ID, &c.CreatorID, &c.Name, &c.Description, &c.CreatedAt, &c.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.Giveaways, err = r.listGiveaways(ctx, id); err != nil {
+		return nil, err
+	}
+	for _, g := range c.Giveaways {
+		c.Participants += int64(g.ParticipantsCount)
+	}
+	return &c, nil
+}
+
+func (r *CampaignRepository) listGiveaways(ctx context.Context, id string) ([]dg.CampaignGiveaway, error) {
+	const q = `
+        SELECT g.id, g.title, g.status, g.started_at, g.ends_at, cg.added_at,
+               (SELECT COUNT(*) FROM giveaway_participants p WHERE p.giveaway_id=g.id)
+        FROM campaign_giveaways cg
+        JOIN giveaways g ON g.id = cg.giveaway_id
+        WHERE cg.campaign_id=$1
+        ORDER BY g.started_at, g.id`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.CampaignGiveaway, 0)
+	for rows.Next() {
+		var g dg.CampaignGiveaway
+		if err := rows.Scan(&g.ID, &g.Title, &g.Status, &g.StartedAt, &g.EndsAt, &g.AddedAt, &g.ParticipantsCount); err != nil {
+			return nil, err
+		}
+		out = append(out, g)
+	}
+	return out, rows.Err()
+}
+
+// ListByCreator returns the creator's campaigns, newest first, without their giveaways.
+func (r *CampaignRepository) ListByCreator(ctx context.Context, creatorID int64) ([]dg.Campaign, error) {
+	const q = `
+        SELECT c.id, c.creator_id, c.name, c.description, c.created_at, c.updated_at,
+               (SELECT COUNT(*) FROM campaign_giveaways cg JOIN giveaway_participants p ON p.giveaway_id = cg.giveaway_id WHERE cg.campaign_id=c.id)
+        FROM campaigns c WHERE c.creator_id=$1
+        ORDER BY c.created_at DESC`
+	rows, err := r.db.QueryContext(ctx, q, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.Campaign, 0)
+	for rows.Next() {
+		var c dg.Campaign
+		if err := rows.Scan(&c.ID, &c.CreatorID, &c.Name, &c.Description, &c.CreatedAt, &c.UpdatedAt, &c.Participants); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// Delete removes a campaign; its giveaways are kept and simply leave the campaign.
+func (r *CampaignRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM campaigns WHERE id=$1`, id)
+	return err
+}
+
+// CountGiveaways returns how many giveaways a campaign holds.
+func (r *CampaignRepository) CountGiveaways(ctx context.Context, id string) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM campaign_giveaways WHERE campaign_id=$1`, id).Scan(&n)
+	return n, err
+}
+
+// AddGiveaway assigns a giveaway to a campaign, moving it out of any other campaign.
+func (r *CampaignRepository) AddGiveaway(ctx context.Context, id, giveawayID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `
+        INSERT INTO campaign_giveaways (campaign_id, giveaway_id) VALUES ($1,$2)
+        ON CONFLICT (giveaway_id) DO UPDATE SET campaign_id=EXCLUDED.campaign_id, added_at=now()
+        WHERE campaign_giveaways.campaign_id <> EXCLUDED.campaign_id`, id, giveawayID); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE campaigns SET updated_at=now() WHERE id=$1`, id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemoveGiveaway takes a giveaway out of a campaign. Returns false when it was not in it.
+func (r *CampaignRepository) RemoveGiveaway(ctx context.Context, id, giveawayID string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM campaign_giveaways WHERE campaign_id=$1 AND giveaway_id=$2`, id, giveawayID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	if n > 0 {
+		_, err = r.db.ExecContext(ctx, `UPDATE campaigns SET updated_at=now() WHERE id=$1`, id)
+	}
+	return n > 0, err
+}
+
+// Stats rolls up participants, winners, sponsor channels and tracked link clicks of a campaign.
+func (r *CampaignRepository) Stats(ctx context.Context, id string) (*dg.CampaignStats, error) {
+	const q = `
+        WITH gs AS (
+            SELECT giveaway_id FROM campaign_giveaways WHERE campaign_id=$1
+        ), joins AS (
+            SELECT p.user_id, COUNT(*) AS n
+            FROM giveaway_participants p JOIN gs ON gs.giveaway_id = p.giveaway_id
+            GROUP BY p.user_id
+        ), clicks AS (
+            SELECT k.user_id
+            FROM short_link_clicks k
+            JOIN short_links l ON l.code = k.code
+            JOIN gs ON gs.giveaway_id = l.giveaway_id
+        )
+        SELECT
+            (SELECT COUNT(*) FROM gs),
+            (SELECT COALESCE(SUM(n), 0) FROM joins),
+            (SELECT COUNT(*) FROM joins),
+            (SELECT COUNT(*) FROM joins WHERE n > 1),
+            (SELECT COUNT(*) FROM giveaway_winners w JOIN gs ON gs.giveaway_id = w.giveaway_id),
+            (SELECT COUNT(DISTINCT s.channel_id) FROM giveaway_sponsors s JOIN gs ON gs.giveaway_id = s.giveaway_id WHERE s.channel_id <> 0),
+            (SELECT COUNT(*) FROM clicks),
+            (SELECT COUNT(*) FROM (
+                SELECT user_id FROM joins
+                UNION
+                SELECT user_id FROM clicks WHERE user_id <> 0
+            ) u)`
+	var s dg.CampaignStats
+	if err := r.db.QueryRowContext(ctx, q, id).Scan(&s.Giveaways, &s.Participants, &s.UniqueParticipants, &s.RepeatParticipants,
+		&s.Winners, &s.Channels, &s.LinkClicks, &s.Reach); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
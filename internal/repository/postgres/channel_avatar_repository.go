@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ChannelAvatarRepository persists the last resolved avatar per channel.
+type ChannelAvatarRepository struct {
+	db *sql.DB
+}
+
+func NewChannelAvatarRepository(db *sql.DB) *ChannelAvatarRepository {
+	return &ChannelAvatarRepository{db: db}
+}
+
+// Upsert stores a; an empty username or file path keeps the stored one when the photo is unchanged.
+func (r *ChannelAvatarRepository) Upsert(ctx context.Context, a *dg.ChannelAvatar) error {
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO channel_avatars (channel_id, username, big_file_id, big_file_unique_id, file_path, updated_at)
+        VALUES ($1,$2,$3,$4,$5,now())
+        ON CONFLICT (channel_id) DO UPDATE SET
+            username = CASE WHEN EXCLUDED.username <> '' THEN EXCLUDED.username ELSE channel_avatars.username END,
+            big_file_id = EXCLUDED.big_file_id,
+            file_path = CASE
+                WHEN EXCLUDED.file_path <> '' THEN EXCLUDED.file_path
+                WHEN channel_avatars.big_file_unique_id = EXCLUDED.big_file_unique_id THEN channel_avatars.file_path
+                ELSE '' END,
+            big_file_unique_id = EXCLUDED.big_file_unique_id,
+            updated_at = now()`,
+		a.ChannelID, strings.TrimPrefix(a.Username, "@"), a.BigFileID, a.BigFileUniqueID, a.FilePath)
+	return err
+}
+
+// GetByChat returns the avatar for a numeric chat id or @username, or nil when unknown.
+func (r *ChannelAvatarRepository) GetByChat(ctx context.Context, chatRef string) (*dg.ChannelAvatar, error) {
+	q := `SELECT channel_id, username, big_file_id, big_file_unique_id, file_path, updated_at FROM channel_avatars `
+	var row *sql.Row
+	if id, err := strconv.ParseInt(chatRef, 10, 64); err == nil {
+		row = r.db.QueryRowContext(ctx, q+`WHERE channel_id=$1`, id)
+	} else {
+		row = r.db.QueryRowContext(ctx, q+`WHERE username <> '' AND lower(username)=lower($1) ORDER BY updated_at DESC LIMIT 1`, strings.TrimPrefix(chatRef, "@"))
+	}
+	var a dg.ChannelAvatar
+	err := row.Scan(&a.ChannelID, &a.Username, &a.BigFileID, &a.BigFileUniqueID, &a.FilePath, &a.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
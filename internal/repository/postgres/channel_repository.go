@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ChannelRepository persists canonical channel records and their cached display data.
+type ChannelRepository struct {
+	db *sql.DB
+}
+
+func NewChannelRepository(db *sql.DB) *ChannelRepository {
+	return &ChannelRepository{db: db}
+}
+
+// Save stores the record and cache of a channel together. An empty username keeps the stored
+// one, an unset owner keeps the stored owner, and cache parts without a fetch time keep theirs.
+func (r *ChannelRepository) Save(ctx context.Context, rec dg.ChannelRecord, c dg.ChannelCache) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO channels (id, username, owner_id, owner_verified_at)
+        VALUES ($1, $2, NULLIF($3, 0), $4)
+        ON CONFLICT (id) DO UPDATE SET
+            username = CASE WHEN EXCLUDED.username <> '' THEN EXCLUDED.username ELSE channels.username END,
+            owner_id = COALESCE(EXCLUDED.owner_id, channels.owner_id),
+            owner_verified_at = COALESCE(EXCLUDED.owner_verified_at, channels.owner_verified_at),
+            updated_at = now()`,
+		rec.ID, strings.TrimPrefix(rec.Username, "@"), rec.OwnerID, rec.OwnerVerifiedAt)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO channel_cache (channel_id, title, title_fetched_at, member_count, member_count_fetched_at)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (channel_id) DO UPDATE SET
+            title = CASE WHEN EXCLUDED.title_fetched_at IS NOT NULL THEN EXCLUDED.title ELSE channel_cache.title END,
+            title_fetched_at = COALESCE(EXCLUDED.title_fetched_at, channel_cache.title_fetched_at),
+            member_count = CASE WHEN EXCLUDED.member_count_fetched_at IS NOT NULL THEN EXCLUDED.member_count ELSE channel_cache.member_count END,
+            member_count_fetched_at = COALESCE(EXCLUDED.member_count_fetched_at, channel_cache.member_count_fetched_at)`,
+		rec.ID, c.Title, c.TitleFetchedAt, c.MemberCount, c.MemberCountFetchedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Load returns the stored channels among ids, keyed by id. Unknown ids are left out.
+func (r *ChannelRepository) Load(ctx context.Context, ids []int64) (map[int64]*dg.StoredChannel, error) {
+	out := make(map[int64]*dg.StoredChannel, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT ch.id, ch.username, COALESCE(ch.owner_id, 0), ch.owner_verified_at,
+               COALESCE(cc.title, ''), cc.title_fetched_at, cc.member_count, cc.member_count_fetched_at, ca.updated_at
+        FROM channels ch
+        LEFT JOIN channel_cache cc ON cc.channel_id = ch.id
+        LEFT JOIN channel_avatars ca ON ca.channel_id = ch.id
+        WHERE ch.id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var sc dg.StoredChannel
+		var verifiedAt, titleAt, membersAt, avatarAt sql.NullTime
+		var members sql.NullInt64
+		if err := rows.Scan(&sc.ID, &sc.Username, &sc.OwnerID, &verifiedAt,
+			&sc.Cache.Title, &titleAt, &members, &membersAt, &avatarAt); err != nil {
+			return nil, err
+		}
+		sc.OwnerVerifiedAt = utcTime(verifiedAt)
+		sc.Cache.ChannelID = sc.ID
+		sc.Cache.TitleFetchedAt = utcTime(titleAt)
+		sc.Cache.MemberCountFetchedAt = utcTime(membersAt)
+		sc.Cache.AvatarFetchedAt = utcTime(avatarAt)
+		if members.Valid {
+			n := int(members.Int64)
+			sc.Cache.MemberCount = &n
+		}
+		out[sc.ID] = &sc
+	}
+	return out, rows.Err()
+}
+
+// utcTime returns t in UTC, or nil when it is NULL.
+func utcTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time.UTC()
+	return &v
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ComplianceRepository stores compliance rules and the acknowledgments of users.
+type ComplianceRepository struct {
+	db *sql.DB
+}
+
+func NewComplianceRepository(db *sql.DB) *ComplianceRepository {
+	return &ComplianceRepository{db: db}
+}
+
+// ListRules returns every compliance rule ordered by prize kind.
+func (r *ComplianceRepository) ListRules(ctx context.Context) ([]dg.ComplianceRule, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT prize_kind, countries, notice, updated_by, updated_at FROM compliance_rules ORDER BY prize_kind`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ComplianceRule
+	for rows.Next() {
+		var rule dg.ComplianceRule
+		if err := rows.Scan(&rule.PrizeKind, pq.Array(&rule.Countries), &rule.Notice, &rule.UpdatedBy, &rule.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, rule)
+	}
+	return out, rows.Err()
+}
+
+// SetRule creates or replaces the rule of its prize kind.
+func (r *ComplianceRepository) SetRule(ctx context.Context, rule dg.ComplianceRule) error {
+	const q = `
+        INSERT INTO compliance_rules (prize_kind, countries, notice, updated_by)
+        VALUES ($1,$2,$3,$4)
+        ON CONFLICT (prize_kind) DO UPDATE SET countries=EXCLUDED.countries, notice=EXCLUDED.notice,
+            updated_by=EXCLUDED.updated_by, updated_at=now()`
+	_, err := r.db.ExecContext(ctx, q, rule.PrizeKind, pq.Array(rule.Countries), rule.Notice, rule.UpdatedBy)
+	return err
+}
+
+// DeleteRule removes the rule of a prize kind; it reports false when there was none.
+func (r *ComplianceRepository) DeleteRule(ctx context.Context, kind dg.PrizeKind) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM compliance_rules WHERE prize_kind=$1`, kind)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// GetAck returns the acknowledgment of a user for a giveaway, or nil when there is none.
+func (r *ComplianceRepository) GetAck(ctx context.Context, giveawayID string, userID int64) (*dg.ComplianceAck, error) {
+	a := dg.ComplianceAck{GiveawayID: giveawayID, UserID: userID}
+	var kinds []string
+	err := r.db.QueryRowContext(ctx, `
+        SELECT prize_kinds, countries, notice, ip, user_agent, acknowledged_at
+        FROM giveaway_compliance_acks WHERE giveaway_id=$1 AND user_id=$2`, giveawayID, userID).
+		Scan(pq.Array(&kinds), pq.Array(&a.Countries), &a.Notice, &a.IP, &a.UserAgent, &a.AcknowledgedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	a.PrizeKinds = prizeKinds(kinds)
+	return &a, nil
+}
+
+// RecordAck stores an acknowledgment, replacing an earlier one of the same user when the
+// restrictions changed since.
+func (r *ComplianceRepository) RecordAck(ctx context.Context, a dg.ComplianceAck) (time.Time, error) {
+	kinds := make([]string, len(a.PrizeKinds))
+	for i, k := range a.PrizeKinds {
+		kinds[i] = string(k)
+	}
+	const q = `
+        INSERT INTO giveaway_compliance_acks (giveaway_id, user_id, prize_kinds, countries, notice, ip, user_agent)
+        VALUES ($1,$2,$3,$4,$5,$6,$7)
+        ON CONFLICT (giveaway_id, user_id) DO UPDATE SET prize_kinds=EXCLUDED.prize_kinds, countries=EXCLUDED.countries,
+            notice=EXCLUDED.notice, ip=EXCLUDED.ip, user_agent=EXCLUDED.user_agent, acknowledged_at=now()
+        RETURNING acknowledged_at`
+	var at time.Time
+	err := r.db.QueryRowContext(ctx, q, a.GiveawayID, a.UserID, pq.Array(kinds), pq.Array(a.Countries), a.Notice, a.IP, a.UserAgent).Scan(&at)
+	return at, err
+}
+
+// ListAcks returns a page of the acknowledgments of a giveaway, latest first.
+func (r *ComplianceRepository) ListAcks(ctx context.Context, giveawayID string, limit, offset int) ([]dg.ComplianceAck, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT user_id, prize_kinds, countries, notice, ip, user_agent, acknowledged_at
+        FROM giveaway_compliance_acks WHERE giveaway_id=$1
+        ORDER BY acknowledged_at DESC, user_id
+        LIMIT $2 OFFSET $3`, giveawayID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.ComplianceAck{}
+	for rows.Next() {
+		a := dg.ComplianceAck{GiveawayID: giveawayID}
+		var kinds []string
+		if err := rows.Scan(&a.UserID, pq.Array(&kinds), pq.Array(&a.Countries), &a.Notice, &a.IP, &a.UserAgent, &a.AcknowledgedAt); err != nil {
+			return nil, err
+		}
+		a.PrizeKinds = prizeKinds(kinds)
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+func prizeKinds(raw []string) []dg.PrizeKind {
+	out := make([]dg.PrizeKind, len(raw))
+	for i, k := range raw {
+		out[i] = dg.PrizeKind(k)
+	}
+	return out
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CRMWebhookRepository persists creator CRM webhooks and their delivery cursors.
+type CRMWebhookRepository struct {
+	db *sql.DB
+}
+
+func NewCRMWebhookRepository(db *sql.DB) *CRMWebhookRepository { return &CRMWebhookRepository{db: db} }
+
+const crmWebhookColumns = `id, creator_id, url, secret, cursor_joined_at, cursor_giveaway_id, cursor_user_id,
+        delivered, failures, open_until, last_error, last_delivery_at, created_at`
+
+func scanCRMWebhook(row interface{ Scan(...any) error }) (*dg.CRMWebhook, error) {
+	var w dg.CRMWebhook
+	var openUntil, lastDelivery sql.NullTime
+	if err := row.Scan(&w.ID, &w.CreatorID, &w.URL, &w.Secret, &w.CursorJoinedAt, &w.CursorGiveawayID, &w.CursorUserID,
+		&w.Delivered, &w.Failures, &openUntil, &w.LastError, &lastDelivery, &w.CreatedAt); err != nil {
+		return nil, err
+	}
+	if openUntil.Valid {
+		t := openUntil.Time
+		w.OpenUntil = &t
+	}
+	if lastDelivery.Valid {
+		t := lastDelivery.Time
+		w.LastDeliveryAt = &t
+	}
+	return &w, nil
+}
+
+// Create inserts a webhook. Its cursor starts at creation, so only later joins are delivered.
+func (r *CRMWebhookRepository) Create(ctx context.Context, w *dg.CRMWebhook) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO crm_webhooks (id, creator_id, url, secret, cursor_joined_at, created_at) VALUES ($1,$2,$3,$4,$5,$5)`,
+		w.ID, w.CreatorID, w.URL, w.Secret, w.CreatedAt)
+	return err
+}
+
+// GetByID returns a webhook or nil when it does not exist.
+func (r *CRMWebhookRepository) GetByID(ctx context.Context, id string) (*dg.CRMWebhook, error) {
+	w, err := scanCRMWebhook(r.db.QueryRowContext(ctx, `SELECT `+crmWebhookColumns+` FROM crm_webhooks WHERE id=$1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return w, err
+}
+
+// ListByCreator returns the webhooks of a creator, oldest first.
+func (r *CRMWebhookRepository) ListByCreator(ctx context.Context, creatorID int64) ([]dg.CRMWebhook, error) {
+	return r.list(ctx, `SELECT `+crmWebhookColumns+` FROM crm_webhooks WHERE creator_id=$1 ORDER BY created_at ASC`, creatorID)
+}
+
+// ListDue returns webhooks whose circuit is closed or whose cool-down ended by now.
+func (r *CRMWebhookRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]dg.CRMWebhook, error) {
+	return r.list(ctx, `SELECT `+crmWebhookColumns+` FROM crm_webhooks WHERE open_until IS NULL OR open_until <= $1 ORDER BY last_delivery_at ASC NULLS FIRST LIMIT $2`, now, limit)
+}
+
+func (r *CRMWebhookRepository) list(ctx context.Context, q string, args ...any) ([]dg.CRMWebhook, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.CRMWebhook, 0)
+	for rows.Next() {
+		w, err := scanCRMWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *w)
+	}
+	return out, rows.Err()
+}
+
+// CountByCreator returns how many webhooks a creator registered.
+func (r *CRMWebhookRepository) CountByCreator(ctx context.Context, creatorID int64) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM crm_webhooks WHERE creator_id=$1`, creatorID).Scan(&n)
+	return n, err
+}
+
+// Delete removes a webhook.
+func (r *CRMWebhookRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM crm_webhooks WHERE id=$1`, id)
+	return err
+}
+
+// PendingParticipants returns participants of the creator's giveaways after the webhook cursor
+// who joined before until, in delivery order.
+func (r *CRMWebhookRepository) PendingParticipants(ctx context.Context, w *dg.CRMWebhook, until time.Time, limit int) ([]dg.CRMParticipant, error) {
+	const q = participantFeedSelect + `
+        WHERE g.creator_id = $1
+          AND (p.joined_at, p.giveaway_id, p.user_id) > ($2, $3, $4)
+          AND p.joined_at < $5
+        ORDER BY p.joined_at, p.giveaway_id, p.user_id
+        LIMIT $6`
+	return queryFeedParticipants(ctx, r.db, q, w.CreatorID, w.CursorJoinedAt, w.CursorGiveawayID, w.CursorUserID, until, limit)
+}
+
+// participantFeedSelect reads participants of a creator's giveaways for CRM webhooks and polling
+// triggers; callers append the WHERE, ORDER BY and LIMIT clauses.
+const participantFeedSelect = `
+        SELECT p.giveaway_id, p.user_id, COALESCE(u.username, ''), p.source, COALESCE(p.source_ref, ''),
+               CASE WHEN EXISTS (
+                   SELECT 1 FROM giveaway_requirements gr
+                   WHERE gr.giveaway_id = p.giveaway_id AND gr.unverifiable_since <= p.joined_at
+               ) THEN 'unverified' ELSE 'met' END,
+               p.tickets, p.joined_at
+        FROM giveaway_participants p
+        JOIN giveaways g ON g.id = p.giveaway_id
+        LEFT JOIN users u ON u.id = p.user_id`
+
+func queryFeedParticipants(ctx context.Context, db *sql.DB, q string, args ...any) ([]dg.CRMParticipant, error) {
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.CRMParticipant
+	for rows.Next() {
+		var p dg.CRMParticipant
+		if err := rows.Scan(&p.GiveawayID, &p.UserID, &p.Username, &p.Source, &p.SourceRef, &p.RequirementStatus, &p.Tickets, &p.JoinedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// Advance moves the cursor past last after a successful delivery of n participants and closes the circuit.
+func (r *CRMWebhookRepository) Advance(ctx context.Context, id string, last dg.CRMParticipant, n int) error {
+	const q = `
+        UPDATE crm_webhooks
+        SET cursor_joined_at=$2, cursor_giveaway_id=$3, cursor_user_id=$4, delivered=delivered+$5,
+            failures=0, open_until=NULL, last_error='', last_delivery_at=now()
+        WHERE id=$1`
+	_, err := r.db.ExecContext(ctx, q, id, last.JoinedAt, last.GiveawayID, last.UserID, n)
+	return err
+}
+
+// RecordFailure counts a failed delivery and, when openUntil is set, opens the circuit until then.
+func (r *CRMWebhookRepository) RecordFailure(ctx context.Context, id, lastError string, openUntil *time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE crm_webhooks SET failures=failures+1, last_error=$2, open_until=$3 WHERE id=$1`, id, lastError, openUntil)
+	return err
+}
+
+// ResetCircuit closes the circuit so delivery is retried on the next tick.
+func (r *CRMWebhookRepository) ResetCircuit(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE crm_webhooks SET failures=0, open_until=NULL WHERE id=$1`, id)
+	return err
+}
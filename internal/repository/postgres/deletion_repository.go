@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// DeletionRepository stores channel posts about giveaways and the cleanup of deleted giveaways.
+type DeletionRepository struct {
+	db *sql.DB
+}
+
+func NewDeletionRepository(db *sql.DB) *DeletionRepository { return &DeletionRepository{db: db} }
+
+const deletionColumns = `giveaway_id, creator_id, title, requested_at, tokens_revoked_at, keys_cleared_at, posts_cleaned_at, completed_at, attempts, last_error`
+
+func scanDeletion(row interface{ Scan(...any) error }) (dg.Deletion, error) {
+	var d dg.Deletion
+	var tokens, keys, posts, completed sql.NullTime
+	err := row.Scan(&d.GiveawayID, &d.CreatorID, &d.Title, &d.RequestedAt, &tokens, &keys, &posts, &completed, &d.Attempts, &d.LastError)
+	for _, f := range []struct {
+		src sql.NullTime
+		dst **time.Time
+	}{{tokens, &d.TokensRevokedAt}, {keys, &d.KeysClearedAt}, {posts, &d.PostsCleanedAt}, {completed, &d.CompletedAt}} {
+		if f.src.Valid {
+			t := f.src.Time
+			*f.dst = &t
+		}
+	}
+	return d, err
+}
+
+// RecordPost remembers a bot post in a sponsor channel.
+func (r *DeletionRepository) RecordPost(ctx context.Context, p dg.ChannelPost) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO giveaway_channel_posts (giveaway_id, channel_id, message_id, kind, media) VALUES ($1,$2,$3,$4,$5)`,
+		p.GiveawayID, p.ChannelID, p.MessageID, p.Kind, p.Media)
+	return err
+}
+
+// Posts lists the remembered posts of a giveaway, oldest first.
+func (r *DeletionRepository) Posts(ctx context.Context, giveawayID string) ([]dg.ChannelPost, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, giveaway_id, channel_id, message_id, kind, media, posted_at
+        FROM giveaway_channel_posts WHERE giveaway_id=$1 ORDER BY id`, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ChannelPost
+	for rows.Next() {
+		var p dg.ChannelPost
+		if err := rows.Scan(&p.ID, &p.GiveawayID, &p.ChannelID, &p.MessageID, &p.Kind, &p.Media, &p.PostedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// ForgetPost drops a post once it was removed or can no longer be touched.
+func (r *DeletionRepository) ForgetPost(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM giveaway_channel_posts WHERE id=$1`, id)
+	return err
+}
+
+// ClaimDue returns up to limit unfinished deletions with fewer than maxAttempts attempts whose
+// next attempt is due, counting the attempt and hiding them from other workers for lease.
+func (r *DeletionRepository) ClaimDue(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]dg.Deletion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        UPDATE giveaway_deletions SET attempts = attempts + 1, next_attempt_at = now() + make_interval(secs => $2)
+        WHERE giveaway_id IN (
+            SELECT giveaway_id FROM giveaway_deletions
+            WHERE completed_at IS NULL AND attempts < $3 AND next_attempt_at <= now()
+            ORDER BY next_attempt_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING `+deletionColumns, limit, lease.Seconds(), maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.Deletion, 0)
+	for rows.Next() {
+		d, err := scanDeletion(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+// CompleteStep stamps a cleanup step as done and completes the deletion once every step is.
+func (r *DeletionRepository) CompleteStep(ctx context.Context, giveawayID string, step dg.DeletionStep) error {
+	var col string
+	switch step {
+	case dg.DeletionStepTokens:
+		col = "tokens_revoked_at"
+	case dg.DeletionStepKeys:
+		col = "keys_cleared_at"
+	case dg.DeletionStepPosts:
+		col = "posts_cleaned_at"
+	default:
+		return fmt.Errorf("unknown deletion step %q", step)
+	}
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_deletions SET `+col+` = COALESCE(`+col+`, now()),
+            completed_at = CASE WHEN (tokens_revoked_at IS NOT NULL OR $2 = 'tokens')
+                                 AND (keys_cleared_at IS NOT NULL OR $2 = 'keys')
+                                 AND (posts_cleaned_at IS NOT NULL OR $2 = 'posts')
+                                THEN now() END,
+            last_error = ''
+        WHERE giveaway_id=$1`, giveawayID, string(step))
+	return err
+}
+
+// Retry records a failed attempt and schedules the next one.
+func (r *DeletionRepository) Retry(ctx context.Context, giveawayID, lastError string, next time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_deletions SET last_error=$2, next_attempt_at=$3 WHERE giveaway_id=$1 AND completed_at IS NULL`, giveawayID, lastError, next)
+	return err
+}
+
+// Get returns the deletion of a giveaway or nil when it was never deleted.
+func (r *DeletionRepository) Get(ctx context.Context, giveawayID string) (*dg.Deletion, error) {
+	d, err := scanDeletion(r.db.QueryRowContext(ctx, `SELECT `+deletionColumns+` FROM giveaway_deletions WHERE giveaway_id=$1`, giveawayID))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ExportJobRepository stores background export jobs and their progress.
+type ExportJobRepository struct {
+	db *sql.DB
+}
+
+func NewExportJobRepository(db *sql.DB) *ExportJobRepository { return &ExportJobRepository{db: db} }
+
+const exportJobColumns = `id, giveaway_id, requested_by, kind, locale, status, total, processed, file_key, error, created_at, started_at, finished_at`
+
+func scanExportJob(row interface{ Scan(...any) error }) (*dg.ExportJob, error) {
+	var j dg.ExportJob
+	var startedAt, finishedAt sql.NullTime
+	if err := row.Scan(&j.ID, &j.GiveawayID, &j.RequestedBy, &j.Kind, &j.Locale, &j.Status, &j.Total, &j.Processed,
+		&j.FileKey, &j.Error, &j.CreatedAt, &startedAt, &finishedAt); err != nil {
+		return nil, err
+	}
+	j.CreatedAt = j.CreatedAt.UTC()
+	j.StartedAt = utcTime(startedAt)
+	j.FinishedAt = utcTime(finishedAt)
+	return &j, nil
+}
+
+// Create queues j unless the giveaway already has an unfinished job of the same kind, which is
+// returned instead. The second result reports whether j was queued.
+func (r *ExportJobRepository) Create(ctx context.Context, j *dg.ExportJob) (*dg.ExportJob, bool, error) {
+	row := r.db.QueryRowContext(ctx, `
+        INSERT INTO giveaway_export_jobs (id, giveaway_id, requested_by, kind, locale)
+        VALUES ($1, $2, $3, $4, $5)
+        ON CONFLICT (giveaway_id, kind) WHERE status IN ('queued','running') DO NOTHING
+        RETURNING `+exportJobColumns,
+		j.ID, j.GiveawayID, j.RequestedBy, string(j.Kind), j.Locale)
+	created, err := scanExportJob(row)
+	if err == nil {
+		return created, true, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, false, err
+	}
+	row = r.db.QueryRowContext(ctx, `
+        SELECT `+exportJobColumns+` FROM giveaway_export_jobs
+        WHERE giveaway_id=$1 AND kind=$2 AND status IN ('queued','running')`, j.GiveawayID, string(j.Kind))
+	active, err := scanExportJob(row)
+	if err != nil {
+		return nil, false, err
+	}
+	return active, false, nil
+}
+
+// Get returns a job or nil when it does not exist.
+func (r *ExportJobRepository) Get(ctx context.Context, id string) (*dg.ExportJob, error) {
+	j, err := scanExportJob(r.db.QueryRowContext(ctx, `SELECT `+exportJobColumns+` FROM giveaway_export_jobs WHERE id=$1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return j, err
+}
+
+// ListByGiveaway returns the latest jobs of a giveaway, newest first.
+func (r *ExportJobRepository) ListByGiveaway(ctx context.Context, giveawayID string, limit int) ([]dg.ExportJob, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT `+exportJobColumns+` FROM giveaway_export_jobs
+        WHERE giveaway_id=$1
+        ORDER BY created_at DESC, id
+        LIMIT $2`, giveawayID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.ExportJob{}
+	for rows.Next() {
+		j, err := scanExportJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *j)
+	}
+	return out, rows.Err()
+}
+
+// Claim starts the oldest queued job, or a running one whose worker stopped reporting for
+// staleAfter, and returns it; nil when there is nothing to do. Concurrent workers never claim
+// the same job.
+func (r *ExportJobRepository) Claim(ctx context.Context, staleAfter time.Duration) (*dg.ExportJob, error) {
+	j, err := scanExportJob(r.db.QueryRowContext(ctx, `
+        UPDATE giveaway_export_jobs SET status='running', processed=0, started_at=now(), heartbeat_at=now()
+        WHERE id = (
+            SELECT id FROM giveaway_export_jobs
+            WHERE status='queued' OR (status='running' AND heartbeat_at < now() - make_interval(secs => $1))
+            ORDER BY created_at ASC
+            LIMIT 1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING `+exportJobColumns, staleAfter.Seconds()))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return j, err
+}
+
+// Progress records the rows written so far. Returns false when the job is no longer running,
+// i.e. it was cancelled.
+func (r *ExportJobRepository) Progress(ctx context.Context, id string, processed, total int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_export_jobs SET processed=$2, total=$3, heartbeat_at=now()
+        WHERE id=$1 AND status='running'`, id, processed, total)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Finish marks a running job done with its file. Returns false when it was cancelled meanwhile.
+func (r *ExportJobRepository) Finish(ctx context.Context, id, fileKey string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_export_jobs SET status='done', processed=total, file_key=$2, finished_at=now()
+        WHERE id=$1 AND status='running'`, id, fileKey)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Fail marks a running job failed with reason.
+func (r *ExportJobRepository) Fail(ctx context.Context, id, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_export_jobs SET status='failed', error=$2, finished_at=now()
+        WHERE id=$1 AND status='running'`, id, reason)
+	return err
+}
+
+// Cancel stops a queued or running job. Returns false when it had already finished.
+func (r *ExportJobRepository) Cancel(ctx context.Context, id string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_export_jobs SET status='cancelled', finished_at=now()
+        WHERE id=$1 AND status IN ('queued','running')`, id)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SponsorRecord is a stored sponsor row as used by maintenance tooling.
+type SponsorRecord struct {
+	ID         int64
+	GiveawayID string
+	ChannelID  int64
+	Username   string
+	Title      string
+	URL        string
+	AvatarURL  string
+	// Display overrides set by the creator; UpdateSponsorMetadata leaves them alone
+	TitleOverride  string
+	AvatarOverride string
+}
+
+// ListSponsors returns sponsor rows of one giveaway, or of all not yet finished giveaways when giveawayID is empty.
+func (r *GiveawayRepository) ListSponsors(ctx context.Context, giveawayID string) ([]SponsorRecord, error) {
+	q := `
+        SELECT s.id, s.giveaway_id, COALESCE(s.channel_id,0), COALESCE(s.username,''), COALESCE(s.title,''), COALESCE(s.url,''), COALESCE(s.avatar_url,''),
+               COALESCE(s.title_override,''), COALESCE(s.avatar_override,'')
+        FROM giveaway_sponsors s`
+	var args []any
+	if giveawayID != "" {
+		q += ` WHERE s.giveaway_id=$1`
+		args = append(args, giveawayID)
+	} else {
+		q += ` JOIN giveaways g ON g.id = s.giveaway_id WHERE g.status IN ('scheduled','active','pending')`
+	}
+	q += ` ORDER BY s.giveaway_id, s.id`
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []SponsorRecord
+	for rows.Next() {
+		var s SponsorRecord
+		if err := rows.Scan(&s.ID, &s.GiveawayID, &s.ChannelID, &s.Username, &s.Title, &s.URL, &s.AvatarURL, &s.TitleOverride, &s.AvatarOverride); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// UpdateSponsorMetadata overwrites the display fields of one sponsor row.
+func (r *GiveawayRepository) UpdateSponsorMetadata(ctx context.Context, s SponsorRecord) error {
+	var uname interface{}
+	if s.Username != "" {
+		uname = s.Username
+	}
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_sponsors SET username=$2, title=$3, url=$4, avatar_url=$5 WHERE id=$1`, s.ID, uname, s.Title, s.URL, s.AvatarURL)
+	return err
+}
+
+// IntegrityIssue describes one inconsistency between winners, participants and prizes.
+type IntegrityIssue struct {
+	GiveawayID string
+	Kind       string
+	Detail     string
+}
+
+// VerifyWinnerIntegrity checks winners and winner prizes of one giveaway, or of all
+// completed giveaways when giveawayID is empty. It only reads.
+func (r *GiveawayRepository) VerifyWinnerIntegrity(ctx context.Context, giveawayID string) ([]IntegrityIssue, error) {
+	scope := `g.status IN ('completed','finished')`
+	var args []any
+	if giveawayID != "" {
+		scope = `g.id=$1`
+		args = append(args, giveawayID)
+	}
+	checks := []struct {
+		kind string
+		q    string
+	}{
+		{"winner_not_participant", `
+            SELECT w.giveaway_id, 'user ' || w.user_id || ' at place ' || w.place
+            FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id
+            LEFT JOIN giveaway_participants p ON p.giveaway_id = w.giveaway_id AND p.user_id = w.user_id
+            WHERE ` + scope + ` AND p.user_id IS NULL`},
+		{"winner_is_creator", `
+            SELECT w.giveaway_id, 'user ' || w.user_id || ' at place ' || w.place
+            FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id
+            WHERE ` + scope + ` AND w.user_id = g.creator_id`},
+		{"duplicate_winner", `
+            SELECT w.giveaway_id, 'user ' || w.user_id || ' holds ' || COUNT(*) || ' places'
+            FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id
+            WHERE ` + scope + `
+            GROUP BY w.giveaway_id, w.user_id HAVING COUNT(*) > 1`},
+		{"place_gap", `
+            SELECT w.giveaway_id, 'places 1..' || MAX(w.place) || ' but ' || COUNT(DISTINCT w.place) || ' distinct'
+            FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id
+            WHERE ` + scope + `
+            GROUP BY w.giveaway_id HAVING MAX(w.place) <> COUNT(DISTINCT w.place) OR MIN(w.place) <> 1`},
+		{"too_many_winners", `
+            SELECT g.id, COUNT(w.user_id) || ' winners, limit ' || g.winners_count
+            FROM giveaways g JOIN giveaway_winners w ON w.giveaway_id = g.id
+            WHERE ` + scope + `
+            GROUP BY g.id, g.winners_count HAVING COUNT(w.user_id) > g.winners_count`},
+		{"prize_without_winner", `
+            SELECT wp.giveaway_id, 'user ' || wp.user_id || ' received "' || wp.prize_title || '"'
+            FROM giveaway_winner_prizes wp JOIN giveaways g ON g.id = wp.giveaway_id
+            LEFT JOIN giveaway_winners w ON w.giveaway_id = wp.giveaway_id AND w.user_id = wp.user_id
+            WHERE ` + scope + ` AND w.user_id IS NULL`},
+		{"prize_overallocated", `
+            SELECT g.id, 'distributed ' || d.qty || ' of ' || t.qty || ' units of "' || t.title || '"'
+            FROM giveaways g
+            JOIN (SELECT giveaway_id, title, SUM(GREATEST(quantity,1)) AS qty FROM giveaway_prizes GROUP BY giveaway_id, title) t ON t.giveaway_id = g.id
+            JOIN (SELECT giveaway_id, prize_title, SUM(quantity) AS qty FROM giveaway_winner_prizes GROUP BY giveaway_id, prize_title) d
+              ON d.giveaway_id = g.id AND d.prize_title = t.title
+            WHERE ` + scope + ` AND d.qty > t.qty`},
+		{"completed_without_winners", `
+            SELECT g.id, COUNT(p.user_id) || ' participants'
+            FROM giveaways g JOIN giveaway_participants p ON p.giveaway_id = g.id
+            WHERE ` + scope + ` AND g.status = 'completed'
+              AND NOT EXISTS (SELECT 1 FROM giveaway_winners w WHERE w.giveaway_id = g.id)
+            GROUP BY g.id`},
+	}
+	var out []IntegrityIssue
+	for _, c := range checks {
+		rows, err := r.db.QueryContext(ctx, c.q, args...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.kind, err)
+		}
+		for rows.Next() {
+			var gid string
+			var detail sql.NullString
+			if err := rows.Scan(&gid, &detail); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			out = append(out, IntegrityIssue{GiveawayID: gid, Kind: c.kind, Detail: detail.String})
+		}
+		rows.Close()
+	}
+	return out, nil
+}
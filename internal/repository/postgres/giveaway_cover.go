@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SetCover stores the cover of a giveaway; nil removes it.
+func (r *GiveawayRepository) SetCover(ctx context.Context, id string, c *dg.Cover) error {
+	if c == nil {
+		_, err := r.db.ExecContext(ctx, `
+            UPDATE giveaways SET cover_key=NULL, cover_content_type=NULL, cover_size=NULL, cover_updated_at=NULL, updated_at=now()
+            WHERE id=$1`, id)
+		return err
+	}
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE giveaways SET cover_key=$2, cover_content_type=$3, cover_size=$4, cover_updated_at=$5, updated_at=now()
+        WHERE id=$1`, id, c.Key, c.ContentType, c.Size, c.UpdatedAt)
+	return err
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListCreatorActions returns what a creator has to act on across their giveaways, soonest
+// deadline first, then oldest. defaultPendingTTLSec applies to pending giveaways without an
+// override, as in ListExpiredPendingIDs.
+func (r *GiveawayRepository) ListCreatorActions(ctx context.Context, creatorID int64, defaultPendingTTLSec int) ([]dg.CreatorAction, error) {
+	const q = `
+        SELECT id, title, status, 'select_winners', 0, COALESCE(pending_since, updated_at),
+               CASE WHEN COALESCE(pending_ttl_sec, $2) > 0 AND pending_since IS NOT NULL
+                    THEN pending_since + make_interval(secs => COALESCE(pending_ttl_sec, $2)) END
+        FROM giveaways
+        WHERE creator_id=$1 AND status='pending'
+        UNION ALL
+        SELECT g.id, g.title, g.status, 'fund_escrow', 0, f.created_at, g.ends_at
+        FROM giveaways g JOIN giveaway_funding f ON f.giveaway_id = g.id
+        WHERE g.creator_id=$1 AND g.status='scheduled' AND f.status='awaiting'
+        UNION ALL
+        SELECT g.id, g.title, g.status, 'resolve_dispute', COUNT(*), MIN(w.disputed_at), NULL
+        FROM giveaways g JOIN giveaway_winners w ON w.giveaway_id = g.id
+        WHERE g.creator_id=$1 AND w.disputed_at IS NOT NULL AND w.fulfillment_status <> 'delivered'
+        GROUP BY g.id
+        UNION ALL
+        SELECT g.id, g.title, g.status, 'redraw_unclaimed', COUNT(*),
+               MIN(w.assigned_at + g.claim_deadline_sec * interval '1 second'), NULL
+        FROM giveaways g JOIN giveaway_winners w ON w.giveaway_id = g.id
+        WHERE g.creator_id=$1 AND g.status='completed' AND g.claim_deadline_sec IS NOT NULL
+          AND w.fulfillment_status = 'pending'
+          AND w.assigned_at + g.claim_deadline_sec * interval '1 second' <= now()
+          AND NOT EXISTS (SELECT 1 FROM giveaway_reserves rv
+                          WHERE rv.giveaway_id = g.id AND rv.status = 'reserve'
+                            AND rv.user_id NOT IN (SELECT user_id FROM giveaway_winners WHERE giveaway_id = g.id))
+        GROUP BY g.id
+        ORDER BY 7 ASC NULLS LAST, 6 ASC`
+	rows, err := r.db.QueryContext(ctx, q, creatorID, defaultPendingTTLSec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.CreatorAction, 0)
+	for rows.Next() {
+		var a dg.CreatorAction
+		var deadline sql.NullTime
+		if err := rows.Scan(&a.GiveawayID, &a.Title, &a.Status, &a.Action, &a.Count, &a.Since, &deadline); err != nil {
+			return nil, err
+		}
+		if deadline.Valid {
+			t := deadline.Time
+			a.Deadline = &t
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
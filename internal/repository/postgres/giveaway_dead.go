@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// deadFlagField is the moderation flag field used for giveaways queued by the dead giveaway detector.
+const deadFlagField = "giveaway"
+
+// SetBotBlocked records whether a user blocked the bot in private chat.
+func (r *GiveawayRepository) SetBotBlocked(ctx context.Context, userID int64, blocked bool) error {
+	const q = `
+        UPDATE users
+        SET bot_blocked_at = CASE WHEN $2 THEN COALESCE(bot_blocked_at, now()) ELSE NULL END
+        WHERE id=$1`
+	_, err := r.db.ExecContext(ctx, q, userID, blocked)
+	return err
+}
+
+// ListDeadCandidates returns scheduled and active giveaways not yet flagged as dead whose creator
+// blocked the bot, or whose channel requirements are all unverifiable. Oldest first.
+func (r *GiveawayRepository) ListDeadCandidates(ctx context.Context, limit int) ([]dg.DeadGiveaway, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	const q = `
+        SELECT g.id, g.title, g.creator_id,
+               CASE WHEN u.bot_blocked_at IS NOT NULL THEN 'creator_blocked_bot' ELSE 'channels_inaccessible' END
+        FROM giveaways g
+        LEFT JOIN users u ON u.id = g.creator_id
+        WHERE g.status IN ('scheduled', 'active')
+          AND g.dead_since IS NULL
+          AND (
+            u.bot_blocked_at IS NOT NULL
+            OR (
+              EXISTS (SELECT 1 FROM giveaway_requirements gr
+                      WHERE gr.giveaway_id = g.id AND gr.type IN ('subscription', 'boost'))
+              AND NOT EXISTS (SELECT 1 FROM giveaway_requirements gr
+                              WHERE gr.giveaway_id = g.id AND gr.type IN ('subscription', 'boost')
+                                AND gr.unverifiable_since IS NULL)
+            )
+          )
+        ORDER BY g.created_at ASC
+        LIMIT $1`
+	rows, err := r.db.QueryContext(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.DeadGiveaway
+	for rows.Next() {
+		var d dg.DeadGiveaway
+		if err := rows.Scan(&d.GiveawayID, &d.Title, &d.CreatorID, &d.Reason); err != nil {
+			return nil, err
+		}
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
+
+// MarkDead flags a running giveaway as dead, queues it for platform review and, when dmText is set,
+// queues a warning for every participant. Reports false when the giveaway was already flagged or
+// is no longer running.
+func (r *GiveawayRepository) MarkDead(ctx context.Context, d dg.DeadGiveaway, match, dmText string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var res sql.Result
+	res, err = tx.ExecContext(ctx, `
+        UPDATE giveaways SET dead_since=now(), dead_reason=$2
+        WHERE id=$1 AND dead_since IS NULL AND status IN ('scheduled', 'active')`, d.GiveawayID, string(d.Reason))
+	if err != nil {
+		return false, err
+	}
+	var n int64
+	if n, err = res.RowsAffected(); err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, tx.Commit()
+	}
+	flag := dg.ModerationFlag{Field: deadFlagField, Rule: "dead_" + string(d.Reason), Match: match}
+	if err = insertModerationFlags(ctx, tx, d.GiveawayID, []dg.ModerationFlag{flag}); err != nil {
+		return false, err
+	}
+	if dmText != "" {
+		if err = enqueueBroadcast(ctx, tx, d.GiveawayID, dg.BroadcastDeadWarning, dmText); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+// DeadReviewReason returns why a giveaway was flagged as dead while its review is still pending,
+// or "" when it is not awaiting review.
+func (r *GiveawayRepository) DeadReviewReason(ctx context.Context, id string) (dg.DeadReason, error) {
+	const q = `
+        SELECT g.dead_reason
+        FROM giveaways g
+        WHERE g.id=$1 AND g.dead_since IS NOT NULL
+          AND EXISTS (SELECT 1 FROM giveaway_moderation_flags f
+                      WHERE f.giveaway_id = g.id AND f.field = $2 AND f.status = 'pending')`
+	var reason string
+	err := r.db.QueryRowContext(ctx, q, id, deadFlagField).Scan(&reason)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return dg.DeadReason(reason), err
+}
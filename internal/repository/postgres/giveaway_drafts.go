@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// draftEscrow is the escrow amount kept on a draft until it is published, or 0.
+func draftEscrow(g *dg.Giveaway) int64 {
+	if g.Status != dg.GiveawayStatusDraft || g.Funding == nil {
+		return 0
+	}
+	return g.Funding.AmountNano
+}
+
+// ListDrafts returns the drafts of a creator, most recently edited first.
+func (r *GiveawayRepository) ListDrafts(ctx context.Context, creatorID int64, limit, offset int) ([]dg.Giveaway, error) {
+	const q = `
+        SELECT id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, created_at, updated_at
+        FROM giveaways WHERE creator_id=$1 AND status='draft'
+        ORDER BY updated_at DESC
+        LIMIT $2 OFFSET $3`
+	return r.listWithSponsors(ctx, q, creatorID, limit, offset)
+}
+
+// ReplaceDraft overwrites a draft of g.CreatorID with g, including its prizes, sponsors,
+// requirements and moderation flags. Reports false when g.ID is not a draft of the creator.
+func (r *GiveawayRepository) ReplaceDraft(ctx context.Context, g *dg.Giveaway) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	strategy := g.WinnerStrategy
+	if strategy == "" {
+		strategy = dg.WinnerStrategyRandom
+	}
+	const q = `
+        UPDATE giveaways SET title=$3, description=$4, started_at=$5, ends_at=$6, duration=$7, winners_count=$8, winner_strategy=$9,
+            updated_at=now(), pending_ttl_sec=$10, pending_action=NULLIF($11,''), join_windows=$12, join_timezone=NULLIF($13,''),
+            max_per_fingerprint=NULLIF($14,0), winner_order=NULLIF($15,''), reserve_winners_count=$16, claim_deadline_sec=$17,
+            unsubscribe_grace_sec=$18, rules=NULLIF($19,''), faq=$20, announce_rules=$21, draft_escrow_nano=NULLIF($22,0),
+            translations=$23, prize_packages=$24, category=NULLIF($25,''), tags=$26,
+            max_participants=NULLIF($27,0)
+        WHERE id=$1 AND creator_id=$2 AND status='draft'`
+	res, err := tx.ExecContext(ctx, q,
+		g.ID, g.CreatorID, g.Title, storedDescription(g.Description, g.DescriptionHTML), g.StartedAt, g.EndsAt, g.Duration, g.MaxWinnersCount, string(strategy),
+		nullableInt(g.PendingTTLSec), string(g.PendingAction), joinWindowsJSON(g.JoinWindows), g.JoinTimezone, g.MaxPerFingerprint, string(g.WinnerOrder),
+		g.ReserveWinnersCount, nullableInt(g.ClaimDeadlineSec), nullableInt(g.UnsubscribeGraceSec),
+		g.Rules, faqJSON(g.FAQ), g.AnnounceRules, draftEscrow(g), translationsJSON(g.Translations), prizePackagesJSON(g.PrizePackages),
+		string(g.Category), tagsArray(g.Tags), g.MaxParticipants,
+	)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		err = tx.Rollback()
+		return false, err
+	}
+	for _, t := range []string{"giveaway_prizes", "giveaway_sponsors", "giveaway_requirements", "giveaway_moderation_flags"} {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM `+t+` WHERE giveaway_id=$1`, g.ID); err != nil {
+			return false, err
+		}
+	}
+	if err = insertGiveawayContent(ctx, tx, g); err != nil {
+		return false, err
+	}
+	if err = insertModerationFlags(ctx, tx, g.ID, g.ModerationFlags); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// Publish moves a draft to g.Status with the run time of g, then records what Create records
+// for new giveaways: funding, approval hold, member snapshots and the giveaway_created event.
+// Reports false when g.ID is no longer a draft.
+func (r *GiveawayRepository) Publish(ctx context.Context, g *dg.Giveaway) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var from dg.GiveawayStatus
+	err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, g.ID).Scan(&from)
+	if err == sql.ErrNoRows || (err == nil && from != dg.GiveawayStatusDraft) {
+		err = tx.Rollback()
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+	const q = `
+        UPDATE giveaways SET status=$2, started_at=$3, ends_at=$4, updated_at=now(), draft_escrow_nano=NULL
+        WHERE id=$1`
+	if _, err = tx.ExecContext(ctx, q, g.ID, string(g.Status), g.StartedAt, g.EndsAt); err != nil {
+		return false, err
+	}
+	if err = insertStatusChange(ctx, tx, g.ID, from, g.Status, "published", g.CreatorID); err != nil {
+		return false, err
+	}
+	if g.Funding != nil {
+		if err = insertFunding(ctx, tx, g.ID, g.Funding); err != nil {
+			return false, err
+		}
+	}
+	if g.Approval != nil {
+		if err = insertApproval(ctx, tx, g.ID, g.Approval); err != nil {
+			return false, err
+		}
+	}
+	if g.Status == dg.GiveawayStatusActive {
+		if err = insertMemberSnapshots(ctx, tx, g.ID); err != nil {
+			return false, err
+		}
+	}
+	if err = enqueueCreated(ctx, tx, g); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
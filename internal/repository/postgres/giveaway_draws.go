@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// FinishWithDraw persists winners like FinishWithWinners and records the draw inputs in the same transaction.
+func (r *GiveawayRepository) FinishWithDraw(ctx context.Context, d *dg.Draw) error {
+	return r.finishWithWinners(ctx, d.GiveawayID, d.Winners, d)
+}
+
+func insertDraw(ctx context.Context, tx *sql.Tx, d *dg.Draw) error {
+	const q = `
+        INSERT INTO giveaway_draws (giveaway_id, strategy, seed, participants_hash, participants_count, skipped, winners)
+        VALUES ($1,$2,$3,$4,$5,$6,$7)`
+	skipped := d.Skipped
+	if skipped == nil {
+		skipped = []int64{}
+	}
+	winners := d.Winners
+	if winners == nil {
+		winners = []int64{}
+	}
+	var drawID int64
+	if err := tx.QueryRowContext(ctx, q+` RETURNING id`, d.GiveawayID, string(d.Strategy), d.Seed, d.ParticipantsHash, d.ParticipantsCount, pq.Array(skipped), pq.Array(winners)).Scan(&drawID); err != nil {
+		return err
+	}
+	d.ID = drawID
+	// A new draw replaces the reserves of an earlier one
+	if _, err := tx.ExecContext(ctx, `DELETE FROM giveaway_reserves WHERE giveaway_id=$1`, d.GiveawayID); err != nil {
+		return err
+	}
+	for i, uid := range d.Reserves {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO giveaway_reserves (giveaway_id, position, user_id) VALUES ($1,$2,$3)`, d.GiveawayID, i+1, uid); err != nil {
+			return err
+		}
+	}
+	const qs = `
+        INSERT INTO giveaway_requirement_snapshots (giveaway_id, draw_id, user_id, requirement_index, requirement_type, channel_id, status, error, checked_at)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)`
+	for _, c := range d.Checks {
+		var cid interface{}
+		if c.ChannelID != 0 {
+			cid = c.ChannelID
+		}
+		if _, err := tx.ExecContext(ctx, qs, d.GiveawayID, drawID, c.UserID, c.RequirementIndex, string(c.RequirementType), cid, c.Status, c.Error, c.CheckedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListRequirementSnapshots returns requirement outcomes recorded by the latest draw of a giveaway.
+func (r *GiveawayRepository) ListRequirementSnapshots(ctx context.Context, id string) ([]dg.RequirementSnapshot, error) {
+	const q = `
+        SELECT user_id, requirement_index, requirement_type, COALESCE(channel_id,0), status, error, checked_at
+        FROM giveaway_requirement_snapshots
+        WHERE draw_id = (SELECT id FROM giveaway_draws WHERE giveaway_id=$1 ORDER BY created_at DESC, id DESC LIMIT 1)
+        ORDER BY id ASC`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.RequirementSnapshot
+	for rows.Next() {
+		var sn dg.RequirementSnapshot
+		if err := rows.Scan(&sn.UserID, &sn.RequirementIndex, &sn.RequirementType, &sn.ChannelID, &sn.Status, &sn.Error, &sn.CheckedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, sn)
+	}
+	return out, rows.Err()
+}
+
+// ListUserRequirementSnapshots returns the latest recorded outcome of each requirement for
+// each of userIDs in a giveaway, across draws, waves and replacements, ordered by user and
+// requirement.
+func (r *GiveawayRepository) ListUserRequirementSnapshots(ctx context.Context, id string, userIDs []int64) ([]dg.RequirementSnapshot, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	const q = `
+        SELECT DISTINCT ON (user_id, requirement_index)
+               user_id, requirement_index, requirement_type, COALESCE(channel_id,0), status, error, checked_at
+        FROM giveaway_requirement_snapshots
+        WHERE giveaway_id=$1 AND user_id = ANY($2)
+        ORDER BY user_id, requirement_index, checked_at DESC, id DESC`
+	rows, err := r.db.QueryContext(ctx, q, id, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.RequirementSnapshot
+	for rows.Next() {
+		var sn dg.RequirementSnapshot
+		if err := rows.Scan(&sn.UserID, &sn.RequirementIndex, &sn.RequirementType, &sn.ChannelID, &sn.Status, &sn.Error, &sn.CheckedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, sn)
+	}
+	return out, rows.Err()
+}
+
+// GetLatestDraw returns the most recent draw of a giveaway or nil when none was recorded.
+func (r *GiveawayRepository) GetLatestDraw(ctx context.Context, id string) (*dg.Draw, error) {
+	const q = `
+        SELECT id, giveaway_id, strategy, seed, participants_hash, participants_count, skipped, winners, created_at
+        FROM giveaway_draws WHERE giveaway_id=$1
+        ORDER BY created_at DESC, id DESC LIMIT 1`
+	var d dg.Draw
+	var skipped, winners pq.Int64Array
+	err := r.db.QueryRowContext(ctx, q, id).Scan(&d.ID, &d.GiveawayID, &d.Strategy, &d.Seed, &d.ParticipantsHash, &d.ParticipantsCount, &skipped, &winners, &d.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.Skipped = []int64(skipped)
+	d.Winners = []int64(winners)
+	return &d, nil
+}
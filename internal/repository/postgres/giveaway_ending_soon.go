@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// activeListColumns are the columns of explore feed entries, as in ListActive.
+const activeListColumns = `
+        SELECT g.id, g.creator_id, g.title, g.description, g.started_at, g.ends_at,
+               g.duration, g.winners_count, g.status, g.created_at, g.updated_at,
+               (SELECT COUNT(*)::int FROM giveaway_participants p WHERE p.giveaway_id = g.id) AS participants_count,
+               COALESCE(g.category,''), g.tags
+        FROM giveaways g`
+
+// ListActiveEndsAt returns the end time of every active giveaway by id.
+func (r *GiveawayRepository) ListActiveEndsAt(ctx context.Context) (map[string]time.Time, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, ends_at FROM giveaways WHERE status='active'`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := map[string]time.Time{}
+	for rows.Next() {
+		var id string
+		var endsAt time.Time
+		if err := rows.Scan(&id, &endsAt); err != nil {
+			return nil, err
+		}
+		out[id] = endsAt
+	}
+	return out, rows.Err()
+}
+
+// ListActiveByIDs returns the giveaways among ids that are still active, in the order of ids.
+func (r *GiveawayRepository) ListActiveByIDs(ctx context.Context, ids []string) ([]dg.Giveaway, error) {
+	if len(ids) == 0 {
+		return []dg.Giveaway{}, nil
+	}
+	return r.listActiveFeed(ctx, activeListColumns+`
+        JOIN unnest($1::text[]) WITH ORDINALITY AS ids(id, pos) ON ids.id = g.id
+        WHERE g.status='active'
+        ORDER BY ids.pos`, pq.Array(ids))
+}
+
+// ListEndingSoon returns active giveaways that have not ended yet, soonest end first.
+func (r *GiveawayRepository) ListEndingSoon(ctx context.Context, limit, offset int) ([]dg.Giveaway, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return r.listActiveFeed(ctx, activeListColumns+`
+        WHERE g.status='active' AND g.ends_at > now()
+        ORDER BY g.ends_at ASC, g.id
+        LIMIT $1 OFFSET $2`, limit, offset)
+}
+
+// listActiveFeed runs a query over activeListColumns and loads the sponsors of each row.
+func (r *GiveawayRepository) listActiveFeed(ctx context.Context, q string, args ...any) ([]dg.Giveaway, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]dg.Giveaway, 0)
+	for rows.Next() {
+		var g dg.Giveaway
+		if err := rows.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt,
+			&g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt, &g.ParticipantsCount,
+			&g.Category, pq.Array(&g.Tags)); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		g.Description, g.DescriptionHTML = loadDescription(g.Description)
+		out = append(out, g)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	const qs = sponsorDisplaySelect
+	for i := range out {
+		srows, err := r.db.QueryContext(ctx, qs, out[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		for srows.Next() {
+			var s dg.ChannelInfo
+			if err := srows.Scan(&s.Username, &s.URL, &s.Title, &s.ID, &s.AvatarURL); err != nil {
+				srows.Close()
+				return nil, err
+			}
+			if s.URL == "" && s.Username != "" {
+				s.URL = "https://t.me/" + s.Username
+			}
+			out[i].Sponsors = append(out[i].Sponsors, s)
+		}
+		srows.Close()
+	}
+	return out, nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// RecordFingerprint stores the join fingerprint of a participant and flags the join when
+// at least limit other participants already used it (limit 0 only records it). Returns
+// whether the join was flagged and how many participants share the fingerprint.
+func (r *GiveawayRepository) RecordFingerprint(ctx context.Context, id string, userID int64, fingerprint string, limit int) (bool, int, error) {
+	const q = `
+        WITH others AS (
+            SELECT COUNT(*) AS n FROM giveaway_participants
+            WHERE giveaway_id=$1 AND fingerprint=$3 AND user_id<>$2
+        )
+        UPDATE giveaway_participants p
+        SET fingerprint=$3, fingerprint_flagged = ($4 > 0 AND others.n >= $4)
+        FROM others
+        WHERE p.giveaway_id=$1 AND p.user_id=$2 AND p.fingerprint IS NULL
+        RETURNING p.fingerprint_flagged, others.n + 1`
+	var flagged bool
+	var accounts int
+	err := r.db.QueryRowContext(ctx, q, id, userID, fingerprint, limit).Scan(&flagged, &accounts)
+	if err == sql.ErrNoRows {
+		return false, 0, nil
+	}
+	return flagged, accounts, err
+}
+
+// ListFingerprintFlags returns flagged participants ordered by fingerprint and join time.
+// Fingerprints are replaced by a group number so they never leave the database.
+func (r *GiveawayRepository) ListFingerprintFlags(ctx context.Context, id string) ([]dg.FingerprintFlag, error) {
+	const q = `
+        SELECT p.user_id, p.joined_at,
+               (SELECT COUNT(*) FROM giveaway_participants o WHERE o.giveaway_id=p.giveaway_id AND o.fingerprint=p.fingerprint),
+               DENSE_RANK() OVER (ORDER BY p.fingerprint)
+        FROM giveaway_participants p
+        WHERE p.giveaway_id=$1 AND p.fingerprint_flagged
+        ORDER BY p.fingerprint, p.joined_at`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.FingerprintFlag
+	for rows.Next() {
+		var f dg.FingerprintFlag
+		if err := rows.Scan(&f.UserID, &f.JoinedAt, &f.Accounts, &f.Group); err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
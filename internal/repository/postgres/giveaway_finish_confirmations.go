@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// GetFinishConfirmation returns the finish preview of a giveaway, or nil.
+func (r *GiveawayRepository) GetFinishConfirmation(ctx context.Context, id string) (*dg.FinishConfirmation, error) {
+	const q = `
+        SELECT giveaway_id, trigger, status, required, participants_count, requested_at, COALESCE(decided_by, 0), decided_at
+        FROM giveaway_finish_confirmations WHERE giveaway_id=$1`
+	var c dg.FinishConfirmation
+	var decidedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, q, id).Scan(&c.GiveawayID, &c.Trigger, &c.Status, &c.Required, &c.ParticipantsCount, &c.RequestedAt, &c.DecidedBy, &decidedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if decidedAt.Valid {
+		t := decidedAt.Time
+		c.DecidedAt = &t
+	}
+	return &c, nil
+}
+
+// RequestFinishConfirmation opens a finish preview, replacing an earlier one of the giveaway.
+func (r *GiveawayRepository) RequestFinishConfirmation(ctx context.Context, c *dg.FinishConfirmation) error {
+	const q = `
+        INSERT INTO giveaway_finish_confirmations (giveaway_id, trigger, status, required, participants_count, requested_at)
+        VALUES ($1,$2,'awaiting',$3,$4,now())
+        ON CONFLICT (giveaway_id) DO UPDATE
+        SET trigger=EXCLUDED.trigger, status='awaiting', required=EXCLUDED.required,
+            participants_count=EXCLUDED.participants_count, requested_at=now(), decided_by=NULL, decided_at=NULL
+        RETURNING requested_at`
+	c.Status = dg.FinishAwaiting
+	c.DecidedBy = 0
+	c.DecidedAt = nil
+	return r.db.QueryRowContext(ctx, q, c.GiveawayID, string(c.Trigger), c.Required, c.ParticipantsCount).Scan(&c.RequestedAt)
+}
+
+// DecideFinishConfirmation records an admin's answer to an awaiting finish preview. It reports
+// false when no preview was awaiting.
+func (r *GiveawayRepository) DecideFinishConfirmation(ctx context.Context, id string, status dg.FinishConfirmationStatus, actorID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_finish_confirmations SET status=$2, decided_by=$3, decided_at=now()
+        WHERE giveaway_id=$1 AND status='awaiting'`, id, string(status), actorID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ListFinishPreviewDue returns pending giveaways that resolve automatically within lead and were
+// not previewed yet. defaultTTLSec applies when a giveaway has no TTL override.
+func (r *GiveawayRepository) ListFinishPreviewDue(ctx context.Context, defaultTTLSec int, lead time.Duration, limit int) ([]string, error) {
+	const q = `
+        SELECT g.id FROM giveaways g
+        WHERE g.status='pending' AND g.pending_since IS NOT NULL
+          AND COALESCE(g.pending_ttl_sec, $1) > 0
+          AND g.pending_since + make_interval(secs => COALESCE(g.pending_ttl_sec, $1)) <= now() + make_interval(secs => $2)
+          AND NOT EXISTS (SELECT 1 FROM giveaway_finish_confirmations c WHERE c.giveaway_id = g.id)
+        ORDER BY g.pending_since ASC
+        LIMIT $3`
+	rows, err := r.db.QueryContext(ctx, q, defaultTTLSec, lead.Seconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
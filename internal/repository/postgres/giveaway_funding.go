@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// insertFunding records the escrow deposit a new giveaway waits for.
+func insertFunding(ctx context.Context, tx execer, id string, f *dg.Funding) error {
+	const q = `INSERT INTO giveaway_funding (giveaway_id, memo, amount_nano) VALUES ($1,$2,$3)`
+	_, err := tx.ExecContext(ctx, q, id, f.Memo, f.AmountNano)
+	return err
+}
+
+// GetFunding returns the escrow funding of a giveaway with its credited deposits, or nil when
+// the giveaway is not escrow-funded. Address is left empty; it comes from configuration.
+func (r *GiveawayRepository) GetFunding(ctx context.Context, id string) (*dg.Funding, error) {
+	const q = `
+        SELECT giveaway_id, memo, amount_nano, received_nano, status, funded_at
+        FROM giveaway_funding WHERE giveaway_id=$1`
+	var f dg.Funding
+	var fundedAt sql.NullTime
+	if err := r.db.QueryRowContext(ctx, q, id).Scan(&f.GiveawayID, &f.Memo, &f.AmountNano, &f.ReceivedNano, &f.Status, &fundedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if fundedAt.Valid {
+		t := fundedAt.Time
+		f.FundedAt = &t
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT tx_hash, lt, sender, amount_nano, received_at FROM giveaway_funding_deposits WHERE giveaway_id=$1 ORDER BY lt ASC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var d dg.Deposit
+		if err := rows.Scan(&d.TxHash, &d.Lt, &d.Sender, &d.AmountNano, &d.ReceivedAt); err != nil {
+			return nil, err
+		}
+		f.Deposits = append(f.Deposits, d)
+	}
+	return &f, rows.Err()
+}
+
+// RecordDeposit credits an incoming escrow transfer to the giveaway whose memo matches.
+// Transfers already recorded are ignored. Once the credited total covers the required amount
+// the funding is marked funded and a scheduled giveaway that has not ended becomes active,
+// unless it is still held for admin approval.
+// Returns the matched giveaway id (empty when no giveaway uses memo) and whether this
+// deposit completed the funding.
+func (r *GiveawayRepository) RecordDeposit(ctx context.Context, memo string, d dg.Deposit) (string, bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var id string
+	var status dg.FundingStatus
+	var amount, received int64
+	err = tx.QueryRowContext(ctx, `SELECT giveaway_id, status, amount_nano, received_nano FROM giveaway_funding WHERE memo=$1 FOR UPDATE`, memo).Scan(&id, &status, &amount, &received)
+	if err == sql.ErrNoRows {
+		err = nil
+		return "", false, tx.Commit()
+	}
+	if err != nil {
+		return "", false, err
+	}
+	res, err := tx.ExecContext(ctx, `
+        INSERT INTO giveaway_funding_deposits (tx_hash, giveaway_id, lt, sender, amount_nano, received_at)
+        VALUES ($1,$2,$3,$4,$5,$6)
+        ON CONFLICT (tx_hash) DO NOTHING`, d.TxHash, id, d.Lt, d.Sender, d.AmountNano, d.ReceivedAt)
+	if err != nil {
+		return "", false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return id, false, tx.Commit()
+	}
+	received += d.AmountNano
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaway_funding SET received_nano=$2 WHERE giveaway_id=$1`, id, received); err != nil {
+		return "", false, err
+	}
+	if status != dg.FundingStatusAwaiting || received < amount {
+		return id, false, tx.Commit()
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaway_funding SET status='funded', funded_at=now() WHERE giveaway_id=$1`, id); err != nil {
+		return "", false, err
+	}
+	var gs dg.GiveawayStatus
+	var ended, held bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT status, ends_at <= now(),
+               EXISTS (SELECT 1 FROM giveaway_approvals a WHERE a.giveaway_id=giveaways.id AND a.status='awaiting')
+        FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&gs, &ended, &held)
+	if err != nil {
+		return "", false, err
+	}
+	// Giveaways held for admin approval start when approved
+	if gs == dg.GiveawayStatusScheduled && !ended && !held {
+		if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='active', updated_at=now() WHERE id=$1`, id); err != nil {
+			return "", false, err
+		}
+		if err = insertStatusChange(ctx, tx, id, gs, dg.GiveawayStatusActive, "escrow funded", 0); err != nil {
+			return "", false, err
+		}
+		if err = insertMemberSnapshots(ctx, tx, id); err != nil {
+			return "", false, err
+		}
+	}
+	return id, true, tx.Commit()
+}
+
+// ListUnfundedExpiredIDs returns scheduled giveaways that ended while still awaiting their deposit.
+func (r *GiveawayRepository) ListUnfundedExpiredIDs(ctx context.Context) ([]string, error) {
+	const q = `
+        SELECT g.id FROM giveaways g
+        JOIN giveaway_funding f ON f.giveaway_id = g.id
+        WHERE f.status='awaiting' AND g.ends_at <= now() AND g.status='scheduled'
+        ORDER BY g.ends_at ASC`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ExpireFunding stops waiting for the deposit of a giveaway that is still awaiting it.
+func (r *GiveawayRepository) ExpireFunding(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_funding SET status='expired' WHERE giveaway_id=$1 AND status='awaiting'`, id)
+	return err
+}
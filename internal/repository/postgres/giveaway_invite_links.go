@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CreateInviteLink stores an invite link of a giveaway while it has fewer than max links.
+// Returns false when the cap is reached; creating an existing link again is a no-op.
+func (r *GiveawayRepository) CreateInviteLink(ctx context.Context, giveawayID, name string, createdBy int64, max int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        INSERT INTO giveaway_invite_links (giveaway_id, name, created_by)
+        SELECT $1, $2, $3
+        WHERE EXISTS (SELECT 1 FROM giveaway_invite_links WHERE giveaway_id=$1 AND name=$2)
+           OR (SELECT count(*) FROM giveaway_invite_links WHERE giveaway_id=$1) < $4
+        ON CONFLICT (giveaway_id, name) DO NOTHING`, giveawayID, name, createdBy, max)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return true, nil
+	}
+	return r.HasInviteLink(ctx, giveawayID, name)
+}
+
+// DeleteInviteLink removes an invite link; joins recorded through it stay. Returns false when
+// the giveaway has no such link.
+func (r *GiveawayRepository) DeleteInviteLink(ctx context.Context, giveawayID, name string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM giveaway_invite_links WHERE giveaway_id=$1 AND name=$2`, giveawayID, name)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// HasInviteLink reports whether the giveaway has an invite link named name.
+func (r *GiveawayRepository) HasInviteLink(ctx context.Context, giveawayID, name string) (bool, error) {
+	var one int
+	err := r.db.QueryRowContext(ctx, `SELECT 1 FROM giveaway_invite_links WHERE giveaway_id=$1 AND name=$2`, giveawayID, name).Scan(&one)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListInviteLinks returns the invite links of a giveaway with the participants who joined
+// through each, most joins first.
+func (r *GiveawayRepository) ListInviteLinks(ctx context.Context, giveawayID string) ([]dg.InviteLink, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT l.name, l.created_at, COUNT(p.user_id)
+        FROM giveaway_invite_links l
+        LEFT JOIN giveaway_participants p
+          ON p.giveaway_id = l.giveaway_id AND p.source = 'invite' AND p.source_ref = l.name
+        WHERE l.giveaway_id=$1
+        GROUP BY l.name, l.created_at
+        ORDER BY COUNT(p.user_id) DESC, l.created_at, l.name`, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.InviteLink{}
+	for rows.Next() {
+		var l dg.InviteLink
+		if err := rows.Scan(&l.Name, &l.CreatedAt, &l.Participants); err != nil {
+			return nil, err
+		}
+		l.CreatedAt = l.CreatedAt.UTC()
+		l.StartParam = dg.InviteStartParam(giveawayID, l.Name)
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// joinWindowsJSON encodes join windows for the join_windows column; none are stored as NULL.
+func joinWindowsJSON(windows []dg.JoinWindow) interface{} {
+	if len(windows) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(windows)
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+func loadJoinWindows(b []byte) ([]dg.JoinWindow, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var windows []dg.JoinWindow
+	if err := json.Unmarshal(b, &windows); err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
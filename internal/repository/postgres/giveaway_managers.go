@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// AddManager grants userID the manager role on a giveaway while it has fewer than max
+// managers. Returns false when the cap is reached; adding a manager twice is a no-op.
+func (r *GiveawayRepository) AddManager(ctx context.Context, giveawayID string, userID, addedBy int64, max int) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	// Serialize grants of one giveaway so concurrent adds cannot pass the cap
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM giveaways WHERE id=$1 FOR NO KEY UPDATE`, giveawayID); err != nil {
+		return false, err
+	}
+	var n int
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `
+        SELECT count(*), COALESCE(bool_or(user_id=$2), false) FROM giveaway_managers WHERE giveaway_id=$1`, giveawayID, userID).Scan(&n, &exists); err != nil {
+		return false, err
+	}
+	if exists {
+		return true, tx.Commit()
+	}
+	if n >= max {
+		return false, nil
+	}
+	if _, err := tx.ExecContext(ctx, `
+        INSERT INTO giveaway_managers (giveaway_id, user_id, added_by) VALUES ($1, $2, $3)`, giveawayID, userID, addedBy); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// RemoveManager revokes the manager role; returns false when the user was no manager.
+func (r *GiveawayRepository) RemoveManager(ctx context.Context, giveawayID string, userID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM giveaway_managers WHERE giveaway_id=$1 AND user_id=$2`, giveawayID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// ListManagers returns the managers of a giveaway, oldest grant first.
+func (r *GiveawayRepository) ListManagers(ctx context.Context, giveawayID string) ([]dg.Manager, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT m.user_id, COALESCE(u.username,''), COALESCE(u.first_name,''), COALESCE(u.last_name,''), m.added_by, m.created_at
+        FROM giveaway_managers m
+        LEFT JOIN users u ON u.id = m.user_id
+        WHERE m.giveaway_id=$1
+        ORDER BY m.created_at, m.user_id`, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.Manager{}
+	for rows.Next() {
+		var m dg.Manager
+		if err := rows.Scan(&m.UserID, &m.Username, &m.FirstName, &m.LastName, &m.AddedBy, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		m.CreatedAt = m.CreatedAt.UTC()
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
+
+// IsManager reports whether userID manages the giveaway.
+func (r *GiveawayRepository) IsManager(ctx context.Context, giveawayID string, userID int64) (bool, error) {
+	var one int
+	err := r.db.QueryRowContext(ctx, `SELECT 1 FROM giveaway_managers WHERE giveaway_id=$1 AND user_id=$2`, giveawayID, userID).Scan(&one)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListManagedBy returns the published giveaways userID manages, newest first.
+func (r *GiveawayRepository) ListManagedBy(ctx context.Context, userID int64, limit, offset int) ([]dg.Giveaway, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	const q = `
+        SELECT g.id, g.creator_id, g.title, g.description, g.started_at, g.ends_at, g.duration, g.winners_count, g.status, g.created_at, g.updated_at
+        FROM giveaways g
+        JOIN giveaway_managers m ON m.giveaway_id = g.id
+        WHERE m.user_id=$1 AND g.status<>'draft'
+        ORDER BY g.created_at DESC
+        LIMIT $2 OFFSET $3`
+	return r.listWithSponsors(ctx, q, userID, limit, offset)
+}
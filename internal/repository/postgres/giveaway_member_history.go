@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// trackedChannelCond limits membership history to channels used by a subscription requirement
+// of any giveaway, so history is already collected when a later giveaway asks for member days.
+const trackedChannelCond = `
+        EXISTS (SELECT 1 FROM giveaway_requirements r WHERE r.channel_id=$1 AND r.type='subscription')`
+
+// RecordMemberSeen starts a membership in the channel history unless one is running.
+func (r *GiveawayRepository) RecordMemberSeen(ctx context.Context, channelID, userID int64, at time.Time) error {
+	q := `
+        INSERT INTO channel_member_history (channel_id, user_id, first_seen_at)
+        SELECT $1, $2, $3 WHERE` + trackedChannelCond + `
+        ON CONFLICT (channel_id, user_id) DO UPDATE
+        SET first_seen_at = COALESCE(channel_member_history.first_seen_at, EXCLUDED.first_seen_at)`
+	_, err := r.db.ExecContext(ctx, q, channelID, userID, at)
+	return err
+}
+
+// RecordMemberLeft ends the running membership in the channel history.
+func (r *GiveawayRepository) RecordMemberLeft(ctx context.Context, channelID, userID int64, at time.Time) error {
+	q := `
+        INSERT INTO channel_member_history (channel_id, user_id, last_left_at)
+        SELECT $1, $2, $3 WHERE` + trackedChannelCond + `
+        ON CONFLICT (channel_id, user_id) DO UPDATE
+        SET first_seen_at = NULL,
+            last_left_at = GREATEST(channel_member_history.last_left_at, EXCLUDED.last_left_at)`
+	_, err := r.db.ExecContext(ctx, q, channelID, userID, at)
+	return err
+}
+
+// GetMemberHistory returns what the bot observed about the user in the requirement channel,
+// with the start of the requirement's giveaway, or nil when nothing was observed.
+func (r *GiveawayRepository) GetMemberHistory(ctx context.Context, requirementID, userID int64) (*dg.MemberHistory, error) {
+	const q = `
+        SELECT h.first_seen_at, h.last_left_at, g.started_at
+        FROM giveaway_requirements r
+        JOIN giveaways g ON g.id = r.giveaway_id
+        JOIN channel_member_history h ON h.channel_id = r.channel_id AND h.user_id = $2
+        WHERE r.id = $1`
+	var firstSeen, lastLeft sql.NullTime
+	var h dg.MemberHistory
+	err := r.db.QueryRowContext(ctx, q, requirementID, userID).Scan(&firstSeen, &lastLeft, &h.GiveawayStartedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if firstSeen.Valid {
+		t := firstSeen.Time
+		h.FirstSeenAt = &t
+	}
+	if lastLeft.Valid {
+		t := lastLeft.Time
+		h.LastLeftAt = &t
+	}
+	return &h, nil
+}
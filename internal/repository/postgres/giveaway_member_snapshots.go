@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"time"
+)
+
+// insertMemberSnapshots records the activation moment for every channel whose subscription
+// requirement accepts existing members only. Channels already snapshotted keep their time.
+func insertMemberSnapshots(ctx context.Context, tx execer, id string) error {
+	const q = `
+        INSERT INTO giveaway_member_snapshots (giveaway_id, channel_id)
+        SELECT DISTINCT giveaway_id, channel_id FROM giveaway_requirements
+        WHERE giveaway_id=$1 AND existing_members_only AND channel_id IS NOT NULL
+        ON CONFLICT (giveaway_id, channel_id) DO NOTHING`
+	_, err := tx.ExecContext(ctx, q, id)
+	return err
+}
+
+// RecordMemberJoin stores a channel join observed by the bot. Joins are only kept for channels
+// used by an existing-members-only requirement of a giveaway that has not finished.
+// Returns whether the join was stored.
+func (r *GiveawayRepository) RecordMemberJoin(ctx context.Context, channelID, userID int64, at time.Time) (bool, error) {
+	const q = `
+        INSERT INTO channel_member_joins (channel_id, user_id, joined_at)
+        SELECT $1, $2, $3
+        WHERE EXISTS (
+            SELECT 1 FROM giveaway_requirements r JOIN giveaways g ON g.id = r.giveaway_id
+            WHERE r.channel_id=$1 AND r.existing_members_only AND g.status IN ('scheduled','active','pending'))
+        ON CONFLICT (channel_id, user_id) DO UPDATE SET joined_at = GREATEST(channel_member_joins.joined_at, EXCLUDED.joined_at)`
+	res, err := r.db.ExecContext(ctx, q, channelID, userID, at)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// JoinedAfterSnapshot reports whether the user's latest observed join of the requirement
+// channel happened at or after the giveaway's snapshot. Giveaways activated without a
+// snapshot are judged against their start time.
+func (r *GiveawayRepository) JoinedAfterSnapshot(ctx context.Context, requirementID, userID int64) (bool, error) {
+	const q = `
+        SELECT EXISTS (
+            SELECT 1 FROM giveaway_requirements r
+            JOIN giveaways g ON g.id = r.giveaway_id
+            JOIN channel_member_joins j ON j.channel_id = r.channel_id AND j.user_id = $2
+            LEFT JOIN giveaway_member_snapshots s ON s.giveaway_id = r.giveaway_id AND s.channel_id = r.channel_id
+            WHERE r.id=$1 AND j.joined_at >= COALESCE(s.taken_at, g.started_at))`
+	var after bool
+	err := r.db.QueryRowContext(ctx, q, requirementID, userID).Scan(&after)
+	return after, err
+}
+
+// PruneMemberJoins drops observed joins of channels no running giveaway judges anymore.
+func (r *GiveawayRepository) PruneMemberJoins(ctx context.Context) (int64, error) {
+	const q = `
+        DELETE FROM channel_member_joins j
+        WHERE NOT EXISTS (
+            SELECT 1 FROM giveaway_requirements r JOIN giveaways g ON g.id = r.giveaway_id
+            WHERE r.channel_id = j.channel_id AND r.existing_members_only
+              AND g.status IN ('scheduled','active','pending'))`
+	res, err := r.db.ExecContext(ctx, q)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
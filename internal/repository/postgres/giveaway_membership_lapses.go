@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// RecordMembershipLapses marks userID ineligible in every active giveaway with continuous
+// membership that they joined and that requires a subscription to channelID. It returns the new
+// lapses; giveaways where the user already lapsed in that channel keep their grace period.
+func (r *GiveawayRepository) RecordMembershipLapses(ctx context.Context, channelID, userID int64, at time.Time) ([]dg.MembershipLapse, error) {
+	const q = `
+        INSERT INTO giveaway_membership_lapses (giveaway_id, user_id, channel_id, left_at, grace_until)
+        SELECT g.id, $2, $1, $3, $3 + g.unsubscribe_grace_sec * interval '1 second'
+        FROM giveaways g
+        JOIN giveaway_participants p ON p.giveaway_id = g.id AND p.user_id = $2
+        WHERE g.status = 'active' AND g.unsubscribe_grace_sec IS NOT NULL
+          AND EXISTS (SELECT 1 FROM giveaway_requirements r
+                      WHERE r.giveaway_id = g.id AND r.channel_id = $1 AND r.type = 'subscription' AND r.bonus_tickets = 0 AND NOT r.optional)
+        ON CONFLICT (giveaway_id, user_id, channel_id) DO NOTHING
+        RETURNING giveaway_id, user_id, channel_id, left_at, grace_until`
+	rows, err := r.db.QueryContext(ctx, q, channelID, userID, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.MembershipLapse
+	for rows.Next() {
+		var l dg.MembershipLapse
+		if err := rows.Scan(&l.GiveawayID, &l.UserID, &l.ChannelID, &l.LeftAt, &l.GraceUntil); err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+// ClearMembershipLapses restores userID in giveaways where they re-subscribed to channelID
+// before the grace period ended, and returns how many lapses were cleared.
+func (r *GiveawayRepository) ClearMembershipLapses(ctx context.Context, channelID, userID int64, at time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+        DELETE FROM giveaway_membership_lapses
+        WHERE channel_id=$1 AND user_id=$2 AND grace_until > $3`, channelID, userID, at)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// ListLapsedUserIDs returns participants of a giveaway who left a required channel and did not
+// come back in time.
+func (r *GiveawayRepository) ListLapsedUserIDs(ctx context.Context, id string) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT DISTINCT user_id FROM giveaway_membership_lapses WHERE giveaway_id=$1`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var uid int64
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// insertModerationFlags stores flags raised while creating a giveaway.
+func insertModerationFlags(ctx context.Context, tx execer, id string, flags []dg.ModerationFlag) error {
+	const q = `INSERT INTO giveaway_moderation_flags (giveaway_id, field, rule, match, lang) VALUES ($1,$2,$3,$4,$5)`
+	for _, f := range flags {
+		if _, err := tx.ExecContext(ctx, q, id, f.Field, f.Rule, f.Match, f.Lang); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListModerationFlags returns flags with the giveaway title, optionally filtered by status, oldest first.
+func (r *GiveawayRepository) ListModerationFlags(ctx context.Context, status dg.ModerationStatus, limit, offset int) ([]dg.ModerationFlag, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	const q = `
+        SELECT f.id, f.giveaway_id, g.title, f.field, f.rule, f.match, f.lang, f.status, COALESCE(f.reviewed_by, 0), f.reviewed_at, f.created_at
+        FROM giveaway_moderation_flags f
+        JOIN giveaways g ON g.id = f.giveaway_id
+        WHERE ($1 = '' OR f.status = $1)
+        ORDER BY f.created_at ASC, f.id ASC
+        LIMIT $2 OFFSET $3`
+	rows, err := r.db.QueryContext(ctx, q, string(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ModerationFlag
+	for rows.Next() {
+		var f dg.ModerationFlag
+		var reviewedAt sql.NullTime
+		if err := rows.Scan(&f.ID, &f.GiveawayID, &f.GiveawayTitle, &f.Field, &f.Rule, &f.Match, &f.Lang, &f.Status, &f.ReviewedBy, &reviewedAt, &f.CreatedAt); err != nil {
+			return nil, err
+		}
+		if reviewedAt.Valid {
+			t := reviewedAt.Time
+			f.ReviewedAt = &t
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
+
+// ResolveModerationFlags closes the pending flags of a giveaway. Returns how many flags were resolved.
+func (r *GiveawayRepository) ResolveModerationFlags(ctx context.Context, id string, status dg.ModerationStatus, adminID int64) (int64, error) {
+	const q = `
+        UPDATE giveaway_moderation_flags
+        SET status=$2, reviewed_by=$3, reviewed_at=now()
+        WHERE giveaway_id=$1 AND status='pending'`
+	res, err := r.db.ExecContext(ctx, q, id, string(status), adminID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
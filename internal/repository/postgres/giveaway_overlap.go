@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+)
+
+// ParticipantOverlap intersects the participant sets of the given giveaways. Only users with
+// user_id mod 100 below samplePct are counted, so every set is sampled the same way and
+// intersections stay comparable. sizes holds the sampled set sizes, shared[a][b] (a < b) the
+// sampled intersections and unique the sampled union.
+func (r *GiveawayRepository) ParticipantOverlap(ctx context.Context, ids []string, samplePct int) (sizes map[string]int, shared map[string]map[string]int, unique int, err error) {
+	const q = `
+        WITH p AS (
+            SELECT giveaway_id, user_id FROM giveaway_participants
+            WHERE giveaway_id = ANY($1) AND mod(user_id, 100) < $2
+        )
+        SELECT a.giveaway_id, b.giveaway_id, COUNT(*)
+        FROM p a JOIN p b ON a.user_id = b.user_id AND a.giveaway_id <= b.giveaway_id
+        GROUP BY a.giveaway_id, b.giveaway_id`
+	rows, err := r.db.QueryContext(ctx, q, pq.Array(ids), samplePct)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer rows.Close()
+	sizes = make(map[string]int, len(ids))
+	shared = make(map[string]map[string]int, len(ids))
+	for rows.Next() {
+		var a, b string
+		var n int
+		if err := rows.Scan(&a, &b, &n); err != nil {
+			return nil, nil, 0, err
+		}
+		if a == b {
+			sizes[a] = n
+			continue
+		}
+		if shared[a] == nil {
+			shared[a] = make(map[string]int)
+		}
+		shared[a][b] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, 0, err
+	}
+	err = r.db.QueryRowContext(ctx, `SELECT COUNT(DISTINCT user_id) FROM giveaway_participants WHERE giveaway_id = ANY($1) AND mod(user_id, 100) < $2`,
+		pq.Array(ids), samplePct).Scan(&unique)
+	return sizes, shared, unique, err
+}
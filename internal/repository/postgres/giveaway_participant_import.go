@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ImportParticipants inserts one batch of bulk-imported participants in a single transaction.
+// Unknown users get a minimal users row; users already participating and the creator are
+// skipped. No participant_joined events are published for imported rows; their joining
+// tickets are credited in the ledger. Returns how many participants were added.
+func (r *GiveawayRepository) ImportParticipants(ctx context.Context, id string, batch []dg.ImportedParticipant) (int, error) {
+	if len(batch) == 0 {
+		return 0, nil
+	}
+	ids := make([]int64, len(batch))
+	names := make([]string, len(batch))
+	joined := make([]string, len(batch))
+	for i, p := range batch {
+		ids[i] = p.UserID
+		names[i] = p.FirstName
+		if p.JoinedAt != nil {
+			joined[i] = p.JoinedAt.UTC().Format(time.RFC3339Nano)
+		}
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `
+        INSERT INTO users (id, first_name)
+        SELECT u.id, u.first_name FROM unnest($1::bigint[], $2::text[]) AS u(id, first_name)
+        ON CONFLICT (id) DO NOTHING`, pq.Array(ids), pq.Array(names)); err != nil {
+		return 0, err
+	}
+	const q = `
+        INSERT INTO giveaway_participants (giveaway_id, user_id, joined_at, source)
+        SELECT g.id, p.user_id, COALESCE(NULLIF(p.joined_at, '')::timestamptz, now()), $4
+        FROM unnest($2::bigint[], $3::text[]) AS p(user_id, joined_at)
+        JOIN giveaways g ON g.id = $1
+        WHERE p.user_id <> g.creator_id
+        ON CONFLICT DO NOTHING
+        RETURNING user_id`
+	rows, err := tx.QueryContext(ctx, q, id, pq.Array(ids), pq.Array(joined), string(dg.ParticipantSourceImport))
+	if err != nil {
+		return 0, err
+	}
+	var entries []dg.LedgerEntry
+	for rows.Next() {
+		var uid int64
+		if err = rows.Scan(&uid); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		e := dg.BaseTicketEntry(id, uid)
+		e.ActorID = 0
+		entries = append(entries, e)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+	if _, err = postLedger(ctx, tx, entries...); err != nil {
+		return 0, err
+	}
+	return len(entries), tx.Commit()
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListParticipatingByUser returns giveaways the user joined that have no final results yet
+// (active or pending), most recently joined first.
+func (r *GiveawayRepository) ListParticipatingByUser(ctx context.Context, userID int64, limit, offset int) ([]dg.Participation, error) {
+	return r.listParticipations(ctx, userID, []string{"active", "pending"}, limit, offset)
+}
+
+// ListParticipatedFinishedByUser returns completed giveaways the user joined, latest first.
+func (r *GiveawayRepository) ListParticipatedFinishedByUser(ctx context.Context, userID int64, limit, offset int) ([]dg.Participation, error) {
+	return r.listParticipations(ctx, userID, []string{"completed", "finished"}, limit, offset)
+}
+
+func (r *GiveawayRepository) listParticipations(ctx context.Context, userID int64, statuses []string, limit, offset int) ([]dg.Participation, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	const q = `
+        SELECT g.id, g.creator_id, g.title, g.description, g.started_at, g.ends_at,
+               g.duration, g.winners_count, g.status, g.created_at, g.updated_at,
+               (SELECT COUNT(*)::int FROM giveaway_participants pc WHERE pc.giveaway_id = g.id),
+               p.joined_at,
+               EXISTS (SELECT 1 FROM giveaway_winners w WHERE w.giveaway_id = g.id AND w.user_id = p.user_id)
+        FROM giveaway_participants p
+        JOIN giveaways g ON g.id = p.giveaway_id
+        WHERE p.user_id=$1 AND g.status::text = ANY($2)
+        ORDER BY g.ends_at DESC, p.joined_at DESC
+        LIMIT $3 OFFSET $4`
+	rows, err := r.db.QueryContext(ctx, q, userID, pq.Array(statuses), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]dg.Participation, 0)
+	for rows.Next() {
+		var p dg.Participation
+		var joinedAt time.Time
+		g := &p.Giveaway
+		if err := rows.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt,
+			&g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt, &g.ParticipantsCount,
+			&joinedAt, &p.Won); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		g.Description, g.DescriptionHTML = loadDescription(g.Description)
+		p.JoinedAt = joinedAt
+		out = append(out, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for i := range out {
+		sponsors, err := r.listSponsorInfos(ctx, out[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		out[i].Sponsors = sponsors
+	}
+	return out, nil
+}
+
+// listSponsorInfos loads the sponsor channels of a giveaway as shown in list views.
+func (r *GiveawayRepository) listSponsorInfos(ctx context.Context, id string) ([]dg.ChannelInfo, error) {
+	const qs = sponsorDisplaySelect
+	rows, err := r.db.QueryContext(ctx, qs, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ChannelInfo
+	for rows.Next() {
+		var s dg.ChannelInfo
+		if err := rows.Scan(&s.Username, &s.URL, &s.Title, &s.ID, &s.AvatarURL); err != nil {
+			return nil, err
+		}
+		if s.URL == "" && s.Username != "" {
+			s.URL = "https://t.me/" + s.Username
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const payoutColumns = `id, giveaway_id, user_id, place, wallet_address, amount_nano, status, screening_result, screening_reason,
+               screening_provider, screened_at, COALESCE(reviewed_by, 0), reviewed_at, COALESCE(review_note, ''), created_at`
+
+func scanPayout(row interface{ Scan(...any) error }) (dg.Payout, error) {
+	var p dg.Payout
+	var reviewedAt sql.NullTime
+	err := row.Scan(&p.ID, &p.GiveawayID, &p.UserID, &p.Place, &p.WalletAddress, &p.AmountNano, &p.Status, &p.Screening, &p.ScreeningReason,
+		&p.ScreeningProvider, &p.ScreenedAt, &p.ReviewedBy, &reviewedAt, &p.ReviewNote, &p.CreatedAt)
+	if reviewedAt.Valid {
+		t := reviewedAt.Time
+		p.ReviewedAt = &t
+	}
+	return p, err
+}
+
+// ListPayoutDueIDs returns completed, escrow-funded giveaways with winners whose payouts were not prepared yet.
+func (r *GiveawayRepository) ListPayoutDueIDs(ctx context.Context) ([]string, error) {
+	const q = `
+        SELECT g.id FROM giveaways g
+        JOIN giveaway_funding f ON f.giveaway_id = g.id
+        WHERE f.status='funded' AND g.status IN ('completed','finished')
+          AND EXISTS (SELECT 1 FROM giveaway_winners w WHERE w.giveaway_id = g.id)
+          AND NOT EXISTS (SELECT 1 FROM giveaway_payouts p WHERE p.giveaway_id = g.id)
+        ORDER BY g.updated_at ASC`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// CreatePayouts stores the screened payouts of a giveaway. Payouts already stored are kept.
+func (r *GiveawayRepository) CreatePayouts(ctx context.Context, payouts []dg.Payout) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	const q = `
+        INSERT INTO giveaway_payouts (giveaway_id, user_id, place, wallet_address, amount_nano, status, screening_result, screening_reason, screening_provider, screened_at)
+        VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+        ON CONFLICT (giveaway_id, user_id) DO NOTHING`
+	for _, p := range payouts {
+		if _, err = tx.ExecContext(ctx, q, p.GiveawayID, p.UserID, p.Place, p.WalletAddress, p.AmountNano, string(p.Status),
+			string(p.Screening), p.ScreeningReason, p.ScreeningProvider, p.ScreenedAt); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListPayouts returns payouts, optionally filtered by giveaway and status, oldest first.
+func (r *GiveawayRepository) ListPayouts(ctx context.Context, giveawayID string, status dg.PayoutStatus, limit, offset int) ([]dg.Payout, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	q := `SELECT ` + payoutColumns + `
+        FROM giveaway_payouts
+        WHERE ($1 = '' OR giveaway_id = $1) AND ($2 = '' OR status = $2)
+        ORDER BY created_at ASC, id ASC
+        LIMIT $3 OFFSET $4`
+	rows, err := r.db.QueryContext(ctx, q, giveawayID, string(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.Payout
+	for rows.Next() {
+		p, err := scanPayout(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// GetPayout returns a payout by id or nil.
+func (r *GiveawayRepository) GetPayout(ctx context.Context, id int64) (*dg.Payout, error) {
+	p, err := scanPayout(r.db.QueryRowContext(ctx, `SELECT `+payoutColumns+` FROM giveaway_payouts WHERE id=$1`, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ReviewPayout records an admin decision on a held payout. Reports false when the payout is not held.
+func (r *GiveawayRepository) ReviewPayout(ctx context.Context, id int64, status dg.PayoutStatus, adminID int64, note string) (bool, error) {
+	const q = `
+        UPDATE giveaway_payouts
+        SET status=$2, reviewed_by=$3, reviewed_at=now(), review_note=NULLIF($4, '')
+        WHERE id=$1 AND status='held'`
+	res, err := r.db.ExecContext(ctx, q, id, string(status), adminID, note)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
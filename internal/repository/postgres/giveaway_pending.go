@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+func nullableInt(v *int) interface{} {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// ListExpiredPendingIDs returns pending giveaways whose pending TTL elapsed. defaultTTLSec applies
+// when a giveaway has no override; a TTL of 0 never expires.
+func (r *GiveawayRepository) ListExpiredPendingIDs(ctx context.Context, defaultTTLSec int) ([]string, error) {
+	const q = `
+        SELECT id FROM giveaways
+        WHERE status='pending' AND pending_since IS NOT NULL
+          AND COALESCE(pending_ttl_sec, $1) > 0
+          AND pending_since + make_interval(secs => COALESCE(pending_ttl_sec, $1)) <= now()
+        ORDER BY pending_since ASC`
+	rows, err := r.db.QueryContext(ctx, q, defaultTTLSec)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SetPendingPolicy stores per-giveaway pending overrides; nil ttl and empty action restore the defaults.
+func (r *GiveawayRepository) SetPendingPolicy(ctx context.Context, id string, ttlSec *int, action dg.PendingAction) error {
+	const q = `UPDATE giveaways SET pending_ttl_sec=$2, pending_action=NULLIF($3,''), updated_at=now() WHERE id=$1`
+	_, err := r.db.ExecContext(ctx, q, id, nullableInt(ttlSec), string(action))
+	return err
+}
+
+// RecordStatusChange appends a status history entry outside of a status transaction.
+func (r *GiveawayRepository) RecordStatusChange(ctx context.Context, id string, from, to dg.GiveawayStatus, reason string, actorID int64) error {
+	return insertStatusChange(ctx, r.db, id, from, to, reason, actorID)
+}
+
+// execer is satisfied by *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
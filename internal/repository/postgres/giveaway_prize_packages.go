@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// storedPackageItem is a package item as kept in giveaways.prize_packages; the description
+// is stored like prize descriptions.
+type storedPackageItem struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Quantity    int    `json:"quantity"`
+}
+
+type storedPrizePackage struct {
+	Label     string              `json:"label"`
+	FromPlace int                 `json:"from_place"`
+	ToPlace   int                 `json:"to_place"`
+	Items     []storedPackageItem `json:"items"`
+}
+
+func prizePackagesJSON(ps []dg.PrizePackage) interface{} {
+	if len(ps) == 0 {
+		return nil
+	}
+	stored := make([]storedPrizePackage, 0, len(ps))
+	for _, p := range ps {
+		sp := storedPrizePackage{Label: p.Label, FromPlace: p.FromPlace, ToPlace: p.ToPlace}
+		for _, it := range p.Items {
+			sp.Items = append(sp.Items, storedPackageItem{Title: it.Title, Description: storedDescription(it.Description, it.DescriptionHTML), Quantity: it.Quantity})
+		}
+		stored = append(stored, sp)
+	}
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+func loadPrizePackages(b []byte) ([]dg.PrizePackage, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var stored []storedPrizePackage
+	if err := json.Unmarshal(b, &stored); err != nil {
+		return nil, err
+	}
+	out := make([]dg.PrizePackage, 0, len(stored))
+	for _, sp := range stored {
+		p := dg.PrizePackage{Label: sp.Label, FromPlace: sp.FromPlace, ToPlace: sp.ToPlace}
+		for _, si := range sp.Items {
+			it := dg.PackageItem{Title: si.Title, Quantity: max(si.Quantity, 1)}
+			if si.Description != "" {
+				it.Description, it.DescriptionHTML = loadDescription(si.Description)
+			}
+			p.Items = append(p.Items, it)
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// distributePackages expands the prize packages of giveaway id into winner prizes: every
+// winner whose place a package covers gets all of its items, labelled with the package.
+func distributePackages(ctx context.Context, tx *sql.Tx, id string, winners []int64) error {
+	var raw []byte
+	if err := tx.QueryRowContext(ctx, `SELECT prize_packages FROM giveaways WHERE id=$1`, id).Scan(&raw); err != nil {
+		return err
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	var packages []storedPrizePackage
+	if err := json.Unmarshal(raw, &packages); err != nil {
+		return err
+	}
+	for _, p := range packages {
+		for place := p.FromPlace; place <= p.ToPlace && place <= len(winners); place++ {
+			for _, it := range p.Items {
+				if _, err := tx.ExecContext(ctx, `
+                    INSERT INTO giveaway_winner_prizes (giveaway_id, user_id, prize_title, prize_description, quantity, package_label)
+                    VALUES ($1,$2,$3,$4,$5,$6)`,
+					id, winners[place-1], it.Title, it.Description, max(it.Quantity, 1), p.Label); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -3,9 +3,14 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"html"
+	"time"
+
+	"github.com/lib/pq"
 
 	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
-	"github.com/open-builders/giveaway-backend/internal/utils/random"
+	"github.com/open-builders/giveaway-backend/internal/utils/richtext"
 )
 
 // GiveawayRepository persists giveaways and their nested entities.
@@ -28,15 +33,70 @@ func (r *GiveawayRepository) Create(ctx context.Context, g *dg.Giveaway) error {
 	}()
 
 	const qGiveaway = `
-	INSERT INTO giveaways (id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, created_at, updated_at)
-	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`
+	INSERT INTO giveaways (id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, winner_strategy, created_at, updated_at, pending_ttl_sec, pending_action, join_windows, join_timezone, max_per_fingerprint, winner_order, reserve_winners_count, claim_deadline_sec, unsubscribe_grace_sec, rules, faq, announce_rules, draft_escrow_nano, translations, prize_packages, category, tags, max_participants)
+	VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,NULLIF($14,''),$15,NULLIF($16,''),NULLIF($17,0),NULLIF($18,''),$19,$20,$21,NULLIF($22,''),$23,$24,NULLIF($25,0),$26,$27,NULLIF($28,''),$29,NULLIF($30,0))`
+	strategy := g.WinnerStrategy
+	if strategy == "" {
+		strategy = dg.WinnerStrategyRandom
+	}
+	draft := g.Status == dg.GiveawayStatusDraft
 	_, err = tx.ExecContext(ctx, qGiveaway,
-		g.ID, g.CreatorID, g.Title, g.Description, g.StartedAt, g.EndsAt, g.Duration, g.MaxWinnersCount, g.Status, g.CreatedAt, g.UpdatedAt,
+		g.ID, g.CreatorID, g.Title, storedDescription(g.Description, g.DescriptionHTML), g.StartedAt, g.EndsAt, g.Duration, g.MaxWinnersCount, g.Status, string(strategy), g.CreatedAt, g.UpdatedAt,
+		nullableInt(g.PendingTTLSec), string(g.PendingAction), joinWindowsJSON(g.JoinWindows), g.JoinTimezone, g.MaxPerFingerprint, string(g.WinnerOrder),
+		g.ReserveWinnersCount, nullableInt(g.ClaimDeadlineSec), nullableInt(g.UnsubscribeGraceSec),
+		g.Rules, faqJSON(g.FAQ), g.AnnounceRules, draftEscrow(g), translationsJSON(g.Translations), prizePackagesJSON(g.PrizePackages),
+		string(g.Category), tagsArray(g.Tags), g.MaxParticipants,
 	)
 	if err != nil {
 		return err
 	}
+	if err = insertGiveawayContent(ctx, tx, g); err != nil {
+		return err
+	}
+	if err = insertModerationFlags(ctx, tx, g.ID, g.ModerationFlags); err != nil {
+		return err
+	}
+	// Drafts are funded, approved and announced to event consumers when published
+	if draft {
+		return tx.Commit()
+	}
+	if g.Funding != nil {
+		if err = insertFunding(ctx, tx, g.ID, g.Funding); err != nil {
+			return err
+		}
+	}
+	if g.Approval != nil {
+		if err = insertApproval(ctx, tx, g.ID, g.Approval); err != nil {
+			return err
+		}
+	}
+	if g.Status == dg.GiveawayStatusActive {
+		if err = insertMemberSnapshots(ctx, tx, g.ID); err != nil {
+			return err
+		}
+	}
+	if err = enqueueCreated(ctx, tx, g); err != nil {
+		return err
+	}
 
+	return tx.Commit()
+}
+
+// enqueueCreated publishes giveaway_created for g within tx.
+func enqueueCreated(ctx context.Context, tx *sql.Tx, g *dg.Giveaway) error {
+	strategy := g.WinnerStrategy
+	if strategy == "" {
+		strategy = dg.WinnerStrategyRandom
+	}
+	created := dg.GiveawayCreatedPayload{
+		GiveawayID: g.ID, CreatorID: g.CreatorID, Title: g.Title, Status: g.Status,
+		StartedAt: g.StartedAt, EndsAt: g.EndsAt, WinnersCount: g.MaxWinnersCount, WinnerStrategy: strategy,
+	}
+	return enqueueEvent(ctx, tx, dg.EventGiveawayCreated, g.ID, created)
+}
+
+// insertGiveawayContent inserts the prizes, sponsors and requirements of g within tx.
+func insertGiveawayContent(ctx context.Context, tx execer, g *dg.Giveaway) error {
 	const qPrize = `INSERT INTO giveaway_prizes (giveaway_id, place, title, description, quantity) VALUES ($1,$2,$3,$4,COALESCE($5,1))`
 	for _, p := range g.Prizes {
 		var placeVal interface{}
@@ -49,7 +109,7 @@ func (r *GiveawayRepository) Create(ctx context.Context, g *dg.Giveaway) error {
 		if qty <= 0 {
 			qty = 1
 		}
-		if _, err = tx.ExecContext(ctx, qPrize, g.ID, placeVal, p.Title, p.Description, qty); err != nil {
+		if _, err := tx.ExecContext(ctx, qPrize, g.ID, placeVal, p.Title, storedDescription(p.Description, p.DescriptionHTML), qty); err != nil {
 			return err
 		}
 	}
@@ -62,63 +122,115 @@ func (r *GiveawayRepository) Create(ctx context.Context, g *dg.Giveaway) error {
 		} else {
 			uname = nil
 		}
-		if _, err = tx.ExecContext(ctx, qSponsor, g.ID, uname, s.URL, s.Title, s.ID, s.AvatarURL); err != nil {
+		if _, err := tx.ExecContext(ctx, qSponsor, g.ID, uname, s.URL, s.Title, s.ID, s.AvatarURL); err != nil {
 			return err
 		}
 	}
 
 	// Requirements
-	if len(g.Requirements) > 0 {
-		const qReq = `INSERT INTO giveaway_requirements (giveaway_id, type, channel_id, channel_username, name, description, ton_min_balance_nano, jetton_address, jetton_min_amount, account_age_max_year)
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`
-		for _, rqm := range g.Requirements {
-			var cid interface{}
-
-			if rqm.ChannelID != 0 {
-				cid = rqm.ChannelID
-			} else {
-				cid = nil
-			}
-			var tonMin interface{}
-			if rqm.TonMinBalanceNano != 0 {
-				tonMin = rqm.TonMinBalanceNano
-			} else {
-				tonMin = nil
-			}
-			var jetMin interface{}
-			if rqm.JettonMinAmount != 0 {
-				jetMin = rqm.JettonMinAmount
-			} else {
-				jetMin = nil
-			}
-			var ageMax interface{}
-			if rqm.AccountAgeMaxYear != 0 {
-				ageMax = rqm.AccountAgeMaxYear
-			} else {
-				ageMax = nil
-			}
-			if _, err = tx.ExecContext(ctx, qReq, g.ID, string(rqm.Type), cid, rqm.ChannelUsername, rqm.ChannelTitle, rqm.Description, tonMin, rqm.JettonAddress, jetMin, ageMax); err != nil {
-				return err
-			}
+	for i, rqm := range g.Requirements {
+		if err := insertRequirement(ctx, tx, g.ID, i, rqm); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return tx.Commit()
+// insertRequirement stores requirement rqm of giveaway id at the given position.
+func insertRequirement(ctx context.Context, tx execer, id string, position int, rqm dg.Requirement) error {
+	const qReq = `INSERT INTO giveaway_requirements (giveaway_id, type, channel_id, channel_username, name, description, ton_min_balance_nano, jetton_address, jetton_min_amount, account_age_max_year, bonus_tickets, existing_members_only, min_member_days, position, optional, bundle_id)
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,NULLIF($16,0))`
+	var cid interface{}
+
+	if rqm.ChannelID != 0 {
+		cid = rqm.ChannelID
+	} else {
+		cid = nil
+	}
+	var tonMin interface{}
+	if rqm.TonMinBalanceNano != 0 {
+		tonMin = rqm.TonMinBalanceNano
+	} else {
+		tonMin = nil
+	}
+	var jetMin interface{}
+	if rqm.JettonMinAmount != 0 {
+		jetMin = rqm.JettonMinAmount
+	} else {
+		jetMin = nil
+	}
+	var ageMax interface{}
+	if rqm.AccountAgeMaxYear != 0 {
+		ageMax = rqm.AccountAgeMaxYear
+	} else {
+		ageMax = nil
+	}
+	_, err := tx.ExecContext(ctx, qReq, id, string(rqm.Type), cid, rqm.ChannelUsername, rqm.ChannelTitle, rqm.Description, tonMin, rqm.JettonAddress, jetMin, ageMax, rqm.BonusTickets, rqm.ExistingMembersOnly, rqm.MinMemberDays, position, rqm.Optional, rqm.BundleID)
+	return err
 }
 
 // GetByID returns a giveaway with nested prizes and sponsors.
 func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaway, error) {
 	const q = `
-        SELECT id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, created_at, updated_at
+        SELECT id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, winner_strategy, created_at, updated_at,
+               pending_ttl_sec, COALESCE(pending_action, ''), pending_since, join_windows, COALESCE(join_timezone, ''),
+               COALESCE(max_per_fingerprint, 0), COALESCE(winner_order, ''), reserve_winners_count, claim_deadline_sec,
+               unsubscribe_grace_sec, version, COALESCE(rules, ''), faq, announce_rules, COALESCE(draft_escrow_nano, 0),
+               COALESCE(cover_key, ''), COALESCE(cover_content_type, ''), COALESCE(cover_size, 0), cover_updated_at, translations, prize_packages,
+               COALESCE(category, ''), tags, COALESCE(max_participants, 0)
         FROM giveaways WHERE id=$1`
 	var g dg.Giveaway
+	var pendingTTL, claimDeadline, unsubscribeGrace sql.NullInt64
+	var draftEscrowNano int64
+	var pendingSince, coverUpdatedAt sql.NullTime
+	var joinWindows, faq, translations, prizePackages []byte
+	var cover dg.Cover
 	row := r.db.QueryRowContext(ctx, q, id)
-	if err := row.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt, &g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt); err != nil {
+	if err := row.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt, &g.Duration, &g.MaxWinnersCount, &g.Status, &g.WinnerStrategy, &g.CreatedAt, &g.UpdatedAt, &pendingTTL, &g.PendingAction, &pendingSince, &joinWindows, &g.JoinTimezone, &g.MaxPerFingerprint, &g.WinnerOrder, &g.ReserveWinnersCount, &claimDeadline, &unsubscribeGrace, &g.Version, &g.Rules, &faq, &g.AnnounceRules, &draftEscrowNano, &cover.Key, &cover.ContentType, &cover.Size, &coverUpdatedAt, &translations, &prizePackages, &g.Category, pq.Array(&g.Tags), &g.MaxParticipants); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		return nil, err
 	}
+	if pendingTTL.Valid {
+		v := int(pendingTTL.Int64)
+		g.PendingTTLSec = &v
+	}
+	if claimDeadline.Valid {
+		v := int(claimDeadline.Int64)
+		g.ClaimDeadlineSec = &v
+	}
+	if unsubscribeGrace.Valid {
+		v := int(unsubscribeGrace.Int64)
+		g.UnsubscribeGraceSec = &v
+	}
+	if draftEscrowNano > 0 && g.Status == dg.GiveawayStatusDraft {
+		g.Funding = &dg.Funding{AmountNano: draftEscrowNano}
+	}
+	if pendingSince.Valid && g.Status == dg.GiveawayStatusPending {
+		t := pendingSince.Time
+		g.PendingSince = &t
+	}
+	if cover.Key != "" {
+		cover.Kind, _ = dg.CoverKindOf(cover.ContentType)
+		cover.UpdatedAt = coverUpdatedAt.Time.UTC()
+		g.Cover = &cover
+	}
+	g.Description, g.DescriptionHTML = loadDescription(g.Description)
+	jw, err := loadJoinWindows(joinWindows)
+	if err != nil {
+		return nil, err
+	}
+	g.JoinWindows = jw
+	if g.FAQ, err = loadFAQ(faq); err != nil {
+		return nil, err
+	}
+	if g.Translations, err = loadTranslations(translations); err != nil {
+		return nil, err
+	}
+	if g.PrizePackages, err = loadPrizePackages(prizePackages); err != nil {
+		return nil, err
+	}
 	// Prizes
 	const qp = `SELECT place, title, description, quantity FROM giveaway_prizes WHERE giveaway_id=$1 ORDER BY place NULLS LAST, place ASC`
 	rows, err := r.db.QueryContext(ctx, qp, id)
@@ -132,6 +244,7 @@ func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaw
 			if err := rows.Scan(&place, &p.Title, &p.Description, &p.Quantity); err != nil {
 				return nil, err
 			}
+			p.Description, p.DescriptionHTML = loadDescription(p.Description)
 			if place.Valid {
 				v := int(place.Int64)
 				p.Place = &v
@@ -150,7 +263,7 @@ func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaw
 	}
 
 	// Sponsors
-	const qs = `SELECT COALESCE(username,'') AS username, url, title, channel_id, COALESCE(avatar_url,'') AS avatar_url FROM giveaway_sponsors WHERE giveaway_id=$1`
+	const qs = sponsorDisplaySelect
 	srows, err := r.db.QueryContext(ctx, qs, id)
 	if err == nil {
 		defer srows.Close()
@@ -173,54 +286,19 @@ func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaw
 	}
 	// If finished or completed, load winners and their prizes
 	if g.Status == dg.GiveawayStatusFinished || g.Status == dg.GiveawayStatusCompleted {
-		// Winners by place
-		wrows, err := r.db.QueryContext(ctx, `SELECT place, user_id FROM giveaway_winners WHERE giveaway_id=$1 ORDER BY place ASC`, id)
+		winners, err := r.ListWinnersWithPrizes(ctx, id)
 		if err != nil {
 			return nil, err
 		}
-		type winner struct {
-			place int
-			user  int64
-		}
-		var winners []winner
-		for wrows.Next() {
-			var pl int
-			var uid int64
-			if err := wrows.Scan(&pl, &uid); err != nil {
-				wrows.Close()
-				return nil, err
-			}
-			winners = append(winners, winner{place: pl, user: uid})
-		}
-		wrows.Close()
-		// Prizes per user
-		prizemap := map[int64][]dg.WinnerPrize{}
-		prows, err := r.db.QueryContext(ctx, `SELECT user_id, prize_title, prize_description, quantity FROM giveaway_winner_prizes WHERE giveaway_id=$1`, id)
-		if err != nil {
-			return nil, err
-		}
-		for prows.Next() {
-			var uid int64
-			var t, d string
-			var qty int
-			if err := prows.Scan(&uid, &t, &d, &qty); err != nil {
-				prows.Close()
-				return nil, err
-			}
-			prizemap[uid] = append(prizemap[uid], dg.WinnerPrize{Title: t, Description: d, Quantity: qty})
-		}
-		prows.Close()
-		// Build DTO
-		for _, w := range winners {
-			g.Winners = append(g.Winners, dg.Winner{Place: w.place, UserID: w.user, Prizes: prizemap[w.user]})
-		}
+		g.Winners = winners
 	}
 
 	// Load requirements (support older schema without name/description)
-	rqrows, err := r.db.QueryContext(ctx, `SELECT type, channel_id, channel_username, name, description, ton_min_balance_nano, jetton_address, jetton_min_amount, account_age_max_year FROM giveaway_requirements WHERE giveaway_id=$1`, id)
+	rqrows, err := r.db.QueryContext(ctx, `SELECT id, type, channel_id, channel_username, name, description, ton_min_balance_nano, jetton_address, jetton_min_amount, account_age_max_year, bonus_tickets, unverifiable_since IS NOT NULL, COALESCE(unverifiable_reason, ''), existing_members_only, min_member_days, position, optional, COALESCE(bundle_id, 0) FROM giveaway_requirements WHERE giveaway_id=$1 ORDER BY position, id`, id)
 	if err == nil {
 		defer rqrows.Close()
 		for rqrows.Next() {
+			var rid int64
 			var t string
 			var cid sql.NullInt64
 			var uname sql.NullString
@@ -230,10 +308,18 @@ func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaw
 			var jaddr sql.NullString
 			var jmin sql.NullInt64
 			var ageMax sql.NullInt64
-			if err := rqrows.Scan(&t, &cid, &uname, &name, &desc, &ton, &jaddr, &jmin, &ageMax); err != nil {
+			var bonus int
+			var unverifiable bool
+			var unverifiableReason string
+			var existingOnly bool
+			var minMemberDays int
+			var position int
+			var optional bool
+			var bundleID int64
+			if err := rqrows.Scan(&rid, &t, &cid, &uname, &name, &desc, &ton, &jaddr, &jmin, &ageMax, &bonus, &unverifiable, &unverifiableReason, &existingOnly, &minMemberDays, &position, &optional, &bundleID); err != nil {
 				return nil, err
 			}
-			req := dg.Requirement{Type: dg.RequirementType(t)}
+			req := dg.Requirement{ID: rid, Type: dg.RequirementType(t), BonusTickets: bonus, Unverifiable: unverifiable, UnverifiableReason: unverifiableReason, ExistingMembersOnly: existingOnly, MinMemberDays: minMemberDays, Position: position, Optional: optional, BundleID: bundleID}
 			if cid.Valid {
 				req.ChannelID = cid.Int64
 			}
@@ -286,7 +372,8 @@ func (r *GiveawayRepository) GetByID(ctx context.Context, id string) (*dg.Giveaw
 	return &g, nil
 }
 
-// ListByCreator returns giveaways for a specific creator ordered by created_at desc.
+// ListByCreator returns giveaways for a specific creator ordered by created_at desc. Drafts are
+// left out; see ListDrafts.
 func (r *GiveawayRepository) ListByCreator(ctx context.Context, creatorID int64, limit, offset int) ([]dg.Giveaway, error) {
 	if limit <= 0 || limit > 1000 {
 		limit = 100
@@ -296,10 +383,16 @@ func (r *GiveawayRepository) ListByCreator(ctx context.Context, creatorID int64,
 	}
 	const q = `
         SELECT id, creator_id, title, description, started_at, ends_at, duration, winners_count, status, created_at, updated_at
-        FROM giveaways WHERE creator_id=$1
+        FROM giveaways WHERE creator_id=$1 AND status<>'draft'
         ORDER BY created_at DESC
         LIMIT $2 OFFSET $3`
-	rows, err := r.db.QueryContext(ctx, q, creatorID, limit, offset)
+	return r.listWithSponsors(ctx, q, creatorID, limit, offset)
+}
+
+// listWithSponsors runs q, which selects the columns of ListByCreator, and loads the sponsors
+// of every giveaway.
+func (r *GiveawayRepository) listWithSponsors(ctx context.Context, q string, args ...any) ([]dg.Giveaway, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -310,8 +403,9 @@ func (r *GiveawayRepository) ListByCreator(ctx context.Context, creatorID int64,
 		if err := rows.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt, &g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt); err != nil {
 			return nil, err
 		}
+		g.Description, g.DescriptionHTML = loadDescription(g.Description)
 		// Load sponsors for each giveaway (same as in GetByID)
-		const qs = `SELECT COALESCE(username,'') AS username, url, title, channel_id, COALESCE(avatar_url,'') AS avatar_url FROM giveaway_sponsors WHERE giveaway_id=$1`
+		const qs = sponsorDisplaySelect
 		srows, err := r.db.QueryContext(ctx, qs, g.ID)
 		if err == nil {
 			for srows.Next() {
@@ -334,35 +428,145 @@ func (r *GiveawayRepository) ListByCreator(ctx context.Context, creatorID int64,
 
 // UpdateStatus updates the giveaway status only.
 func (r *GiveawayRepository) UpdateStatus(ctx context.Context, id string, status dg.GiveawayStatus) error {
-	const q = `UPDATE giveaways SET status=$2, updated_at=now() WHERE id=$1`
-	_, err := r.db.ExecContext(ctx, q, id, status)
-	return err
+	// pending_since starts the pending TTL clock; see ListExpiredPendingIDs
+	const q = `
+        UPDATE giveaways
+        SET status=$2, updated_at=now(),
+            pending_since = CASE WHEN $2 = 'pending' THEN now() ELSE pending_since END
+        WHERE id=$1`
+	if _, err := r.db.ExecContext(ctx, q, id, string(status)); err != nil {
+		return err
+	}
+	if status == dg.GiveawayStatusActive {
+		return insertMemberSnapshots(ctx, r.db, id)
+	}
+	return nil
 }
 
-// DeleteByOwner removes a giveaway only if the requester is the creator.
-// Returns true if a row was deleted, false otherwise.
+// DeleteByOwner removes a giveaway only if the requester is the creator and queues its cleanup
+// in giveaway_deletions. Posted winners announcements are kept as channel posts, since their
+// rows go with the giveaway. Returns true if a row was deleted, false otherwise.
 func (r *GiveawayRepository) DeleteByOwner(ctx context.Context, id string, ownerID int64) (bool, error) {
-	const q = `DELETE FROM giveaways WHERE id=$1 AND creator_id=$2`
-	res, err := r.db.ExecContext(ctx, q, id, ownerID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return false, err
 	}
-	n, _ := res.RowsAffected()
-	return n > 0, nil
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	const qPosts = `
+        INSERT INTO giveaway_channel_posts (giveaway_id, channel_id, message_id, kind, media, posted_at)
+        SELECT p.giveaway_id, p.channel_id, p.message_id, 'results', TRUE, COALESCE(p.posted_at, now())
+        FROM giveaway_result_posts p JOIN giveaways g ON g.id = p.giveaway_id
+        WHERE p.giveaway_id=$1 AND g.creator_id=$2 AND p.status='posted' AND p.message_id IS NOT NULL`
+	if _, err = tx.ExecContext(ctx, qPosts, id, ownerID); err != nil {
+		return false, err
+	}
+	var title string
+	err = tx.QueryRowContext(ctx, `DELETE FROM giveaways WHERE id=$1 AND creator_id=$2 RETURNING title`, id, ownerID).Scan(&title)
+	if err == sql.ErrNoRows {
+		_ = tx.Rollback()
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	const qSaga = `
+        INSERT INTO giveaway_deletions (giveaway_id, creator_id, title) VALUES ($1,$2,$3)
+        ON CONFLICT (giveaway_id) DO NOTHING`
+	if _, err = tx.ExecContext(ctx, qSaga, id, ownerID, title); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
 }
 
 // Join adds a participant if not the creator; does nothing if creator.
-func (r *GiveawayRepository) Join(ctx context.Context, id string, userID int64) error {
+// Reports whether a new participant row was created. source and ref record how the user
+// arrived; an empty ref is stored as NULL. The joining ticket is credited in the ledger.
+// Joins of a giveaway with max_participants fail with "giveaway is full" once it is reached.
+func (r *GiveawayRepository) Join(ctx context.Context, id string, userID int64, source dg.ParticipantSource, ref string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	// Joins of a capped giveaway take its row lock in turn, so each one counts every earlier join
+	var maxParticipants int
+	err = tx.QueryRowContext(ctx, `SELECT max_participants FROM giveaways WHERE id=$1 AND max_participants IS NOT NULL FOR NO KEY UPDATE`, id).Scan(&maxParticipants)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
 	const q = `
-        INSERT INTO giveaway_participants (giveaway_id, user_id)
-        SELECT $1, $2
+        INSERT INTO giveaway_participants (giveaway_id, user_id, source, source_ref)
+        SELECT $1, $2, $3, NULLIF($4, '')
         WHERE EXISTS (
             SELECT 1 FROM giveaways g
             WHERE g.id=$1 AND g.creator_id<>$2 AND g.status='active'
+              AND (g.max_participants IS NULL
+                   OR (SELECT COUNT(*) FROM giveaway_participants p WHERE p.giveaway_id=g.id) < g.max_participants)
         )
-        ON CONFLICT DO NOTHING`
-	_, err := r.db.ExecContext(ctx, q, id, userID)
-	return err
+        ON CONFLICT DO NOTHING
+        RETURNING joined_at`
+	var joinedAt time.Time
+	err = tx.QueryRowContext(ctx, q, id, userID, string(source), ref).Scan(&joinedAt)
+	if err == sql.ErrNoRows {
+		if maxParticipants > 0 {
+			var full bool
+			err = tx.QueryRowContext(ctx, `
+                SELECT NOT EXISTS (SELECT 1 FROM giveaway_participants WHERE giveaway_id=$1 AND user_id=$2)
+                   AND (SELECT COUNT(*) FROM giveaway_participants WHERE giveaway_id=$1) >= $3`, id, userID, maxParticipants).Scan(&full)
+			if err != nil {
+				return false, err
+			}
+			if full {
+				err = errors.New("giveaway is full")
+				return false, err
+			}
+		}
+		// Not eligible or already joined: nothing to publish
+		err = nil
+		return false, tx.Commit()
+	}
+	if err != nil {
+		return false, err
+	}
+	if _, err = postLedger(ctx, tx, dg.BaseTicketEntry(id, userID)); err != nil {
+		return false, err
+	}
+	if err = enqueueEvent(ctx, tx, dg.EventParticipantJoined, id, dg.ParticipantJoinedPayload{GiveawayID: id, UserID: userID, JoinedAt: joinedAt}); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// SourceBreakdown counts participants per join source and reference, largest first.
+func (r *GiveawayRepository) SourceBreakdown(ctx context.Context, id string) ([]dg.SourceStats, error) {
+	const q = `
+        SELECT source, COALESCE(source_ref, ''), COUNT(*)
+        FROM giveaway_participants
+        WHERE giveaway_id=$1
+        GROUP BY source, source_ref
+        ORDER BY COUNT(*) DESC, source, source_ref`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.SourceStats
+	for rows.Next() {
+		var s dg.SourceStats
+		if err := rows.Scan(&s.Source, &s.Ref, &s.Participants); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
 }
 
 // FinishExpired marks finished giveaways whose ends_at passed and in scheduled/active.
@@ -370,7 +574,9 @@ func (r *GiveawayRepository) FinishExpired(ctx context.Context) (int64, error) {
 	const q = `
         UPDATE giveaways
         SET status='completed', updated_at=now()
-        WHERE ends_at <= now() AND status IN ('scheduled','active')`
+        WHERE ends_at <= now() AND status IN ('scheduled','active')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_funding f WHERE f.giveaway_id=giveaways.id AND f.status='awaiting')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_approvals a WHERE a.giveaway_id=giveaways.id AND a.status='awaiting')`
 	res, err := r.db.ExecContext(ctx, q)
 	if err != nil {
 		return 0, err
@@ -380,7 +586,13 @@ func (r *GiveawayRepository) FinishExpired(ctx context.Context) (int64, error) {
 
 // ListExpiredIDs returns IDs of giveaways that should be finished now.
 func (r *GiveawayRepository) ListExpiredIDs(ctx context.Context) ([]string, error) {
-	const q = `SELECT id FROM giveaways WHERE ends_at <= now() AND status IN ('scheduled','active') ORDER BY ends_at ASC`
+	// Unfunded and unapproved giveaways are cancelled by their workers instead of being drawn
+	const q = `
+        SELECT id FROM giveaways
+        WHERE ends_at <= now() AND status IN ('scheduled','active')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_funding f WHERE f.giveaway_id=giveaways.id AND f.status='awaiting')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_approvals a WHERE a.giveaway_id=giveaways.id AND a.status='awaiting')
+        ORDER BY ends_at ASC`
 	rows, err := r.db.QueryContext(ctx, q)
 	if err != nil {
 		return nil, err
@@ -424,111 +636,6 @@ func (r *GiveawayRepository) ListCompletedWithParticipantsNoWinners(ctx context.
 	return ids, rows.Err()
 }
 
-// FinishOneWithDistribution finalizes a single giveaway: selects winners by place, assigns fixed-place prizes,
-// and randomly distributes unassigned prizes among winners without a fixed prize. If extra prizes remain,
-// distributes in round-robin starting from place 1.
-func (r *GiveawayRepository) FinishOneWithDistribution(ctx context.Context, id string, winnersCount int) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	// Lock giveaway row to prevent concurrent finishing and prefetch requirements presence
-	var status string
-	if err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&status); err != nil {
-		return err
-	}
-	if status == "finished" {
-		return tx.Commit()
-	}
-
-	// If custom requirement exists -> set pending and exit
-	var hasCustom bool
-	if err = tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM giveaway_requirements WHERE giveaway_id=$1 AND type='custom')`, id).Scan(&hasCustom); err != nil {
-		return err
-	}
-	if hasCustom {
-		if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='pending', updated_at=now() WHERE id=$1`, id); err != nil {
-			return err
-		}
-		return tx.Commit()
-	}
-
-	// Collect participants (shuffle for randomness)
-	rows, err := tx.QueryContext(ctx, `SELECT user_id FROM giveaway_participants WHERE giveaway_id=$1`, id)
-	if err != nil {
-		return err
-	}
-	var participants []int64
-	for rows.Next() {
-		var uid int64
-		if err := rows.Scan(&uid); err != nil {
-			rows.Close()
-			return err
-		}
-		participants = append(participants, uid)
-	}
-	rows.Close()
-	if err := random.Shuffle(participants); err != nil {
-		return err
-	}
-
-	// Prepare winners slice size winnersCount or participants length
-	if winnersCount > len(participants) {
-		winnersCount = len(participants)
-	}
-	winners := make([]int64, 0, winnersCount)
-	for i := 0; i < winnersCount; i++ {
-		winners = append(winners, participants[i])
-	}
-
-	// Assign winners to places 1..winnersCount
-	for place := 1; place <= winnersCount; place++ {
-		if _, err = tx.ExecContext(ctx, `INSERT INTO giveaway_winners (giveaway_id, place, user_id) VALUES ($1,$2,$3)`, id, place, winners[place-1]); err != nil {
-			return err
-		}
-	}
-
-	// Load prizes
-	pRows, err := tx.QueryContext(ctx, `SELECT place, title, description, quantity FROM giveaway_prizes WHERE giveaway_id=$1`, id)
-	if err != nil {
-		return err
-	}
-	var fixed = map[int][]prize{}
-	var loose []prize
-	for pRows.Next() {
-		var pr prize
-		if err := pRows.Scan(&pr.place, &pr.title, &pr.desc, &pr.qty); err != nil {
-			pRows.Close()
-			return err
-		}
-		if pr.qty <= 0 {
-			pr.qty = 1
-		}
-		if pr.place.Valid {
-			fixed[int(pr.place.Int64)] = append(fixed[int(pr.place.Int64)], pr)
-		} else {
-			loose = append(loose, pr)
-		}
-	}
-	pRows.Close()
-
-	if err := r.distributePrizes(ctx, tx, id, winners, fixed, loose); err != nil {
-		return err
-	}
-
-	// Mark giveaway finished
-	if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='completed', updated_at=now() WHERE id=$1`, id); err != nil {
-		return err
-	}
-	return tx.Commit()
-}
-
 // IsParticipant returns true if the user participated in the giveaway.
 func (r *GiveawayRepository) IsParticipant(ctx context.Context, id string, userID int64) (bool, error) {
 	const q = `SELECT 1 FROM giveaway_participants WHERE giveaway_id=$1 AND user_id=$2 LIMIT 1`
@@ -558,8 +665,13 @@ func (r *GiveawayRepository) IsWinner(ctx context.Context, id string, userID int
 }
 
 // FinishWithWinners finalizes a giveaway using the provided winners list (ordered by place).
-// It assigns fixed and loose prizes similarly to FinishOneWithDistribution.
+// Fixed-place prizes go to their place; loose prizes are spread across winners. Prize
+// packages go whole to every place they cover.
 func (r *GiveawayRepository) FinishWithWinners(ctx context.Context, id string, winners []int64) error {
+	return r.finishWithWinners(ctx, id, winners, nil)
+}
+
+func (r *GiveawayRepository) finishWithWinners(ctx context.Context, id string, winners []int64, draw *dg.Draw) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -578,6 +690,32 @@ func (r *GiveawayRepository) FinishWithWinners(ctx context.Context, id string, w
 	if status == "finished" {
 		return tx.Commit()
 	}
+	// A draw that committed while this one was checking candidates already holds the winners
+	if status == string(dg.GiveawayStatusCompleted) {
+		var drawn bool
+		if err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM giveaway_winners WHERE giveaway_id=$1)`, id).Scan(&drawn); err != nil {
+			return err
+		}
+		if drawn {
+			return tx.Commit()
+		}
+	}
+
+	if draw != nil {
+		if err = insertDraw(ctx, tx, draw); err != nil {
+			return err
+		}
+	}
+
+	// Winners drawn by earlier waves keep their places; the final draw fills the rest
+	prior, err := listWaveWinners(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaway_waves SET status='skipped' WHERE giveaway_id=$1 AND status='pending'`, id); err != nil {
+		return err
+	}
+	winners = append(prior, winners...)
 
 	winnersCount := len(winners)
 	if winnersCount == 0 {
@@ -590,7 +728,7 @@ func (r *GiveawayRepository) FinishWithWinners(ctx context.Context, id string, w
 	}
 
 	// Persist winners per place
-	for place := 1; place <= winnersCount; place++ {
+	for place := len(prior) + 1; place <= winnersCount; place++ {
 		if _, err = tx.ExecContext(ctx, `INSERT INTO giveaway_winners (giveaway_id, place, user_id) VALUES ($1,$2,$3)`, id, place, winners[place-1]); err != nil {
 			return err
 		}
@@ -620,16 +758,55 @@ func (r *GiveawayRepository) FinishWithWinners(ctx context.Context, id string, w
 	}
 	pRows.Close()
 
-	if err := r.distributePrizes(ctx, tx, id, winners, fixed, loose); err != nil {
+	if err = r.distributePrizes(ctx, tx, id, winners, fixed, loose); err != nil {
+		return err
+	}
+	if err = distributePackages(ctx, tx, id, winners); err != nil {
 		return err
 	}
 
 	if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='completed', updated_at=now() WHERE id=$1`, id); err != nil {
 		return err
 	}
+	var drawID int64
+	if draw != nil {
+		drawID = draw.ID
+	}
+	if err = enqueueWinnerEvents(ctx, tx, id, drawID, false); err != nil {
+		return err
+	}
 	return tx.Commit()
 }
 
+// CompletePending moves a pending giveaway with manually chosen winners to completed.
+// Returns false when the giveaway is not pending.
+func (r *GiveawayRepository) CompletePending(ctx context.Context, id string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var status string
+	if err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&status); err != nil {
+		return false, err
+	}
+	if status != "pending" {
+		return false, tx.Commit()
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='completed', updated_at=now() WHERE id=$1`, id); err != nil {
+		return false, err
+	}
+	if err = enqueueWinnerEvents(ctx, tx, id, 0, true); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
 // SetManualWinners replaces winners and distributes prizes while keeping giveaway in pending status.
 // Existing winners and winner_prizes are deleted and replaced.
 func (r *GiveawayRepository) SetManualWinners(ctx context.Context, id string, winners []int64) error {
@@ -698,6 +875,9 @@ func (r *GiveawayRepository) SetManualWinners(ctx context.Context, id string, wi
 	if err := r.distributePrizes(ctx, tx, id, winners, fixed, loose); err != nil {
 		return err
 	}
+	if err := distributePackages(ctx, tx, id, winners); err != nil {
+		return err
+	}
 
 	// Keep status pending
 	return tx.Commit()
@@ -705,47 +885,68 @@ func (r *GiveawayRepository) SetManualWinners(ctx context.Context, id string, wi
 
 // ListWinnersWithPrizes returns winners ordered by place with their prizes regardless of giveaway status.
 func (r *GiveawayRepository) ListWinnersWithPrizes(ctx context.Context, id string) ([]dg.Winner, error) {
-	// Winners by place
-	wrows, err := r.db.QueryContext(ctx, `SELECT place, user_id FROM giveaway_winners WHERE giveaway_id=$1 ORDER BY place ASC`, id)
+	// Winners by place, which is unique per giveaway
+	const wq = `
+        SELECT w.place, w.user_id, COALESCE(p.source, 'unknown'), w.fulfillment_status, w.assigned_at,
+               COALESCE(w.contact_method, ''), COALESCE(w.contact_email, ''), COALESCE(w.contact_wallet, ''), w.contact_updated_at
+        FROM giveaway_winners w
+        LEFT JOIN giveaway_participants p ON p.giveaway_id = w.giveaway_id AND p.user_id = w.user_id
+        WHERE w.giveaway_id=$1
+        ORDER BY w.place ASC`
+	wrows, err := r.db.QueryContext(ctx, wq, id)
 	if err != nil {
 		return nil, err
 	}
 	type winner struct {
-		place int
-		user  int64
+		place       int
+		user        int64
+		source      dg.ParticipantSource
+		fulfillment dg.FulfillmentStatus
+		assignedAt  time.Time
+		contact     *dg.ContactPreference
 	}
 	var winners []winner
 	for wrows.Next() {
 		var pl int
 		var uid int64
-		if err := wrows.Scan(&pl, &uid); err != nil {
+		var src, ful, method, email, wallet string
+		var at time.Time
+		var contactAt sql.NullTime
+		if err := wrows.Scan(&pl, &uid, &src, &ful, &at, &method, &email, &wallet, &contactAt); err != nil {
 			wrows.Close()
 			return nil, err
 		}
-		winners = append(winners, winner{place: pl, user: uid})
+		var contact *dg.ContactPreference
+		if method != "" {
+			contact = &dg.ContactPreference{Method: dg.ContactMethod(method), Email: email, WalletAddress: wallet, UpdatedAt: contactAt.Time.UTC()}
+		}
+		winners = append(winners, winner{place: pl, user: uid, source: dg.ParticipantSource(src), fulfillment: dg.FulfillmentStatus(ful), assignedAt: at, contact: contact})
 	}
 	wrows.Close()
 
 	prizemap := map[int64][]dg.WinnerPrize{}
-	prows, err := r.db.QueryContext(ctx, `SELECT user_id, prize_title, prize_description, quantity FROM giveaway_winner_prizes WHERE giveaway_id=$1`, id)
+	prows, err := r.db.QueryContext(ctx, `SELECT user_id, prize_title, prize_description, quantity, package_label FROM giveaway_winner_prizes WHERE giveaway_id=$1 ORDER BY id ASC`, id)
 	if err != nil {
 		return nil, err
 	}
 	for prows.Next() {
 		var uid int64
-		var t, d string
+		var t, d, pkg string
 		var qty int
-		if err := prows.Scan(&uid, &t, &d, &qty); err != nil {
+		if err := prows.Scan(&uid, &t, &d, &qty, &pkg); err != nil {
 			prows.Close()
 			return nil, err
 		}
-		prizemap[uid] = append(prizemap[uid], dg.WinnerPrize{Title: t, Description: d, Quantity: qty})
+		plain, rich := loadDescription(d)
+		prizemap[uid] = append(prizemap[uid], dg.WinnerPrize{Title: t, Description: plain, DescriptionHTML: rich, Quantity: qty, Package: pkg})
 	}
 	prows.Close()
 
 	out := make([]dg.Winner, 0, len(winners))
 	for _, w := range winners {
-		out = append(out, dg.Winner{Place: w.place, UserID: w.user, Prizes: prizemap[w.user]})
+		prizes := dg.MergeWinnerPrizes(prizemap[w.user])
+		assignedAt := w.assignedAt
+		out = append(out, dg.Winner{Place: w.place, UserID: w.user, Prizes: prizes, TotalUnits: dg.TotalPrizeQuantity(prizes), Source: w.source, Fulfillment: w.fulfillment, AssignedAt: &assignedAt, Contact: w.contact})
 	}
 	return out, nil
 }
@@ -801,36 +1002,41 @@ func (r *GiveawayRepository) ListFinishedByCreator(ctx context.Context, creatorI
 		if err := rows.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt, &g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt); err != nil {
 			return nil, err
 		}
+		g.Description, g.DescriptionHTML = loadDescription(g.Description)
 		out = append(out, g)
 	}
 	return out, rows.Err()
 }
 
-// ListActive returns active giveaways with participants count, filtered by minParticipants and paginated.
-func (r *GiveawayRepository) ListActive(ctx context.Context, limit, offset, minParticipants int) ([]dg.Giveaway, error) {
+// ListActive returns active giveaways with participants count, filtered by f and paginated.
+func (r *GiveawayRepository) ListActive(ctx context.Context, limit, offset int, f dg.ActiveFilter) ([]dg.Giveaway, error) {
 	if limit <= 0 || limit > 1000 {
 		limit = 100
 	}
 	if offset < 0 {
 		offset = 0
 	}
-	if minParticipants < 0 {
-		minParticipants = 0
+	if f.MinParticipants < 0 {
+		f.MinParticipants = 0
 	}
 	const q = `
         SELECT g.id, g.creator_id, g.title, g.description, g.started_at, g.ends_at,
                g.duration, g.winners_count, g.status, g.created_at, g.updated_at,
-               COALESCE(pc.cnt,0) as participants_count
+               COALESCE(pc.cnt,0) as participants_count, COALESCE(g.category,''), g.tags
         FROM giveaways g
         LEFT JOIN (
             SELECT giveaway_id, COUNT(*)::int AS cnt
             FROM giveaway_participants
             GROUP BY giveaway_id
         ) pc ON pc.giveaway_id = g.id
+        LEFT JOIN users u ON u.id = g.creator_id
         WHERE g.status='active' AND COALESCE(pc.cnt,0) >= $3
-        ORDER BY pc.cnt DESC NULLS LAST, g.created_at DESC
+          AND ($4 = '' OR g.category = $4)
+          AND ($5 = '' OR g.tags @> ARRAY[$5]::text[])
+        -- Popularity weighted by creator reputation; creators not scored yet count as 100
+        ORDER BY COALESCE(pc.cnt,0) * COALESCE(u.reputation_score, 100) DESC, g.created_at DESC
         LIMIT $1 OFFSET $2`
-	rows, err := r.db.QueryContext(ctx, q, limit, offset, minParticipants)
+	rows, err := r.db.QueryContext(ctx, q, limit, offset, f.MinParticipants, string(f.Category), f.Tag)
 	if err != nil {
 		return nil, err
 	}
@@ -839,11 +1045,13 @@ func (r *GiveawayRepository) ListActive(ctx context.Context, limit, offset, minP
 	for rows.Next() {
 		var g dg.Giveaway
 		if err := rows.Scan(&g.ID, &g.CreatorID, &g.Title, &g.Description, &g.StartedAt, &g.EndsAt,
-			&g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt, &g.ParticipantsCount); err != nil {
+			&g.Duration, &g.MaxWinnersCount, &g.Status, &g.CreatedAt, &g.UpdatedAt, &g.ParticipantsCount,
+			&g.Category, pq.Array(&g.Tags)); err != nil {
 			return nil, err
 		}
+		g.Description, g.DescriptionHTML = loadDescription(g.Description)
 		// Load sponsors
-		const qs = `SELECT COALESCE(username,'') AS username, url, title, channel_id, COALESCE(avatar_url,'') AS avatar_url FROM giveaway_sponsors WHERE giveaway_id=$1`
+		const qs = sponsorDisplaySelect
 		srows, err := r.db.QueryContext(ctx, qs, g.ID)
 		if err == nil {
 			for srows.Next() {
@@ -883,16 +1091,117 @@ func (r *GiveawayRepository) GetParticipants(ctx context.Context, id string) ([]
 	return participants, rows.Err()
 }
 
-// RemoveRequirementsByChannelID removes any requirements that depend on the given channel ID.
-// Only deletes requirements for giveaways that are not yet finished (active, scheduled, pending).
-func (r *GiveawayRepository) RemoveRequirementsByChannelID(ctx context.Context, channelID int64) error {
+// ListParticipantEntries returns participants with join time and ticket count ordered by join time.
+func (r *GiveawayRepository) ListParticipantEntries(ctx context.Context, id string) ([]dg.Participant, error) {
+	const q = `SELECT user_id, joined_at, tickets FROM giveaway_participants WHERE giveaway_id=$1 ORDER BY joined_at, user_id`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.Participant
+	for rows.Next() {
+		var p dg.Participant
+		if err := rows.Scan(&p.UserID, &p.JoinedAt, &p.Tickets); err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// ListActiveWithBonusRequirementIDs returns active giveaways that have at least one bonus task.
+func (r *GiveawayRepository) ListActiveWithBonusRequirementIDs(ctx context.Context) ([]string, error) {
 	const q = `
-		DELETE FROM giveaway_requirements gr
-		USING giveaways g
-		WHERE gr.giveaway_id = g.id
-		  AND gr.channel_id = $1
-		  AND gr.type IN ('subscription', 'boost')
-		  AND g.status IN ('active')`
-	_, err := r.db.ExecContext(ctx, q, channelID)
-	return err
+        SELECT g.id FROM giveaways g
+        WHERE g.status='active'
+          AND EXISTS (SELECT 1 FROM giveaway_requirements r WHERE r.giveaway_id=g.id AND r.bonus_tickets > 0)
+        ORDER BY g.ends_at ASC`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// MarkRequirementsUnverifiable flags the subscription and boost requirements on the given channel
+// of scheduled and active giveaways as unverifiable. Requirements already flagged keep their
+// original reason. Returns the ids of giveaways with newly flagged requirements.
+func (r *GiveawayRepository) MarkRequirementsUnverifiable(ctx context.Context, channelID int64, reason string) ([]string, error) {
+	const q = `
+		WITH marked AS (
+			UPDATE giveaway_requirements gr
+			SET unverifiable_since = now(), unverifiable_reason = $2
+			FROM giveaways g
+			WHERE gr.giveaway_id = g.id
+			  AND gr.channel_id = $1
+			  AND gr.type IN ('subscription', 'boost')
+			  AND gr.unverifiable_since IS NULL
+			  AND g.status IN ('scheduled', 'active')
+			RETURNING gr.giveaway_id
+		)
+		SELECT DISTINCT giveaway_id FROM marked`
+	rows, err := r.db.QueryContext(ctx, q, channelID, reason)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ClearUnverifiableRequirements removes the unverifiable flag from requirements on the given
+// channel once the bot regains access. Returns how many requirements were cleared.
+func (r *GiveawayRepository) ClearUnverifiableRequirements(ctx context.Context, channelID int64) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE giveaway_requirements
+		SET unverifiable_since = NULL, unverifiable_reason = NULL
+		WHERE channel_id = $1 AND unverifiable_since IS NOT NULL`, channelID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// storedDescription returns the column value for a description: the sanitized HTML
+// when present, otherwise the escaped plain text so reads can treat it the same way.
+func storedDescription(plain, rich string) string {
+	if rich != "" {
+		return rich
+	}
+	return html.EscapeString(plain)
+}
+
+// loadDescription splits a stored description into plain text and sanitized HTML.
+// Rows written before rich text support hold raw text, so the value is sanitized again.
+func loadDescription(stored string) (plain, rich string) {
+	rich = richtext.Sanitize(stored)
+	return richtext.Plain(rich), rich
+}
+
+// EndNow moves the end of an active giveaway that has not ended yet to now, so the draw can
+// run right away. Returns false when the giveaway is not active or already ended.
+func (r *GiveawayRepository) EndNow(ctx context.Context, id string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE giveaways SET ends_at=now(), updated_at=now() WHERE id=$1 AND status='active' AND ends_at > now()`, id)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
 }
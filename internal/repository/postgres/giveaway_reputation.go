@@ -0,0 +1,246 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CreatorReputationStats counts the behavior a creator's reputation is computed from.
+func (r *GiveawayRepository) CreatorReputationStats(ctx context.Context, creatorID int64) (dg.ReputationStats, error) {
+	const q = `
+        SELECT
+            (SELECT COUNT(*) FROM giveaways WHERE creator_id=$1),
+            (SELECT COUNT(*) FROM giveaways WHERE creator_id=$1 AND status='cancelled'),
+            (SELECT COUNT(*) FROM giveaway_participants p JOIN giveaways g ON g.id = p.giveaway_id WHERE g.creator_id=$1),
+            (SELECT COUNT(*) FROM giveaway_reports rp JOIN giveaways g ON g.id = rp.giveaway_id WHERE g.creator_id=$1),
+            (SELECT COUNT(*) FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id WHERE g.creator_id=$1),
+            (SELECT COUNT(*) FROM giveaway_winners w JOIN giveaways g ON g.id = w.giveaway_id
+              WHERE g.creator_id=$1 AND w.disputed_at IS NOT NULL AND w.fulfillment_status <> 'delivered')`
+	var st dg.ReputationStats
+	err := r.db.QueryRowContext(ctx, q, creatorID).Scan(&st.Giveaways, &st.Cancelled, &st.Participants, &st.Reports, &st.Winners, &st.Disputes)
+	return st, err
+}
+
+// SaveReputationScore stores the computed score on the creator's user row.
+func (r *GiveawayRepository) SaveReputationScore(ctx context.Context, creatorID int64, score int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE users SET reputation_score=$2, reputation_updated_at=now() WHERE id=$1`, creatorID, score)
+	return err
+}
+
+// ListCreatorIDs returns every user who created at least one giveaway.
+func (r *GiveawayRepository) ListCreatorIDs(ctx context.Context) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT DISTINCT creator_id FROM giveaways ORDER BY creator_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// ListReputations returns scored creators, lowest score first, without stats.
+func (r *GiveawayRepository) ListReputations(ctx context.Context, limit, offset int) ([]dg.Reputation, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	const q = `
+        SELECT id, reputation_score, reputation_updated_at FROM users
+        WHERE reputation_score IS NOT NULL
+        ORDER BY reputation_score ASC, id ASC
+        LIMIT $1 OFFSET $2`
+	rows, err := r.db.QueryContext(ctx, q, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.Reputation
+	for rows.Next() {
+		var rep dg.Reputation
+		if err := rows.Scan(&rep.CreatorID, &rep.Score, &rep.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, rep)
+	}
+	return out, rows.Err()
+}
+
+// CreateReport records a user's report of a giveaway. Returns false when the user already reported it.
+func (r *GiveawayRepository) CreateReport(ctx context.Context, id string, reporterID int64, reason string) (bool, error) {
+	const q = `
+        INSERT INTO giveaway_reports (giveaway_id, reporter_id, reason) VALUES ($1,$2,$3)
+        ON CONFLICT (giveaway_id, reporter_id) DO NOTHING`
+	res, err := r.db.ExecContext(ctx, q, id, reporterID, reason)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// DisputePrize marks a claimed, undelivered prize as disputed by the winner. Returns false when
+// the prize is not claimed, already delivered or already disputed.
+func (r *GiveawayRepository) DisputePrize(ctx context.Context, id string, userID int64) (bool, error) {
+	const q = `
+        UPDATE giveaway_winners SET disputed_at=now()
+        WHERE giveaway_id=$1 AND user_id=$2 AND fulfillment_status='claimed' AND disputed_at IS NULL`
+	res, err := r.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// insertApproval holds a new giveaway for admin review.
+func insertApproval(ctx context.Context, tx execer, id string, a *dg.Approval) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO giveaway_approvals (giveaway_id, reputation_score) VALUES ($1,$2)`, id, a.ReputationScore)
+	return err
+}
+
+const approvalColumns = `a.giveaway_id, g.title, g.creator_id, a.status, a.reputation_score, COALESCE(a.reviewed_by, 0), a.reviewed_at, a.created_at`
+
+func scanApproval(row interface{ Scan(...any) error }) (dg.Approval, error) {
+	var a dg.Approval
+	var reviewedAt sql.NullTime
+	err := row.Scan(&a.GiveawayID, &a.GiveawayTitle, &a.CreatorID, &a.Status, &a.ReputationScore, &a.ReviewedBy, &reviewedAt, &a.CreatedAt)
+	if reviewedAt.Valid {
+		t := reviewedAt.Time
+		a.ReviewedAt = &t
+	}
+	return a, err
+}
+
+// ListApprovals returns held giveaways, optionally filtered by status, oldest first.
+func (r *GiveawayRepository) ListApprovals(ctx context.Context, status dg.ApprovalStatus, limit, offset int) ([]dg.Approval, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	q := `SELECT ` + approvalColumns + `
+        FROM giveaway_approvals a JOIN giveaways g ON g.id = a.giveaway_id
+        WHERE ($1 = '' OR a.status = $1)
+        ORDER BY a.created_at ASC
+        LIMIT $2 OFFSET $3`
+	rows, err := r.db.QueryContext(ctx, q, string(status), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.Approval
+	for rows.Next() {
+		a, err := scanApproval(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+// GetApproval returns the approval hold of a giveaway, or nil when it was never held.
+func (r *GiveawayRepository) GetApproval(ctx context.Context, id string) (*dg.Approval, error) {
+	q := `SELECT ` + approvalColumns + ` FROM giveaway_approvals a JOIN giveaways g ON g.id = a.giveaway_id WHERE a.giveaway_id=$1`
+	a, err := scanApproval(r.db.QueryRowContext(ctx, q, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ApproveGiveaway releases an awaiting giveaway. A scheduled giveaway that has not ended and
+// has no outstanding escrow deposit becomes active. Returns false when nothing was awaiting.
+func (r *GiveawayRepository) ApproveGiveaway(ctx context.Context, id string, adminID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	res, err := tx.ExecContext(ctx, `
+        UPDATE giveaway_approvals SET status='approved', reviewed_by=$2, reviewed_at=now()
+        WHERE giveaway_id=$1 AND status='awaiting'`, id, adminID)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, tx.Commit()
+	}
+	var gs dg.GiveawayStatus
+	var ready bool
+	err = tx.QueryRowContext(ctx, `
+        SELECT status, ends_at > now()
+               AND NOT EXISTS (SELECT 1 FROM giveaway_funding f WHERE f.giveaway_id=giveaways.id AND f.status='awaiting')
+        FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&gs, &ready)
+	if err != nil {
+		return false, err
+	}
+	if gs == dg.GiveawayStatusScheduled && ready {
+		if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='active', updated_at=now() WHERE id=$1`, id); err != nil {
+			return false, err
+		}
+		if err = insertStatusChange(ctx, tx, id, gs, dg.GiveawayStatusActive, "approved by admin", adminID); err != nil {
+			return false, err
+		}
+		if err = insertMemberSnapshots(ctx, tx, id); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+// SetApprovalStatus closes an awaiting approval as rejected or expired. Returns false when
+// nothing was awaiting.
+func (r *GiveawayRepository) SetApprovalStatus(ctx context.Context, id string, status dg.ApprovalStatus, adminID int64) (bool, error) {
+	const q = `
+        UPDATE giveaway_approvals SET status=$2, reviewed_by=NULLIF($3, 0), reviewed_at=now()
+        WHERE giveaway_id=$1 AND status='awaiting'`
+	res, err := r.db.ExecContext(ctx, q, id, string(status), adminID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// ListUnapprovedExpiredIDs returns scheduled giveaways that ended while still awaiting approval.
+func (r *GiveawayRepository) ListUnapprovedExpiredIDs(ctx context.Context) ([]string, error) {
+	const q = `
+        SELECT g.id FROM giveaways g
+        JOIN giveaway_approvals a ON a.giveaway_id = g.id
+        WHERE a.status='awaiting' AND g.ends_at <= now() AND g.status='scheduled'
+        ORDER BY g.ends_at ASC`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// RecordRequirementOutcomes stores what checking requirements of a giveaway gave for a user.
+// Each requirement keeps whether any check failed or passed, so a later pass does not hide an
+// earlier failure; rows only change when one of those flips.
+func (r *GiveawayRepository) RecordRequirementOutcomes(ctx context.Context, giveawayID string, userID int64, outcomes []dg.RequirementOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(outcomes))
+	types := make([]string, len(outcomes))
+	passed := make([]bool, len(outcomes))
+	for i, o := range outcomes {
+		ids[i], types[i], passed[i] = o.RequirementID, string(o.Type), o.Passed
+	}
+	_, err := r.db.ExecContext(ctx, `
+        INSERT INTO giveaway_requirement_outcomes (requirement_id, user_id, giveaway_id, requirement_type, failed, passed)
+        SELECT o.id, $2, $1, o.type, NOT o.passed, o.passed
+        FROM unnest($3::bigint[], $4::text[], $5::bool[]) AS o(id, type, passed)
+        ON CONFLICT (requirement_id, user_id) DO UPDATE SET
+            failed = giveaway_requirement_outcomes.failed OR EXCLUDED.failed,
+            passed = giveaway_requirement_outcomes.passed OR EXCLUDED.passed
+        WHERE (EXCLUDED.failed AND NOT giveaway_requirement_outcomes.failed)
+           OR (EXCLUDED.passed AND NOT giveaway_requirement_outcomes.passed)`,
+		giveawayID, userID, pq.Array(ids), pq.Array(types), pq.Array(passed))
+	return err
+}
+
+// RequirementFailures returns the failure counts of each requirement of a giveaway, in
+// requirement order. Requirements nobody was checked against yet have zero counts.
+func (r *GiveawayRepository) RequirementFailures(ctx context.Context, giveawayID string) ([]dg.RequirementFailureStats, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT rq.id, rq.type, COALESCE(NULLIF(rq.name, ''), rq.channel_username, ''),
+               COUNT(o.user_id), COUNT(*) FILTER (WHERE o.failed), COUNT(*) FILTER (WHERE o.failed AND o.passed)
+        FROM giveaway_requirements rq
+        LEFT JOIN giveaway_requirement_outcomes o ON o.requirement_id = rq.id
+        WHERE rq.giveaway_id=$1
+        GROUP BY rq.id
+        ORDER BY rq.position, rq.id`, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.RequirementFailureStats{}
+	for rows.Next() {
+		var s dg.RequirementFailureStats
+		if err := rows.Scan(&s.RequirementID, &s.Type, &s.Name, &s.Checked, &s.Failed, &s.Resolved); err != nil {
+			return nil, err
+		}
+		s.FillPct()
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// GlobalRequirementFailures returns failure counts by requirement type across all giveaways,
+// for users first checked since since, most failed type first.
+func (r *GiveawayRepository) GlobalRequirementFailures(ctx context.Context, since time.Time) ([]dg.RequirementFailureStats, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT requirement_type, COUNT(*), COUNT(*) FILTER (WHERE failed), COUNT(*) FILTER (WHERE failed AND passed)
+        FROM giveaway_requirement_outcomes
+        WHERE first_checked_at >= $1
+        GROUP BY requirement_type
+        ORDER BY COUNT(*) FILTER (WHERE failed)::float / COUNT(*) DESC, requirement_type`, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.RequirementFailureStats{}
+	for rows.Next() {
+		var s dg.RequirementFailureStats
+		if err := rows.Scan(&s.Type, &s.Checked, &s.Failed, &s.Resolved); err != nil {
+			return nil, err
+		}
+		s.FillPct()
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
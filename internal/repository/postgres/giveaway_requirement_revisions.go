@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ReplaceRequirements makes reqs the requirements of a giveaway that has not ended and records
+// them as its next revision, after the original list prev on the first edit. Requirements with
+// an ID keep their row and task claims; ones left out are removed and their claims revoked
+// through the ledger. Returns the new revision.
+func (r *GiveawayRepository) ReplaceRequirements(ctx context.Context, id string, prev, reqs []dg.Requirement, changedBy int64) (*dg.RequirementRevision, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var status dg.GiveawayStatus
+	var creatorID int64
+	var createdAt time.Time
+	err = tx.QueryRowContext(ctx, `SELECT status, creator_id, created_at FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&status, &creatorID, &createdAt)
+	if err == sql.ErrNoRows {
+		err = errors.New("not found")
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch status {
+	case dg.GiveawayStatusDraft, dg.GiveawayStatusScheduled, dg.GiveawayStatusActive:
+	default:
+		err = errors.New("giveaway ended")
+		return nil, err
+	}
+	keep := make([]int64, 0, len(reqs))
+	for _, rq := range reqs {
+		if rq.ID != 0 {
+			keep = append(keep, rq.ID)
+		}
+	}
+	revoked, err := revokeTaskClaimsExcept(ctx, tx, id, keep)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_requirements WHERE giveaway_id=$1 AND NOT (id = ANY($2))`, id, pq.Array(keep)); err != nil {
+		return nil, err
+	}
+	// A new channel may well be verifiable, so its old unverifiable mark goes
+	const qUpdate = `
+        UPDATE giveaway_requirements SET channel_id=NULLIF($3::bigint,0), channel_username=$4, name=$5, description=$6,
+            ton_min_balance_nano=NULLIF($7,0), jetton_address=$8, jetton_min_amount=NULLIF($9,0), account_age_max_year=NULLIF($10,0),
+            bonus_tickets=$11, existing_members_only=$12, min_member_days=$13, position=$14, optional=$15,
+            unverifiable_since = CASE WHEN channel_id IS DISTINCT FROM NULLIF($3::bigint,0) THEN NULL ELSE unverifiable_since END,
+            unverifiable_reason = CASE WHEN channel_id IS DISTINCT FROM NULLIF($3::bigint,0) THEN NULL ELSE unverifiable_reason END
+        WHERE id=$1 AND giveaway_id=$2`
+	for i, rq := range reqs {
+		if rq.ID == 0 {
+			if err = insertRequirement(ctx, tx, id, i, rq); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, qUpdate, rq.ID, id, rq.ChannelID, rq.ChannelUsername, rq.ChannelTitle, rq.Description,
+			rq.TonMinBalanceNano, rq.JettonAddress, rq.JettonMinAmount, rq.AccountAgeMaxYear,
+			rq.BonusTickets, rq.ExistingMembersOnly, rq.MinMemberDays, i, rq.Optional); err != nil {
+			return nil, err
+		}
+	}
+	// Existing-members-only channels added now count members from this edit on
+	if status == dg.GiveawayStatusActive {
+		if err = insertMemberSnapshots(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	}
+	var last int
+	if err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(revision), 0) FROM giveaway_requirement_revisions WHERE giveaway_id=$1`, id).Scan(&last); err != nil {
+		return nil, err
+	}
+	if last == 0 {
+		if err = insertRequirementRevision(ctx, tx, id, 1, prev, creatorID, 0, createdAt); err != nil {
+			return nil, err
+		}
+		last = 1
+	}
+	rev := &dg.RequirementRevision{Revision: last + 1, Requirements: reqs, ChangedBy: changedBy, RevokedClaims: revoked, CreatedAt: time.Now().UTC()}
+	if err = insertRequirementRevision(ctx, tx, id, rev.Revision, reqs, changedBy, revoked, rev.CreatedAt); err != nil {
+		return nil, err
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET updated_at=now() WHERE id=$1`, id); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// revokeTaskClaimsExcept withdraws the bonus task claims of a giveaway whose requirement is not
+// in keep, reversing their ledger credits. Returns how many claims were revoked.
+func revokeTaskClaimsExcept(ctx context.Context, tx *sql.Tx, id string, keep []int64) (int, error) {
+	rows, err := tx.QueryContext(ctx, `
+        DELETE FROM giveaway_task_claims WHERE giveaway_id=$1 AND NOT (requirement_id = ANY($2))
+        RETURNING user_id, requirement_id, bonus_tickets, claimed_at`, id, pq.Array(keep))
+	if err != nil {
+		return 0, err
+	}
+	var entries []dg.LedgerEntry
+	var users []int64
+	seen := map[int64]bool{}
+	n := 0
+	for rows.Next() {
+		var userID, requirementID int64
+		var bonus int
+		var claimedAt time.Time
+		if err := rows.Scan(&userID, &requirementID, &bonus, &claimedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		n++
+		if !seen[userID] {
+			seen[userID] = true
+			users = append(users, userID)
+		}
+		if bonus > 0 {
+			entries = append(entries, dg.LedgerEntry{
+				IdempotencyKey: "task_revoke:" + taskLedgerKey(id, userID, requirementID, claimedAt),
+				Asset:          dg.LedgerAssetTicket,
+				Source:         dg.LedgerSourceTaskRevoke,
+				Debit:          dg.TicketAccount(id, userID),
+				Credit:         dg.LedgerIssuanceAccount,
+				Amount:         int64(bonus),
+				GiveawayID:     id,
+			})
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if _, err := postLedger(ctx, tx, entries...); err != nil {
+		return 0, err
+	}
+	for _, uid := range users {
+		if err := syncTickets(ctx, tx, id, uid); err != nil {
+			return 0, err
+		}
+	}
+	return n, nil
+}
+
+func insertRequirementRevision(ctx context.Context, tx *sql.Tx, id string, revision int, reqs []dg.Requirement, changedBy int64, revoked int, at time.Time) error {
+	if reqs == nil {
+		reqs = []dg.Requirement{}
+	}
+	raw, err := json.Marshal(reqs)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO giveaway_requirement_revisions (giveaway_id, revision, requirements, changed_by, revoked_claims, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)`, id, revision, raw, changedBy, revoked, at)
+	return err
+}
+
+// ListRequirementRevisions returns the requirement revisions of a giveaway, newest first.
+// A giveaway whose requirements were never edited has none.
+func (r *GiveawayRepository) ListRequirementRevisions(ctx context.Context, id string) ([]dg.RequirementRevision, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT revision, requirements, changed_by, revoked_claims, created_at FROM giveaway_requirement_revisions
+        WHERE giveaway_id=$1 ORDER BY revision DESC`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.RequirementRevision, 0)
+	for rows.Next() {
+		var rev dg.RequirementRevision
+		var raw []byte
+		if err := rows.Scan(&rev.Revision, &raw, &rev.ChangedBy, &rev.RevokedClaims, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &rev.Requirements); err != nil {
+			return nil, err
+		}
+		rev.CreatedAt = rev.CreatedAt.UTC()
+		out = append(out, rev)
+	}
+	return out, rows.Err()
+}
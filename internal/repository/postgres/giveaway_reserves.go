@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListReserves returns the reserve winners of a giveaway in position order.
+func (r *GiveawayRepository) ListReserves(ctx context.Context, id string) ([]dg.Reserve, error) {
+	const q = `
+        SELECT position, user_id, status, COALESCE(promoted_place, 0), COALESCE(replaced_user_id, 0), promoted_at
+        FROM giveaway_reserves WHERE giveaway_id=$1
+        ORDER BY position`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.Reserve, 0)
+	for rows.Next() {
+		var rv dg.Reserve
+		var promotedAt sql.NullTime
+		if err := rows.Scan(&rv.Position, &rv.UserID, &rv.Status, &rv.PromotedPlace, &rv.ReplacedUserID, &promotedAt); err != nil {
+			return nil, err
+		}
+		if promotedAt.Valid {
+			t := promotedAt.Time
+			rv.PromotedAt = &t
+		}
+		out = append(out, rv)
+	}
+	return out, rows.Err()
+}
+
+// ReplaceWinner removes userID from the winners of a completed giveaway and promotes the next
+// waiting reserve to the freed place with the same prizes, logging both in the status history.
+// Without a waiting reserve the place stays empty, unless requireReserve is set, in which case
+// nothing changes. It returns the promoted reserve (nil when none) and whether the winner was removed.
+func (r *GiveawayRepository) ReplaceWinner(ctx context.Context, id string, userID int64, reason string, actorID int64, requireReserve bool) (*dg.Reserve, bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var status string
+	if err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	// Delivered prizes are final
+	var place int
+	if err = tx.QueryRowContext(ctx, `SELECT place FROM giveaway_winners WHERE giveaway_id=$1 AND user_id=$2 AND fulfillment_status <> 'delivered'`, id, userID).Scan(&place); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var rv dg.Reserve
+	err = tx.QueryRowContext(ctx, `
+        SELECT position, user_id FROM giveaway_reserves
+        WHERE giveaway_id=$1 AND status='reserve'
+          AND user_id NOT IN (SELECT user_id FROM giveaway_winners WHERE giveaway_id=$1)
+        ORDER BY position LIMIT 1 FOR UPDATE`, id).Scan(&rv.Position, &rv.UserID)
+	hasReserve := err == nil
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+	if !hasReserve && requireReserve {
+		_ = tx.Rollback()
+		return nil, false, nil
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_winners WHERE giveaway_id=$1 AND user_id=$2`, id, userID); err != nil {
+		return nil, false, err
+	}
+	history := fmt.Sprintf("Winner %d removed from place %d: %s", userID, place, reason)
+	if hasReserve {
+		if _, err = tx.ExecContext(ctx, `INSERT INTO giveaway_winners (giveaway_id, place, user_id) VALUES ($1,$2,$3)`, id, place, rv.UserID); err != nil {
+			return nil, false, err
+		}
+		if _, err = tx.ExecContext(ctx, `UPDATE giveaway_winner_prizes SET user_id=$3 WHERE giveaway_id=$1 AND user_id=$2`, id, userID, rv.UserID); err != nil {
+			return nil, false, err
+		}
+		now := time.Now().UTC()
+		if _, err = tx.ExecContext(ctx, `
+            UPDATE giveaway_reserves SET status='promoted', promoted_place=$3, replaced_user_id=$4, promoted_at=$5
+            WHERE giveaway_id=$1 AND position=$2`, id, rv.Position, place, userID, now); err != nil {
+			return nil, false, err
+		}
+		rv.Status = dg.ReservePromoted
+		rv.PromotedPlace = place
+		rv.ReplacedUserID = userID
+		rv.PromotedAt = &now
+		history += fmt.Sprintf("; reserve %d promoted", rv.UserID)
+	} else {
+		if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_winner_prizes WHERE giveaway_id=$1 AND user_id=$2`, id, userID); err != nil {
+			return nil, false, err
+		}
+		history += "; no reserve left"
+	}
+	st := dg.GiveawayStatus(status)
+	if err = insertStatusChange(ctx, tx, id, st, st, history, actorID); err != nil {
+		return nil, false, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	if !hasReserve {
+		return nil, true, nil
+	}
+	return &rv, true, nil
+}
+
+// ListMissedClaims returns unclaimed winners of completed giveaways whose claim deadline passed
+// by now and who can be replaced by a waiting reserve, oldest first. Giveaways with escrow
+// payouts are skipped since payouts are bound to the original winners.
+func (r *GiveawayRepository) ListMissedClaims(ctx context.Context, now time.Time, limit int) ([]dg.MissedClaim, error) {
+	const q = `
+        SELECT w.giveaway_id, w.user_id
+        FROM giveaway_winners w
+        JOIN giveaways g ON g.id = w.giveaway_id
+        WHERE g.status = 'completed' AND g.claim_deadline_sec IS NOT NULL
+          AND w.fulfillment_status = 'pending'
+          AND w.assigned_at + g.claim_deadline_sec * interval '1 second' <= $1
+          AND NOT EXISTS (SELECT 1 FROM giveaway_payouts p WHERE p.giveaway_id = w.giveaway_id)
+          AND EXISTS (SELECT 1 FROM giveaway_reserves rv
+                      WHERE rv.giveaway_id = w.giveaway_id AND rv.status = 'reserve'
+                        AND rv.user_id NOT IN (SELECT user_id FROM giveaway_winners WHERE giveaway_id = w.giveaway_id))
+        ORDER BY w.assigned_at
+        LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.MissedClaim
+	for rows.Next() {
+		var m dg.MissedClaim
+		if err := rows.Scan(&m.GiveawayID, &m.UserID); err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
+}
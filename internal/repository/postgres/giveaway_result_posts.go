@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// resultPostSelect reads result posts with the sponsor title and the due time, which is known once
+// the giveaway completed.
+const resultPostSelect = `
+        SELECT rp.giveaway_id, rp.channel_id, COALESCE(s.title_override, s.title, ''), rp.delay_sec, rp.image_url, rp.status,
+               COALESCE(rp.message_id, 0), rp.attempts, rp.error,
+               CASE WHEN g.status = 'completed'
+                    THEN COALESCE(g.finished_at, g.updated_at) + rp.delay_sec * interval '1 second' END,
+               rp.posted_at, rp.created_at
+        FROM giveaway_result_posts rp
+        JOIN giveaways g ON g.id = rp.giveaway_id
+        LEFT JOIN giveaway_sponsors s ON s.giveaway_id = rp.giveaway_id AND s.channel_id = rp.channel_id`
+
+func scanResultPost(row interface{ Scan(...any) error }) (*dg.ResultPost, error) {
+	var p dg.ResultPost
+	var due, posted sql.NullTime
+	if err := row.Scan(&p.GiveawayID, &p.ChannelID, &p.ChannelTitle, &p.DelaySec, &p.ImageURL, &p.Status,
+		&p.MessageID, &p.Attempts, &p.Error, &due, &posted, &p.CreatedAt); err != nil {
+		return nil, err
+	}
+	if due.Valid {
+		t := due.Time
+		p.DueAt = &t
+	}
+	if posted.Valid {
+		t := posted.Time
+		p.PostedAt = &t
+	}
+	return &p, nil
+}
+
+// ReplaceResultPosts replaces the waiting result posts of a giveaway with posts. Channels that
+// were already posted to keep their post.
+func (r *GiveawayRepository) ReplaceResultPosts(ctx context.Context, id string, posts []dg.ResultPost) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_result_posts WHERE giveaway_id=$1 AND status='waiting'`, id); err != nil {
+		return err
+	}
+	const q = `
+        INSERT INTO giveaway_result_posts (giveaway_id, channel_id, delay_sec, image_url)
+        VALUES ($1,$2,$3,$4)
+        ON CONFLICT (giveaway_id, channel_id) DO NOTHING`
+	for _, p := range posts {
+		if _, err = tx.ExecContext(ctx, q, id, p.ChannelID, p.DelaySec, p.ImageURL); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ListResultPosts returns the result posts of a giveaway by channel.
+func (r *GiveawayRepository) ListResultPosts(ctx context.Context, id string) ([]dg.ResultPost, error) {
+	return r.queryResultPosts(ctx, resultPostSelect+` WHERE rp.giveaway_id=$1 ORDER BY rp.channel_id`, id)
+}
+
+// ListDueResultPosts returns waiting posts of completed giveaways whose delay elapsed by now, oldest first.
+func (r *GiveawayRepository) ListDueResultPosts(ctx context.Context, now time.Time, limit int) ([]dg.ResultPost, error) {
+	return r.queryResultPosts(ctx, resultPostSelect+`
+        WHERE rp.status = 'waiting' AND g.status = 'completed'
+          AND COALESCE(g.finished_at, g.updated_at) + rp.delay_sec * interval '1 second' <= $1
+        ORDER BY rp.created_at
+        LIMIT $2`, now, limit)
+}
+
+func (r *GiveawayRepository) queryResultPosts(ctx context.Context, q string, args ...any) ([]dg.ResultPost, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.ResultPost, 0)
+	for rows.Next() {
+		p, err := scanResultPost(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *p)
+	}
+	return out, rows.Err()
+}
+
+// GetResultPost returns the result post of a giveaway in a channel, or nil.
+func (r *GiveawayRepository) GetResultPost(ctx context.Context, id string, channelID int64) (*dg.ResultPost, error) {
+	p, err := scanResultPost(r.db.QueryRowContext(ctx, resultPostSelect+` WHERE rp.giveaway_id=$1 AND rp.channel_id=$2`, id, channelID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// MarkResultPosted records the message id of a delivered post.
+func (r *GiveawayRepository) MarkResultPosted(ctx context.Context, id string, channelID, messageID int64) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_result_posts
+        SET status='posted', message_id=$3, attempts=attempts+1, error='', posted_at=now()
+        WHERE giveaway_id=$1 AND channel_id=$2`, id, channelID, messageID)
+	return err
+}
+
+// MarkResultPostError counts a failed delivery; the post is given up after maxAttempts.
+func (r *GiveawayRepository) MarkResultPostError(ctx context.Context, id string, channelID int64, errText string, maxAttempts int) error {
+	_, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_result_posts
+        SET attempts=attempts+1, error=$3,
+            status=CASE WHEN attempts+1 >= $4 THEN 'failed' ELSE status END
+        WHERE giveaway_id=$1 AND channel_id=$2`, id, channelID, errText, maxAttempts)
+	return err
+}
+
+// MarkResultPostDeleted records that a posted announcement was deleted from the channel.
+func (r *GiveawayRepository) MarkResultPostDeleted(ctx context.Context, id string, channelID int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_result_posts SET status='deleted' WHERE giveaway_id=$1 AND channel_id=$2`, id, channelID)
+	return err
+}
+
+// DeleteResultPost removes a post that was never delivered.
+func (r *GiveawayRepository) DeleteResultPost(ctx context.Context, id string, channelID int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM giveaway_result_posts WHERE giveaway_id=$1 AND channel_id=$2 AND status<>'posted'`, id, channelID)
+	return err
+}
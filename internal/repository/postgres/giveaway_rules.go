@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// faqJSON encodes FAQ entries for the faq column; none are stored as NULL.
+func faqJSON(faq []dg.FAQEntry) interface{} {
+	if len(faq) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(faq)
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+func loadFAQ(b []byte) ([]dg.FAQEntry, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var faq []dg.FAQEntry
+	if err := json.Unmarshal(b, &faq); err != nil {
+		return nil, err
+	}
+	return faq, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// MarkFinished stamps finished_at the first time a giveaway is finished after expiry.
+func (r *GiveawayRepository) MarkFinished(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaways SET finished_at=now() WHERE id=$1 AND finished_at IS NULL`, id)
+	return err
+}
+
+// ListOverdue returns giveaways the expiry worker should have finished before cutoff, oldest
+// first. Giveaways waiting for funding or approval are not due and are left out.
+func (r *GiveawayRepository) ListOverdue(ctx context.Context, cutoff time.Time, limit int) ([]dg.CompletionLag, error) {
+	const q = `
+        SELECT id, title, status, ends_at, EXTRACT(EPOCH FROM now() - ends_at)::bigint
+        FROM giveaways
+        WHERE ends_at <= $1 AND status IN ('scheduled','active')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_funding f WHERE f.giveaway_id=giveaways.id AND f.status='awaiting')
+          AND NOT EXISTS (SELECT 1 FROM giveaway_approvals a WHERE a.giveaway_id=giveaways.id AND a.status='awaiting')
+        ORDER BY ends_at ASC
+        LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, q, cutoff, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.CompletionLag, 0)
+	for rows.Next() {
+		var l dg.CompletionLag
+		if err := rows.Scan(&l.GiveawayID, &l.Title, &l.Status, &l.EndsAt, &l.LagSec); err != nil {
+			return nil, err
+		}
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+// CompletionLagStats summarizes finished_at - ends_at of giveaways finished since since.
+func (r *GiveawayRepository) CompletionLagStats(ctx context.Context, since time.Time, sla time.Duration) (dg.CompletionLagStats, error) {
+	const q = `
+        WITH lags AS (
+            SELECT GREATEST(EXTRACT(EPOCH FROM finished_at - ends_at), 0) AS lag
+            FROM giveaways WHERE finished_at >= $1
+        )
+        SELECT COUNT(*), COUNT(*) FILTER (WHERE lag > $2),
+               COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY lag), 0),
+               COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY lag), 0),
+               COALESCE(MAX(lag), 0)
+        FROM lags`
+	var s dg.CompletionLagStats
+	err := r.db.QueryRowContext(ctx, q, since, sla.Seconds()).Scan(&s.Finished, &s.Late, &s.P50Sec, &s.P95Sec, &s.MaxSec)
+	return s, err
+}
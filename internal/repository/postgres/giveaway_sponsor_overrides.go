@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// sponsorDisplaySelect reads the sponsors of a giveaway as shown to users, with the creator's
+// display overrides in place of the cached Telegram title and avatar.
+const sponsorDisplaySelect = `SELECT COALESCE(username,'') AS username, url, COALESCE(title_override, title) AS title, channel_id, COALESCE(avatar_override, avatar_url, '') AS avatar_url FROM giveaway_sponsors WHERE giveaway_id=$1`
+
+// SetSponsorOverride replaces the display title and avatar of a sponsor. A nil field keeps
+// its override and an empty one clears it; once both are clear the sponsor shows its Telegram
+// data again. Returns false when the giveaway has no such sponsor.
+func (r *GiveawayRepository) SetSponsorOverride(ctx context.Context, giveawayID string, channelID int64, title, avatarURL *string, by int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_sponsors SET
+            title_override = CASE WHEN $3::text IS NULL THEN title_override ELSE NULLIF($3, '') END,
+            avatar_override = CASE WHEN $4::text IS NULL THEN avatar_override ELSE NULLIF($4, '') END,
+            override_by = $5, override_at = now()
+        WHERE giveaway_id=$1 AND channel_id=$2`, giveawayID, channelID, title, avatarURL, by)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+	// Nothing left to flag once both overrides are cleared
+	_, err = r.db.ExecContext(ctx, `
+        UPDATE giveaway_sponsors SET override_by = NULL, override_at = NULL
+        WHERE giveaway_id=$1 AND channel_id=$2 AND title_override IS NULL AND avatar_override IS NULL`, giveawayID, channelID)
+	return true, err
+}
+
+// ListSponsorOverrides returns sponsors with a display override, latest change first.
+func (r *GiveawayRepository) ListSponsorOverrides(ctx context.Context, limit, offset int) ([]dg.SponsorOverride, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT s.giveaway_id, g.creator_id, s.channel_id, COALESCE(s.username,''), COALESCE(s.title,''), COALESCE(s.avatar_url,''),
+               COALESCE(s.title_override,''), COALESCE(s.avatar_override,''), s.override_by, s.override_at
+        FROM giveaway_sponsors s
+        JOIN giveaways g ON g.id = s.giveaway_id
+        WHERE s.override_at IS NOT NULL
+        ORDER BY s.override_at DESC, s.id
+        LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := []dg.SponsorOverride{}
+	for rows.Next() {
+		var o dg.SponsorOverride
+		if err := rows.Scan(&o.GiveawayID, &o.CreatorID, &o.ChannelID, &o.Username, &o.Title, &o.AvatarURL,
+			&o.TitleOverride, &o.AvatarOverride, &o.UpdatedBy, &o.UpdatedAt); err != nil {
+			return nil, err
+		}
+		o.UpdatedAt = o.UpdatedAt.UTC()
+		out = append(out, o)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListStatusSummaries returns status, participant count and end time of the given giveaways
+// in one query. Unknown ids are skipped.
+func (r *GiveawayRepository) ListStatusSummaries(ctx context.Context, ids []string) ([]dg.StatusSummary, error) {
+	const q = `
+        SELECT g.id, g.status, g.ends_at,
+               (SELECT COUNT(*) FROM giveaway_participants p WHERE p.giveaway_id = g.id)
+        FROM giveaways g
+        WHERE g.id = ANY($1)`
+	rows, err := r.db.QueryContext(ctx, q, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.StatusSummary, 0, len(ids))
+	for rows.Next() {
+		var s dg.StatusSummary
+		if err := rows.Scan(&s.ID, &s.Status, &s.EndsAt, &s.ParticipantsCount); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
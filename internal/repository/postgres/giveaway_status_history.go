@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// insertStatusChange appends a status history entry within tx. Empty reason and zero actor are stored as NULL.
+func insertStatusChange(ctx context.Context, tx execer, id string, from, to dg.GiveawayStatus, reason string, actorID int64) error {
+	const q = `
+        INSERT INTO giveaway_status_history (giveaway_id, from_status, to_status, reason, actor_id)
+        VALUES ($1, NULLIF($2, ''), $3, NULLIF($4, ''), NULLIF($5, 0))`
+	_, err := tx.ExecContext(ctx, q, id, string(from), string(to), reason, actorID)
+	return err
+}
+
+// Cancel moves a scheduled, active or pending giveaway without winners to cancelled, records
+// the reason in status history, queues dmText for every participant and a refund for every
+// ticket purchase. Reports false when the giveaway is missing or no longer cancellable.
+func (r *GiveawayRepository) Cancel(ctx context.Context, id string, actorID int64, reason, dmText string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	// Lock row so a concurrent draw cannot slip in between the checks and the update
+	var from dg.GiveawayStatus
+	err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, id).Scan(&from)
+	if err == sql.ErrNoRows {
+		err = nil
+		return false, tx.Commit()
+	}
+	if err != nil {
+		return false, err
+	}
+	var drawn bool
+	if err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM giveaway_winners WHERE giveaway_id=$1)`, id).Scan(&drawn); err != nil {
+		return false, err
+	}
+	switch from {
+	case dg.GiveawayStatusScheduled, dg.GiveawayStatusActive, dg.GiveawayStatusPending:
+	default:
+		drawn = true
+	}
+	if drawn {
+		return false, tx.Commit()
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaways SET status='cancelled', updated_at=now() WHERE id=$1`, id); err != nil {
+		return false, err
+	}
+	if err = insertStatusChange(ctx, tx, id, from, dg.GiveawayStatusCancelled, reason, actorID); err != nil {
+		return false, err
+	}
+	if err = enqueueBroadcast(ctx, tx, id, dg.BroadcastCancelled, dmText); err != nil {
+		return false, err
+	}
+	if _, err = enqueueRefunds(ctx, tx, id); err != nil {
+		return false, err
+	}
+	if err = enqueueEvent(ctx, tx, dg.EventGiveawayCancelled, id, dg.GiveawayCancelledPayload{GiveawayID: id, From: from, Reason: reason, ActorID: actorID}); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// ListStatusHistory returns status changes of a giveaway, oldest first.
+func (r *GiveawayRepository) ListStatusHistory(ctx context.Context, id string) ([]dg.StatusChange, error) {
+	const q = `
+        SELECT COALESCE(from_status, ''), to_status, COALESCE(reason, ''), COALESCE(actor_id, 0), created_at
+        FROM giveaway_status_history
+        WHERE giveaway_id=$1
+        ORDER BY created_at ASC, id ASC`
+	rows, err := r.db.QueryContext(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.StatusChange
+	for rows.Next() {
+		var c dg.StatusChange
+		if err := rows.Scan(&c.From, &c.To, &c.Reason, &c.ActorID, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
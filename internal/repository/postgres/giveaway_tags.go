@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"github.com/lib/pq"
+)
+
+// tagsArray binds giveaway tags to the NOT NULL tags column; no tags is an empty array.
+func tagsArray(tags []string) interface{} {
+	if tags == nil {
+		tags = []string{}
+	}
+	return pq.Array(tags)
+}
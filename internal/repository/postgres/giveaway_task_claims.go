@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// taskLedgerKey identifies one claim of a bonus task; a task claimed again after being
+// revoked is a new claim with its own timestamp.
+func taskLedgerKey(id string, userID, requirementID int64, claimedAt time.Time) string {
+	return fmt.Sprintf("%s:%d:%d:%d", id, userID, requirementID, claimedAt.UnixMicro())
+}
+
+// ClaimTask credits a bonus task to a participant through the ledger and updates their tickets.
+// Returns false when the task was already claimed.
+func (r *GiveawayRepository) ClaimTask(ctx context.Context, id string, userID, requirementID int64, bonus int) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var claimedAt time.Time
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO giveaway_task_claims (giveaway_id, user_id, requirement_id, bonus_tickets)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (giveaway_id, user_id, requirement_id) DO NOTHING
+        RETURNING claimed_at`, id, userID, requirementID, bonus).Scan(&claimedAt)
+	if err == sql.ErrNoRows {
+		err = tx.Commit()
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+	if bonus > 0 {
+		e := dg.LedgerEntry{
+			IdempotencyKey: "task:" + taskLedgerKey(id, userID, requirementID, claimedAt),
+			Asset:          dg.LedgerAssetTicket,
+			Source:         dg.LedgerSourceTask,
+			Debit:          dg.LedgerIssuanceAccount,
+			Credit:         dg.TicketAccount(id, userID),
+			Amount:         int64(bonus),
+			GiveawayID:     id,
+		}
+		if _, err = postLedger(ctx, tx, e); err != nil {
+			return false, err
+		}
+	}
+	if err = syncTickets(ctx, tx, id, userID); err != nil {
+		return false, err
+	}
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// RevokeTask withdraws a claimed bonus task with a reversing ledger entry and updates the
+// participant's tickets.
+// Returns false when the task was not claimed.
+func (r *GiveawayRepository) RevokeTask(ctx context.Context, id string, userID, requirementID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var bonus int
+	var claimedAt time.Time
+	err = tx.QueryRowContext(ctx, `DELETE FROM giveaway_task_claims WHERE giveaway_id=$1 AND user_id=$2 AND requirement_id=$3 RETURNING bonus_tickets, claimed_at`,
+		id, userID, requirementID).Scan(&bonus, &claimedAt)
+	if err == sql.ErrNoRows {
+		err = tx.Commit()
+		return false, err
+	}
+	if err != nil {
+		return false, err
+	}
+	if bonus > 0 {
+		// Reverses exactly the amount credited by the claim
+		e := dg.LedgerEntry{
+			IdempotencyKey: "task_revoke:" + taskLedgerKey(id, userID, requirementID, claimedAt),
+			Asset:          dg.LedgerAssetTicket,
+			Source:         dg.LedgerSourceTaskRevoke,
+			Debit:          dg.TicketAccount(id, userID),
+			Credit:         dg.LedgerIssuanceAccount,
+			Amount:         int64(bonus),
+			GiveawayID:     id,
+		}
+		if _, err = postLedger(ctx, tx, e); err != nil {
+			return false, err
+		}
+	}
+	if err = syncTickets(ctx, tx, id, userID); err != nil {
+		return false, err
+	}
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// TicketBalance returns the tickets of a participant according to the ledger.
+func (r *GiveawayRepository) TicketBalance(ctx context.Context, id string, userID int64) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `SELECT balance FROM ledger_balances WHERE account=$1 AND asset='ticket'`, dg.TicketAccount(id, userID)).Scan(&n)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return n, err
+}
+
+// ListTaskClaims returns the bonus tasks claimed by a participant.
+func (r *GiveawayRepository) ListTaskClaims(ctx context.Context, id string, userID int64) ([]dg.TaskClaim, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT requirement_id, bonus_tickets, claimed_at FROM giveaway_task_claims
+        WHERE giveaway_id=$1 AND user_id=$2 ORDER BY requirement_id`, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.TaskClaim
+	for rows.Next() {
+		var c dg.TaskClaim
+		var at time.Time
+		if err := rows.Scan(&c.RequirementID, &c.BonusTickets, &at); err != nil {
+			return nil, err
+		}
+		c.ClaimedAt = at.UTC()
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// storedTranslation is a translation as kept in giveaways.translations; the description
+// is stored like the giveaway's own.
+type storedTranslation struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func translationsJSON(ts map[string]dg.Translation) interface{} {
+	if len(ts) == 0 {
+		return nil
+	}
+	stored := make(map[string]storedTranslation, len(ts))
+	for tag, t := range ts {
+		stored[tag] = storedTranslation{Title: t.Title, Description: storedDescription(t.Description, t.DescriptionHTML)}
+	}
+	b, err := json.Marshal(stored)
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
+
+func loadTranslations(b []byte) (map[string]dg.Translation, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var stored map[string]storedTranslation
+	if err := json.Unmarshal(b, &stored); err != nil {
+		return nil, err
+	}
+	out := make(map[string]dg.Translation, len(stored))
+	for tag, st := range stored {
+		t := dg.Translation{Title: st.Title}
+		if st.Description != "" {
+			t.Description, t.DescriptionHTML = loadDescription(st.Description)
+		}
+		out[tag] = t
+	}
+	return out, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// EditVersion runs edit as the next version of the giveaway when pre still matches it. Edits of
+// one giveaway are serialized by a transaction lock, so the check, the edit and the version bump
+// cannot interleave with another edit, and a failed edit leaves the version unchanged. Returns
+// "version conflict" when pre no longer matches and the version after the edit otherwise.
+func (r *GiveawayRepository) EditVersion(ctx context.Context, id string, pre dg.EditPrecondition, edit func() error) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('giveaway_edit:' || $1))`, id); err != nil {
+		return 0, err
+	}
+	var (
+		version   int64
+		updatedAt time.Time
+	)
+	err = tx.QueryRowContext(ctx, `SELECT version, updated_at FROM giveaways WHERE id=$1`, id).Scan(&version, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = errors.New("not found")
+		return 0, err
+	}
+	if err != nil {
+		return 0, err
+	}
+	if (pre.Version != 0 && pre.Version != version) || (!pre.UpdatedAt.IsZero() && !pre.UpdatedAt.Equal(updatedAt)) {
+		err = errors.New("version conflict")
+		return 0, err
+	}
+	if err = edit(); err != nil {
+		return 0, err
+	}
+	// Read after the edit committed, so status changes it made are counted as well
+	if err = tx.QueryRowContext(ctx, `UPDATE giveaways SET version = version + 1 WHERE id=$1 RETURNING version`, id).Scan(&version); err != nil {
+		return 0, err
+	}
+	return version, tx.Commit()
+}
+
+// Version returns the current edit version of a giveaway, 0 when it does not exist.
+func (r *GiveawayRepository) Version(ctx context.Context, id string) (int64, error) {
+	var version int64
+	err := r.db.QueryRowContext(ctx, `SELECT version FROM giveaways WHERE id=$1`, id).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return version, err
+}
+
+// GetStamp returns the version, last update and status of a giveaway; nil when it does not exist.
+func (r *GiveawayRepository) GetStamp(ctx context.Context, id string) (*dg.Stamp, error) {
+	var st dg.Stamp
+	err := r.db.QueryRowContext(ctx, `SELECT version, updated_at, status FROM giveaways WHERE id=$1`, id).Scan(&st.Version, &st.UpdatedAt, &st.Status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
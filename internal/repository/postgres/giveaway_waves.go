@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const waveColumns = `id, giveaway_id, number, draw_at, winners_count, status, COALESCE(draw_id,0), drawn_at`
+
+func scanWave(sc interface{ Scan(dest ...any) error }) (dg.Wave, error) {
+	var w dg.Wave
+	var drawnAt sql.NullTime
+	if err := sc.Scan(&w.ID, &w.GiveawayID, &w.Number, &w.DrawAt, &w.WinnersCount, &w.Status, &w.DrawID, &drawnAt); err != nil {
+		return w, err
+	}
+	if drawnAt.Valid {
+		t := drawnAt.Time
+		w.DrawnAt = &t
+	}
+	return w, nil
+}
+
+// ListWaves returns the waves of a giveaway in order with the winners each one drew.
+func (r *GiveawayRepository) ListWaves(ctx context.Context, id string) ([]dg.Wave, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+waveColumns+` FROM giveaway_waves WHERE giveaway_id=$1 ORDER BY number`, id)
+	if err != nil {
+		return nil, err
+	}
+	var out []dg.Wave
+	for rows.Next() {
+		w, err := scanWave(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+	wrows, err := r.db.QueryContext(ctx, `SELECT wave, user_id FROM giveaway_winners WHERE giveaway_id=$1 AND wave IS NOT NULL ORDER BY place`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer wrows.Close()
+	byNumber := make(map[int][]int64)
+	for wrows.Next() {
+		var n int
+		var uid int64
+		if err := wrows.Scan(&n, &uid); err != nil {
+			return nil, err
+		}
+		byNumber[n] = append(byNumber[n], uid)
+	}
+	for i := range out {
+		out[i].Winners = byNumber[out[i].Number]
+	}
+	return out, wrows.Err()
+}
+
+// ReplaceWaves swaps the wave schedule of a giveaway. Waves are numbered in the given order.
+// Returns false when a wave was already drawn, since the schedule is then fixed.
+func (r *GiveawayRepository) ReplaceWaves(ctx context.Context, id string, waves []dg.Wave) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `SELECT 1 FROM giveaways WHERE id=$1 FOR UPDATE`, id); err != nil {
+		return false, err
+	}
+	var drawn bool
+	if err = tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM giveaway_waves WHERE giveaway_id=$1 AND status='drawn')`, id).Scan(&drawn); err != nil {
+		return false, err
+	}
+	if drawn {
+		err = tx.Commit()
+		return false, err
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_waves WHERE giveaway_id=$1`, id); err != nil {
+		return false, err
+	}
+	const q = `INSERT INTO giveaway_waves (giveaway_id, number, draw_at, winners_count) VALUES ($1,$2,$3,$4)`
+	for i, w := range waves {
+		if _, err = tx.ExecContext(ctx, q, id, i+1, w.DrawAt, w.WinnersCount); err != nil {
+			return false, err
+		}
+	}
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// ListDueWaves returns pending waves of active giveaways whose draw time has come, earliest first.
+func (r *GiveawayRepository) ListDueWaves(ctx context.Context, now time.Time, limit int) ([]dg.Wave, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	const q = `
+        SELECT w.id, w.giveaway_id, w.number, w.draw_at, w.winners_count, w.status, COALESCE(w.draw_id,0), w.drawn_at
+        FROM giveaway_waves w
+        JOIN giveaways g ON g.id = w.giveaway_id
+        WHERE w.status='pending' AND w.draw_at <= $1 AND g.status='active'
+        ORDER BY w.draw_at, w.number
+        LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.Wave
+	for rows.Next() {
+		w, err := scanWave(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	return out, rows.Err()
+}
+
+// ListWaveWinnerIDs returns winners already drawn by waves, ordered by place.
+func (r *GiveawayRepository) ListWaveWinnerIDs(ctx context.Context, id string) ([]int64, error) {
+	return listWaveWinners(ctx, r.db, id)
+}
+
+func listWaveWinners(ctx context.Context, q interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}, id string) ([]int64, error) {
+	rows, err := q.QueryContext(ctx, `SELECT user_id FROM giveaway_winners WHERE giveaway_id=$1 AND wave IS NOT NULL ORDER BY place`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var uid int64
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		out = append(out, uid)
+	}
+	return out, rows.Err()
+}
+
+// RecordWaveDraw stores the winners of a wave after the places already taken and publishes them.
+// Prizes are assigned when the giveaway completes. Returns false when the giveaway is no longer
+// active, the wave was drawn meanwhile or one of the winners already won.
+func (r *GiveawayRepository) RecordWaveDraw(ctx context.Context, w *dg.Wave, d *dg.Draw) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var status string
+	if err = tx.QueryRowContext(ctx, `SELECT status FROM giveaways WHERE id=$1 FOR UPDATE`, w.GiveawayID).Scan(&status); err != nil {
+		if err == sql.ErrNoRows {
+			err = nil
+			return false, tx.Commit()
+		}
+		return false, err
+	}
+	var waveStatus string
+	if err = tx.QueryRowContext(ctx, `SELECT status FROM giveaway_waves WHERE id=$1`, w.ID).Scan(&waveStatus); err != nil {
+		if err == sql.ErrNoRows {
+			err = nil
+			return false, tx.Commit()
+		}
+		return false, err
+	}
+	if status != string(dg.GiveawayStatusActive) || waveStatus != string(dg.WaveStatusPending) {
+		err = tx.Commit()
+		return false, err
+	}
+	var taken bool
+	if err = tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM giveaway_winners WHERE giveaway_id=$1 AND user_id = ANY($2))`, w.GiveawayID, pq.Array(d.Winners)).Scan(&taken); err != nil {
+		return false, err
+	}
+	if taken {
+		err = tx.Commit()
+		return false, err
+	}
+	if err = insertDraw(ctx, tx, d); err != nil {
+		return false, err
+	}
+	var last int
+	if err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(place),0) FROM giveaway_winners WHERE giveaway_id=$1`, w.GiveawayID).Scan(&last); err != nil {
+		return false, err
+	}
+	for i, uid := range d.Winners {
+		if _, err = tx.ExecContext(ctx, `INSERT INTO giveaway_winners (giveaway_id, place, user_id, wave) VALUES ($1,$2,$3,$4)`, w.GiveawayID, last+i+1, uid, w.Number); err != nil {
+			return false, err
+		}
+	}
+	if _, err = tx.ExecContext(ctx, `UPDATE giveaway_waves SET status='drawn', draw_id=$2, drawn_at=now() WHERE id=$1`, w.ID, d.ID); err != nil {
+		return false, err
+	}
+	winners := d.Winners
+	if winners == nil {
+		winners = []int64{}
+	}
+	if err = enqueueEvent(ctx, tx, dg.EventWaveDrawn, w.GiveawayID, dg.WaveDrawnPayload{GiveawayID: w.GiveawayID, Wave: w.Number, DrawID: d.ID, Winners: winners}); err != nil {
+		return false, err
+	}
+	err = tx.Commit()
+	return err == nil, err
+}
@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SetWinnerOrder stores how winners are listed; empty restores ordering by place.
+func (r *GiveawayRepository) SetWinnerOrder(ctx context.Context, id string, order dg.WinnerOrder) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaways SET winner_order=NULLIF($2,''), updated_at=now() WHERE id=$1`, id, string(order))
+	return err
+}
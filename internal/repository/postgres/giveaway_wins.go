@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListWinsByUser returns the giveaways a user won, newest first, with the prizes assigned so far.
+// Winners still under manual review (pending giveaways) are not listed.
+func (r *GiveawayRepository) ListWinsByUser(ctx context.Context, userID int64, limit, offset int) ([]dg.Win, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	const q = `
+        SELECT w.giveaway_id, g.title, g.status, w.place, w.assigned_at, g.ends_at, w.fulfillment_status, w.claimed_at, w.delivered_at
+        FROM giveaway_winners w
+        JOIN giveaways g ON g.id = w.giveaway_id
+        WHERE w.user_id=$1 AND g.status IN ('active','completed','finished')
+        ORDER BY w.assigned_at DESC, w.giveaway_id
+        LIMIT $2 OFFSET $3`
+	rows, err := r.db.QueryContext(ctx, q, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	var out []dg.Win
+	var ids []string
+	for rows.Next() {
+		var w dg.Win
+		var claimedAt, deliveredAt sql.NullTime
+		if err := rows.Scan(&w.GiveawayID, &w.Title, &w.GiveawayStatus, &w.Place, &w.WonAt, &w.EndsAt, &w.Fulfillment, &claimedAt, &deliveredAt); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if claimedAt.Valid {
+			t := claimedAt.Time
+			w.ClaimedAt = &t
+		}
+		if deliveredAt.Valid {
+			t := deliveredAt.Time
+			w.DeliveredAt = &t
+		}
+		out = append(out, w)
+		ids = append(ids, w.GiveawayID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	prizemap := map[string][]dg.WinnerPrize{}
+	prows, err := r.db.QueryContext(ctx, `SELECT giveaway_id, prize_title, prize_description, quantity, package_label FROM giveaway_winner_prizes WHERE user_id=$1 AND giveaway_id = ANY($2) ORDER BY id ASC`, userID, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer prows.Close()
+	for prows.Next() {
+		var gid, t, d, pkg string
+		var qty int
+		if err := prows.Scan(&gid, &t, &d, &qty, &pkg); err != nil {
+			return nil, err
+		}
+		plain, rich := loadDescription(d)
+		prizemap[gid] = append(prizemap[gid], dg.WinnerPrize{Title: t, Description: plain, DescriptionHTML: rich, Quantity: qty, Package: pkg})
+	}
+	for i := range out {
+		out[i].Prizes = dg.MergeWinnerPrizes(prizemap[out[i].GiveawayID])
+		if out[i].Prizes == nil {
+			out[i].Prizes = []dg.WinnerPrize{}
+		}
+		out[i].TotalUnits = dg.TotalPrizeQuantity(out[i].Prizes)
+	}
+	return out, prows.Err()
+}
+
+// ClaimPrize marks the prize of a winner as requested. Returns false unless the winner's
+// fulfillment is still pending in a completed giveaway.
+func (r *GiveawayRepository) ClaimPrize(ctx context.Context, id string, userID int64) (bool, error) {
+	const q = `
+        UPDATE giveaway_winners w SET fulfillment_status='claimed', claimed_at=now()
+        FROM giveaways g
+        WHERE g.id = w.giveaway_id AND w.giveaway_id=$1 AND w.user_id=$2
+          AND w.fulfillment_status='pending' AND g.status IN ('completed','finished')`
+	res, err := r.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// SetWinnerContact stores how a winner wants to be contacted for delivery, replacing an earlier
+// choice. Returns false when the user is not a winner or their prizes were already delivered.
+func (r *GiveawayRepository) SetWinnerContact(ctx context.Context, id string, userID int64, c dg.ContactPreference) (bool, error) {
+	const q = `
+        UPDATE giveaway_winners
+        SET contact_method=$3, contact_email=NULLIF($4, ''), contact_wallet=NULLIF($5, ''), contact_updated_at=now()
+        WHERE giveaway_id=$1 AND user_id=$2 AND fulfillment_status <> 'delivered'`
+	res, err := r.db.ExecContext(ctx, q, id, userID, string(c.Method), c.Email, c.WalletAddress)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// MarkPrizeDelivered records that the creator delivered a winner's prizes.
+// Returns false when the user is not a winner or delivery was already recorded.
+func (r *GiveawayRepository) MarkPrizeDelivered(ctx context.Context, id string, userID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE giveaway_winners SET fulfillment_status='delivered', delivered_at=now() WHERE giveaway_id=$1 AND user_id=$2 AND fulfillment_status <> 'delivered'`, id, userID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
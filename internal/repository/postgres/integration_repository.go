@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// IntegrationRepository stores creator API keys and reads the polling trigger feeds.
+type IntegrationRepository struct {
+	db *sql.DB
+}
+
+func NewIntegrationRepository(db *sql.DB) *IntegrationRepository {
+	return &IntegrationRepository{db: db}
+}
+
+// CreateAPIKey stores a key by its hash.
+func (r *IntegrationRepository) CreateAPIKey(ctx context.Context, k *dg.APIKey, keyHash string) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO api_keys (id, creator_id, name, key_hash, prefix, created_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+		k.ID, k.CreatorID, k.Name, keyHash, k.Prefix, k.CreatedAt)
+	return err
+}
+
+// ListAPIKeys returns the keys of a creator, oldest first.
+func (r *IntegrationRepository) ListAPIKeys(ctx context.Context, creatorID int64) ([]dg.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, creator_id, name, prefix, created_at, last_used_at FROM api_keys WHERE creator_id=$1 ORDER BY created_at ASC`, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.APIKey, 0)
+	for rows.Next() {
+		var k dg.APIKey
+		var lastUsed sql.NullTime
+		if err := rows.Scan(&k.ID, &k.CreatorID, &k.Name, &k.Prefix, &k.CreatedAt, &lastUsed); err != nil {
+			return nil, err
+		}
+		if lastUsed.Valid {
+			t := lastUsed.Time
+			k.LastUsedAt = &t
+		}
+		out = append(out, k)
+	}
+	return out, rows.Err()
+}
+
+// CountAPIKeys returns how many keys a creator has.
+func (r *IntegrationRepository) CountAPIKeys(ctx context.Context, creatorID int64) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM api_keys WHERE creator_id=$1`, creatorID).Scan(&n)
+	return n, err
+}
+
+// DeleteAPIKey revokes a key of creatorID. Reports false when no such key exists.
+func (r *IntegrationRepository) DeleteAPIKey(ctx context.Context, id string, creatorID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM api_keys WHERE id=$1 AND creator_id=$2`, id, creatorID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// ResolveAPIKey returns the creator owning keyHash and records the use, or 0 when the key is unknown.
+func (r *IntegrationRepository) ResolveAPIKey(ctx context.Context, keyHash string) (int64, error) {
+	var creatorID int64
+	err := r.db.QueryRowContext(ctx, `UPDATE api_keys SET last_used_at=now() WHERE key_hash=$1 RETURNING creator_id`, keyHash).Scan(&creatorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return creatorID, err
+}
+
+// NewParticipants returns participants of the creator's giveaways who joined before until. With a
+// cursor, items after it are returned oldest first; without one, the latest items newest first.
+func (r *IntegrationRepository) NewParticipants(ctx context.Context, creatorID int64, after *dg.FeedCursor, until time.Time, limit int) ([]dg.CRMParticipant, error) {
+	if after == nil {
+		const q = participantFeedSelect + `
+        WHERE g.creator_id = $1 AND p.joined_at < $2
+        ORDER BY p.joined_at DESC, p.giveaway_id DESC, p.user_id DESC
+        LIMIT $3`
+		return queryFeedParticipants(ctx, r.db, q, creatorID, until, limit)
+	}
+	const q = participantFeedSelect + `
+        WHERE g.creator_id = $1
+          AND (p.joined_at, p.giveaway_id, p.user_id) > ($2, $3, $4)
+          AND p.joined_at < $5
+        ORDER BY p.joined_at, p.giveaway_id, p.user_id
+        LIMIT $6`
+	return queryFeedParticipants(ctx, r.db, q, creatorID, after.At, after.GiveawayID, after.Key, until, limit)
+}
+
+const winnerFeedSelect = `
+        SELECT w.giveaway_id, g.title, w.place, w.user_id, COALESCE(u.username, ''),
+               COALESCE((SELECT array_agg(wp.prize_title ORDER BY wp.id) FROM giveaway_winner_prizes wp
+                         WHERE wp.giveaway_id = w.giveaway_id AND wp.user_id = w.user_id), '{}'),
+               w.assigned_at
+        FROM giveaway_winners w
+        JOIN giveaways g ON g.id = w.giveaway_id
+        LEFT JOIN users u ON u.id = w.user_id`
+
+// NewWinners returns winners of the creator's giveaways assigned before until, ordered like NewParticipants.
+func (r *IntegrationRepository) NewWinners(ctx context.Context, creatorID int64, after *dg.FeedCursor, until time.Time, limit int) ([]dg.IntegrationWinner, error) {
+	var rows *sql.Rows
+	var err error
+	if after == nil {
+		rows, err = r.db.QueryContext(ctx, winnerFeedSelect+`
+        WHERE g.creator_id = $1 AND w.assigned_at < $2
+        ORDER BY w.assigned_at DESC, w.giveaway_id DESC, w.place DESC
+        LIMIT $3`, creatorID, until, limit)
+	} else {
+		rows, err = r.db.QueryContext(ctx, winnerFeedSelect+`
+        WHERE g.creator_id = $1
+          AND (w.assigned_at, w.giveaway_id, w.place) > ($2, $3, $4)
+          AND w.assigned_at < $5
+        ORDER BY w.assigned_at, w.giveaway_id, w.place
+        LIMIT $6`, creatorID, after.At, after.GiveawayID, after.Key, until, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.IntegrationWinner
+	for rows.Next() {
+		var w dg.IntegrationWinner
+		if err := rows.Scan(&w.GiveawayID, &w.GiveawayTitle, &w.Place, &w.UserID, &w.Username, pq.Array(&w.Prizes), &w.AssignedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	return out, rows.Err()
+}
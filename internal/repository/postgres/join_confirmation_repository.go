@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// JoinConfirmationRepository stores per-giveaway join confirmation settings and their delivery.
+type JoinConfirmationRepository struct {
+	db *sql.DB
+}
+
+func NewJoinConfirmationRepository(db *sql.DB) *JoinConfirmationRepository {
+	return &JoinConfirmationRepository{db: db}
+}
+
+// Get returns the settings of a giveaway or nil when the creator never configured them.
+func (r *JoinConfirmationRepository) Get(ctx context.Context, giveawayID string) (*dg.JoinConfirmation, error) {
+	var jc dg.JoinConfirmation
+	err := r.db.QueryRowContext(ctx, `SELECT enabled, rules, updated_at FROM giveaway_join_confirmations WHERE giveaway_id=$1`, giveawayID).
+		Scan(&jc.Enabled, &jc.Rules, &jc.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &jc, nil
+}
+
+// Set creates or replaces the settings of a giveaway.
+func (r *JoinConfirmationRepository) Set(ctx context.Context, giveawayID string, jc dg.JoinConfirmation) error {
+	const q = `
+        INSERT INTO giveaway_join_confirmations (giveaway_id, enabled, rules)
+        VALUES ($1,$2,$3)
+        ON CONFLICT (giveaway_id) DO UPDATE SET enabled=EXCLUDED.enabled, rules=EXCLUDED.rules, updated_at=now()`
+	_, err := r.db.ExecContext(ctx, q, giveawayID, jc.Enabled, jc.Rules)
+	return err
+}
+
+// ClaimDelivery marks the confirmation of a participant as sent and returns the giveaway's rules.
+// It reports false when confirmations are off for the giveaway, the user opted out, or the
+// message was already claimed, so each participant gets at most one.
+func (r *JoinConfirmationRepository) ClaimDelivery(ctx context.Context, giveawayID string, userID int64) (string, bool, error) {
+	const q = `
+        UPDATE giveaway_participants p SET confirmation_sent_at=now()
+        FROM giveaway_join_confirmations jc
+        WHERE p.giveaway_id=$1 AND p.user_id=$2 AND p.confirmation_sent_at IS NULL
+          AND jc.giveaway_id=p.giveaway_id AND jc.enabled
+          AND NOT EXISTS (SELECT 1 FROM users u WHERE u.id=p.user_id AND NOT u.notify_join_confirmations)
+        RETURNING jc.rules`
+	var rules string
+	err := r.db.QueryRowContext(ctx, q, giveawayID, userID).Scan(&rules)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return rules, true, nil
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const refundColumns = `id, giveaway_id, user_id, charge_id, stars, tickets, status, attempts, last_error, created_at, completed_at`
+
+func scanRefund(row interface{ Scan(...any) error }) (dg.Refund, error) {
+	var rf dg.Refund
+	var completedAt sql.NullTime
+	err := row.Scan(&rf.ID, &rf.GiveawayID, &rf.UserID, &rf.ChargeID, &rf.Stars, &rf.Tickets, &rf.Status, &rf.Attempts,
+		&rf.LastError, &rf.CreatedAt, &completedAt)
+	if completedAt.Valid {
+		t := completedAt.Time
+		rf.CompletedAt = &t
+	}
+	return rf, err
+}
+
+func scanRefunds(rows *sql.Rows) ([]dg.Refund, error) {
+	defer rows.Close()
+	out := make([]dg.Refund, 0)
+	for rows.Next() {
+		rf, err := scanRefund(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rf)
+	}
+	return out, rows.Err()
+}
+
+// purchaseKey is the idempotency key of the Stars side of a purchase; the ticket side appends ":tickets".
+func purchaseKey(chargeID string) string { return "purchase:" + chargeID }
+
+// RecordPurchase books a Stars payment for tickets: the Stars go to the giveaway's sales
+// account and the tickets to the buyer. Returns false when the charge was already recorded.
+func (r *LedgerRepository) RecordPurchase(ctx context.Context, p dg.TicketPurchase) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	stars := dg.LedgerEntry{
+		IdempotencyKey: purchaseKey(p.ChargeID),
+		Asset:          dg.LedgerAssetStar,
+		Source:         dg.LedgerSourcePurchase,
+		Debit:          dg.LedgerStarsAccount,
+		Credit:         dg.SalesAccount(p.GiveawayID),
+		Amount:         p.Stars,
+		GiveawayID:     p.GiveawayID,
+		ActorID:        p.UserID,
+		Ref:            p.ChargeID,
+	}
+	tickets := dg.LedgerEntry{
+		IdempotencyKey: purchaseKey(p.ChargeID) + ":tickets",
+		Asset:          dg.LedgerAssetTicket,
+		Source:         dg.LedgerSourcePurchase,
+		Debit:          dg.LedgerIssuanceAccount,
+		Credit:         dg.TicketAccount(p.GiveawayID, p.UserID),
+		Amount:         p.Tickets,
+		GiveawayID:     p.GiveawayID,
+		ActorID:        p.UserID,
+		Ref:            p.ChargeID,
+	}
+	var n int
+	if n, err = postLedger(ctx, tx, stars, tickets); err != nil {
+		return false, err
+	}
+	if n > 0 {
+		if err = syncTickets(ctx, tx, p.GiveawayID, p.UserID); err != nil {
+			return false, err
+		}
+	}
+	err = tx.Commit()
+	return err == nil && n > 0, err
+}
+
+// enqueueRefunds creates a pending refund for every Stars purchase of a giveaway that has
+// none yet. Returns how many were created.
+func enqueueRefunds(ctx context.Context, ex execer, id string) (int64, error) {
+	res, err := ex.ExecContext(ctx, `
+        INSERT INTO ticket_refunds (purchase_entry_id, giveaway_id, user_id, charge_id, stars, tickets)
+        SELECT s.id, s.giveaway_id, s.actor_id, s.external_ref, s.amount, COALESCE(t.amount, 0)
+        FROM ledger_entries s
+        LEFT JOIN ledger_entries t ON t.idempotency_key = s.idempotency_key || ':tickets'
+        WHERE s.giveaway_id=$1 AND s.source='purchase' AND s.asset='star'
+          AND s.actor_id IS NOT NULL AND s.external_ref IS NOT NULL
+        ON CONFLICT (purchase_entry_id) DO NOTHING`, id)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// ClaimDueRefunds returns up to limit pending refunds whose next attempt is due, counting the
+// attempt and hiding them from other workers for lease.
+func (r *LedgerRepository) ClaimDueRefunds(ctx context.Context, limit int, lease time.Duration) ([]dg.Refund, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        UPDATE ticket_refunds SET attempts = attempts + 1, next_attempt_at = now() + make_interval(secs => $2)
+        WHERE id IN (
+            SELECT id FROM ticket_refunds
+            WHERE status='pending' AND next_attempt_at <= now()
+            ORDER BY next_attempt_at
+            LIMIT $1
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING `+refundColumns, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return scanRefunds(rows)
+}
+
+// RetryRefund records a failed attempt and schedules the next one.
+func (r *LedgerRepository) RetryRefund(ctx context.Context, id int64, lastError string, next time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE ticket_refunds SET last_error=$2, next_attempt_at=$3 WHERE id=$1 AND status='pending'`, id, lastError, next)
+	return err
+}
+
+// CompleteRefund closes a pending refund and books it: the Stars leave the sales account,
+// either back to Telegram (refunded) or to the buyer's Stars account (credited), and the
+// purchased tickets still held are withdrawn. Returns false when the refund was not pending.
+func (r *LedgerRepository) CompleteRefund(ctx context.Context, rf dg.Refund, status dg.RefundStatus, lastError string) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	res, err := tx.ExecContext(ctx, `
+        UPDATE ticket_refunds SET status=$2, last_error=$3, completed_at=now()
+        WHERE id=$1 AND status='pending'`, rf.ID, string(status), lastError)
+	if err != nil {
+		return false, err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		err = tx.Commit()
+		return false, err
+	}
+	to := dg.LedgerStarsAccount
+	if status == dg.RefundStatusCredited {
+		to = dg.StarsAccount(rf.UserID)
+	}
+	entries := []dg.LedgerEntry{{
+		IdempotencyKey: "refund:" + rf.ChargeID,
+		Asset:          dg.LedgerAssetStar,
+		Source:         dg.LedgerSourceRefund,
+		Debit:          dg.SalesAccount(rf.GiveawayID),
+		Credit:         to,
+		Amount:         rf.Stars,
+		GiveawayID:     rf.GiveawayID,
+		Ref:            rf.ChargeID,
+	}}
+	// Manual deductions may have left fewer tickets than were bought
+	account := dg.TicketAccount(rf.GiveawayID, rf.UserID)
+	var held int64
+	err = tx.QueryRowContext(ctx, `SELECT balance FROM ledger_balances WHERE account=$1 AND asset='ticket' FOR UPDATE`, account).Scan(&held)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if withdraw := min(rf.Tickets, held); withdraw > 0 {
+		entries = append(entries, dg.LedgerEntry{
+			IdempotencyKey: "refund:" + rf.ChargeID + ":tickets",
+			Asset:          dg.LedgerAssetTicket,
+			Source:         dg.LedgerSourceRefund,
+			Debit:          account,
+			Credit:         dg.LedgerIssuanceAccount,
+			Amount:         withdraw,
+			GiveawayID:     rf.GiveawayID,
+			Ref:            rf.ChargeID,
+		})
+	}
+	if _, err = postLedger(ctx, tx, entries...); err != nil {
+		return false, err
+	}
+	if err = syncTickets(ctx, tx, rf.GiveawayID, rf.UserID); err != nil {
+		return false, err
+	}
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// ListRefundsByUser returns the refunds owed to a user, newest first.
+func (r *LedgerRepository) ListRefundsByUser(ctx context.Context, userID int64, limit, offset int) ([]dg.Refund, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+refundColumns+` FROM ticket_refunds WHERE user_id=$1 ORDER BY id DESC LIMIT $2 OFFSET $3`,
+		userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanRefunds(rows)
+}
+
+// ListRefundsByGiveaway returns the refunds of a giveaway, oldest first.
+func (r *LedgerRepository) ListRefundsByGiveaway(ctx context.Context, id string, limit, offset int) ([]dg.Refund, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+refundColumns+` FROM ticket_refunds WHERE giveaway_id=$1 ORDER BY id LIMIT $2 OFFSET $3`,
+		id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return scanRefunds(rows)
+}
+
+// RefundSummary counts the refunds of a giveaway by status.
+func (r *LedgerRepository) RefundSummary(ctx context.Context, id string) (dg.RefundSummary, error) {
+	var s dg.RefundSummary
+	err := r.db.QueryRowContext(ctx, `
+        SELECT COUNT(*) FILTER (WHERE status='pending'),
+               COUNT(*) FILTER (WHERE status='refunded'),
+               COUNT(*) FILTER (WHERE status='credited'),
+               COALESCE(SUM(stars), 0)
+        FROM ticket_refunds WHERE giveaway_id=$1`, id).Scan(&s.Pending, &s.Refunded, &s.Credited, &s.Stars)
+	return s, err
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// LedgerRepository reads and writes the ticket and Stars ledger.
+type LedgerRepository struct {
+	db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) *LedgerRepository { return &LedgerRepository{db: db} }
+
+// rowQueryer is satisfied by *sql.DB and *sql.Tx.
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// postLedger appends entries and applies them to the balances of user-held accounts in one
+// statement. Entries whose idempotency key was already posted are skipped. A balance that
+// would go negative fails the statement with "insufficient balance", so callers must roll
+// back their transaction. Returns how many entries were posted.
+func postLedger(ctx context.Context, q rowQueryer, entries ...dg.LedgerEntry) (int, error) {
+	if len(entries) == 0 {
+		return 0, nil
+	}
+	n := len(entries)
+	keys, assets, sources := make([]string, n), make([]string, n), make([]string, n)
+	debits, credits, giveaways, memos, refs := make([]string, n), make([]string, n), make([]string, n), make([]string, n), make([]string, n)
+	amounts, actors := make([]int64, n), make([]int64, n)
+	for i, e := range entries {
+		if e.IdempotencyKey == "" || e.Amount <= 0 || e.Debit == "" || e.Credit == "" || e.Debit == e.Credit {
+			return 0, fmt.Errorf("invalid ledger entry %q", e.IdempotencyKey)
+		}
+		keys[i], assets[i], sources[i] = e.IdempotencyKey, string(e.Asset), string(e.Source)
+		debits[i], credits[i], giveaways[i], memos[i], refs[i] = e.Debit, e.Credit, e.GiveawayID, e.Memo, e.Ref
+		amounts[i], actors[i] = e.Amount, e.ActorID
+	}
+	const query = `
+        WITH e AS (
+            INSERT INTO ledger_entries (idempotency_key, asset, source, debit_account, credit_account, amount, giveaway_id, actor_id, memo, external_ref)
+            SELECT k, a, s, d, c, amt, NULLIF(g, ''), NULLIF(act, 0), m, NULLIF(ref, '')
+            FROM unnest($1::text[], $2::text[], $3::text[], $4::text[], $5::text[], $6::bigint[], $7::text[], $8::bigint[], $9::text[], $10::text[])
+                AS x(k, a, s, d, c, amt, g, act, m, ref)
+            ON CONFLICT (idempotency_key) DO NOTHING
+            RETURNING asset, debit_account, credit_account, amount
+        ), legs AS (
+            SELECT debit_account AS account, asset, -amount AS delta FROM e
+            UNION ALL
+            SELECT credit_account, asset, amount FROM e
+        ), b AS (
+            INSERT INTO ledger_balances (account, asset, balance)
+            SELECT account, asset, SUM(delta) FROM legs
+            WHERE account NOT LIKE 'system:%'
+            GROUP BY account, asset
+            ON CONFLICT (account, asset) DO UPDATE
+            SET balance = ledger_balances.balance + EXCLUDED.balance, updated_at = now()
+            RETURNING 1
+        )
+        SELECT COUNT(*) FROM e`
+	var posted int
+	err := q.QueryRowContext(ctx, query, pq.Array(keys), pq.Array(assets), pq.Array(sources), pq.Array(debits),
+		pq.Array(credits), pq.Array(amounts), pq.Array(giveaways), pq.Array(actors), pq.Array(memos), pq.Array(refs)).Scan(&posted)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23514" && pqErr.Table == "ledger_balances" {
+		return 0, errors.New("insufficient balance")
+	}
+	return posted, err
+}
+
+// syncTickets copies a participant's ticket balance to giveaway_participants, where draws
+// read it from.
+func syncTickets(ctx context.Context, ex execer, id string, userID int64) error {
+	_, err := ex.ExecContext(ctx, `
+        UPDATE giveaway_participants SET tickets = COALESCE(
+            (SELECT balance FROM ledger_balances WHERE account=$3 AND asset='ticket'), 0)
+        WHERE giveaway_id=$1 AND user_id=$2`, id, userID, dg.TicketAccount(id, userID))
+	return err
+}
+
+// Post appends entries in one transaction; see postLedger.
+func (r *LedgerRepository) Post(ctx context.Context, entries ...dg.LedgerEntry) (int, error) {
+	return postLedger(ctx, r.db, entries...)
+}
+
+// GrantTickets posts a ticket entry for a participant and updates their tickets in one
+// transaction. Returns false when the idempotency key was already posted.
+func (r *LedgerRepository) GrantTickets(ctx context.Context, e dg.LedgerEntry, id string, userID int64) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	var n int
+	if n, err = postLedger(ctx, tx, e); err != nil {
+		return false, err
+	}
+	if n > 0 {
+		if err = syncTickets(ctx, tx, id, userID); err != nil {
+			return false, err
+		}
+	}
+	err = tx.Commit()
+	return err == nil && n > 0, err
+}
+
+// Balance returns the balance of a user-held account; accounts without entries have none.
+func (r *LedgerRepository) Balance(ctx context.Context, account string, asset dg.LedgerAsset) (int64, error) {
+	var b int64
+	err := r.db.QueryRowContext(ctx, `SELECT balance FROM ledger_balances WHERE account=$1 AND asset=$2`, account, string(asset)).Scan(&b)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return b, err
+}
+
+// ListByGiveaway returns the entries of a giveaway, newest first.
+func (r *LedgerRepository) ListByGiveaway(ctx context.Context, id string, limit, offset int) ([]dg.LedgerEntry, error) {
+	return r.list(ctx, `WHERE giveaway_id=$1`, id, limit, offset)
+}
+
+// ListByAccount returns the entries debiting or crediting an account, newest first.
+func (r *LedgerRepository) ListByAccount(ctx context.Context, account string, limit, offset int) ([]dg.LedgerEntry, error) {
+	return r.list(ctx, `WHERE debit_account=$1 OR credit_account=$1`, account, limit, offset)
+}
+
+func (r *LedgerRepository) list(ctx context.Context, where, arg string, limit, offset int) ([]dg.LedgerEntry, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT id, idempotency_key, asset, source, debit_account, credit_account, amount,
+               COALESCE(giveaway_id, ''), COALESCE(actor_id, 0), memo, COALESCE(external_ref, ''), created_at
+        FROM ledger_entries `+where+`
+        ORDER BY id DESC LIMIT $2 OFFSET $3`, arg, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.LedgerEntry, 0)
+	for rows.Next() {
+		var e dg.LedgerEntry
+		var asset, source string
+		var at time.Time
+		if err := rows.Scan(&e.ID, &e.IdempotencyKey, &asset, &source, &e.Debit, &e.Credit, &e.Amount,
+			&e.GiveawayID, &e.ActorID, &e.Memo, &e.Ref, &at); err != nil {
+			return nil, err
+		}
+		e.Asset = dg.LedgerAsset(asset)
+		e.Source = dg.LedgerSource(source)
+		e.CreatedAt = at.UTC()
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+// Reconcile compares stored balances with the sum of their entries, and participant tickets
+// with their ticket accounts. Returns every mismatch; none means the books agree.
+func (r *LedgerRepository) Reconcile(ctx context.Context) ([]dg.LedgerDiscrepancy, error) {
+	const q = `
+        WITH posted AS (
+            SELECT account, asset, SUM(delta) AS total FROM (
+                SELECT credit_account AS account, asset, amount AS delta FROM ledger_entries
+                UNION ALL
+                SELECT debit_account, asset, -amount FROM ledger_entries
+            ) l
+            WHERE account NOT LIKE 'system:%'
+            GROUP BY account, asset
+        )
+        SELECT 'balance', COALESCE(p.account, b.account), COALESCE(p.asset, b.asset), COALESCE(p.total, 0), COALESCE(b.balance, 0)
+        FROM posted p FULL JOIN ledger_balances b ON b.account = p.account AND b.asset = p.asset
+        WHERE COALESCE(p.total, 0) <> COALESCE(b.balance, 0)
+        UNION ALL
+        SELECT 'tickets', a.account, 'ticket', COALESCE(b.balance, 0), a.tickets
+        FROM (
+            SELECT 'tickets:' || giveaway_id || ':' || user_id AS account, tickets FROM giveaway_participants
+        ) a
+        LEFT JOIN ledger_balances b ON b.account = a.account AND b.asset = 'ticket'
+        WHERE a.tickets <> COALESCE(b.balance, 0)
+        ORDER BY 1, 2`
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.LedgerDiscrepancy, 0)
+	for rows.Next() {
+		var d dg.LedgerDiscrepancy
+		var asset string
+		if err := rows.Scan(&d.Kind, &d.Account, &asset, &d.Ledger, &d.Actual); err != nil {
+			return nil, err
+		}
+		d.Asset = dg.LedgerAsset(asset)
+		out = append(out, d)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// OutboxRepository reads domain events written by other repositories and tracks their delivery.
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository { return &OutboxRepository{db: db} }
+
+// enqueueEvent stores an event in the outbox as part of the caller's transaction,
+// so it is published if and only if the state change commits.
+func enqueueEvent(ctx context.Context, tx *sql.Tx, typ dg.EventType, aggregateID string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	const q = `INSERT INTO event_outbox (event_id, type, version, aggregate_id, payload, occurred_at) VALUES ($1,$2,$3,$4,$5,$6)`
+	_, err = tx.ExecContext(ctx, q, uuid.NewString(), string(typ), dg.EventSchemaVersion, aggregateID, body, time.Now().UTC())
+	return err
+}
+
+// enqueueWinnerEvents emits WinnersSelected and one PrizeDistributed per winner from the rows written in tx.
+func enqueueWinnerEvents(ctx context.Context, tx *sql.Tx, id string, drawID int64, manual bool) error {
+	rows, err := tx.QueryContext(ctx, `SELECT place, user_id FROM giveaway_winners WHERE giveaway_id=$1 ORDER BY place ASC, user_id ASC`, id)
+	if err != nil {
+		return err
+	}
+	var places []int
+	var winners []int64
+	for rows.Next() {
+		var pl int
+		var uid int64
+		if err := rows.Scan(&pl, &uid); err != nil {
+			rows.Close()
+			return err
+		}
+		places = append(places, pl)
+		winners = append(winners, uid)
+	}
+	rows.Close()
+	if len(winners) == 0 {
+		return nil
+	}
+
+	prizemap := map[int64][]dg.WinnerPrize{}
+	prows, err := tx.QueryContext(ctx, `SELECT user_id, prize_title, prize_description, quantity, package_label FROM giveaway_winner_prizes WHERE giveaway_id=$1 ORDER BY id ASC`, id)
+	if err != nil {
+		return err
+	}
+	for prows.Next() {
+		var uid int64
+		var t, d, pkg string
+		var qty int
+		if err := prows.Scan(&uid, &t, &d, &qty, &pkg); err != nil {
+			prows.Close()
+			return err
+		}
+		plain, rich := loadDescription(d)
+		prizemap[uid] = append(prizemap[uid], dg.WinnerPrize{Title: t, Description: plain, DescriptionHTML: rich, Quantity: qty, Package: pkg})
+	}
+	prows.Close()
+
+	if err := enqueueEvent(ctx, tx, dg.EventWinnersSelected, id, dg.WinnersSelectedPayload{GiveawayID: id, DrawID: drawID, Manual: manual, Winners: winners}); err != nil {
+		return err
+	}
+	for i, uid := range winners {
+		prizes := dg.MergeWinnerPrizes(prizemap[uid])
+		if len(prizes) == 0 {
+			continue
+		}
+		p := dg.PrizeDistributedPayload{GiveawayID: id, UserID: uid, Place: places[i], Prizes: prizes, TotalUnits: dg.TotalPrizeQuantity(prizes)}
+		if err := enqueueEvent(ctx, tx, dg.EventPrizeDistributed, id, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishPending hands unpublished events to publish in outbox order and marks delivered ones.
+// Rows are locked for the duration of the batch so several instances can run side by side.
+// Delivery stops at the first failure to keep per-aggregate ordering intact.
+func (r *OutboxRepository) PublishPending(ctx context.Context, limit int, publish func(context.Context, dg.Event) error) (int, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	const q = `
+        SELECT id, event_id, type, version, aggregate_id, payload, occurred_at
+        FROM event_outbox
+        WHERE published_at IS NULL
+        ORDER BY id
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED`
+	rows, err := tx.QueryContext(ctx, q, limit)
+	if err != nil {
+		return 0, err
+	}
+	type pending struct {
+		rowID int64
+		ev    dg.Event
+	}
+	var batch []pending
+	for rows.Next() {
+		var p pending
+		var payload []byte
+		if err = rows.Scan(&p.rowID, &p.ev.ID, &p.ev.Type, &p.ev.Version, &p.ev.AggregateID, &payload, &p.ev.OccurredAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		p.ev.Payload = json.RawMessage(payload)
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, p := range batch {
+		if pubErr := publish(ctx, p.ev); pubErr != nil {
+			if _, err = tx.ExecContext(ctx, `UPDATE event_outbox SET attempts=attempts+1, last_error=$2 WHERE id=$1`, p.rowID, pubErr.Error()); err != nil {
+				return 0, err
+			}
+			break
+		}
+		if _, err = tx.ExecContext(ctx, `UPDATE event_outbox SET published_at=now(), attempts=attempts+1, last_error='' WHERE id=$1`, p.rowID); err != nil {
+			return 0, err
+		}
+		published++
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return published, nil
+}
+
+// DeletePublishedBefore removes delivered events older than the retention cut-off.
+func (r *OutboxRepository) DeletePublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM event_outbox WHERE published_at IS NOT NULL AND published_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// PlatformStats computes the public platform counters; since bounds the weekly window.
+func (r *GiveawayRepository) PlatformStats(ctx context.Context, since time.Time) (dg.PlatformStats, error) {
+	const q = `
+        SELECT
+            (SELECT COUNT(*) FROM giveaways WHERE status NOT IN ('cancelled','draft')),
+            (SELECT COUNT(*) FROM giveaways WHERE status = 'active'),
+            (SELECT COUNT(DISTINCT user_id) FROM giveaway_participants WHERE joined_at >= $1),
+            (SELECT COALESCE(SUM(quantity),0) FROM giveaway_winner_prizes)`
+	var s dg.PlatformStats
+	err := r.db.QueryRowContext(ctx, q, since).Scan(&s.TotalGiveaways, &s.ActiveNow, &s.ParticipantsThisWeek, &s.PrizesDistributed)
+	s.ComputedAt = time.Now().UTC()
+	return s, err
+}
+
+// PlatformBreakdown computes the detailed counters shown to platform admins.
+func (r *GiveawayRepository) PlatformBreakdown(ctx context.Context, since time.Time) (*dg.PlatformBreakdown, error) {
+	base, err := r.PlatformStats(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	b := &dg.PlatformBreakdown{
+		PlatformStats:     base,
+		ByStatus:          map[dg.GiveawayStatus]int64{},
+		ByStrategy:        map[dg.WinnerStrategy]int64{},
+		JoinsBySourceWeek: map[dg.ParticipantSource]int64{},
+	}
+	if err := r.countBy(ctx, `SELECT status::text, COUNT(*) FROM giveaways GROUP BY status`, nil, func(k string, n int64) {
+		b.ByStatus[dg.GiveawayStatus(k)] = n
+	}); err != nil {
+		return nil, err
+	}
+	if err := r.countBy(ctx, `SELECT winner_strategy, COUNT(*) FROM giveaways GROUP BY winner_strategy`, nil, func(k string, n int64) {
+		b.ByStrategy[dg.WinnerStrategy(k)] = n
+	}); err != nil {
+		return nil, err
+	}
+	if err := r.countBy(ctx, `SELECT source, COUNT(*) FROM giveaway_participants WHERE joined_at >= $1 GROUP BY source`, []any{since}, func(k string, n int64) {
+		b.JoinsBySourceWeek[dg.ParticipantSource(k)] = n
+		b.JoinsThisWeek += n
+	}); err != nil {
+		return nil, err
+	}
+	const q = `
+        SELECT
+            (SELECT COUNT(*) FROM giveaways WHERE created_at >= $1),
+            (SELECT COUNT(DISTINCT creator_id) FROM giveaways),
+            (SELECT COUNT(*) FROM giveaway_winners)`
+	if err := r.db.QueryRowContext(ctx, q, since).Scan(&b.NewGiveawaysThisWeek, &b.CreatorsTotal, &b.WinnersTotal); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *GiveawayRepository) countBy(ctx context.Context, q string, args []any, fn func(key string, n int64)) error {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var k string
+		var n int64
+		if err := rows.Scan(&k, &n); err != nil {
+			return err
+		}
+		fn(k, n)
+	}
+	return rows.Err()
+}
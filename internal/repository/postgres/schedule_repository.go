@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ScheduleRepository persists giveaway content plans.
+type ScheduleRepository struct {
+	db *sql.DB
+}
+
+func NewScheduleRepository(db *sql.DB) *ScheduleRepository { return &ScheduleRepository{db: db} }
+
+const scheduleItemColumns = `id, giveaway_id, kind, scheduled_at, text, status, attempts, last_error, sent_at, created_at`
+
+func scanScheduleItem(sc interface{ Scan(dest ...any) error }) (dg.ScheduleItem, error) {
+	var it dg.ScheduleItem
+	var sentAt sql.NullTime
+	if err := sc.Scan(&it.ID, &it.GiveawayID, &it.Kind, &it.ScheduledAt, &it.Text, &it.Status, &it.Attempts, &it.LastError, &sentAt, &it.CreatedAt); err != nil {
+		return it, err
+	}
+	if sentAt.Valid {
+		t := sentAt.Time
+		it.SentAt = &t
+	}
+	return it, nil
+}
+
+// ListByGiveaway returns all plan items of a giveaway ordered by schedule time.
+func (r *ScheduleRepository) ListByGiveaway(ctx context.Context, giveawayID string) ([]dg.ScheduleItem, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+scheduleItemColumns+` FROM giveaway_schedule_items WHERE giveaway_id=$1 ORDER BY scheduled_at, id`, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ScheduleItem
+	for rows.Next() {
+		it, err := scanScheduleItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	return out, rows.Err()
+}
+
+// ReplacePending drops not yet delivered items and inserts the new plan in a single transaction.
+// Items that were already sent or failed are kept as history.
+func (r *ScheduleRepository) ReplacePending(ctx context.Context, giveawayID string, items []dg.ScheduleItem) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if _, err = tx.ExecContext(ctx, `DELETE FROM giveaway_schedule_items WHERE giveaway_id=$1 AND status IN ('pending','cancelled')`, giveawayID); err != nil {
+		return err
+	}
+	const q = `INSERT INTO giveaway_schedule_items (giveaway_id, kind, scheduled_at, text, status) VALUES ($1,$2,$3,$4,'pending')`
+	for _, it := range items {
+		if _, err = tx.ExecContext(ctx, q, giveawayID, string(it.Kind), it.ScheduledAt, it.Text); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Cancel marks a pending item as cancelled. Returns false when no pending item matched.
+func (r *ScheduleRepository) Cancel(ctx context.Context, giveawayID string, itemID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE giveaway_schedule_items SET status='cancelled' WHERE id=$1 AND giveaway_id=$2 AND status='pending'`, itemID, giveawayID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// CancelStale cancels pending items, and processing items whose claim is older than lease,
+// that can no longer be delivered: lifecycle posts of giveaways that are no longer active and
+// any item of a cancelled giveaway.
+func (r *ScheduleRepository) CancelStale(ctx context.Context, lease time.Duration) (int64, error) {
+	const q = `
+        UPDATE giveaway_schedule_items i SET status='cancelled'
+        FROM giveaways g
+        WHERE g.id = i.giveaway_id
+          AND (i.status='pending' OR (i.status='processing' AND i.claimed_at < now() - make_interval(secs => $1)))
+          AND (g.status = 'cancelled' OR (i.kind <> 'results' AND g.status IN ('finished','completed','pending')))`
+	res, err := r.db.ExecContext(ctx, q, lease.Seconds())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// ClaimDue moves due items to processing and returns them. Results posts become due
+// only once the giveaway is completed; other posts only while it is active. Items left
+// processing for longer than lease, because their worker stopped, are claimed again until
+// maxAttempts is used up and fail after that.
+func (r *ScheduleRepository) ClaimDue(ctx context.Context, now time.Time, limit, maxAttempts int, lease time.Duration) ([]dg.ScheduleItem, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if _, err := r.db.ExecContext(ctx, `
+        UPDATE giveaway_schedule_items SET status='failed', last_error='delivery did not finish'
+        WHERE status='processing' AND claimed_at < $1::timestamptz - make_interval(secs => $2) AND attempts >= $3`,
+		now, lease.Seconds(), maxAttempts); err != nil {
+		return nil, err
+	}
+	const q = `
+        UPDATE giveaway_schedule_items SET status='processing', attempts=attempts+1, claimed_at=$1
+        WHERE id IN (
+            SELECT i.id FROM giveaway_schedule_items i
+            JOIN giveaways g ON g.id = i.giveaway_id
+            WHERE (i.status='pending' OR (i.status='processing' AND i.claimed_at < $1::timestamptz - make_interval(secs => $3)))
+              AND i.scheduled_at <= $1
+              AND ((i.kind = 'results' AND g.status = 'completed') OR (i.kind <> 'results' AND g.status = 'active'))
+            ORDER BY i.scheduled_at
+            LIMIT $2
+            FOR UPDATE OF i SKIP LOCKED
+        )
+        RETURNING ` + scheduleItemColumns
+	rows, err := r.db.QueryContext(ctx, q, now, limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ScheduleItem
+	for rows.Next() {
+		it, err := scanScheduleItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, it)
+	}
+	return out, rows.Err()
+}
+
+// MarkSent records a successful delivery.
+func (r *ScheduleRepository) MarkSent(ctx context.Context, itemID int64) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_schedule_items SET status='sent', sent_at=now(), last_error='' WHERE id=$1`, itemID)
+	return err
+}
+
+// MarkFailed records a delivery error. The item goes back to pending until maxAttempts is reached.
+func (r *ScheduleRepository) MarkFailed(ctx context.Context, itemID int64, errText string, maxAttempts int) error {
+	const q = `
+        UPDATE giveaway_schedule_items
+        SET status = CASE WHEN attempts >= $3 THEN 'failed' ELSE 'pending' END, last_error=$2
+        WHERE id=$1`
+	_, err := r.db.ExecContext(ctx, q, itemID, errText, maxAttempts)
+	return err
+}
+
+// RetryFailed puts failed items of a giveaway back into the delivery queue with a fresh attempt budget.
+func (r *ScheduleRepository) RetryFailed(ctx context.Context, giveawayID string) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE giveaway_schedule_items SET status='pending', attempts=0 WHERE giveaway_id=$1 AND status='failed'`, giveawayID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
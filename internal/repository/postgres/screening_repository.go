@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+)
+
+// ScreeningRepository stores the wallet deny-list used by payout screening.
+type ScreeningRepository struct {
+	db *sql.DB
+}
+
+func NewScreeningRepository(db *sql.DB) *ScreeningRepository { return &ScreeningRepository{db: db} }
+
+// IsDenied reports whether the normalized address is deny-listed and why.
+func (r *ScreeningRepository) IsDenied(ctx context.Context, address string) (bool, string, error) {
+	var reason string
+	err := r.db.QueryRowContext(ctx, `SELECT reason FROM screening_denylist WHERE address=$1`, address).Scan(&reason)
+	if err == sql.ErrNoRows {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	return true, reason, nil
+}
+
+// Deny adds or updates a deny-listed address.
+func (r *ScreeningRepository) Deny(ctx context.Context, address, reason string) error {
+	const q = `
+        INSERT INTO screening_denylist (address, reason) VALUES ($1, $2)
+        ON CONFLICT (address) DO UPDATE SET reason = EXCLUDED.reason`
+	_, err := r.db.ExecContext(ctx, q, address, reason)
+	return err
+}
+
+// Allow removes an address from the deny-list. Reports false when it was not listed.
+func (r *ScreeningRepository) Allow(ctx context.Context, address string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM screening_denylist WHERE address=$1`, address)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
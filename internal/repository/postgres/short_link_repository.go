@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ShortLinkRepository persists short links and their click log.
+type ShortLinkRepository struct {
+	db *sql.DB
+}
+
+func NewShortLinkRepository(db *sql.DB) *ShortLinkRepository { return &ShortLinkRepository{db: db} }
+
+// EnsureMany inserts links that do not exist yet. Codes are derived from the target,
+// so existing rows are left untouched.
+func (r *ShortLinkRepository) EnsureMany(ctx context.Context, links []dg.ShortLink) error {
+	if len(links) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(links))
+	args := make([]any, 0, len(links)*5)
+	for i, l := range links {
+		n := i * 5
+		values = append(values, fmt.Sprintf("($%d,$%d,$%d,$%d,$%d)", n+1, n+2, n+3, n+4, n+5))
+		args = append(args, l.Code, l.GiveawayID, string(l.Kind), l.Position, l.URL)
+	}
+	q := `INSERT INTO short_links (code, giveaway_id, kind, position, url) VALUES ` + strings.Join(values, ",") + ` ON CONFLICT (code) DO NOTHING`
+	_, err := r.db.ExecContext(ctx, q, args...)
+	return err
+}
+
+// Get returns the link by code or nil when unknown.
+func (r *ShortLinkRepository) Get(ctx context.Context, code string) (*dg.ShortLink, error) {
+	var l dg.ShortLink
+	err := r.db.QueryRowContext(ctx, `SELECT code, giveaway_id, kind, position, url, created_at FROM short_links WHERE code=$1`, code).
+		Scan(&l.Code, &l.GiveawayID, &l.Kind, &l.Position, &l.URL, &l.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// RecordClick appends a click; userID is 0 for anonymous clicks.
+func (r *ShortLinkRepository) RecordClick(ctx context.Context, code string, userID int64) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO short_link_clicks (code, user_id) VALUES ($1,$2)`, code, userID)
+	return err
+}
+
+// Stats returns click totals for every short link of a giveaway, including links without clicks.
+func (r *ShortLinkRepository) Stats(ctx context.Context, giveawayID string) ([]dg.ShortLinkStats, error) {
+	const q = `
+        SELECT l.code, l.kind, l.position, l.url,
+               COUNT(c.id),
+               COUNT(DISTINCT NULLIF(c.user_id, 0)),
+               MAX(c.clicked_at)
+        FROM short_links l
+        LEFT JOIN short_link_clicks c ON c.code = l.code
+        WHERE l.giveaway_id=$1
+        GROUP BY l.code, l.kind, l.position, l.url
+        ORDER BY l.kind, l.position, l.code`
+	rows, err := r.db.QueryContext(ctx, q, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []dg.ShortLinkStats
+	for rows.Next() {
+		var s dg.ShortLinkStats
+		var last sql.NullTime
+		if err := rows.Scan(&s.Code, &s.Kind, &s.Position, &s.URL, &s.Clicks, &s.UniqueUsers, &last); err != nil {
+			return nil, err
+		}
+		if last.Valid {
+			t := last.Time
+			s.LastClickAt = &t
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
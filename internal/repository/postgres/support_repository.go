@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SupportRepository persists support tickets and their message threads.
+type SupportRepository struct {
+	db *sql.DB
+}
+
+func NewSupportRepository(db *sql.DB) *SupportRepository { return &SupportRepository{db: db} }
+
+const supportTicketColumns = `t.id, t.giveaway_id, g.title, g.creator_id, t.user_id, t.category, t.subject, t.status,
+               t.escalated_at, t.resolved_at, t.created_at, t.updated_at`
+
+func scanSupportTicket(row interface{ Scan(...any) error }) (dg.SupportTicket, error) {
+	var t dg.SupportTicket
+	var escalatedAt, resolvedAt sql.NullTime
+	err := row.Scan(&t.ID, &t.GiveawayID, &t.GiveawayTitle, &t.CreatorID, &t.UserID, &t.Category, &t.Subject, &t.Status,
+		&escalatedAt, &resolvedAt, &t.CreatedAt, &t.UpdatedAt)
+	if escalatedAt.Valid {
+		at := escalatedAt.Time
+		t.EscalatedAt = &at
+	}
+	if resolvedAt.Valid {
+		at := resolvedAt.Time
+		t.ResolvedAt = &at
+	}
+	return t, err
+}
+
+func (r *SupportRepository) listTickets(ctx context.Context, q string, args ...any) ([]dg.SupportTicket, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.SupportTicket, 0)
+	for rows.Next() {
+		t, err := scanSupportTicket(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+// isUniqueViolation reports whether err is a unique constraint violation on index.
+func isUniqueViolation(err error, index string) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == index
+}
+
+// Open creates a ticket with its first message and sets t.ID. Returns "ticket already open"
+// when the participant has an unresolved ticket for the giveaway.
+func (r *SupportRepository) Open(ctx context.Context, t *dg.SupportTicket, body string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	err = tx.QueryRowContext(ctx, `
+        INSERT INTO support_tickets (giveaway_id, user_id, category, subject)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, status, created_at, updated_at`, t.GiveawayID, t.UserID, string(t.Category), t.Subject).
+		Scan(&t.ID, &t.Status, &t.CreatedAt, &t.UpdatedAt)
+	if isUniqueViolation(err, "support_tickets_unresolved_uidx") {
+		err = errors.New("ticket already open")
+	}
+	if err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, `INSERT INTO support_ticket_messages (ticket_id, author_id, author_role, body) VALUES ($1, $2, $3, $4)`,
+		t.ID, t.UserID, string(dg.SupportRoleParticipant), body); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetByID loads a ticket with its messages, or nil when it does not exist.
+func (r *SupportRepository) GetByID(ctx context.Context, id int64) (*dg.SupportTicket, error) {
+	t, err := scanSupportTicket(r.db.QueryRowContext(ctx, `
+        SELECT `+supportTicketColumns+`
+        FROM support_tickets t JOIN giveaways g ON g.id = t.giveaway_id
+        WHERE t.id=$1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(ctx, `SELECT id, author_id, author_role, body, created_at FROM support_ticket_messages WHERE ticket_id=$1 ORDER BY id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	t.Messages = make([]dg.SupportMessage, 0)
+	for rows.Next() {
+		var m dg.SupportMessage
+		if err := rows.Scan(&m.ID, &m.AuthorID, &m.Role, &m.Body, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		t.Messages = append(t.Messages, m)
+	}
+	return &t, rows.Err()
+}
+
+// AddMessage appends a message and moves the ticket to status; a resolved ticket is reopened.
+// Returns "ticket already open" when reopening would give the participant a second
+// unresolved ticket for the giveaway.
+func (r *SupportRepository) AddMessage(ctx context.Context, ticketID, authorID int64, role dg.SupportRole, body string, status dg.SupportStatus) (*dg.SupportMessage, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	_, err = tx.ExecContext(ctx, `UPDATE support_tickets SET status=$2, resolved_at=NULL, updated_at=now() WHERE id=$1`, ticketID, string(status))
+	if isUniqueViolation(err, "support_tickets_unresolved_uidx") {
+		err = errors.New("ticket already open")
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := dg.SupportMessage{AuthorID: authorID, Role: role, Body: body}
+	if err = tx.QueryRowContext(ctx, `
+        INSERT INTO support_ticket_messages (ticket_id, author_id, author_role, body)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at`, ticketID, authorID, string(role), body).Scan(&m.ID, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &m, tx.Commit()
+}
+
+// Escalate hands an unresolved ticket over to platform admins. Returns false when the ticket
+// is resolved or already escalated.
+func (r *SupportRepository) Escalate(ctx context.Context, id, adminID int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE support_tickets SET status='escalated', escalated_at=now(), escalated_by=$2, updated_at=now()
+        WHERE id=$1 AND status IN ('open','answered')`, id, adminID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Resolve closes an unresolved ticket. Returns false when it was already resolved.
+func (r *SupportRepository) Resolve(ctx context.Context, id int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+        UPDATE support_tickets SET status='resolved', resolved_at=now(), updated_at=now()
+        WHERE id=$1 AND status <> 'resolved'`, id)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// ListByUser returns the tickets a participant opened, most recently updated first.
+func (r *SupportRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]dg.SupportTicket, error) {
+	return r.listTickets(ctx, `
+        SELECT `+supportTicketColumns+`
+        FROM support_tickets t JOIN giveaways g ON g.id = t.giveaway_id
+        WHERE t.user_id=$1
+        ORDER BY t.updated_at DESC, t.id DESC LIMIT $2 OFFSET $3`, userID, limit, offset)
+}
+
+// ListByGiveaway returns the tickets of a giveaway, optionally of one status, oldest update first
+// so the longest waiting come first.
+func (r *SupportRepository) ListByGiveaway(ctx context.Context, id string, status dg.SupportStatus, limit, offset int) ([]dg.SupportTicket, error) {
+	return r.listTickets(ctx, `
+        SELECT `+supportTicketColumns+`
+        FROM support_tickets t JOIN giveaways g ON g.id = t.giveaway_id
+        WHERE t.giveaway_id=$1 AND ($2 = '' OR t.status = $2)
+        ORDER BY t.updated_at ASC, t.id ASC LIMIT $3 OFFSET $4`, id, string(status), limit, offset)
+}
+
+// ListUnresolved returns unresolved tickets of every giveaway, optionally of one status,
+// oldest update first.
+func (r *SupportRepository) ListUnresolved(ctx context.Context, status dg.SupportStatus, limit, offset int) ([]dg.SupportTicket, error) {
+	return r.listTickets(ctx, `
+        SELECT `+supportTicketColumns+`
+        FROM support_tickets t JOIN giveaways g ON g.id = t.giveaway_id
+        WHERE t.status <> 'resolved' AND ($1 = '' OR t.status = $1)
+        ORDER BY t.updated_at ASC, t.id ASC LIMIT $2 OFFSET $3`, string(status), limit, offset)
+}
+
+// CountersByCreator counts unresolved tickets per giveaway of a creator, giveaways with the
+// most waiting tickets first. Giveaways without unresolved tickets are left out.
+func (r *SupportRepository) CountersByCreator(ctx context.Context, creatorID int64) ([]dg.SupportCounter, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT g.id, g.title, COUNT(*), COUNT(*) FILTER (WHERE t.status = 'open')
+        FROM support_tickets t JOIN giveaways g ON g.id = t.giveaway_id
+        WHERE g.creator_id=$1 AND t.status <> 'resolved'
+        GROUP BY g.id, g.title
+        ORDER BY COUNT(*) FILTER (WHERE t.status = 'open') DESC, COUNT(*) DESC, g.id`, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.SupportCounter, 0)
+	for rows.Next() {
+		var c dg.SupportCounter
+		if err := rows.Scan(&c.GiveawayID, &c.GiveawayTitle, &c.Unresolved, &c.AwaitingReply); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
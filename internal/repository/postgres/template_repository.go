@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// TemplateRepository persists creators' giveaway templates.
+type TemplateRepository struct {
+	db *sql.DB
+}
+
+func NewTemplateRepository(db *sql.DB) *TemplateRepository { return &TemplateRepository{db: db} }
+
+const templateColumns = `id, creator_id, name, config, created_at, updated_at`
+
+func scanTemplate(row interface{ Scan(...any) error }) (*dg.Template, error) {
+	var t dg.Template
+	var config []byte
+	if err := row.Scan(&t.ID, &t.CreatorID, &t.Name, &config, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	t.Config = config
+	t.CreatedAt = t.CreatedAt.UTC()
+	t.UpdatedAt = t.UpdatedAt.UTC()
+	return &t, nil
+}
+
+// Create inserts t. Returns "name taken" when the creator has a template of that name.
+func (r *TemplateRepository) Create(ctx context.Context, t *dg.Template) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO giveaway_templates (id, creator_id, name, config, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6)`,
+		t.ID, t.CreatorID, t.Name, []byte(t.Config), t.CreatedAt, t.UpdatedAt)
+	if isUniqueViolation(err, "giveaway_templates_name_uidx") {
+		return errors.New("name taken")
+	}
+	return err
+}
+
+// GetByID returns a template or nil when it does not exist.
+func (r *TemplateRepository) GetByID(ctx context.Context, id string) (*dg.Template, error) {
+	t, err := scanTemplate(r.db.QueryRowContext(ctx, `SELECT `+templateColumns+` FROM giveaway_templates WHERE id=$1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// ListByCreator returns the creator's templates by name.
+func (r *TemplateRepository) ListByCreator(ctx context.Context, creatorID int64) ([]dg.Template, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+templateColumns+` FROM giveaway_templates WHERE creator_id=$1 ORDER BY name, id`, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	out := make([]dg.Template, 0)
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *t)
+	}
+	return out, rows.Err()
+}
+
+// CountByCreator returns how many templates the creator has.
+func (r *TemplateRepository) CountByCreator(ctx context.Context, creatorID int64) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM giveaway_templates WHERE creator_id=$1`, creatorID).Scan(&n)
+	return n, err
+}
+
+// Update replaces the name and config of t. Returns "name taken" like Create.
+func (r *TemplateRepository) Update(ctx context.Context, t *dg.Template) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE giveaway_templates SET name=$2, config=$3, updated_at=$4 WHERE id=$1`,
+		t.ID, t.Name, []byte(t.Config), t.UpdatedAt)
+	if isUniqueViolation(err, "giveaway_templates_name_uidx") {
+		return errors.New("name taken")
+	}
+	return err
+}
+
+// Delete removes a template.
+func (r *TemplateRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM giveaway_templates WHERE id=$1`, id)
+	return err
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	domain "github.com/open-builders/giveaway-backend/internal/domain/user"
+)
+
+// GetPublicProfile loads the profile card of a user with win and created giveaway counts.
+// Privacy settings are returned alongside and applied by the caller. Returns nil if not found.
+func (r *UserRepository) GetPublicProfile(ctx context.Context, id int64) (*domain.PublicProfile, *domain.Privacy, error) {
+	const q = `
+        SELECT u.id, COALESCE(u.username, ''), COALESCE(u.avatar_url, ''), u.is_verified, u.hide_profile, u.hide_wins,
+               (SELECT COUNT(DISTINCT w.giveaway_id) FROM giveaway_winners w
+                  JOIN giveaways g ON g.id = w.giveaway_id
+                 WHERE w.user_id = u.id AND g.status IN ('completed','finished')),
+               (SELECT COUNT(*) FROM giveaways g WHERE g.creator_id = u.id AND g.status NOT IN ('cancelled','draft'))
+        FROM users u WHERE u.id=$1`
+	var p domain.PublicProfile
+	var pr domain.Privacy
+	var wins, created int
+	err := r.db.QueryRowContext(ctx, q, id).Scan(&p.ID, &p.Username, &p.AvatarURL, &p.Verified, &pr.HideProfile, &pr.HideWins, &wins, &created)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	p.WinsCount = &wins
+	p.GiveawaysCreated = &created
+	return &p, &pr, nil
+}
+
+// SetPrivacy stores the profile privacy settings of a user. Returns false if the user does not exist.
+func (r *UserRepository) SetPrivacy(ctx context.Context, id int64, p domain.Privacy) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET hide_profile=$2, hide_wins=$3, updated_at=now() WHERE id=$1`, id, p.HideProfile, p.HideWins)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// SetPreferredLanguage stores the language picked in the app settings; an empty tag clears it.
+// Returns false if the user does not exist.
+func (r *UserRepository) SetPreferredLanguage(ctx context.Context, id int64, tag string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET preferred_language=NULLIF($2, ''), updated_at=now() WHERE id=$1`, id, tag)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// GetNotificationPrefs returns the optional bot messages a user receives; nil if the user does not exist.
+func (r *UserRepository) GetNotificationPrefs(ctx context.Context, id int64) (*domain.NotificationPrefs, error) {
+	var p domain.NotificationPrefs
+	err := r.db.QueryRowContext(ctx, `SELECT notify_join_confirmations FROM users WHERE id=$1`, id).Scan(&p.JoinConfirmations)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// SetNotificationPrefs stores the optional bot messages a user receives. Returns false if the user does not exist.
+func (r *UserRepository) SetNotificationPrefs(ctx context.Context, id int64, p domain.NotificationPrefs) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET notify_join_confirmations=$2, updated_at=now() WHERE id=$1`, id, p.JoinConfirmations)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// SetVerified grants or revokes the verification badge. Returns false if the user does not exist.
+func (r *UserRepository) SetVerified(ctx context.Context, id int64, verified bool) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE users SET is_verified=$2, updated_at=now() WHERE id=$1`, id, verified)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
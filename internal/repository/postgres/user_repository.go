@@ -19,8 +19,8 @@ func NewUserRepository(db *sql.DB) *UserRepository { return &UserRepository{db:
 // Upsert inserts or updates a user by ID. Username uniqueness is case-insensitive when present.
 func (r *UserRepository) Upsert(ctx context.Context, u *domain.User) error {
 	const q = `
-	INSERT INTO users (id, username, first_name, last_name, role, status, avatar_url, is_premium, wallet_address, created_at, updated_at)
-	VALUES ($1, lower(NULLIF($2, '')), $3, $4, $5, $6, NULLIF($7, ''), $8, lower(NULLIF($9, '')), COALESCE($10, now()), COALESCE($11, now()))
+	INSERT INTO users (id, username, first_name, last_name, role, status, avatar_url, is_premium, wallet_address, created_at, updated_at, language_code)
+	VALUES ($1, lower(NULLIF($2, '')), $3, $4, $5, $6, NULLIF($7, ''), $8, lower(NULLIF($9, '')), COALESCE($10, now()), COALESCE($11, now()), NULLIF($12, ''))
 	ON CONFLICT (id) DO UPDATE SET
 		username = EXCLUDED.username,
 		first_name = EXCLUDED.first_name,
@@ -30,6 +30,7 @@ func (r *UserRepository) Upsert(ctx context.Context, u *domain.User) error {
 		avatar_url = COALESCE(EXCLUDED.avatar_url, users.avatar_url),
 		is_premium = EXCLUDED.is_premium,
 		wallet_address = COALESCE(EXCLUDED.wallet_address, users.wallet_address),
+		language_code = COALESCE(EXCLUDED.language_code, users.language_code),
 		updated_at = now();
 `
 	_, err := r.db.ExecContext(ctx, q,
@@ -44,39 +45,44 @@ func (r *UserRepository) Upsert(ctx context.Context, u *domain.User) error {
 		u.WalletAddress,
 		u.CreatedAt,
 		u.UpdatedAt,
+		u.LanguageCode,
 	)
 	return err
 }
 
 // GetByID returns a user by Telegram ID.
 func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
-	const q = `SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), created_at, updated_at FROM users WHERE id=$1`
+	const q = `SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), COALESCE(language_code, ''), COALESCE(preferred_language, ''), created_at, updated_at, reputation_score FROM users WHERE id=$1`
 	row := r.db.QueryRowContext(ctx, q, id)
 	var u domain.User
-	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.CreatedAt, &u.UpdatedAt); err != nil {
+	var reputation sql.NullInt64
+	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.LanguageCode, &u.PreferredLanguage, &u.CreatedAt, &u.UpdatedAt, &reputation); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	u.ReputationScore = nullIntPtr(reputation)
 	return &u, nil
 }
 
 // GetByUsername returns a user by username (case-insensitive). Returns nil if not found.
 func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	const q = `
-SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), created_at, updated_at
+SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), COALESCE(language_code, ''), COALESCE(preferred_language, ''), created_at, updated_at, reputation_score
 FROM users
 WHERE lower(username) = lower($1)
 `
 	row := r.db.QueryRowContext(ctx, q, username)
 	var u domain.User
-	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.CreatedAt, &u.UpdatedAt); err != nil {
+	var reputation sql.NullInt64
+	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.LanguageCode, &u.PreferredLanguage, &u.CreatedAt, &u.UpdatedAt, &reputation); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	u.ReputationScore = nullIntPtr(reputation)
 	return &u, nil
 }
 
@@ -84,18 +90,20 @@ WHERE lower(username) = lower($1)
 // GetByWalletAddress returns a user by wallet address (case-insensitive). Returns nil if not found.
 func (r *UserRepository) GetByWalletAddress(ctx context.Context, wallet string) (*domain.User, error) {
 	const q = `
-SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), created_at, updated_at
+SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), COALESCE(language_code, ''), COALESCE(preferred_language, ''), created_at, updated_at, reputation_score
 FROM users
 WHERE lower(wallet_address) = lower($1)
 `
 	row := r.db.QueryRowContext(ctx, q, wallet)
 	var u domain.User
-	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.CreatedAt, &u.UpdatedAt); err != nil {
+	var reputation sql.NullInt64
+	if err := row.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.LanguageCode, &u.PreferredLanguage, &u.CreatedAt, &u.UpdatedAt, &reputation); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
 		return nil, err
 	}
+	u.ReputationScore = nullIntPtr(reputation)
 	return &u, nil
 }
 
@@ -109,7 +117,7 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]domain.
 		offset = 0
 	}
 	const q = `
-SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), created_at, updated_at
+SELECT id, COALESCE(username, ''), first_name, last_name, COALESCE(avatar_url, ''), is_premium, role, status, COALESCE(wallet_address, ''), COALESCE(language_code, ''), COALESCE(preferred_language, ''), created_at, updated_at, reputation_score
 FROM users
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2`
@@ -122,9 +130,11 @@ LIMIT $1 OFFSET $2`
 	var users []domain.User
 	for rows.Next() {
 		var u domain.User
-		if err := rows.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		var reputation sql.NullInt64
+		if err := rows.Scan(&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.AvatarURL, &u.IsPremium, &u.Role, &u.Status, &u.WalletAddress, &u.LanguageCode, &u.PreferredLanguage, &u.CreatedAt, &u.UpdatedAt, &reputation); err != nil {
 			return nil, err
 		}
+		u.ReputationScore = nullIntPtr(reputation)
 		users = append(users, u)
 	}
 	return users, rows.Err()
@@ -147,3 +157,12 @@ func (r *UserRepository) Touch(ctx context.Context, id int64) error {
 
 // Ensure compiles with a usage to time to avoid removal by formatters
 var _ = time.Now
+
+// nullIntPtr converts a nullable integer column to a pointer, nil when NULL.
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
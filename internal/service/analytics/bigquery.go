@@ -0,0 +1,204 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const bigQueryScope = "https://www.googleapis.com/auth/bigquery.insertdata"
+
+// BigQuerySink streams events with tabledata.insertAll. Event.ID is used as insertId
+// so BigQuery best-effort deduplicates retried batches.
+type BigQuerySink struct {
+	insertURL  string
+	httpClient *http.Client
+
+	email    string
+	key      *rsa.PrivateKey
+	tokenURL string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+type serviceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+func NewBigQuerySink(project, dataset, table, credentialsFile string) (*BigQuerySink, error) {
+	if project == "" || dataset == "" {
+		return nil, fmt.Errorf("bigquery project and dataset are required")
+	}
+	if table == "" {
+		table = "giveaway_events"
+	}
+	raw, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("read bigquery credentials: %w", err)
+	}
+	var sa serviceAccountKey
+	if err := json.Unmarshal(raw, &sa); err != nil {
+		return nil, fmt.Errorf("parse bigquery credentials: %w", err)
+	}
+	block, _ := pem.Decode([]byte(sa.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("bigquery credentials: invalid private key")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("bigquery credentials: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("bigquery credentials: private key is not RSA")
+	}
+	if sa.TokenURI == "" {
+		sa.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+	return &BigQuerySink{
+		insertURL: fmt.Sprintf("https://bigquery.googleapis.com/bigquery/v2/projects/%s/datasets/%s/tables/%s/insertAll",
+			url.PathEscape(project), url.PathEscape(dataset), url.PathEscape(table)),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		email:      sa.ClientEmail,
+		key:        key,
+		tokenURL:   sa.TokenURI,
+	}, nil
+}
+
+func (s *BigQuerySink) Name() string { return "bigquery" }
+
+func (s *BigQuerySink) Write(ctx context.Context, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	type row struct {
+		InsertID string `json:"insertId"`
+		JSON     Event  `json:"json"`
+	}
+	payload := struct {
+		Rows []row `json:"rows"`
+	}{Rows: make([]row, 0, len(events))}
+	for _, e := range events {
+		payload.Rows = append(payload.Rows, row{InsertID: e.ID, JSON: e})
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.insertURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bigquery insertAll: status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+	var out struct {
+		InsertErrors []struct {
+			Index  int `json:"index"`
+			Errors []struct {
+				Reason  string `json:"reason"`
+				Message string `json:"message"`
+			} `json:"errors"`
+		} `json:"insertErrors"`
+	}
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return err
+	}
+	if len(out.InsertErrors) > 0 {
+		first := out.InsertErrors[0]
+		msg := "unknown"
+		if len(first.Errors) > 0 {
+			msg = first.Errors[0].Reason + ": " + first.Errors[0].Message
+		}
+		return fmt.Errorf("bigquery insertAll: %d rows rejected (row %d: %s)", len(out.InsertErrors), first.Index, msg)
+	}
+	return nil
+}
+
+// accessToken exchanges a self-signed service account JWT for an OAuth token and caches it.
+func (s *BigQuerySink) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && time.Until(s.expiry) > time.Minute {
+		return s.token, nil
+	}
+	now := time.Now()
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]any{
+		"iss":   s.email,
+		"scope": bigQueryScope,
+		"aud":   s.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", unsigned+"."+base64.RawURLEncoding.EncodeToString(sig))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("bigquery token: status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return "", err
+	}
+	if tok.AccessToken == "" {
+		return "", fmt.Errorf("bigquery token: empty access token")
+	}
+	s.token = tok.AccessToken
+	s.expiry = now.Add(time.Duration(tok.ExpiresIn) * time.Second)
+	return s.token, nil
+}
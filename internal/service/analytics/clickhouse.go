@@ -0,0 +1,91 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ClickHouseSink inserts events through the ClickHouse HTTP interface using JSONEachRow.
+// Expected table (ReplacingMergeTree on event_id collapses retried batches):
+//
+//	CREATE TABLE giveaway_events (
+//	    event_id String, event_type LowCardinality(String), giveaway_id String,
+//	    user_id Int64, occurred_at DateTime64(3, 'UTC')
+//	) ENGINE = ReplacingMergeTree ORDER BY (giveaway_id, event_type, occurred_at, event_id)
+type ClickHouseSink struct {
+	endpoint   string
+	user       string
+	password   string
+	query      string
+	httpClient *http.Client
+}
+
+func NewClickHouseSink(rawURL, database, table string) (*ClickHouseSink, error) {
+	if rawURL == "" {
+		return nil, fmt.Errorf("empty clickhouse url")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clickhouse url: %w", err)
+	}
+	s := &ClickHouseSink{httpClient: &http.Client{Timeout: 30 * time.Second}}
+	if u.User != nil {
+		s.user = u.User.Username()
+		s.password, _ = u.User.Password()
+		u.User = nil
+	}
+	if table == "" {
+		table = "giveaway_events"
+	}
+	if database != "" {
+		table = database + "." + table
+	}
+	s.query = "INSERT INTO " + table + " FORMAT JSONEachRow"
+	q := u.Query()
+	q.Set("date_time_input_format", "best_effort")
+	u.RawQuery = q.Encode()
+	s.endpoint = u.String()
+	return s, nil
+}
+
+func (s *ClickHouseSink) Name() string { return "clickhouse" }
+
+func (s *ClickHouseSink) Write(ctx context.Context, events []Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	body.WriteString(s.query)
+	body.WriteByte('\n')
+	enc := json.NewEncoder(&body)
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	if s.user != "" {
+		req.Header.Set("X-ClickHouse-User", s.user)
+		req.Header.Set("X-ClickHouse-Key", s.password)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("clickhouse insert: status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	return nil
+}
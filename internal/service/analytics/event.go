@@ -0,0 +1,85 @@
+package analytics
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	rplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// EventType names a funnel event exported to the analytical store.
+type EventType string
+
+const (
+	EventGiveawayView EventType = "giveaway_view"
+	EventGiveawayJoin EventType = "giveaway_join"
+	EventLinkClick    EventType = "link_click" // requirement or sponsor short link opened
+)
+
+// Event is one row of the analytics table.
+type Event struct {
+	ID         string    `json:"event_id"`
+	Type       EventType `json:"event_type"`
+	GiveawayID string    `json:"giveaway_id"`
+	UserID     int64     `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Stream is the Redis stream buffering events until the exporter ships them.
+const Stream = "analytics:events"
+
+// streamMaxLen bounds the buffer when the sink is unavailable for a long time.
+const streamMaxLen = 1000000
+
+// Recorder appends events to the Redis buffer. A nil Recorder drops events,
+// so callers do not need to check whether analytics is enabled.
+type Recorder struct {
+	rdb *rplatform.Client
+}
+
+func NewRecorder(rdb *rplatform.Client) *Recorder { return &Recorder{rdb: rdb} }
+
+// Record buffers an event. Failures are logged and never surface to the request path.
+func (r *Recorder) Record(ctx context.Context, typ EventType, giveawayID string, userID int64) {
+	if r == nil || r.rdb == nil {
+		return
+	}
+	err := r.rdb.XAdd(ctx, &go_redis.XAddArgs{
+		Stream: Stream,
+		MaxLen: streamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"event_id":    uuid.NewString(),
+			"event_type":  string(typ),
+			"giveaway_id": giveawayID,
+			"user_id":     strconv.FormatInt(userID, 10),
+			"occurred_at": strconv.FormatInt(time.Now().UnixMilli(), 10),
+		},
+	}).Err()
+	if err != nil {
+		log.Printf("analytics record %s: %v", typ, err)
+	}
+}
+
+// DecodeStreamValues converts a buffered stream entry back into an Event.
+func DecodeStreamValues(values map[string]interface{}) (Event, bool) {
+	str := func(k string) string {
+		s, _ := values[k].(string)
+		return s
+	}
+	e := Event{ID: str("event_id"), Type: EventType(str("event_type")), GiveawayID: str("giveaway_id")}
+	if e.ID == "" || e.Type == "" || e.GiveawayID == "" {
+		return Event{}, false
+	}
+	e.UserID, _ = strconv.ParseInt(str("user_id"), 10, 64)
+	ms, err := strconv.ParseInt(str("occurred_at"), 10, 64)
+	if err != nil {
+		return Event{}, false
+	}
+	e.OccurredAt = time.UnixMilli(ms).UTC()
+	return e, true
+}
@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// Signer signs audit bundles with Ed25519 so third parties can verify them with the public key.
+type Signer struct {
+	key       ed25519.PrivateKey
+	keyID     string
+	pseudonym []byte
+}
+
+// Signature accompanies a signed payload.
+type Signature struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"key_id"`
+	PublicKey string `json:"public_key"`
+	Value     string `json:"value"`
+}
+
+// NewSigner builds a signer from a 32-byte seed encoded as hex or base64. The seed has to be
+// configured on its own: deriving it from another secret would change the signing key and
+// every pseudonym whenever that secret is rotated.
+func NewSigner(seed string) (*Signer, error) {
+	var raw []byte
+	seed = strings.TrimSpace(seed)
+	if seed == "" {
+		return nil, errors.New("audit signing key is not configured")
+	}
+	if b, err := hex.DecodeString(seed); err == nil {
+		raw = b
+	} else if b, err := base64.StdEncoding.DecodeString(seed); err == nil {
+		raw = b
+	} else {
+		return nil, errors.New("audit signing key must be hex or base64")
+	}
+	if len(raw) != ed25519.SeedSize {
+		return nil, errors.New("audit signing key must be 32 bytes")
+	}
+	key := ed25519.NewKeyFromSeed(raw)
+	pubSum := sha256.Sum256(key.Public().(ed25519.PublicKey))
+	pseudonym := sha256.Sum256(append([]byte("giveaway-audit-pseudonym:"), raw...))
+	return &Signer{key: key, keyID: hex.EncodeToString(pubSum[:8]), pseudonym: pseudonym[:]}, nil
+}
+
+// PublicKey returns the base64 encoded public key.
+func (s *Signer) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(s.key.Public().(ed25519.PublicKey))
+}
+
+// KeyID returns a short identifier of the public key.
+func (s *Signer) KeyID() string { return s.keyID }
+
+// PseudonymKey returns the secret that keys user pseudonyms in audit bundles. It is derived
+// from the signing seed, so pseudonyms stay stable across restarts and never leave the server.
+func (s *Signer) PseudonymKey() []byte { return s.pseudonym }
+
+// Sign signs payload bytes as-is.
+func (s *Signer) Sign(payload []byte) Signature {
+	return Signature{
+		Algorithm: "Ed25519",
+		KeyID:     s.keyID,
+		PublicKey: s.PublicKey(),
+		Value:     base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)),
+	}
+}
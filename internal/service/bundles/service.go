@@ -0,0 +1,168 @@
+package bundles
+
+import (
+	"context"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	chsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+)
+
+// Service publishes sponsored requirement bundles and attaches them to giveaways.
+type Service struct {
+	repo     *repo.BundleRepository
+	channels *chsvc.Service
+}
+
+func NewService(r *repo.BundleRepository, channels *chsvc.Service) *Service {
+	return &Service{repo: r, channels: channels}
+}
+
+// OwnedBundle is a bundle with its usage, as shown to its owner.
+type OwnedBundle struct {
+	dg.RequirementBundle
+	Stats dg.BundleStats `json:"stats"`
+}
+
+// Publish stores a bundle of channel requirements. Every channel must belong to the owner;
+// requirements are enriched now so giveaways attach them without looking channels up again.
+func (s *Service) Publish(ctx context.Context, ownerID int64, title, description string, reqs []dg.Requirement) (*dg.RequirementBundle, error) {
+	if len(reqs) == 0 {
+		return nil, errors.New("requirements are required")
+	}
+	env := requirements.Env{Channels: s.channels, CreatorID: ownerID}
+	out := make([]dg.Requirement, 0, len(reqs))
+	for _, r := range reqs {
+		if r.Type != dg.RequirementTypeSubscription && r.Type != dg.RequirementTypeBoost {
+			return nil, errors.New("unsupported requirement type")
+		}
+		if r.ChannelID == 0 {
+			return nil, errors.New("channel_id is required")
+		}
+		t, _ := requirements.Lookup(r.Type)
+		if err := t.Enrich(ctx, env, &r); err != nil {
+			return nil, err
+		}
+		r.Position = len(out)
+		out = append(out, r)
+	}
+	b := &dg.RequirementBundle{OwnerID: ownerID, Title: title, Description: description, Requirements: out}
+	if err := s.repo.Create(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Get returns a bundle.
+func (s *Service) Get(ctx context.Context, id int64) (*dg.RequirementBundle, error) {
+	b, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, errors.New("not found")
+	}
+	return b, nil
+}
+
+// Browse lists bundles creators can attach, optionally of one owner.
+func (s *Service) Browse(ctx context.Context, ownerID int64, limit, offset int) ([]dg.RequirementBundle, error) {
+	return s.repo.ListActive(ctx, ownerID, limit, offset)
+}
+
+// Mine lists the bundles of an owner with their usage.
+func (s *Service) Mine(ctx context.Context, ownerID int64, limit, offset int) ([]OwnedBundle, error) {
+	list, err := s.repo.ListByOwner(ctx, ownerID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, len(list))
+	for i, b := range list {
+		ids[i] = b.ID
+	}
+	stats, err := s.repo.Stats(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]OwnedBundle, len(list))
+	for i, b := range list {
+		out[i] = OwnedBundle{RequirementBundle: b, Stats: stats[b.ID]}
+	}
+	return out, nil
+}
+
+// Stats reports how many giveaways and participants used a bundle. Access: owner or platform admins.
+func (s *Service) Stats(ctx context.Context, id, viewerID int64, admin bool) (dg.BundleStats, error) {
+	b, err := s.Get(ctx, id)
+	if err != nil {
+		return dg.BundleStats{}, err
+	}
+	if b.OwnerID != viewerID && !admin {
+		return dg.BundleStats{}, errors.New("forbidden")
+	}
+	stats, err := s.repo.Stats(ctx, []int64{id})
+	if err != nil {
+		return dg.BundleStats{}, err
+	}
+	return stats[id], nil
+}
+
+// Deactivate stops new giveaways from attaching a bundle; giveaways that did keep its
+// requirements. Access: owner or platform admins.
+func (s *Service) Deactivate(ctx context.Context, id, viewerID int64, admin bool) error {
+	b, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if b.OwnerID != viewerID && !admin {
+		return errors.New("forbidden")
+	}
+	if _, err := s.repo.Deactivate(ctx, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Attach appends the requirements of the given active bundles to reqs, in bundle order.
+// Requirements for a channel and type already present are skipped.
+func (s *Service) Attach(ctx context.Context, reqs []dg.Requirement, ids []int64) ([]dg.Requirement, error) {
+	if len(ids) == 0 {
+		return reqs, nil
+	}
+	list, err := s.repo.GetActive(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]dg.RequirementBundle, len(list))
+	for _, b := range list {
+		byID[b.ID] = b
+	}
+	type key struct {
+		t  dg.RequirementType
+		id int64
+	}
+	seen := make(map[key]bool, len(reqs))
+	for _, r := range reqs {
+		if r.ChannelID != 0 {
+			seen[key{r.Type, r.ChannelID}] = true
+		}
+	}
+	for _, id := range ids {
+		b, ok := byID[id]
+		if !ok {
+			return nil, errors.New("bundle not found")
+		}
+		for _, r := range b.Requirements {
+			if seen[key{r.Type, r.ChannelID}] {
+				continue
+			}
+			seen[key{r.Type, r.ChannelID}] = true
+			r.BundleID = b.ID
+			r.Position = len(reqs)
+			reqs = append(reqs, r)
+		}
+	}
+	return reqs, nil
+}
@@ -0,0 +1,176 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	maxNameLength           = 64
+	maxDescriptionLength    = 1000
+	maxGiveawaysPerCampaign = 50
+)
+
+// Service manages campaigns, which group a creator's giveaways for combined analytics.
+type Service struct {
+	repo      *repo.CampaignRepository
+	giveaways *repo.GiveawayRepository
+}
+
+func NewService(r *repo.CampaignRepository, giveaways *repo.GiveawayRepository) *Service {
+	return &Service{repo: r, giveaways: giveaways}
+}
+
+// Create starts an empty campaign owned by creatorID.
+func (s *Service) Create(ctx context.Context, creatorID int64, name, description string) (*dg.Campaign, error) {
+	name = strings.TrimSpace(name)
+	description = strings.TrimSpace(description)
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return nil, fmt.Errorf("name exceeds %d characters", maxNameLength)
+	}
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		return nil, fmt.Errorf("description exceeds %d characters", maxDescriptionLength)
+	}
+	now := time.Now().UTC()
+	c := &dg.Campaign{
+		ID:          uuid.NewString(),
+		CreatorID:   creatorID,
+		Name:        name,
+		Description: description,
+		Giveaways:   []dg.CampaignGiveaway{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.repo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// List returns the campaigns of creatorID.
+func (s *Service) List(ctx context.Context, creatorID int64) ([]dg.Campaign, error) {
+	return s.repo.ListByCreator(ctx, creatorID)
+}
+
+// Get returns a campaign with its giveaways to its creator.
+func (s *Service) Get(ctx context.Context, id string, requesterID int64) (*dg.Campaign, error) {
+	return s.loadOwned(ctx, id, requesterID)
+}
+
+// Delete removes a campaign; its giveaways are kept.
+func (s *Service) Delete(ctx context.Context, id string, requesterID int64) error {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// AddGiveaway assigns one of the creator's giveaways to the campaign. A giveaway belongs to at
+// most one campaign, so it leaves its previous one.
+func (s *Service) AddGiveaway(ctx context.Context, id, giveawayID string, requesterID int64) (*dg.Campaign, error) {
+	c, err := s.loadOwned(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.giveaways.GetByID(ctx, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("giveaway not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	for _, it := range c.Giveaways {
+		if it.ID == giveawayID {
+			return c, nil
+		}
+	}
+	if len(c.Giveaways) >= maxGiveawaysPerCampaign {
+		return nil, fmt.Errorf("too many giveaways in campaign (max %d)", maxGiveawaysPerCampaign)
+	}
+	if err := s.repo.AddGiveaway(ctx, id, giveawayID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// RemoveGiveaway takes a giveaway out of the campaign.
+func (s *Service) RemoveGiveaway(ctx context.Context, id, giveawayID string, requesterID int64) (*dg.Campaign, error) {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return nil, err
+	}
+	ok, err := s.repo.RemoveGiveaway(ctx, id, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("giveaway not found")
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// Stats rolls up reach, participants and winners across the campaign's giveaways.
+func (s *Service) Stats(ctx context.Context, id string, requesterID int64) (*dg.CampaignStats, error) {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.Stats(ctx, id)
+}
+
+// Public returns a campaign for its share page. Creator identity and cancelled giveaways
+// are left out.
+func (s *Service) Public(ctx context.Context, id string) (*dg.Campaign, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, errors.New("not found")
+	}
+	c.CreatorID = 0
+	visible := c.Giveaways[:0]
+	c.Participants = 0
+	for _, g := range c.Giveaways {
+		if g.Status == dg.GiveawayStatusCancelled {
+			continue
+		}
+		visible = append(visible, g)
+		c.Participants += int64(g.ParticipantsCount)
+	}
+	c.Giveaways = visible
+	return c, nil
+}
+
+func (s *Service) loadOwned(ctx context.Context, id string, requesterID int64) (*dg.Campaign, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, errors.New("not found")
+	}
+	if c.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return c, nil
+}
@@ -0,0 +1,30 @@
+package channels
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+// WithAvatarStore keeps the last resolved avatar of every channel in Postgres.
+func (s *Service) WithAvatarStore(repo *pgrepo.ChannelAvatarRepository) *Service {
+	s.avatars = repo
+	return s
+}
+
+// SaveAvatar records the avatar last resolved for a channel. It is a no-op without a store.
+func (s *Service) SaveAvatar(ctx context.Context, a *dg.ChannelAvatar) error {
+	if s.avatars == nil || a == nil || a.ChannelID == 0 || a.BigFileUniqueID == "" {
+		return nil
+	}
+	return s.avatars.Upsert(ctx, a)
+}
+
+// LastAvatar returns the stored avatar for a numeric chat id or @username, or nil when unknown.
+func (s *Service) LastAvatar(ctx context.Context, chatRef string) (*dg.ChannelAvatar, error) {
+	if s.avatars == nil {
+		return nil, nil
+	}
+	return s.avatars.GetByChat(ctx, chatRef)
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"errors"
 
 	rplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/utils/circuit"
 	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
 	"github.com/redis/go-redis/v9"
 )
@@ -21,14 +26,34 @@ type Channel struct {
 	URL           string `json:"url,omitempty"`
 	AvatarURL     string `json:"avatar_url,omitempty"`
 	PhotoSmallURL string `json:"photo_small_url,omitempty"`
+	// Stale marks info served from memory while lookups for the channel keep failing
+	Stale bool `json:"stale,omitempty"`
+	// When the title, member count and avatar were fetched from Telegram; unset when unknown
+	TitleUpdatedAt       *time.Time `json:"title_updated_at,omitempty"`
+	MemberCount          *int       `json:"member_count,omitempty"`
+	MemberCountUpdatedAt *time.Time `json:"member_count_updated_at,omitempty"`
+	AvatarUpdatedAt      *time.Time `json:"avatar_updated_at,omitempty"`
+	// Outdated is set when the title is older than maxTitleAge or of unknown age
+	Outdated bool `json:"outdated"`
+	// OwnerVerified is set once the channel's owner was confirmed as one of its admins
+	OwnerVerified bool `json:"owner_verified"`
 }
 
 // Service provides access to Telegram channel data stored in Redis.
 type Service struct {
 	rdb *rplatform.Client
+	// Per-channel breaker so channels that keep failing don't slow down giveaway reads
+	breaker *circuit.Breaker[int64, Channel]
+	// Optional Postgres fallback for channel avatars
+	avatars *pgrepo.ChannelAvatarRepository
+	// Optional canonical records and timestamped display data, see WithStore
+	store *pgrepo.ChannelRepository
+	tg    ChatSource
 }
 
-func NewService(rdb *rplatform.Client) *Service { return &Service{rdb: rdb} }
+func NewService(rdb *rplatform.Client) *Service {
+	return &Service{rdb: rdb, breaker: circuit.New[int64, Channel]()}
+}
 
 // GetByID returns channel info by numeric id from Redis keys
 // channel:{id}:title, channel:{id}:username, channel:{id}:url. Missing keys yield empty fields.
@@ -47,18 +72,42 @@ func (s *Service) GetByID(ctx context.Context, id int64, requesterUserID ...int6
 		}
 	}
 
-	title, _ := s.rdb.Get(ctx, fmt.Sprintf("channel:%d:title", id)).Result()
-	username, _ := s.rdb.Get(ctx, fmt.Sprintf("channel:%d:username", id)).Result()
-	urlVal, _ := s.rdb.Get(ctx, fmt.Sprintf("channel:%d:url", id)).Result()
-	photoSmall, _ := s.rdb.Get(ctx, fmt.Sprintf("channel:%d:photo_small_url", id)).Result()
+	ch, stale, err := s.breaker.Do(id, func() (Channel, error) { return s.load(ctx, id) })
+	if errors.Is(err, circuit.ErrOpen) {
+		return nil, errors.New("channel not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	ch.Stale = stale
+	s.applyStored(ctx, []*Channel{&ch})
+	return &ch, nil
+}
+
+// load reads the channel keys from Redis. Redis errors other than missing keys fail the lookup.
+func (s *Service) load(ctx context.Context, id int64) (Channel, error) {
+	get := func(field string) (string, error) {
+		v, err := s.rdb.Get(ctx, fmt.Sprintf("channel:%d:%s", id, field)).Result()
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return v, err
+	}
+	title, err := get("title")
+	if err != nil {
+		return Channel{}, err
+	}
+	username, _ := get("username")
+	urlVal, _ := get("url")
+	photoSmall, _ := get("photo_small_url")
 	avatar := buildAvatarURL(username, title, id)
 
 	// if all fields are empty, return nil
 	if title == "" && username == "" && urlVal == "" && photoSmall == "" && avatar == "" {
-		return nil, errors.New("channel not found")
+		return Channel{}, errors.New("channel not found")
 	}
 
-	return &Channel{ID: id, Title: title, Username: username, URL: urlVal, AvatarURL: avatar, PhotoSmallURL: photoSmall}, nil
+	return Channel{ID: id, Title: title, Username: username, URL: urlVal, AvatarURL: avatar, PhotoSmallURL: photoSmall}, nil
 }
 
 // ListUserChannels returns all channels for a user by reading set user:{id}:channels
@@ -111,6 +160,32 @@ func (s *Service) ListUserChannels(ctx context.Context, userID int64) ([]Channel
 			// PhotoSmallURL: photoSmall
 		})
 	}
+	ptrs := make([]*Channel, len(out))
+	for i := range out {
+		ptrs[i] = &out[i]
+	}
+	s.applyStored(ctx, ptrs)
+	return out, nil
+}
+
+// SearchUserChannels returns the user's channels whose title or username contains query
+// (case-insensitive, leading @ ignored), sorted by title. An empty query matches all channels.
+func (s *Service) SearchUserChannels(ctx context.Context, userID int64, query string, limit int) ([]Channel, error) {
+	items, err := s.ListUserChannels(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	q := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(query), "@"))
+	out := make([]Channel, 0, len(items))
+	for _, ch := range items {
+		if q == "" || strings.Contains(strings.ToLower(ch.Title), q) || strings.Contains(strings.ToLower(ch.Username), q) {
+			out = append(out, ch)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return strings.ToLower(out[i].Title) < strings.ToLower(out[j].Title) })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
 	return out, nil
 }
 
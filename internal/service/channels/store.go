@@ -0,0 +1,130 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+const (
+	// maxTitleAge marks titles older than this as outdated
+	maxTitleAge = 24 * time.Hour
+	// refreshCooldown spaces forced refreshes of one channel
+	refreshCooldown = time.Minute
+)
+
+// ChatSource fetches channel details from Telegram for refreshes; *telegram.Client implements it.
+type ChatSource interface {
+	GetPublicChannelInfoByID(ctx context.Context, id int64) (*tg.PublicChannelInfo, error)
+	GetChatAdministrators(ctx context.Context, chat string) ([]int64, error)
+	GetChatMemberCount(ctx context.Context, chat string) (int, error)
+}
+
+// WithStore keeps canonical channel records and timestamped display data in Postgres. Reads
+// prefer the stored data and report its age; src enables Refresh.
+func (s *Service) WithStore(repo *pgrepo.ChannelRepository, src ChatSource) *Service {
+	s.store = repo
+	s.tg = src
+	return s
+}
+
+// applyStored overlays the stored username, title and member count on channels read from
+// Redis and sets their freshness. Redis titles have no timestamp, so without a stored title
+// a channel is outdated. Store errors leave the channels as read.
+func (s *Service) applyStored(ctx context.Context, chs []*Channel) {
+	var stored map[int64]*dg.StoredChannel
+	if s.store != nil && len(chs) > 0 {
+		ids := make([]int64, len(chs))
+		for i, ch := range chs {
+			ids[i] = ch.ID
+		}
+		var err error
+		if stored, err = s.store.Load(ctx, ids); err != nil {
+			log.Printf("channels: load stored: %v", err)
+		}
+	}
+	now := time.Now()
+	for _, ch := range chs {
+		sc := stored[ch.ID]
+		if sc == nil {
+			ch.Outdated = true
+			continue
+		}
+		if sc.Username != "" {
+			ch.Username = sc.Username
+		}
+		if sc.Cache.TitleFetchedAt != nil && sc.Cache.Title != "" {
+			ch.Title = sc.Cache.Title
+			ch.TitleUpdatedAt = sc.Cache.TitleFetchedAt
+		}
+		ch.MemberCount = sc.Cache.MemberCount
+		ch.MemberCountUpdatedAt = sc.Cache.MemberCountFetchedAt
+		ch.AvatarUpdatedAt = sc.Cache.AvatarFetchedAt
+		ch.Outdated = ch.TitleUpdatedAt == nil || now.Sub(*ch.TitleUpdatedAt) > maxTitleAge
+		ch.OwnerVerified = sc.OwnerVerifiedAt != nil
+	}
+}
+
+// Refresh fetches the title, username and member count of a connected channel from Telegram
+// now and stores them with their fetch time. A requester who is an admin of the channel is
+// recorded as its verified owner. Each channel can be refreshed once per refreshCooldown.
+func (s *Service) Refresh(ctx context.Context, id, requesterID int64) (*Channel, error) {
+	if s.store == nil || s.tg == nil {
+		return nil, errors.New("refresh not available")
+	}
+	owns, err := s.rdb.SIsMember(ctx, fmt.Sprintf("user:%d:channels", requesterID), strconv.FormatInt(id, 10)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !owns {
+		return nil, errors.New("forbidden")
+	}
+	ok, err := s.rdb.SetNX(ctx, fmt.Sprintf("channel:%d:refresh_lock", id), requesterID, refreshCooldown).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("refreshed recently")
+	}
+	chat := strconv.FormatInt(id, 10)
+	info, err := s.tg.GetPublicChannelInfoByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("telegram: %w", err)
+	}
+	now := time.Now().UTC()
+	rec := dg.ChannelRecord{ID: id, Username: info.Username}
+	cache := dg.ChannelCache{ChannelID: id, Title: info.Title, TitleFetchedAt: &now}
+	if admins, err := s.tg.GetChatAdministrators(ctx, chat); err == nil && slices.Contains(admins, requesterID) {
+		rec.OwnerID, rec.OwnerVerifiedAt = requesterID, &now
+	}
+	if n, err := s.tg.GetChatMemberCount(ctx, chat); err == nil {
+		cache.MemberCount, cache.MemberCountFetchedAt = &n, &now
+	}
+	if err := s.store.Save(ctx, rec, cache); err != nil {
+		return nil, err
+	}
+	// Keep the Redis keys other readers use in step
+	pipe := s.rdb.Pipeline()
+	pipe.Set(ctx, fmt.Sprintf("channel:%d:title", id), info.Title, 0)
+	if info.Username != "" {
+		pipe.Set(ctx, fmt.Sprintf("channel:%d:username", id), info.Username, 0)
+		pipe.Set(ctx, fmt.Sprintf("channel:%d:url", id), info.ChannelURL, 0)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("channel %d: update redis: %v", id, err)
+	}
+	ch, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.applyStored(ctx, []*Channel{&ch})
+	return &ch, nil
+}
@@ -0,0 +1,185 @@
+// Package compliance gates joins of giveaways whose prizes are restricted in some
+// jurisdictions behind an eligibility acknowledgment, recorded for audit.
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+// Service manages compliance rules and the acknowledgments of joining users.
+type Service struct {
+	repo      *repo.ComplianceRepository
+	giveaways *repo.GiveawayRepository
+}
+
+func NewService(r *repo.ComplianceRepository, giveaways *repo.GiveawayRepository) *Service {
+	return &Service{repo: r, giveaways: giveaways}
+}
+
+// Rules returns every compliance rule. Access: platform admins.
+func (s *Service) Rules(ctx context.Context) ([]dg.ComplianceRule, error) {
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil || rules != nil {
+		return rules, err
+	}
+	return []dg.ComplianceRule{}, nil
+}
+
+// SetRule creates or replaces the rule of a prize kind. Users who acknowledged fewer countries
+// acknowledge again on their next join. Access: platform admins.
+func (s *Service) SetRule(ctx context.Context, kind dg.PrizeKind, countries []string, notice string, adminID int64) (*dg.ComplianceRule, error) {
+	if !kind.Valid() {
+		return nil, errors.New("unknown prize kind")
+	}
+	countries, err := dg.NormalizeCountries(countries)
+	if err != nil {
+		return nil, err
+	}
+	if len(countries) == 0 {
+		return nil, errors.New("countries are required")
+	}
+	notice = strings.TrimSpace(notice)
+	if utf8.RuneCountInString(notice) > dg.MaxComplianceNoticeLength {
+		return nil, fmt.Errorf("notice exceeds %d characters", dg.MaxComplianceNoticeLength)
+	}
+	rule := dg.ComplianceRule{PrizeKind: kind, Countries: countries, Notice: notice, UpdatedBy: adminID}
+	if err := s.repo.SetRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rules {
+		if rules[i].PrizeKind == kind {
+			return &rules[i], nil
+		}
+	}
+	return nil, errors.New("rule not found")
+}
+
+// DeleteRule lifts the restrictions of a prize kind. Recorded acknowledgments stay for audit.
+// Access: platform admins.
+func (s *Service) DeleteRule(ctx context.Context, kind dg.PrizeKind) error {
+	ok, err := s.repo.DeleteRule(ctx, kind)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("rule not found")
+	}
+	return nil
+}
+
+// GateFor returns the acknowledgment userID owes before joining giveaway id.
+func (s *Service) GateFor(ctx context.Context, id string, userID int64) (*dg.ComplianceGate, error) {
+	g, err := s.giveaways.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || (g.Status == dg.GiveawayStatusDraft && g.CreatorID != userID) {
+		return nil, errors.New("not found")
+	}
+	return s.Gate(ctx, g, userID)
+}
+
+// Gate returns the acknowledgment userID owes before joining g: the restricted countries of
+// every rule matching the kinds of its prizes, and whether the user already acknowledged them.
+func (s *Service) Gate(ctx context.Context, g *dg.Giveaway, userID int64) (*dg.ComplianceGate, error) {
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gate := &dg.ComplianceGate{}
+	if len(rules) == 0 {
+		return gate, nil
+	}
+	escrowed := g.Funding != nil
+	if !escrowed {
+		f, err := s.giveaways.GetFunding(ctx, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		escrowed = f != nil
+	}
+	byKind := make(map[dg.PrizeKind]dg.ComplianceRule, len(rules))
+	for _, r := range rules {
+		byKind[r.PrizeKind] = r
+	}
+	var countries []string
+	for _, k := range dg.PrizeKinds(g, escrowed) {
+		r, ok := byKind[k]
+		if !ok || len(r.Countries) == 0 {
+			continue
+		}
+		gate.PrizeKinds = append(gate.PrizeKinds, k)
+		countries = append(countries, r.Countries...)
+		if r.Notice != "" {
+			gate.Notices = append(gate.Notices, r.Notice)
+		}
+	}
+	if len(countries) == 0 {
+		return gate, nil
+	}
+	if gate.Countries, err = dg.NormalizeCountries(countries); err != nil {
+		return nil, err
+	}
+	gate.Required = true
+	ack, err := s.repo.GetAck(ctx, g.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ack != nil && ack.Covers(gate.Countries) {
+		at := ack.AcknowledgedAt
+		gate.AcknowledgedAt = &at
+	}
+	return gate, nil
+}
+
+// Acknowledge records that userID confirmed not residing in the restricted countries of g,
+// with the client the confirmation came from. Giveaways without restrictions record nothing.
+func (s *Service) Acknowledge(ctx context.Context, g *dg.Giveaway, userID int64, ip, userAgent string) (*dg.ComplianceGate, error) {
+	gate, err := s.Gate(ctx, g, userID)
+	if err != nil || !gate.Pending() {
+		return gate, err
+	}
+	at, err := s.repo.RecordAck(ctx, dg.ComplianceAck{
+		GiveawayID: g.ID,
+		UserID:     userID,
+		PrizeKinds: gate.PrizeKinds,
+		Countries:  gate.Countries,
+		Notice:     strings.Join(gate.Notices, "\n\n"),
+		IP:         ip,
+		UserAgent:  userAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	gate.AcknowledgedAt = &at
+	return gate, nil
+}
+
+// Acks returns a page of the acknowledgments recorded for a giveaway, latest first. Access:
+// creator or platform admins; admins can also read those of deleted giveaways.
+func (s *Service) Acks(ctx context.Context, id string, requesterID int64, admin bool, limit, offset int) ([]dg.ComplianceAck, error) {
+	if !admin {
+		g, err := s.giveaways.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if g == nil {
+			return nil, errors.New("not found")
+		}
+		if g.CreatorID != requesterID {
+			return nil, errors.New("forbidden")
+		}
+	}
+	return s.repo.ListAcks(ctx, id, limit, offset)
+}
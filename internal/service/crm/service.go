@@ -0,0 +1,301 @@
+package crm
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	maxWebhooksPerCreator = 5
+	maxURLLength          = 2048
+	// batchSize is the most participants posted in one request
+	batchSize = 100
+	// maxBatchesPerTick keeps one busy endpoint from delaying the others
+	maxBatchesPerTick = 10
+	// settleDelay skips joins younger than this so transactions still committing are not passed by the cursor
+	settleDelay = 5 * time.Second
+	// failureThreshold consecutive failures open the circuit; each further failure doubles the cool-down
+	failureThreshold = 5
+	minCoolDown      = time.Minute
+	maxCoolDown      = time.Hour
+	// SignatureHeader carries "t=<unix>,v1=<hex hmac-sha256 of "<t>.<body>">"
+	SignatureHeader = "X-Giveaway-Signature"
+)
+
+// Service manages creator CRM webhooks and delivers new participants to them.
+type Service struct {
+	repo       *repo.CRMWebhookRepository
+	httpClient *http.Client
+}
+
+func NewService(r *repo.CRMWebhookRepository) *Service {
+	return &Service{repo: r, httpClient: newWebhookClient()}
+}
+
+// newWebhookClient checks the address every connection actually dials, so a public name that
+// resolves or later rebinds to an internal address is refused, and it never follows redirects.
+// Proxies are not used since they would hide the real destination from the check.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: dialControl}
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return errors.New("redirects are not followed")
+		},
+	}
+}
+
+// dialControl runs after name resolution and rejects non-public addresses.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("refusing to connect to non-public address %s", host)
+	}
+	return nil
+}
+
+// cgnat is the shared address space of RFC 6598, which is not covered by net.IP.IsPrivate.
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || cgnat.Contains(ip))
+}
+
+// Create registers a webhook for creatorID. The returned webhook carries the signing secret,
+// which is not shown again.
+func (s *Service) Create(ctx context.Context, creatorID int64, rawURL string) (*dg.CRMWebhook, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if err := validateURL(rawURL); err != nil {
+		return nil, err
+	}
+	n, err := s.repo.CountByCreator(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= maxWebhooksPerCreator {
+		return nil, fmt.Errorf("at most %d webhooks per creator", maxWebhooksPerCreator)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	w := &dg.CRMWebhook{
+		ID:        uuid.NewString(),
+		CreatorID: creatorID,
+		URL:       rawURL,
+		Secret:    hex.EncodeToString(secret),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.Create(ctx, w); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// List returns the webhooks of creatorID without their secrets.
+func (s *Service) List(ctx context.Context, creatorID int64) ([]dg.CRMWebhook, error) {
+	items, err := s.repo.ListByCreator(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].Secret = ""
+	}
+	return items, nil
+}
+
+// Delete removes a webhook of its creator.
+func (s *Service) Delete(ctx context.Context, id string, requesterID int64) error {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// ResetCircuit retries an endpoint right away after its owner fixed it.
+func (s *Service) ResetCircuit(ctx context.Context, id string, requesterID int64) error {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return s.repo.ResetCircuit(ctx, id)
+}
+
+func (s *Service) loadOwned(ctx context.Context, id string, requesterID int64) (*dg.CRMWebhook, error) {
+	w, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return nil, errors.New("not found")
+	}
+	if w.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return w, nil
+}
+
+// DeliverDue posts pending participants to every webhook with a closed circuit and returns how
+// many participants were delivered.
+func (s *Service) DeliverDue(ctx context.Context) (int, error) {
+	hooks, err := s.repo.ListDue(ctx, time.Now().UTC(), 100)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for i := range hooks {
+		n, err := s.deliver(ctx, &hooks[i])
+		if err != nil {
+			log.Printf("crm webhook %s: %v", hooks[i].ID, err)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// deliver sends full batches back to back until the webhook is caught up or a post fails.
+func (s *Service) deliver(ctx context.Context, w *dg.CRMWebhook) (int, error) {
+	sent := 0
+	for i := 0; i < maxBatchesPerTick && ctx.Err() == nil; i++ {
+		items, err := s.repo.PendingParticipants(ctx, w, time.Now().UTC().Add(-settleDelay), batchSize)
+		if err != nil || len(items) == 0 {
+			return sent, err
+		}
+		if err := s.post(ctx, w, items); err != nil {
+			return sent, s.recordFailure(ctx, w, err)
+		}
+		last := items[len(items)-1]
+		if err := s.repo.Advance(ctx, w.ID, last, len(items)); err != nil {
+			return sent, err
+		}
+		w.CursorJoinedAt, w.CursorGiveawayID, w.CursorUserID = last.JoinedAt, last.GiveawayID, last.UserID
+		w.Failures = 0
+		sent += len(items)
+		if len(items) < batchSize {
+			break
+		}
+	}
+	return sent, nil
+}
+
+// recordFailure counts a failed post and opens the circuit once failures reach the threshold.
+func (s *Service) recordFailure(ctx context.Context, w *dg.CRMWebhook, cause error) error {
+	failures := w.Failures + 1
+	var openUntil *time.Time
+	if failures >= failureThreshold {
+		coolDown := maxCoolDown
+		if shift := failures - failureThreshold; shift < 6 {
+			coolDown = min(minCoolDown<<shift, maxCoolDown)
+		}
+		t := time.Now().UTC().Add(coolDown)
+		openUntil = &t
+	}
+	if err := s.repo.RecordFailure(ctx, w.ID, cause.Error(), openUntil); err != nil {
+		return err
+	}
+	return cause
+}
+
+// post sends one signed batch. Any non-2xx response is a failure and the batch is resent later.
+func (s *Service) post(ctx context.Context, w *dg.CRMWebhook, items []dg.CRMParticipant) error {
+	now := time.Now().UTC()
+	body, err := json.Marshal(dg.CRMWebhookBatch{DeliveryID: deliveryID(w, items), WebhookID: w.ID, SentAt: now, Participants: items})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Giveaway-Webhook-Id", w.ID)
+	req.Header.Set(SignatureHeader, Sign(w.Secret, now, body))
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("endpoint responded %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// deliveryID names a batch by the webhook and the cursor range it covers, so a batch that is
+// resent after a failure keeps its ID and receivers can dedupe on it.
+func deliveryID(w *dg.CRMWebhook, items []dg.CRMParticipant) string {
+	last := items[len(items)-1]
+	key := fmt.Sprintf("%s|%d:%s:%d|%d:%s:%d", w.ID,
+		w.CursorJoinedAt.UnixMicro(), w.CursorGiveawayID, w.CursorUserID,
+		last.JoinedAt.UnixMicro(), last.GiveawayID, last.UserID)
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(key)).String()
+}
+
+// Sign returns the signature header value for body sent at t. Receivers recompute the HMAC and
+// reject timestamps older than a few minutes, so a captured request cannot be replayed later.
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// validateURL accepts https endpoints that do not name an internal host. Names are resolved
+// and checked again on every connection by dialControl.
+func validateURL(raw string) error {
+	if raw == "" {
+		return errors.New("url is required")
+	}
+	if len(raw) > maxURLLength {
+		return fmt.Errorf("url exceeds %d characters", maxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return errors.New("invalid url")
+	}
+	if u.Scheme != "https" {
+		return errors.New("url must use https")
+	}
+	host := u.Hostname()
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return errors.New("url must point to a public host")
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return errors.New("url must point to a public host")
+	}
+	return nil
+}
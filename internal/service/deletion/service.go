@@ -0,0 +1,204 @@
+// Package deletion cleans up after deleted giveaways: it revokes export links, drops the
+// giveaway's Redis keys and removes the bot's posts about it from sponsor channels. Each step
+// is recorded once done, so a failed run resumes where it stopped.
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	"github.com/open-builders/giveaway-backend/internal/utils/backoff"
+)
+
+const (
+	// maxAttempts failed runs give up on a deletion; tokens and keys left behind still expire
+	// or are reclaimed by housekeeping
+	maxAttempts = 8
+	batchSize   = 20
+	// lease hides claimed deletions from other workers while one is processed
+	lease     = 10 * time.Minute
+	scanCount = 500
+	// removedNotice replaces posts the bot may edit but not delete
+	removedNotice = "This giveaway was removed by its creator."
+)
+
+// Service runs the cleanup of deleted giveaways.
+type Service struct {
+	repo *repo.DeletionRepository
+	rdb  *redisp.Client
+	tg   tg.API
+	// shards hold the giveaway:{<id>}:* keys when set, see WithShards
+	shards *redisp.Shards
+}
+
+// NewService creates the cleanup service. Without Redis the Redis steps are skipped; without
+// Telegram channel posts are left as they are.
+func NewService(r *repo.DeletionRepository, rdb *redisp.Client, tgc tg.API) *Service {
+	return &Service{repo: r, rdb: rdb, tg: tgc}
+}
+
+// WithShards also clears the hot giveaway keys kept on the shards.
+func (s *Service) WithShards(sh *redisp.Shards) *Service {
+	s.shards = sh
+	return s
+}
+
+// ProcessDue runs the pending steps of due deletions. Failed runs are retried with growing
+// delays until maxAttempts. Returns how many deletions were completed.
+func (s *Service) ProcessDue(ctx context.Context) (int, error) {
+	due, err := s.repo.ClaimDue(ctx, batchSize, maxAttempts, lease)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, d := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := s.run(ctx, d); err != nil {
+			if d.Attempts >= maxAttempts {
+				log.Printf("giveaway %s deletion: giving up after %d attempts: %v", d.GiveawayID, d.Attempts, err)
+			}
+			next := time.Now().Add(backoff.Exponential(d.Attempts, time.Minute, time.Hour))
+			if err := s.repo.Retry(ctx, d.GiveawayID, err.Error(), next); err != nil {
+				log.Printf("giveaway %s deletion: %v", d.GiveawayID, err)
+			}
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+// run performs the steps of d that are not done yet.
+func (s *Service) run(ctx context.Context, d dg.Deletion) error {
+	steps := []struct {
+		step dg.DeletionStep
+		done *time.Time
+		fn   func(context.Context, string) error
+	}{
+		{dg.DeletionStepTokens, d.TokensRevokedAt, s.revokeTokens},
+		{dg.DeletionStepKeys, d.KeysClearedAt, s.clearKeys},
+		{dg.DeletionStepPosts, d.PostsCleanedAt, s.cleanPosts},
+	}
+	for _, st := range steps {
+		if st.done != nil {
+			continue
+		}
+		if err := st.fn(ctx, d.GiveawayID); err != nil {
+			return fmt.Errorf("%s: %w", st.step, err)
+		}
+		if err := s.repo.CompleteStep(ctx, d.GiveawayID, st.step); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeTokens deletes the export links handed out for the giveaway.
+func (s *Service) revokeTokens(ctx context.Context, id string) error {
+	if s.rdb == nil {
+		return nil
+	}
+	index := "giveaway:" + id + ":export_tokens"
+	tokens, err := s.rdb.SMembers(ctx, index).Result()
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(tokens)+1)
+	for _, t := range tokens {
+		keys = append(keys, "export:giveaway:"+t)
+	}
+	keys = append(keys, index)
+	return s.rdb.Del(ctx, keys...).Err()
+}
+
+// clearKeys deletes every giveaway:<id>:* key: the prepared inline message, import progress
+// and alert cooldowns, and the giveaway:{<id>}:* page cache and usage counters, which live on
+// the shards when they are configured.
+func (s *Service) clearKeys(ctx context.Context, id string) error {
+	if s.rdb == nil {
+		return nil
+	}
+	if err := scanDelete(ctx, s.rdb, "giveaway:"+id+":*"); err != nil {
+		return err
+	}
+	hot := []*redisp.Client{s.rdb}
+	if s.shards != nil {
+		hot = s.shards.Clients()
+	}
+	for _, c := range hot {
+		if err := scanDelete(ctx, c, "giveaway:{"+id+"}:*"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scanDelete deletes the keys of c matching pattern.
+func scanDelete(ctx context.Context, c *redisp.Client, pattern string) error {
+	var cursor uint64
+	for {
+		keys, next, err := c.Scan(ctx, cursor, pattern, scanCount).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := c.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// cleanPosts deletes the bot's posts about the giveaway. Posts it may no longer delete, e.g.
+// older than 48 hours, are edited to a removal notice; posts it cannot touch at all because it
+// lost its rights are forgotten. Network failures and rate limits keep the post for a retry.
+func (s *Service) cleanPosts(ctx context.Context, id string) error {
+	if s.tg == nil {
+		return nil
+	}
+	posts, err := s.repo.Posts(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		err := s.tg.DeleteMessage(ctx, p.ChannelID, p.MessageID)
+		if err != nil && !transient(err) {
+			if p.Media {
+				err = s.tg.EditMessageCaption(ctx, p.ChannelID, p.MessageID, removedNotice, "", "", "")
+			} else {
+				err = s.tg.EditMessageText(ctx, p.ChannelID, p.MessageID, removedNotice, "", "", "")
+			}
+			if err != nil && !transient(err) {
+				log.Printf("giveaway %s deletion: leaving post %d in channel %d: %v", id, p.MessageID, p.ChannelID, err)
+				err = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.repo.ForgetPost(ctx, p.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transient reports whether a Telegram call may succeed when retried. Telegram's own
+// rejections other than rate limits are final.
+func transient(err error) bool {
+	msg := err.Error()
+	return !strings.HasPrefix(msg, "telegram ") || strings.Contains(msg, "Too Many Requests")
+}
@@ -0,0 +1,215 @@
+package exports
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+)
+
+// winnerColumns are the leading CSV columns filled by winnerRow.
+var winnerColumns = []string{"place", "user_id", "username", "first_name", "last_name", "wallet_address", "source"}
+
+// winnerRow returns the winnerColumns of w; u may be nil.
+func winnerRow(w dg.Winner, u *du.User) []string {
+	var username, firstName, lastName, wallet string
+	if u != nil {
+		username, firstName, lastName, wallet = u.Username, u.FirstName, u.LastName, u.WalletAddress
+	}
+	return []string{
+		strconv.Itoa(w.Place),
+		strconv.FormatInt(w.UserID, 10),
+		username,
+		firstName,
+		lastName,
+		wallet,
+		string(w.Source),
+	}
+}
+
+// contactColumns returns the contact_method and contact_details cells of c, empty when the
+// winner has not chosen a method.
+func contactColumns(c *dg.ContactPreference) (string, string) {
+	if c == nil {
+		return "", ""
+	}
+	switch c.Method {
+	case dg.ContactEmail:
+		return string(c.Method), c.Email
+	case dg.ContactWallet:
+		return string(c.Method), c.WalletAddress
+	}
+	return string(c.Method), ""
+}
+
+// RequirementChecks are the requirement outcomes recorded for winners when they were drawn.
+// The winners CSV shows them as a status and a checked_at column per requirement, so creators
+// can show sponsors that every winner met the terms.
+type RequirementChecks struct {
+	columns []requirementColumn
+	byUser  map[int64]map[requirementKey]dg.RequirementSnapshot
+}
+
+// requirementKey identifies a requirement as recorded in snapshots. Requirements edited after
+// the draw change their type or channel, so their checks keep a column of their own.
+type requirementKey struct {
+	index     int
+	typ       dg.RequirementType
+	channelID int64
+}
+
+type requirementColumn struct {
+	key   requirementKey
+	label string
+}
+
+// NewRequirementChecks indexes snapshots by winner. There is a column for each requirement of
+// reqs, the requirements of the giveaway now, and for each requirement the snapshots were
+// recorded against, labeled from the snapshot itself when it is no longer in reqs.
+func NewRequirementChecks(reqs []dg.Requirement, snapshots []dg.RequirementSnapshot) *RequirementChecks {
+	rc := &RequirementChecks{byUser: make(map[int64]map[requirementKey]dg.RequirementSnapshot)}
+	seen := map[requirementKey]bool{}
+	usernames := map[int64]string{}
+	for i, r := range reqs {
+		key := requirementKey{index: i, typ: r.Type, channelID: r.ChannelID}
+		if r.ChannelID != 0 && r.ChannelUsername != "" {
+			usernames[r.ChannelID] = r.ChannelUsername
+		}
+		seen[key] = true
+		rc.columns = append(rc.columns, requirementColumn{key: key, label: requirementLabel(r.Type, r.ChannelID, r.ChannelUsername)})
+	}
+	for _, sn := range snapshots {
+		key := requirementKey{index: sn.RequirementIndex, typ: sn.RequirementType, channelID: sn.ChannelID}
+		if rc.byUser[sn.UserID] == nil {
+			rc.byUser[sn.UserID] = make(map[requirementKey]dg.RequirementSnapshot)
+		}
+		rc.byUser[sn.UserID][key] = sn
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		rc.columns = append(rc.columns, requirementColumn{key: key, label: requirementLabel(sn.RequirementType, sn.ChannelID, usernames[sn.ChannelID])})
+	}
+	// Keep draw-time order; checks of an edited requirement follow its current column
+	sort.SliceStable(rc.columns, func(i, j int) bool { return rc.columns[i].key.index < rc.columns[j].key.index })
+	return rc
+}
+
+// requirementLabel names a requirement column by type and channel, by @username when known.
+func requirementLabel(t dg.RequirementType, channelID int64, username string) string {
+	switch {
+	case username != "":
+		return string(t) + " @" + username
+	case channelID != 0:
+		return string(t) + " " + strconv.FormatInt(channelID, 10)
+	}
+	return string(t)
+}
+
+// header returns the requirement column headers in loc.
+func (rc *RequirementChecks) header(loc *i18n.Locale) []string {
+	if rc == nil {
+		return nil
+	}
+	out := make([]string, 0, 2*len(rc.columns))
+	for i, col := range rc.columns {
+		out = append(out, fmt.Sprintf(loc.T("csv.requirement_status"), i+1, col.label), fmt.Sprintf(loc.T("csv.requirement_checked_at"), i+1, col.label))
+	}
+	return out
+}
+
+// row returns the requirement cells of userID: pass, fail or error with the check time, empty
+// for requirements not checked at draw time, such as optional ones or for manual winners.
+func (rc *RequirementChecks) row(userID int64, loc *i18n.Locale) []string {
+	if rc == nil {
+		return nil
+	}
+	out := make([]string, 0, 2*len(rc.columns))
+	checks := rc.byUser[userID]
+	for _, col := range rc.columns {
+		sn, ok := checks[col.key]
+		if !ok {
+			out = append(out, "", "")
+			continue
+		}
+		status := "error"
+		switch sn.Status {
+		case "success":
+			status = "pass"
+		case "failed":
+			status = "fail"
+		}
+		out = append(out, status, loc.FormatTime(sn.CheckedAt))
+	}
+	return out
+}
+
+// WriteWinnersCSV renders winners with their prizes, one row per prize, with headers, dates
+// and the field separator of loc. withQuantity adds the prize_quantity column; checks, when
+// set, adds the requirement columns. user looks up winner profiles and may return nil.
+// progress, when set, is called with the number of winners written after each one; an error
+// from it stops the export.
+func WriteWinnersCSV(out io.Writer, winners []dg.Winner, withQuantity bool, loc *i18n.Locale, checks *RequirementChecks, user func(int64) *du.User, progress func(done int) error) error {
+	// UTF-8 BOM for Excel compatibility with Cyrillic
+	if _, err := out.Write([]byte{0xEF, 0xBB, 0xBF}); err != nil {
+		return err
+	}
+	writer := csv.NewWriter(out)
+	writer.Comma = loc.ListSeparator
+	columns := append(append([]string{}, winnerColumns...), "prize_title", "prize_description", "prize_package")
+	if withQuantity {
+		columns = append(columns, "prize_quantity")
+	}
+	columns = append(columns, "won_at", "contact_method", "contact_details")
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = loc.T("csv." + col)
+	}
+	_ = writer.Write(append(header, checks.header(loc)...))
+	for i, w := range winners {
+		base := winnerRow(w, user(w.UserID))
+		wonAt := ""
+		if w.AssignedAt != nil {
+			wonAt = loc.FormatTime(*w.AssignedAt)
+		}
+		method, details := contactColumns(w.Contact)
+		reqCells := checks.row(w.UserID, loc)
+		if len(w.Prizes) == 0 {
+			row := append(base, "", "", "")
+			if withQuantity {
+				row = append(row, "")
+			}
+			_ = writer.Write(append(append(row, wonAt, method, details), reqCells...))
+		}
+		for _, p := range w.Prizes {
+			row := append(append([]string{}, base...), p.Title, p.Description, p.Package)
+			if withQuantity {
+				row = append(row, strconv.Itoa(p.Quantity))
+			}
+			_ = writer.Write(append(append(row, wonAt, method, details), reqCells...))
+		}
+		if progress != nil {
+			if err := progress(i + 1); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// FileKey is the storage object of a giveaway export. Each giveaway has one object per kind
+// that every new export overwrites.
+func FileKey(giveawayID string, kind dg.ExportKind) string {
+	return "exports/giveaways/" + giveawayID + "/" + string(kind) + ".csv"
+}
+
+// FileDisposition is the Content-Disposition of a giveaway export.
+func FileDisposition(giveawayID string) string {
+	return "attachment; filename=\"giveaway_" + giveawayID + "_winners.csv\""
+}
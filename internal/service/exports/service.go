@@ -0,0 +1,308 @@
+// Package exports renders giveaway CSV exports, in the request for small giveaways and as
+// background jobs with progress for large ones.
+package exports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	du "github.com/open-builders/giveaway-backend/internal/domain/user"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+)
+
+const (
+	// progressInterval spaces progress writes of a running job
+	progressInterval = 2 * time.Second
+	// staleAfter hands a running job to another worker once its progress stops this long
+	staleAfter = 5 * time.Minute
+	// LinkTTL is how long download links of finished jobs stay valid
+	LinkTTL = 24 * time.Hour
+	// listLimit bounds the jobs listed per giveaway
+	listLimit = 20
+)
+
+// errCancelled stops a job that was cancelled while running.
+var errCancelled = errors.New("cancelled")
+
+// Service queues export jobs and runs them for the export worker.
+type Service struct {
+	repo      *repo.ExportJobRepository
+	giveaways *repo.GiveawayRepository
+	users     *usersvc.Service
+	files     storage.Storage
+	ntf       *notify.Service
+}
+
+// NewService builds the export service. Jobs need files; without it only inline exports work.
+func NewService(r *repo.ExportJobRepository, giveaways *repo.GiveawayRepository, users *usersvc.Service, files storage.Storage) *Service {
+	return &Service{repo: r, giveaways: giveaways, users: users, files: files}
+}
+
+// WithNotifier tells requesters by DM when their job finished.
+func (s *Service) WithNotifier(ntf *notify.Service) *Service {
+	s.ntf = ntf
+	return s
+}
+
+// managedGiveaway loads a giveaway its creator or one of its managers exports.
+func (s *Service) managedGiveaway(ctx context.Context, id string, requesterID int64) (*dg.Giveaway, error) {
+	g, err := s.giveaways.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		ok, err := s.giveaways.IsManager(ctx, id, requesterID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("forbidden")
+		}
+	}
+	return g, nil
+}
+
+// Create queues an export of a giveaway in locale. A giveaway has at most one unfinished job
+// per kind; asking again returns it. The creator and managers can export.
+func (s *Service) Create(ctx context.Context, giveawayID string, requesterID int64, kind dg.ExportKind, locale string) (*dg.ExportJob, error) {
+	if s.files == nil {
+		return nil, errors.New("storage not configured")
+	}
+	switch kind {
+	case dg.ExportWinners, dg.ExportStats:
+	default:
+		return nil, errors.New("invalid kind")
+	}
+	if _, err := s.managedGiveaway(ctx, giveawayID, requesterID); err != nil {
+		return nil, err
+	}
+	j, created, err := s.repo.Create(ctx, &dg.ExportJob{ID: uuid.NewString(), GiveawayID: giveawayID, RequestedBy: requesterID, Kind: kind, Locale: locale})
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		log.Printf("export job %s: queued %s export of giveaway %s", j.ID, kind, giveawayID)
+	}
+	return j, nil
+}
+
+// Get returns a job of a giveaway to its creator and managers.
+func (s *Service) Get(ctx context.Context, giveawayID, jobID string, requesterID int64) (*dg.ExportJob, error) {
+	if _, err := s.managedGiveaway(ctx, giveawayID, requesterID); err != nil {
+		return nil, err
+	}
+	j, err := s.repo.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if j == nil || j.GiveawayID != giveawayID {
+		return nil, errors.New("not found")
+	}
+	return j, nil
+}
+
+// List returns the latest jobs of a giveaway, newest first, to its creator and managers.
+func (s *Service) List(ctx context.Context, giveawayID string, requesterID int64) ([]dg.ExportJob, error) {
+	if _, err := s.managedGiveaway(ctx, giveawayID, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByGiveaway(ctx, giveawayID, listLimit)
+}
+
+// Cancel stops a queued or running job; a running one stops at its next progress update.
+func (s *Service) Cancel(ctx context.Context, giveawayID, jobID string, requesterID int64) (*dg.ExportJob, error) {
+	j, err := s.Get(ctx, giveawayID, jobID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.repo.Cancel(ctx, j.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("already finished")
+	}
+	return s.repo.Get(ctx, j.ID)
+}
+
+// DownloadURL returns a signed link to the file of a finished job, or "" before it is done.
+func (s *Service) DownloadURL(ctx context.Context, j *dg.ExportJob, ttl time.Duration) (string, error) {
+	if j.Status != dg.ExportJobDone || j.FileKey == "" || s.files == nil {
+		return "", nil
+	}
+	return s.files.SignedURL(ctx, j.FileKey, ttl)
+}
+
+// Step claims and runs one job. It reports whether there was a job to run.
+func (s *Service) Step(ctx context.Context) (bool, error) {
+	if s.files == nil {
+		return false, nil
+	}
+	j, err := s.repo.Claim(ctx, staleAfter)
+	if err != nil || j == nil {
+		return false, err
+	}
+	g, err := s.run(ctx, j)
+	switch {
+	case errors.Is(err, errCancelled):
+		log.Printf("export job %s: cancelled at %d/%d", j.ID, j.Processed, j.Total)
+	case err != nil:
+		log.Printf("export job %s: %v", j.ID, err)
+		if ferr := s.repo.Fail(ctx, j.ID, err.Error()); ferr != nil {
+			return true, ferr
+		}
+		if s.ntf != nil && g != nil {
+			_ = s.ntf.SendExportFailedDM(ctx, g, j.RequestedBy)
+		}
+	default:
+		log.Printf("export job %s: done, %d winners", j.ID, j.Total)
+		if s.ntf != nil {
+			url, err := s.files.SignedURL(ctx, FileKey(j.GiveawayID, j.Kind), LinkTTL)
+			if err != nil {
+				log.Printf("export job %s: sign link: %v", j.ID, err)
+			} else if err := s.ntf.SendExportReadyDM(ctx, g, j.RequestedBy, url); err != nil {
+				log.Printf("export job %s: notify: %v", j.ID, err)
+			}
+		}
+	}
+	return true, nil
+}
+
+// run renders the export of j into a temp file and uploads it, reporting progress as it goes.
+func (s *Service) run(ctx context.Context, j *dg.ExportJob) (*dg.Giveaway, error) {
+	g, err := s.giveaways.GetByID(ctx, j.GiveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("giveaway not found")
+	}
+	loc, ok := i18n.Lookup(j.Locale)
+	if !ok {
+		loc = i18n.Match()
+	}
+	winners, err := s.giveaways.ListWinnersWithPrizes(ctx, j.GiveawayID)
+	if err != nil {
+		return g, err
+	}
+	winners = s.orderWinners(ctx, g, winners)
+	j.Total = len(winners)
+	if err := s.progress(ctx, j, 0); err != nil {
+		return g, err
+	}
+	checks, err := s.requirementChecks(ctx, g, winners)
+	if err != nil {
+		return g, err
+	}
+	tmp, err := os.CreateTemp("", "giveaway-export-*.csv")
+	if err != nil {
+		return g, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	last := time.Now()
+	err = WriteWinnersCSV(tmp, winners, j.Kind == dg.ExportStats, loc, checks, s.userLookup(ctx), func(done int) error {
+		if time.Since(last) < progressInterval {
+			return nil
+		}
+		last = time.Now()
+		return s.progress(ctx, j, done)
+	})
+	if err != nil {
+		return g, err
+	}
+	key := FileKey(j.GiveawayID, j.Kind)
+	if err := s.upload(ctx, tmp, key, j.GiveawayID); err != nil {
+		return g, fmt.Errorf("upload: %w", err)
+	}
+	done, err := s.repo.Finish(ctx, j.ID, key)
+	if err != nil {
+		return g, err
+	}
+	if !done {
+		return g, errCancelled
+	}
+	return g, nil
+}
+
+// progress stores the rows written of j, turning a cancellation into errCancelled.
+func (s *Service) progress(ctx context.Context, j *dg.ExportJob, done int) error {
+	j.Processed = done
+	running, err := s.repo.Progress(ctx, j.ID, done, j.Total)
+	if err != nil {
+		return err
+	}
+	if !running {
+		return errCancelled
+	}
+	return nil
+}
+
+// upload stores the rendered file f under key.
+func (s *Service) upload(ctx context.Context, f *os.File, key, giveawayID string) error {
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return s.files.Put(ctx, key, f, size, storage.PutOptions{
+		ContentType:        "text/csv; charset=utf-8",
+		ContentDisposition: FileDisposition(giveawayID),
+		CacheControl:       "private, no-store",
+	})
+}
+
+// requirementChecks loads the requirement outcomes recorded for winners when they were drawn.
+func (s *Service) requirementChecks(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) (*RequirementChecks, error) {
+	ids := make([]int64, len(winners))
+	for i, w := range winners {
+		ids[i] = w.UserID
+	}
+	snapshots, err := s.giveaways.ListUserRequirementSnapshots(ctx, g.ID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return NewRequirementChecks(g.Requirements, snapshots), nil
+}
+
+// userLookup returns a winner profile lookup for WriteWinnersCSV.
+func (s *Service) userLookup(ctx context.Context) func(int64) *du.User {
+	return func(userID int64) *du.User {
+		if s.users == nil {
+			return nil
+		}
+		u, err := s.users.GetByID(ctx, userID)
+		if err != nil {
+			return nil
+		}
+		return u
+	}
+}
+
+// orderWinners lists winners in the giveaway's display order.
+func (s *Service) orderWinners(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) []dg.Winner {
+	lookup := s.userLookup(ctx)
+	return dg.OrderWinners(winners, g.WinnerOrder, g.ID, func(userID int64) string {
+		if u := lookup(userID); u != nil {
+			return dg.WinnerSortName(u.Username, u.FirstName, u.LastName)
+		}
+		return ""
+	})
+}
@@ -0,0 +1,222 @@
+package giveaway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// auditBundleVersion is bumped whenever the bundle layout changes.
+const auditBundleVersion = 3
+
+// AuditBundle is a self-contained description of a giveaway and its draw.
+// Participants are listed in the canonical order used by winner strategies,
+// so a verifier can replay the draw from the seed without learning user IDs.
+// Every user, including the creator, appears only as its ParticipantHash.
+type AuditBundle struct {
+	Version              int                        `json:"version"`
+	GeneratedAt          time.Time                  `json:"generated_at"`
+	Giveaway             AuditGiveaway              `json:"giveaway"`
+	Participants         AuditParticipants          `json:"participants"`
+	RequirementSnapshots []AuditRequirementSnapshot `json:"requirement_snapshots"`
+	Draw                 *AuditDraw                 `json:"draw,omitempty"`
+	Winners              []AuditWinner              `json:"winners"`
+}
+
+// AuditGiveaway is the giveaway configuration at export time.
+type AuditGiveaway struct {
+	ID             string            `json:"id"`
+	Creator        string            `json:"creator"`
+	Title          string            `json:"title"`
+	Description    string            `json:"description"`
+	StartedAt      time.Time         `json:"started_at"`
+	EndsAt         time.Time         `json:"ends_at"`
+	WinnersCount   int               `json:"winners_count"`
+	Status         dg.GiveawayStatus `json:"status"`
+	WinnerStrategy dg.WinnerStrategy `json:"winner_strategy"`
+	Prizes         []dg.PrizePlace   `json:"prizes"`
+	Sponsors       []dg.ChannelInfo  `json:"sponsors"`
+	Requirements   []dg.Requirement  `json:"requirements"`
+}
+
+// AuditParticipants lists hashed participant entries. SnapshotHash is the hex SHA-256 of
+// one "hash:tickets:joined_at\n" line per entry in listed order, with joined_at in RFC 3339
+// as published, so auditors can recompute it from the bundle alone.
+type AuditParticipants struct {
+	Count        int                     `json:"count"`
+	SnapshotHash string                  `json:"snapshot_hash"`
+	Entries      []AuditParticipantEntry `json:"entries"`
+}
+
+// AuditParticipantEntry identifies a participant by its ParticipantHash.
+type AuditParticipantEntry struct {
+	Hash     string    `json:"hash"`
+	Tickets  int       `json:"tickets"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// AuditDraw is the recorded draw with candidates given by ParticipantHash.
+type AuditDraw struct {
+	Strategy          dg.WinnerStrategy `json:"strategy"`
+	Seed              string            `json:"seed,omitempty"`
+	ParticipantsHash  string            `json:"participants_hash"`
+	ParticipantsCount int               `json:"participants_count"`
+	Skipped           []string          `json:"skipped"`
+	Winners           []string          `json:"winners"`
+	CreatedAt         time.Time         `json:"created_at"`
+}
+
+// AuditRequirementSnapshot is a requirement check outcome of the participant with Hash.
+type AuditRequirementSnapshot struct {
+	Hash             string             `json:"hash"`
+	RequirementIndex int                `json:"requirement_index"`
+	RequirementType  dg.RequirementType `json:"requirement_type"`
+	ChannelID        int64              `json:"channel_id,omitempty"`
+	Status           string             `json:"status"`
+	Error            string             `json:"error,omitempty"`
+	CheckedAt        time.Time          `json:"checked_at"`
+}
+
+// AuditWinner is a winner with the prizes of its place.
+type AuditWinner struct {
+	Place      int              `json:"place"`
+	Hash       string           `json:"hash"`
+	Prizes     []dg.WinnerPrize `json:"prizes,omitempty"`
+	TotalUnits int              `json:"total_units"`
+}
+
+// ParticipantHash returns the pseudonymous user identifier used in audit bundles,
+// HMAC-SHA256("<giveaway_id>:<user_id>") under key. The key stays on the server, so
+// the small space of Telegram user IDs cannot be enumerated against the hashes.
+func ParticipantHash(key []byte, giveawayID string, userID int64) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(giveawayID + ":" + strconv.FormatInt(userID, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// AuditBundle assembles the audit bundle of a giveaway, pseudonymizing users with key.
+// Access control is up to the caller.
+func (s *Service) AuditBundle(ctx context.Context, id string, key []byte) (*AuditBundle, error) {
+	if len(key) == 0 {
+		return nil, errors.New("missing pseudonym key")
+	}
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	participants, err := s.repo.ListParticipantEntries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	draw, err := s.repo.GetLatestDraw(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.repo.ListRequirementSnapshots(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	winners, err := s.repo.ListWinnersWithPrizes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	hash := func(userID int64) string { return ParticipantHash(key, g.ID, userID) }
+	hashAll := func(ids []int64) []string {
+		out := make([]string, len(ids))
+		for i, uid := range ids {
+			out[i] = hash(uid)
+		}
+		return out
+	}
+
+	canon := canonicalParticipants(participants)
+	entries := make([]AuditParticipantEntry, 0, len(canon))
+	for _, p := range canon {
+		entries = append(entries, AuditParticipantEntry{
+			Hash:     hash(p.UserID),
+			Tickets:  p.Tickets,
+			JoinedAt: p.JoinedAt.UTC(),
+		})
+	}
+	checks := make([]AuditRequirementSnapshot, 0, len(snapshots))
+	for _, sn := range snapshots {
+		checks = append(checks, AuditRequirementSnapshot{
+			Hash:             hash(sn.UserID),
+			RequirementIndex: sn.RequirementIndex,
+			RequirementType:  sn.RequirementType,
+			ChannelID:        sn.ChannelID,
+			Status:           sn.Status,
+			Error:            sn.Error,
+			CheckedAt:        sn.CheckedAt,
+		})
+	}
+	// Delivery contacts and join sources are private to the creator
+	won := make([]AuditWinner, 0, len(winners))
+	for _, w := range winners {
+		won = append(won, AuditWinner{Place: w.Place, Hash: hash(w.UserID), Prizes: w.Prizes, TotalUnits: w.TotalUnits})
+	}
+	var auditDraw *AuditDraw
+	if draw != nil {
+		auditDraw = &AuditDraw{
+			Strategy:          draw.Strategy,
+			Seed:              draw.Seed,
+			ParticipantsHash:  draw.ParticipantsHash,
+			ParticipantsCount: draw.ParticipantsCount,
+			Skipped:           hashAll(draw.Skipped),
+			Winners:           hashAll(draw.Winners),
+			CreatedAt:         draw.CreatedAt,
+		}
+	}
+	strategy := g.WinnerStrategy
+	if strategy == "" {
+		strategy = dg.WinnerStrategyRandom
+	}
+	return &AuditBundle{
+		Version:     auditBundleVersion,
+		GeneratedAt: time.Now().UTC(),
+		Giveaway: AuditGiveaway{
+			ID:             g.ID,
+			Creator:        hash(g.CreatorID),
+			Title:          g.Title,
+			Description:    g.Description,
+			StartedAt:      g.StartedAt.UTC(),
+			EndsAt:         g.EndsAt.UTC(),
+			WinnersCount:   g.MaxWinnersCount,
+			Status:         g.Status,
+			WinnerStrategy: strategy,
+			Prizes:         g.Prizes,
+			Sponsors:       g.Sponsors,
+			Requirements:   g.Requirements,
+		},
+		Participants: AuditParticipants{
+			Count:        len(canon),
+			SnapshotHash: auditEntriesHash(entries),
+			Entries:      entries,
+		},
+		RequirementSnapshots: checks,
+		Draw:                 auditDraw,
+		Winners:              won,
+	}, nil
+}
+
+// auditEntriesHash hashes the published participant entries, see AuditParticipants.
+func auditEntriesHash(entries []AuditParticipantEntry) string {
+	h := sha256.New()
+	for _, e := range entries {
+		fmt.Fprintf(h, "%s:%d:%s\n", e.Hash, e.Tickets, e.JoinedAt.Format(time.RFC3339Nano))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,97 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxBonusTickets caps the tickets a single bonus task can add.
+const maxBonusTickets = 10
+
+func validateBonusTickets(reqs []dg.Requirement) error {
+	for _, r := range reqs {
+		if r.BonusTickets < 0 || r.BonusTickets > maxBonusTickets {
+			return errors.New("bonus_tickets must be between 0 and 10")
+		}
+		if r.IsBonus() && r.Type == dg.RequirementTypeCustom {
+			// Custom tasks cannot be verified, so the bonus would be free for everyone
+			return errors.New("bonus_tickets not supported for custom requirements")
+		}
+	}
+	return nil
+}
+
+// syncBonusTasks re-verifies every bonus task of a participant, claiming met tasks and revoking
+// ones that are no longer met. Tasks whose check errors keep their current claim state.
+// Returns true when the participant's tickets changed.
+func (s *Service) syncBonusTasks(ctx context.Context, id string, uid int64, reqs []dg.Requirement) bool {
+	changed := false
+	for i := range reqs {
+		if !reqs[i].IsBonus() || reqs[i].ID == 0 {
+			continue
+		}
+		res := s.CheckSingleRequirement(ctx, uid, &reqs[i])
+		var ok bool
+		var err error
+		switch {
+		case res.Status == "success":
+			ok, err = s.repo.ClaimTask(ctx, id, uid, reqs[i].ID, reqs[i].BonusTickets)
+		case res.Error == "":
+			ok, err = s.repo.RevokeTask(ctx, id, uid, reqs[i].ID)
+		}
+		if err != nil {
+			log.Printf("bonus task %s/%d/%d: %v", id, uid, reqs[i].ID, err)
+			continue
+		}
+		changed = changed || ok
+	}
+	return changed
+}
+
+// RecheckBonusTickets re-evaluates bonus tasks of all participants in active giveaways so tasks
+// completed after joining are credited and abandoned ones (e.g. unsubscribed) are withdrawn.
+// Returns the number of participants whose tickets changed.
+func (s *Service) RecheckBonusTickets(ctx context.Context) (int64, error) {
+	ids, err := s.repo.ListActiveWithBonusRequirementIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil || g == nil || g.Status != dg.GiveawayStatusActive {
+			continue
+		}
+		updated += s.recheckGiveawayBonus(ctx, g)
+	}
+	return updated, nil
+}
+
+// recheckGiveawayBonus syncs the bonus tasks of every participant of g. Returns the number of
+// participants whose tickets changed.
+func (s *Service) recheckGiveawayBonus(ctx context.Context, g *dg.Giveaway) int64 {
+	participants, err := s.repo.ListParticipantEntries(ctx, g.ID)
+	if err != nil {
+		log.Printf("bonus recheck %s: %v", g.ID, err)
+		return 0
+	}
+	var updated int64
+	for _, p := range participants {
+		if ctx.Err() != nil {
+			break
+		}
+		if s.syncBonusTasks(ctx, g.ID, p.UserID, g.Requirements) {
+			updated++
+		}
+		// Avoid rate limits, same pacing as draws
+		time.Sleep(50 * time.Millisecond)
+	}
+	return updated
+}
@@ -0,0 +1,116 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// userBanned reports whether the platform banned userID. Unknown users are not banned.
+func (s *Service) userBanned(ctx context.Context, userID int64) bool {
+	if s.users == nil {
+		return false
+	}
+	u, err := s.users.GetByID(ctx, userID)
+	return err == nil && u != nil && u.Status == "banned"
+}
+
+// CanJoin lists everything that would make a join of userID fail now, so clients can show
+// what to do instead of a join error. Requirements are only checked once nothing else
+// blocks, since the user cannot act on them before.
+func (s *Service) CanJoin(ctx context.Context, id string, userID int64) (*dg.JoinEligibility, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || g.Status == dg.GiveawayStatusDraft {
+		return nil, errors.New("not found")
+	}
+	now := time.Now()
+	var blockers []dg.JoinBlocker
+	switch g.Status {
+	case dg.GiveawayStatusActive:
+	case dg.GiveawayStatusScheduled:
+		b := dg.JoinBlocker{Code: dg.JoinBlockerNotStarted}
+		if g.StartedAt.After(now) {
+			startsAt := g.StartedAt.UTC()
+			b.StartsAt = &startsAt
+		}
+		blockers = append(blockers, b)
+	case dg.GiveawayStatusCancelled:
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerCancelled})
+	default:
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerEnded})
+	}
+	if g.CreatorID == userID {
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerCreator})
+	} else if ok, err := s.repo.IsManager(ctx, id, userID); err != nil {
+		return nil, err
+	} else if ok {
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerManager})
+	}
+	if s.userBanned(ctx, userID) {
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerBanned})
+	}
+	joined, err := s.repo.IsParticipant(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if joined {
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerAlreadyJoined})
+	} else if g.MaxParticipants > 0 && g.ParticipantsCount >= g.MaxParticipants {
+		blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerFull})
+	}
+	if g.Status == dg.GiveawayStatusActive {
+		var closed *JoinWindowClosedError
+		if err := CheckJoinWindow(g, now); errors.As(err, &closed) {
+			blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerWindowClosed, OpensAt: closed.OpensAt})
+		}
+	}
+	if len(blockers) == 0 {
+		unmet := s.unmetRequirements(ctx, userID, g.Requirements)
+		s.recordUnmet(ctx, g, userID, unmet)
+		if len(unmet) > 0 {
+			blockers = append(blockers, dg.JoinBlocker{Code: dg.JoinBlockerRequirements, Requirements: unmet})
+		}
+	}
+	if blockers == nil {
+		blockers = []dg.JoinBlocker{}
+	}
+	return &dg.JoinEligibility{GiveawayID: g.ID, CanJoin: len(blockers) == 0, Blockers: blockers}, nil
+}
+
+// unmetRequirements checks every required requirement like CheckRequirements, without
+// stopping at the first miss. The checks run concurrently.
+func (s *Service) unmetRequirements(ctx context.Context, userID int64, reqs []dg.Requirement) []dg.UnmetRequirement {
+	required := make([]dg.Requirement, 0, len(reqs))
+	for _, req := range reqs {
+		if !req.IsOptional() {
+			required = append(required, req)
+		}
+	}
+	results := s.CheckAllRequirements(ctx, userID, required)
+	var out []dg.UnmetRequirement
+	for i, req := range required {
+		res := results[i]
+		if res.Status == "success" {
+			continue
+		}
+		out = append(out, dg.UnmetRequirement{
+			ID:              req.ID,
+			Type:            req.Type,
+			ChannelID:       req.ChannelID,
+			ChannelUsername: req.ChannelUsername,
+			ChannelTitle:    req.ChannelTitle,
+			ChannelURL:      req.ChannelURL,
+			Status:          res.Status,
+			Error:           res.Error,
+		})
+	}
+	return out
+}
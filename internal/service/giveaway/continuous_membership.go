@@ -0,0 +1,58 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxUnsubscribeGrace bounds how long participants may take to re-subscribe.
+const maxUnsubscribeGrace = 7 * 24 * time.Hour
+
+func validateUnsubscribeGrace(g *dg.Giveaway) error {
+	if d := g.UnsubscribeGraceSec; d != nil && (*d < 0 || time.Duration(*d)*time.Second > maxUnsubscribeGrace) {
+		return errors.New("unsubscribe_grace_sec must be between 0 and 7 days")
+	}
+	return nil
+}
+
+// recordMembershipLapses makes a participant who left a required channel ineligible in the
+// giveaways with continuous membership and warns them once per giveaway. Warnings are best-effort.
+func (s *Service) recordMembershipLapses(ctx context.Context, channelID, userID int64, at time.Time) error {
+	lapses, err := s.repo.RecordMembershipLapses(ctx, channelID, userID, at)
+	if err != nil || len(lapses) == 0 || s.ntf == nil {
+		return err
+	}
+	for _, l := range lapses {
+		g, err := s.repo.GetByID(ctx, l.GiveawayID)
+		if err != nil || g == nil {
+			continue
+		}
+		if err := s.ntf.SendMembershipLapseDM(ctx, g, userID, requirementChannelName(g, channelID), l.GraceUntil); err != nil {
+			log.Printf("membership lapse warning %s/%d: %v", g.ID, userID, err)
+		}
+	}
+	return nil
+}
+
+// lapsedEligible wraps a draw eligibility check so participants who left a required channel and
+// did not re-subscribe in time are skipped without checking their requirements.
+func (s *Service) lapsedEligible(ctx context.Context, id string, eligible func(uid int64) bool) (func(uid int64) bool, error) {
+	ids, err := s.repo.ListLapsedUserIDs(ctx, id)
+	if err != nil || len(ids) == 0 {
+		return eligible, err
+	}
+	lapsed := make(map[int64]struct{}, len(ids))
+	for _, uid := range ids {
+		lapsed[uid] = struct{}{}
+	}
+	return func(uid int64) bool {
+		if _, ok := lapsed[uid]; ok {
+			return false
+		}
+		return eligible(uid)
+	}, nil
+}
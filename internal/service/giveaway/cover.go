@@ -0,0 +1,109 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+)
+
+// coverLinkTTL is how long cover links handed to Telegram and clients stay valid. Telegram
+// fetches the file right away and keeps its own copy.
+const coverLinkTTL = time.Hour
+
+// WithCovers stores uploaded covers in files; without it covers cannot be uploaded.
+func (s *Service) WithCovers(files storage.Storage) *Service { s.covers = files; return s }
+
+// SetCover uploads size bytes of body as the cover of a giveaway, replacing the previous one.
+// Covers can change until the giveaway ends. Only the creator can set it.
+func (s *Service) SetCover(ctx context.Context, id string, requesterID int64, contentType string, body io.Reader, size int64) (*dg.Cover, error) {
+	if s.covers == nil {
+		return nil, errors.New("storage not configured")
+	}
+	kind, ok := dg.CoverKindOf(contentType)
+	if !ok {
+		return nil, errors.New("unsupported cover type")
+	}
+	if size <= 0 {
+		return nil, errors.New("empty cover")
+	}
+	if size > kind.MaxSize() {
+		return nil, fmt.Errorf("cover exceeds %d MB", kind.MaxSize()>>20)
+	}
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if coverLocked(g) {
+		return nil, errors.New("giveaway already finished")
+	}
+	now := time.Now().UTC()
+	c := &dg.Cover{
+		// A new key per upload, so links to the old cover never serve the new one
+		Key:         fmt.Sprintf("covers/giveaways/%s/%d", g.ID, now.UnixNano()),
+		ContentType: contentType,
+		Kind:        kind,
+		Size:        size,
+		UpdatedAt:   now,
+	}
+	if err := s.covers.Put(ctx, c.Key, body, size, storage.PutOptions{ContentType: contentType, CacheControl: "private, max-age=3600"}); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetCover(ctx, g.ID, c); err != nil {
+		_ = s.covers.Delete(ctx, c.Key)
+		return nil, err
+	}
+	s.dropCover(ctx, g)
+	return c, nil
+}
+
+// RemoveCover restores the default animation of a giveaway. Only the creator can remove it.
+func (s *Service) RemoveCover(ctx context.Context, id string, requesterID int64) error {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return err
+	}
+	if g.Cover == nil {
+		return nil
+	}
+	if coverLocked(g) {
+		return errors.New("giveaway already finished")
+	}
+	if err := s.repo.SetCover(ctx, g.ID, nil); err != nil {
+		return err
+	}
+	s.dropCover(ctx, g)
+	return nil
+}
+
+// CoverURL returns a short-lived link to the cover of g, or "" when it has none.
+func (s *Service) CoverURL(ctx context.Context, g *dg.Giveaway) (string, error) {
+	if g == nil || g.Cover == nil || s.covers == nil {
+		return "", nil
+	}
+	return s.covers.SignedURL(ctx, g.Cover.Key, coverLinkTTL)
+}
+
+// coverLocked reports whether the cover of g can no longer change.
+func coverLocked(g *dg.Giveaway) bool {
+	switch g.Status {
+	case dg.GiveawayStatusCompleted, dg.GiveawayStatusFinished, dg.GiveawayStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// dropCover deletes the replaced cover file of g; a leftover file only costs storage.
+func (s *Service) dropCover(ctx context.Context, g *dg.Giveaway) {
+	if g.Cover == nil {
+		return
+	}
+	if err := s.covers.Delete(ctx, g.Cover.Key); err != nil {
+		log.Printf("giveaway %s: delete old cover: %v", g.ID, err)
+	}
+}
@@ -0,0 +1,18 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// PendingActions lists the giveaways of a creator that wait for them: manual winners, escrow
+// deposits, prize disputes and unclaimed prizes without a reserve, soonest deadline first.
+func (s *Service) PendingActions(ctx context.Context, creatorID int64) ([]dg.CreatorAction, error) {
+	if creatorID == 0 {
+		return nil, errors.New("missing creator_id")
+	}
+	return s.repo.ListCreatorActions(ctx, creatorID, int(s.pendingTTL/time.Second))
+}
@@ -0,0 +1,69 @@
+package giveaway
+
+import (
+	"context"
+	"log"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+// maxDeadPerSweep bounds the giveaways flagged in one detector run
+const maxDeadPerSweep = 100
+
+// deadFlagMatch is the text shown in the moderation queue for each dead reason.
+var deadFlagMatch = map[dg.DeadReason]string{
+	dg.DeadCreatorBlockedBot:    "creator blocked the bot",
+	dg.DeadChannelsInaccessible: "bot lost access to all requirement channels",
+}
+
+// HandleCreatorBotBlocked records that userID blocked (or unblocked) the bot in private chat.
+// Blocking re-runs the detector so the creator's running giveaways are queued for review at once.
+func (s *Service) HandleCreatorBotBlocked(ctx context.Context, userID int64, blocked bool) error {
+	if err := s.repo.SetBotBlocked(ctx, userID, blocked); err != nil {
+		return err
+	}
+	if !blocked {
+		return nil
+	}
+	_, err := s.DetectDeadGiveaways(ctx)
+	return err
+}
+
+// DetectDeadGiveaways flags running giveaways that can no longer complete normally because their
+// creator blocked the bot or the bot lost access to all requirement channels. Each one is queued
+// for platform review once and its participants are warned of a possible cancellation. Returns
+// how many giveaways were flagged.
+func (s *Service) DetectDeadGiveaways(ctx context.Context) (int, error) {
+	found, err := s.repo.ListDeadCandidates(ctx, maxDeadPerSweep)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, d := range found {
+		g, err := s.repo.GetByID(ctx, d.GiveawayID)
+		if err != nil || g == nil {
+			continue
+		}
+		ok, err := s.repo.MarkDead(ctx, d, deadFlagMatch[d.Reason], notify.DeadWarningMessage(g, d.Reason))
+		if err != nil {
+			log.Printf("dead giveaway %s: %v", d.GiveawayID, err)
+			continue
+		}
+		if ok {
+			log.Printf("giveaway %s queued for review: %s", d.GiveawayID, d.Reason)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeadReviewReason returns why a giveaway is awaiting review by the dead giveaway detector, or "".
+func (s *Service) DeadReviewReason(ctx context.Context, id string) dg.DeadReason {
+	reason, err := s.repo.DeadReviewReason(ctx, id)
+	if err != nil {
+		log.Printf("dead review reason %s: %v", id, err)
+		return ""
+	}
+	return reason
+}
@@ -0,0 +1,115 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// createDraft stores a validated giveaway as a draft. The escrow amount is only checked here;
+// the deposit address, the approval hold and duplicate detection come with Publish.
+func (s *Service) createDraft(ctx context.Context, g *dg.Giveaway) (string, error) {
+	if err := s.checkDraftEscrow(g); err != nil {
+		return "", err
+	}
+	g.ID = uuid.NewString()
+	if g.CreatedAt.IsZero() {
+		g.CreatedAt = time.Now().UTC()
+	}
+	g.UpdatedAt = time.Now().UTC()
+	g.Approval = nil
+	if err := s.repo.Create(ctx, g); err != nil {
+		return "", err
+	}
+	return g.ID, nil
+}
+
+// checkDraftEscrow validates the escrow amount of a draft and keeps only the amount.
+func (s *Service) checkDraftEscrow(g *dg.Giveaway) error {
+	if g.Funding == nil {
+		return nil
+	}
+	amount := g.Funding.AmountNano
+	if err := s.prepareFunding(g.Funding); err != nil {
+		return err
+	}
+	g.Funding = &dg.Funding{AmountNano: amount}
+	return nil
+}
+
+// UpdateDraft replaces every setting of a draft with g, validated like a new giveaway.
+// Only the creator can edit it, and only while it is a draft.
+func (s *Service) UpdateDraft(ctx context.Context, id string, g *dg.Giveaway) error {
+	cur, err := s.loadOwnedGiveaway(ctx, id, g.CreatorID)
+	if err != nil {
+		return err
+	}
+	if cur.Status != dg.GiveawayStatusDraft {
+		return errors.New("not a draft")
+	}
+	if err := s.validateNew(g); err != nil {
+		return err
+	}
+	if err := s.checkDraftEscrow(g); err != nil {
+		return err
+	}
+	g.ID = id
+	g.Status = dg.GiveawayStatusDraft
+	ok, err := s.repo.ReplaceDraft(ctx, g)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not a draft")
+	}
+	return nil
+}
+
+// Publish starts a draft now for its duration. Like Create, it stays scheduled while an escrow
+// deposit or an admin approval is outstanding.
+func (s *Service) Publish(ctx context.Context, id string, requesterID int64) (*dg.Giveaway, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status != dg.GiveawayStatusDraft {
+		return nil, errors.New("not a draft")
+	}
+	now := time.Now().UTC()
+	g.StartedAt = now
+	g.EndsAt = now.Add(time.Duration(g.Duration) * time.Second)
+	g.Status = dg.GiveawayStatusActive
+	if g.Funding != nil {
+		if err := s.prepareFunding(g.Funding); err != nil {
+			return nil, err
+		}
+		g.Status = dg.GiveawayStatusScheduled
+	}
+	if err := s.holdForApproval(ctx, g); err != nil {
+		return nil, err
+	}
+	if g.Approval != nil {
+		g.Status = dg.GiveawayStatusScheduled
+	}
+	ok, err := s.repo.Publish(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("not a draft")
+	}
+	s.trackEndsAt(ctx, g)
+	return g, nil
+}
+
+// ListDrafts returns the drafts of a creator, most recently edited first.
+func (s *Service) ListDrafts(ctx context.Context, creatorID int64, limit, offset int) ([]dg.Giveaway, error) {
+	if creatorID == 0 {
+		return nil, errors.New("missing creator_id")
+	}
+	return s.repo.ListDrafts(ctx, creatorID, limit, offset)
+}
@@ -0,0 +1,224 @@
+package giveaway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/random"
+)
+
+// RedrawResult compares a replay of the recorded draw with the stored winners.
+type RedrawResult struct {
+	DryRun                   bool              `json:"dry_run"`
+	Strategy                 dg.WinnerStrategy `json:"strategy"`
+	Seed                     string            `json:"seed"`
+	RecordedParticipantsHash string            `json:"recorded_participants_hash"`
+	ParticipantsHash         string            `json:"participants_hash"`
+	ParticipantsMatch        bool              `json:"participants_match"`
+	RecordedWinners          []int64           `json:"recorded_winners"`
+	Winners                  []int64           `json:"winners"`
+	Reproduced               bool              `json:"reproduced"`
+}
+
+// FairnessInfo describes how winners were drawn. Seed is only disclosed after completion.
+type FairnessInfo struct {
+	Strategy          dg.WinnerStrategy `json:"strategy"`
+	ParticipantsHash  string            `json:"participants_hash"`
+	ParticipantsCount int               `json:"participants_count"`
+	SkippedCount      int               `json:"skipped_count"`
+	DrawnAt           time.Time         `json:"drawn_at"`
+	Seed              string            `json:"seed,omitempty"`
+}
+
+// runDraw orders candidates with the strategy seeded by seed and keeps the first
+// winnersCount candidates accepted by eligible. The next accepted candidates become reserves,
+// so the winners are the same with or without reserves.
+func runDraw(g *dg.Giveaway, name dg.WinnerStrategy, seed [random.SeedSize]byte, participants []dg.Participant, eligible func(uid int64) bool) (*dg.Draw, error) {
+	strategy, err := strategyFor(name)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = dg.WinnerStrategyRandom
+	}
+	canon := canonicalParticipants(participants)
+	candidates, err := strategy.Candidates(random.NewSeeded(seed), canon)
+	if err != nil {
+		return nil, err
+	}
+	winnersCount := g.MaxWinnersCount
+	if winnersCount <= 0 {
+		winnersCount = 1
+	}
+	d := &dg.Draw{
+		GiveawayID:        g.ID,
+		Strategy:          name,
+		Seed:              hex.EncodeToString(seed[:]),
+		ParticipantsHash:  participantsHash(canon),
+		ParticipantsCount: len(canon),
+		Skipped:           []int64{},
+		Winners:           make([]int64, 0, winnersCount),
+	}
+	for _, uid := range candidates {
+		if len(d.Winners) >= winnersCount && len(d.Reserves) >= g.ReserveWinnersCount {
+			break
+		}
+		switch {
+		case !eligible(uid):
+			d.Skipped = append(d.Skipped, uid)
+		case len(d.Winners) < winnersCount:
+			d.Winners = append(d.Winners, uid)
+		default:
+			d.Reserves = append(d.Reserves, uid)
+		}
+	}
+	return d, nil
+}
+
+// canonicalParticipants returns a copy sorted by user ID, the order strategies receive.
+func canonicalParticipants(participants []dg.Participant) []dg.Participant {
+	out := append([]dg.Participant(nil), participants...)
+	sort.Slice(out, func(i, j int) bool { return out[i].UserID < out[j].UserID })
+	return out
+}
+
+// participantsHash fingerprints the participant snapshot (user, tickets, join time).
+func participantsHash(canon []dg.Participant) string {
+	h := sha256.New()
+	for _, p := range canon {
+		fmt.Fprintf(h, "%d:%d:%d\n", p.UserID, p.Tickets, p.JoinedAt.UnixMicro())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Redraw replays the recorded draw of a giveaway from its seed and current participant
+// snapshot, reusing the recorded requirement outcomes. Only dry runs are supported.
+func (s *Service) Redraw(ctx context.Context, id string, requesterID int64, dryRun bool) (*RedrawResult, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	if !dryRun {
+		return nil, errors.New("only dry_run redraws are supported")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	d, err := s.repo.GetLatestDraw(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, errors.New("no draw recorded")
+	}
+	seed, err := random.ParseSeed(d.Seed)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := s.repo.ListParticipantEntries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Wave winners drawn before this draw were not candidates; the draw itself fixed the count
+	waveWinners, err := s.repo.ListWaveWinnerIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(waveWinners) > 0 {
+		own := make(map[int64]struct{}, len(d.Winners))
+		for _, uid := range d.Winners {
+			own[uid] = struct{}{}
+		}
+		earlier := make([]int64, 0, len(waveWinners))
+		for _, uid := range waveWinners {
+			if _, ok := own[uid]; !ok {
+				earlier = append(earlier, uid)
+			}
+		}
+		participants = excludeParticipants(participants, earlier)
+		if len(d.Winners) > 0 {
+			gr := *g
+			gr.MaxWinnersCount = len(d.Winners)
+			g = &gr
+		}
+	}
+	skipped := make(map[int64]struct{}, len(d.Skipped))
+	for _, uid := range d.Skipped {
+		skipped[uid] = struct{}{}
+	}
+	replay, err := runDraw(g, d.Strategy, seed, participants, func(uid int64) bool {
+		_, ok := skipped[uid]
+		return !ok
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &RedrawResult{
+		DryRun:                   true,
+		Strategy:                 d.Strategy,
+		Seed:                     d.Seed,
+		RecordedParticipantsHash: d.ParticipantsHash,
+		ParticipantsHash:         replay.ParticipantsHash,
+		ParticipantsMatch:        replay.ParticipantsHash == d.ParticipantsHash,
+		RecordedWinners:          d.Winners,
+		Winners:                  replay.Winners,
+		Reproduced:               equalIDs(replay.Winners, d.Winners),
+	}
+	return res, nil
+}
+
+// Fairness returns public draw metadata of a giveaway.
+func (s *Service) Fairness(ctx context.Context, id string) (*FairnessInfo, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	d, err := s.repo.GetLatestDraw(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, errors.New("no draw recorded")
+	}
+	info := &FairnessInfo{
+		Strategy:          d.Strategy,
+		ParticipantsHash:  d.ParticipantsHash,
+		ParticipantsCount: d.ParticipantsCount,
+		SkippedCount:      len(d.Skipped),
+		DrawnAt:           d.CreatedAt,
+	}
+	if g.Status == dg.GiveawayStatusCompleted || g.Status == dg.GiveawayStatusFinished {
+		info.Seed = d.Seed
+	}
+	return info, nil
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package giveaway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// duplicateWindow is how long a created giveaway blocks identical submissions by its creator.
+const duplicateWindow = 10 * time.Minute
+
+// duplicatePending marks a submission whose giveaway is still being created.
+const duplicatePending = "creating"
+
+// DuplicateError rejects a giveaway identical to one the creator submitted within the last
+// ten minutes. ExistingID is empty while that giveaway is still being created.
+type DuplicateError struct {
+	ExistingID string
+}
+
+func (e *DuplicateError) Error() string { return "duplicate giveaway" }
+
+// contentHash identifies a submission by normalized title, prizes and channels, ignoring
+// their order and letter case.
+func contentHash(g *dg.Giveaway) string {
+	prizes := make([]string, 0, len(g.Prizes))
+	for _, p := range g.Prizes {
+		prizes = append(prizes, normalizeText(p.Title)+"|"+strconv.Itoa(p.Quantity))
+	}
+	for _, p := range g.PrizePackages {
+		places := strconv.Itoa(p.FromPlace) + "-" + strconv.Itoa(p.ToPlace)
+		for _, it := range p.Items {
+			prizes = append(prizes, normalizeText(it.Title)+"|"+strconv.Itoa(it.Quantity)+"|"+places)
+		}
+	}
+	sort.Strings(prizes)
+	channels := make([]string, 0, len(g.Requirements)+len(g.Sponsors))
+	for _, r := range g.Requirements {
+		switch {
+		case r.ChannelID != 0:
+			channels = append(channels, string(r.Type)+":"+strconv.FormatInt(r.ChannelID, 10))
+		case r.ChannelUsername != "":
+			channels = append(channels, string(r.Type)+":@"+strings.ToLower(r.ChannelUsername))
+		}
+	}
+	for _, sp := range g.Sponsors {
+		channels = append(channels, "sponsor:"+strconv.FormatInt(sp.ID, 10))
+	}
+	sort.Strings(channels)
+	sum := sha256.Sum256([]byte(normalizeText(g.Title) + "\x00" + strings.Join(prizes, "\x01") + "\x00" + strings.Join(channels, "\x01")))
+	return hex.EncodeToString(sum[:16])
+}
+
+func normalizeText(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+func duplicateKey(creatorID int64, hash string) string {
+	return fmt.Sprintf("giveaway:dup:%d:%s", creatorID, hash)
+}
+
+// reserveDuplicate claims the content hash of g before it is stored. Without allow it returns
+// a *DuplicateError when the hash is taken; with allow it proceeds and sets g.DuplicateOf.
+// The returned key is empty when nothing was claimed.
+func (s *Service) reserveDuplicate(ctx context.Context, g *dg.Giveaway, allow bool) (string, error) {
+	if s.rdb == nil {
+		return "", nil
+	}
+	key := duplicateKey(g.CreatorID, contentHash(g))
+	ok, err := s.rdb.SetNX(ctx, key, duplicatePending, duplicateWindow).Result()
+	if err != nil {
+		// Detection is best-effort; never block creation on Redis
+		log.Printf("duplicate check: %v", err)
+		return "", nil
+	}
+	if ok {
+		return key, nil
+	}
+	existing, _ := s.rdb.Get(ctx, key).Result()
+	if existing == duplicatePending {
+		existing = ""
+	}
+	if !allow {
+		return "", &DuplicateError{ExistingID: existing}
+	}
+	g.DuplicateOf = existing
+	return "", nil
+}
+
+// settleDuplicate points a claimed hash at the created giveaway, or releases it when
+// creation failed.
+func (s *Service) settleDuplicate(ctx context.Context, key, id string) {
+	if key == "" {
+		return
+	}
+	if id == "" {
+		_ = s.rdb.Del(ctx, key).Err()
+		return
+	}
+	_ = s.rdb.Set(ctx, key, id, duplicateWindow).Err()
+}
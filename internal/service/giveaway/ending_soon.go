@@ -0,0 +1,111 @@
+package giveaway
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// endsAtKey is the sorted set of active giveaway ids scored by ends_at in unix seconds.
+const endsAtKey = "giveaways:active:ends_at"
+
+// WithBadges sets the thresholds of the ending soon and just started list badges.
+func (s *Service) WithBadges(t dg.BadgeThresholds) *Service { s.badges = t; return s }
+
+// ApplyBadges sets the list badges of g as of now.
+func (s *Service) ApplyBadges(g *dg.Giveaway) {
+	g.ApplyBadges(time.Now(), s.badges)
+}
+
+// applyBadges sets the list badges of every giveaway in list.
+func (s *Service) applyBadges(list []dg.Giveaway) []dg.Giveaway {
+	now := time.Now()
+	for i := range list {
+		list[i].ApplyBadges(now, s.badges)
+	}
+	return list
+}
+
+// trackEndsAt adds an active giveaway to the ending soon set and removes any other. Failures
+// are left to SyncEndingSoon.
+func (s *Service) trackEndsAt(ctx context.Context, g *dg.Giveaway) {
+	if s.rdb == nil {
+		return
+	}
+	if g.Status == dg.GiveawayStatusActive {
+		_ = s.rdb.ZAdd(ctx, endsAtKey, redis.Z{Score: float64(g.EndsAt.Unix()), Member: g.ID}).Err()
+		return
+	}
+	_ = s.rdb.ZRem(ctx, endsAtKey, g.ID).Err()
+}
+
+// ListEndingSoon returns active giveaways that have not ended yet, soonest end first. With
+// Redis the page is read from the ending soon set; ids that are no longer active are dropped
+// from it on the way, so a page may come out short until the next sync.
+func (s *Service) ListEndingSoon(ctx context.Context, limit, offset int) ([]dg.Giveaway, error) {
+	if s.rdb == nil {
+		list, err := s.repo.ListEndingSoon(ctx, limit, offset)
+		return s.applyBadges(list), err
+	}
+	ids, err := s.rdb.ZRangeByScore(ctx, endsAtKey, &redis.ZRangeBy{
+		Min:    "(" + strconv.FormatInt(time.Now().Unix(), 10),
+		Max:    "+inf",
+		Offset: int64(offset),
+		Count:  int64(limit),
+	}).Result()
+	if err != nil {
+		list, err := s.repo.ListEndingSoon(ctx, limit, offset)
+		return s.applyBadges(list), err
+	}
+	list, err := s.repo.ListActiveByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) < len(ids) {
+		active := make(map[string]bool, len(list))
+		for _, g := range list {
+			active[g.ID] = true
+		}
+		var stale []any
+		for _, id := range ids {
+			if !active[id] {
+				stale = append(stale, id)
+			}
+		}
+		_ = s.rdb.ZRem(ctx, endsAtKey, stale...).Err()
+	}
+	return s.applyBadges(list), nil
+}
+
+// SyncEndingSoon rebuilds the ending soon set from the database and returns its size. It
+// picks up giveaways activated or finished outside the service, e.g. by funding or approval.
+func (s *Service) SyncEndingSoon(ctx context.Context) (int, error) {
+	if s.rdb == nil {
+		return 0, nil
+	}
+	active, err := s.repo.ListActiveEndsAt(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(active) == 0 {
+		return 0, s.rdb.Del(ctx, endsAtKey).Err()
+	}
+	members := make([]redis.Z, 0, len(active))
+	for id, endsAt := range active {
+		members = append(members, redis.Z{Score: float64(endsAt.Unix()), Member: id})
+	}
+	// Build aside and swap so readers never see a partial set
+	tmp := endsAtKey + ":sync"
+	pipe := s.rdb.TxPipeline()
+	pipe.Del(ctx, tmp)
+	pipe.ZAdd(ctx, tmp, members...)
+	pipe.Rename(ctx, tmp, endsAtKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return len(members), nil
+}
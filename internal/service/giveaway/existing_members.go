@@ -0,0 +1,68 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+func validateExistingMembers(reqs []dg.Requirement) error {
+	for _, r := range reqs {
+		if !r.ExistingMembersOnly {
+			continue
+		}
+		if r.Type != dg.RequirementTypeSubscription {
+			return errors.New("existing_members_only is only supported for subscription requirements")
+		}
+		// Join events carry numeric chat ids only
+		if r.ChannelID == 0 {
+			return errors.New("existing_members_only requires a channel_id")
+		}
+	}
+	return nil
+}
+
+// checkExistingMember fails a met subscription requirement when the user joined the channel
+// after the giveaway's membership snapshot.
+func (s *Service) checkExistingMember(ctx context.Context, userID int64, rqm *dg.Requirement, res CheckRequirementResult) CheckRequirementResult {
+	if !rqm.ExistingMembersOnly || rqm.ID == 0 || res.Status != "success" {
+		return res
+	}
+	after, err := s.repo.JoinedAfterSnapshot(ctx, rqm.ID, userID)
+	if err != nil {
+		return CheckRequirementResult{Status: "failed", Error: err.Error()}
+	}
+	if after {
+		return CheckRequirementResult{Status: "failed", Error: "joined the channel after the giveaway started"}
+	}
+	return res
+}
+
+// HandleMemberJoined records a channel join reported by the bot so later requirement checks
+// can tell existing members from users who joined during the giveaway, and how long they are members.
+// A join within the grace period of a continuous-membership giveaway restores the participant.
+func (s *Service) HandleMemberJoined(ctx context.Context, channelID, userID int64, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	if _, err := s.repo.RecordMemberJoin(ctx, channelID, userID, at); err != nil {
+		return err
+	}
+	if err := s.repo.RecordMemberSeen(ctx, channelID, userID, at); err != nil {
+		return err
+	}
+	_, err := s.repo.ClearMembershipLapses(ctx, channelID, userID, at)
+	return err
+}
+
+// pruneMemberJoins drops joins no running giveaway needs anymore.
+func (s *Service) pruneMemberJoins(ctx context.Context) {
+	if n, err := s.repo.PruneMemberJoins(ctx); err != nil {
+		log.Printf("prune member joins: %v", err)
+	} else if n > 0 {
+		log.Printf("pruned %d member joins", n)
+	}
+}
@@ -0,0 +1,82 @@
+package giveaway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxPerFingerprint bounds the per-giveaway accounts-per-fingerprint cap.
+const maxPerFingerprint = 100
+
+// fingerprintNotifyEvery throttles creator DMs about flagged joins per giveaway.
+const fingerprintNotifyEvery = time.Hour
+
+// JoinClient is what the joining client revealed about its device.
+type JoinClient struct {
+	IP        string
+	UserAgent string
+}
+
+// WithFingerprintSecret enables join fingerprints keyed by secret.
+func (s *Service) WithFingerprintSecret(secret string) *Service {
+	if secret != "" {
+		s.fingerprintKey = []byte(secret)
+	}
+	return s
+}
+
+// fingerprint hashes IP and user agent with the giveaway id, so the same device cannot be
+// linked across giveaways and the raw values are not recoverable.
+func (s *Service) fingerprint(id string, c JoinClient) string {
+	mac := hmac.New(sha256.New, s.fingerprintKey)
+	mac.Write([]byte(id + "\x00" + c.IP + "\x00" + c.UserAgent))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// recordFingerprint stores the join fingerprint and tells the creator when the join went over
+// the giveaway's accounts-per-fingerprint cap. Errors are logged; the join itself stands.
+func (s *Service) recordFingerprint(ctx context.Context, g *dg.Giveaway, userID int64, c JoinClient) {
+	if s.fingerprintKey == nil || c.IP == "" {
+		return
+	}
+	flagged, accounts, err := s.repo.RecordFingerprint(ctx, g.ID, userID, s.fingerprint(g.ID, c), g.MaxPerFingerprint)
+	if err != nil {
+		log.Printf("record fingerprint %s/%d: %v", g.ID, userID, err)
+		return
+	}
+	if !flagged || s.ntf == nil {
+		return
+	}
+	if s.rdb != nil {
+		ok, err := s.rdb.SetNX(ctx, "giveaway:"+g.ID+":fingerprint_notified", 1, fingerprintNotifyEvery).Result()
+		if err != nil || !ok {
+			return
+		}
+	}
+	s.ntf.NotifyCreatorFingerprintFlag(ctx, g, accounts)
+}
+
+// ListFingerprintFlags returns joins that went over the accounts-per-fingerprint cap. Access: creator only.
+func (s *Service) ListFingerprintFlags(ctx context.Context, id string, requesterID int64) ([]dg.FingerprintFlag, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.ListFingerprintFlags(ctx, id)
+}
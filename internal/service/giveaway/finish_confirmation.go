@@ -0,0 +1,256 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// errAwaitingConfirmation holds a finish until a giveaway admin confirms it.
+var errAwaitingConfirmation = errors.New("awaiting confirmation")
+
+// WithFinishConfirmation makes finishing giveaways with more than minParticipants participants wait
+// for a co-admin confirmation, and previews pending giveaways to their admins lead before they
+// resolve automatically. Zero values disable either.
+func (s *Service) WithFinishConfirmation(minParticipants int, lead time.Duration) *Service {
+	s.finishConfirmMin = minParticipants
+	s.finishPreviewLead = lead
+	return s
+}
+
+func (s *Service) finishConfirmRequired(g *dg.Giveaway) bool {
+	return s.finishConfirmMin > 0 && g.ParticipantsCount > s.finishConfirmMin
+}
+
+// giveawayAdmins returns the creator followed by the human admins of the sponsor channels.
+// Channels the bot cannot read are skipped.
+func (s *Service) giveawayAdmins(ctx context.Context, g *dg.Giveaway) []int64 {
+	admins := []int64{g.CreatorID}
+	if s.tg == nil {
+		return admins
+	}
+	seen := map[int64]bool{g.CreatorID: true}
+	for _, ch := range g.Sponsors {
+		if ch.ID == 0 {
+			continue
+		}
+		ids, err := s.tg.GetChatAdministrators(ctx, strconv.FormatInt(ch.ID, 10))
+		if err != nil {
+			log.Printf("admins of channel %d: %v", ch.ID, err)
+			continue
+		}
+		for _, uid := range ids {
+			if !seen[uid] {
+				seen[uid] = true
+				admins = append(admins, uid)
+			}
+		}
+	}
+	return admins
+}
+
+// finishConfirmed reports whether the giveaway may be finished by trigger now. Giveaways above
+// the participant threshold wait for an admin confirmation, which is requested on first call;
+// an aborted preview stops the automatic resolution. A creator confirmation below the threshold
+// only notifies the admins.
+func (s *Service) finishConfirmed(ctx context.Context, g *dg.Giveaway, trigger dg.FinishTrigger) (bool, error) {
+	c, err := s.repo.GetFinishConfirmation(ctx, g.ID)
+	if err != nil {
+		return false, err
+	}
+	if c != nil && c.Trigger == trigger {
+		switch {
+		case c.Status == dg.FinishConfirmed:
+			return true, nil
+		case c.Status == dg.FinishAwaiting && c.Required:
+			return false, nil
+		case c.Status == dg.FinishAborted && trigger == dg.FinishTriggerPendingExpiry:
+			return false, nil
+		}
+	}
+	// The creator is already waiting for admins to confirm their winners
+	if c != nil && c.Trigger == dg.FinishTriggerCreatorConfirm && c.Status == dg.FinishAwaiting && trigger == dg.FinishTriggerPendingExpiry {
+		return false, nil
+	}
+	if !s.finishConfirmRequired(g) {
+		if trigger == dg.FinishTriggerCreatorConfirm {
+			go s.notifyFinishPreview(context.Background(), g, &dg.FinishConfirmation{GiveawayID: g.ID, Trigger: trigger, Status: dg.FinishConfirmed, ParticipantsCount: g.ParticipantsCount})
+		}
+		return true, nil
+	}
+	if _, err := s.requestFinishConfirmation(ctx, g, trigger); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// requestFinishConfirmation opens a finish preview and sends it to the giveaway admins.
+func (s *Service) requestFinishConfirmation(ctx context.Context, g *dg.Giveaway, trigger dg.FinishTrigger) (*dg.FinishConfirmation, error) {
+	c := &dg.FinishConfirmation{
+		GiveawayID:        g.ID,
+		Trigger:           trigger,
+		Required:          s.finishConfirmRequired(g),
+		ParticipantsCount: g.ParticipantsCount,
+	}
+	if err := s.repo.RequestFinishConfirmation(ctx, c); err != nil {
+		return nil, err
+	}
+	go s.notifyFinishPreview(context.Background(), g, c)
+	return c, nil
+}
+
+// notifyFinishPreview DMs the finish summary to every giveaway admin. Best-effort.
+func (s *Service) notifyFinishPreview(ctx context.Context, g *dg.Giveaway, c *dg.FinishConfirmation) {
+	if s.ntf == nil {
+		return
+	}
+	winners, err := s.repo.ListWinnersWithPrizes(ctx, g.ID)
+	if err != nil {
+		log.Printf("finish preview %s: %v", g.ID, err)
+		return
+	}
+	outcome := s.finishOutcome(g, c.Trigger, len(winners))
+	for _, uid := range s.giveawayAdmins(ctx, g) {
+		if err := s.ntf.SendFinishPreviewDM(ctx, g, uid, c, outcome); err != nil {
+			log.Printf("finish preview %s to %d: %v", g.ID, uid, err)
+		}
+	}
+}
+
+// finishOutcome describes what finishing the giveaway will do.
+func (s *Service) finishOutcome(g *dg.Giveaway, trigger dg.FinishTrigger, winners int) string {
+	if trigger == dg.FinishTriggerCreatorConfirm {
+		return fmt.Sprintf("The creator confirmed %d of %d winners.", winners, g.MaxWinnersCount)
+	}
+	action := g.PendingAction
+	if action == "" {
+		action = s.pendingAction
+	}
+	what := fmt.Sprintf("winners will be drawn automatically (%d places)", g.MaxWinnersCount)
+	switch {
+	case winners > 0:
+		what = fmt.Sprintf("the %d winners uploaded by the creator will be confirmed", winners)
+	case action == dg.PendingActionCancel:
+		what = "the giveaway will be cancelled"
+	}
+	ttl := s.pendingTTL
+	if g.PendingTTLSec != nil {
+		ttl = time.Duration(*g.PendingTTLSec) * time.Second
+	}
+	if g.PendingSince == nil || ttl <= 0 {
+		return "When the pending period ends, " + what + "."
+	}
+	return fmt.Sprintf("On %s, %s.", g.PendingSince.Add(ttl).UTC().Format("Jan 2, 15:04 UTC"), what)
+}
+
+// PreviewPendingFinishes sends finish previews for pending giveaways that resolve automatically
+// within the preview lead and returns how many were sent.
+func (s *Service) PreviewPendingFinishes(ctx context.Context) (int, error) {
+	if s.finishPreviewLead <= 0 {
+		return 0, nil
+	}
+	ids, err := s.repo.ListFinishPreviewDue(ctx, int(s.pendingTTL/time.Second), s.finishPreviewLead, 50)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, id := range ids {
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil || g == nil {
+			continue
+		}
+		if _, err := s.requestFinishConfirmation(ctx, g, dg.FinishTriggerPendingExpiry); err != nil {
+			log.Printf("finish preview %s: %v", id, err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// FinishConfirmation returns the finish preview of a giveaway to one of its admins.
+func (s *Service) FinishConfirmation(ctx context.Context, id string, requesterID int64) (*dg.FinishConfirmation, error) {
+	g, err := s.loadAdminGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.repo.GetFinishConfirmation(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, errors.New("not found")
+	}
+	return c, nil
+}
+
+// DecideFinish confirms or aborts the awaiting finish preview of a giveaway. Winners confirmed by
+// the creator need another admin, unless the creator is the only one; once confirmed the giveaway
+// is completed right away. Abort keeps the giveaway pending.
+func (s *Service) DecideFinish(ctx context.Context, id string, requesterID int64, confirm bool) (*dg.FinishConfirmation, error) {
+	g, err := s.loadAdminGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.repo.GetFinishConfirmation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil || c.Status != dg.FinishAwaiting {
+		return nil, errors.New("no confirmation awaiting")
+	}
+	if confirm && c.Trigger == dg.FinishTriggerCreatorConfirm && requesterID == g.CreatorID && len(s.giveawayAdmins(ctx, g)) > 1 {
+		return nil, errors.New("co-admin confirmation required")
+	}
+	status := dg.FinishAborted
+	if confirm {
+		status = dg.FinishConfirmed
+	}
+	ok, err := s.repo.DecideFinishConfirmation(ctx, id, status, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("no confirmation awaiting")
+	}
+	if err := s.repo.RecordStatusChange(ctx, id, g.Status, g.Status, fmt.Sprintf("Finish %s (%s)", status, c.Trigger), requesterID); err != nil {
+		log.Printf("status history %s: %v", id, err)
+	}
+	if confirm && c.Trigger == dg.FinishTriggerCreatorConfirm && g.Status == dg.GiveawayStatusPending {
+		if err := s.UpdateStatus(ctx, id, dg.GiveawayStatusCompleted); err != nil {
+			return nil, err
+		}
+	}
+	if s.ntf != nil && requesterID != g.CreatorID {
+		go s.ntf.NotifyCreatorFinishDecision(context.Background(), g, status)
+	}
+	return s.repo.GetFinishConfirmation(ctx, id)
+}
+
+// loadAdminGiveaway returns a giveaway requesterID administers.
+func (s *Service) loadAdminGiveaway(ctx context.Context, id string, requesterID int64) (*dg.Giveaway, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID == requesterID {
+		return g, nil
+	}
+	for _, uid := range s.giveawayAdmins(ctx, g) {
+		if uid == requesterID {
+			return g, nil
+		}
+	}
+	return nil, errors.New("forbidden")
+}
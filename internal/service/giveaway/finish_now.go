@@ -0,0 +1,67 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// finishNowConfirmTTL is how long the creator has to repeat a finish-now call with its token.
+const finishNowConfirmTTL = 2 * time.Minute
+
+func finishNowKey(id string, userID int64) string {
+	return "giveaway:" + id + ":finish_now:" + strconv.FormatInt(userID, 10)
+}
+
+// FinishNow ends an active giveaway early by moving ends_at to now; the expiry worker then
+// draws its winners like for any expired giveaway, so there is only ever one draw and it does
+// not run inside the request. Guarded against accidental calls: without token it only returns
+// a confirmation whose token must be sent back within two minutes, and a token is good for one
+// attempt. Only the creator can finish a giveaway.
+func (s *Service) FinishNow(ctx context.Context, id string, requesterID int64, token string) (*dg.FinishNowConfirmation, error) {
+	if s.rdb == nil {
+		return nil, errors.New("confirmation unavailable")
+	}
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status != dg.GiveawayStatusActive {
+		return nil, errors.New("not active")
+	}
+	key := finishNowKey(g.ID, requesterID)
+	if token == "" {
+		c := &dg.FinishNowConfirmation{
+			Token:             uuid.NewString(),
+			ExpiresAt:         time.Now().Add(finishNowConfirmTTL).UTC(),
+			ParticipantsCount: g.ParticipantsCount,
+			WinnersCount:      g.MaxWinnersCount,
+		}
+		if err := s.rdb.Set(ctx, key, c.Token, finishNowConfirmTTL).Err(); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+	stored, err := s.rdb.GetDel(ctx, key).Result()
+	if err != nil || stored != token {
+		return nil, errors.New("invalid confirmation")
+	}
+	ok, err := s.repo.EndNow(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("not active")
+	}
+	log.Printf("giveaway %s: finished early by creator %d", g.ID, requesterID)
+	// Ended already, so drop it from the ending soon set
+	g.Status = dg.GiveawayStatusFinished
+	s.trackEndsAt(ctx, g)
+	return nil, nil
+}
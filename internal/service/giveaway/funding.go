@@ -0,0 +1,168 @@
+package giveaway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
+)
+
+const fundingExpiredReason = "Prize deposit was not received before the giveaway ended"
+
+// fundingPollLimit bounds how many recent escrow transactions one poll inspects;
+// bursts larger than this are still credited through the webhook.
+const fundingPollLimit = 100
+
+// WithEscrow enables on-chain prize funding to the given escrow wallet.
+func (s *Service) WithEscrow(address string) *Service {
+	s.escrow = strings.TrimSpace(address)
+	return s
+}
+
+// prepareFunding validates a funding request and assigns the memo the deposit must carry.
+func (s *Service) prepareFunding(f *dg.Funding) error {
+	if s.escrow == "" {
+		return errors.New("escrow funding unavailable")
+	}
+	if f.AmountNano <= 0 {
+		return errors.New("funding amount must be > 0")
+	}
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return err
+	}
+	f.Memo = "gw-" + hex.EncodeToString(b[:])
+	f.Address = s.escrow
+	f.ReceivedNano = 0
+	f.Status = dg.FundingStatusAwaiting
+	f.FundedAt = nil
+	f.Deposits = nil
+	return nil
+}
+
+// GetFunding returns the deposit instructions and progress of an escrow-funded giveaway; only the creator can view it.
+func (s *Service) GetFunding(ctx context.Context, id string, requesterID int64) (*dg.Funding, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	f, err := s.repo.GetFunding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, errors.New("not escrow funded")
+	}
+	f.Address = s.escrow
+	return f, nil
+}
+
+// requireFunded rejects activating an escrow-funded giveaway before its deposit is confirmed.
+func (s *Service) requireFunded(ctx context.Context, id string) error {
+	f, err := s.repo.GetFunding(ctx, id)
+	if err != nil {
+		return err
+	}
+	if f != nil && f.Status != dg.FundingStatusFunded {
+		return errors.New("not funded")
+	}
+	return nil
+}
+
+// PollFunding credits recent transfers to the escrow wallet and cancels giveaways that ended
+// unfunded. Returns how many giveaways became funded.
+func (s *Service) PollFunding(ctx context.Context) (int, error) {
+	if s.escrow == "" || s.ton == nil {
+		return 0, nil
+	}
+	transfers, err := s.ton.ListIncomingTransfers(ctx, s.escrow, fundingPollLimit)
+	if err != nil {
+		return 0, err
+	}
+	funded := 0
+	for _, tr := range transfers {
+		ok, err := s.creditTransfer(ctx, tr)
+		if err != nil {
+			log.Printf("credit escrow transfer %s: %v", tr.Hash, err)
+			continue
+		}
+		if ok {
+			funded++
+		}
+	}
+	s.expireUnfunded(ctx)
+	return funded, nil
+}
+
+// HandleEscrowTransaction credits a single escrow transaction reported by the TonAPI webhook.
+func (s *Service) HandleEscrowTransaction(ctx context.Context, txHash string) error {
+	if s.escrow == "" || s.ton == nil {
+		return errors.New("escrow funding unavailable")
+	}
+	if txHash == "" {
+		return errors.New("missing tx_hash")
+	}
+	tr, ok, err := s.ton.GetTransfer(ctx, txHash)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	_, err = s.creditTransfer(ctx, tr)
+	return err
+}
+
+// creditTransfer records a transfer to the escrow wallet against the giveaway named by its comment.
+func (s *Service) creditTransfer(ctx context.Context, tr tonb.Transfer) (bool, error) {
+	if tr.Comment == "" || tr.Account != tonb.NormalizeAddress(s.escrow) {
+		return false, nil
+	}
+	id, funded, err := s.repo.RecordDeposit(ctx, strings.ToLower(tr.Comment), dg.Deposit{
+		TxHash: tr.Hash, Lt: tr.Lt, Sender: tr.Sender, AmountNano: tr.AmountNano, ReceivedAt: tr.At,
+	})
+	if err != nil {
+		return false, err
+	}
+	if funded {
+		log.Printf("giveaway %s funded by tx %s", id, tr.Hash)
+	}
+	return funded, nil
+}
+
+// expireUnfunded cancels scheduled giveaways whose end passed before the deposit arrived.
+func (s *Service) expireUnfunded(ctx context.Context) {
+	ids, err := s.repo.ListUnfundedExpiredIDs(ctx)
+	if err != nil {
+		log.Printf("list unfunded giveaways: %v", err)
+		return
+	}
+	for _, id := range ids {
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil || g == nil {
+			continue
+		}
+		if _, err := s.repo.Cancel(ctx, id, 0, fundingExpiredReason, notify.CancelledMessage(g, fundingExpiredReason)); err != nil {
+			log.Printf("cancel unfunded giveaway %s: %v", id, err)
+			continue
+		}
+		if err := s.repo.ExpireFunding(ctx, id); err != nil {
+			log.Printf("expire funding %s: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// Health runs configuration diagnostics of a giveaway for its creator: bot access to requirement
+// channels, prize coverage, announcement, end time and escrow funding.
+func (s *Service) Health(ctx context.Context, id string, requesterID int64) (*dg.Health, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	h := &dg.Health{GiveawayID: id, OK: true}
+	h.Checks = append(h.Checks, s.botAccessCheck(ctx, g)...)
+	h.Checks = append(h.Checks, prizeCoverageCheck(g), s.announcementCheck(ctx, g), endTimeCheck(g, time.Now().UTC()))
+	funding, err := s.fundingCheck(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+	h.Checks = append(h.Checks, funding)
+	for _, c := range h.Checks {
+		switch c.Status {
+		case dg.HealthError:
+			h.OK = false
+		case dg.HealthWarning:
+			h.Warnings++
+		}
+	}
+	return h, nil
+}
+
+// running reports whether the giveaway can still take participants.
+func running(g *dg.Giveaway) bool {
+	return g.Status == dg.GiveawayStatusScheduled || g.Status == dg.GiveawayStatusActive
+}
+
+// botAccessCheck verifies the bot is an admin in every subscription and boost channel,
+// with one entry per channel.
+func (s *Service) botAccessCheck(ctx context.Context, g *dg.Giveaway) []dg.HealthCheck {
+	var out []dg.HealthCheck
+	seen := make(map[string]bool)
+	for _, r := range g.Requirements {
+		if r.Type != dg.RequirementTypeSubscription && r.Type != dg.RequirementTypeBoost {
+			continue
+		}
+		chat := ""
+		if r.ChannelID != 0 {
+			chat = strconv.FormatInt(r.ChannelID, 10)
+		} else if r.ChannelUsername != "" {
+			chat = "@" + r.ChannelUsername
+		}
+		if chat == "" || seen[chat] {
+			continue
+		}
+		seen[chat] = true
+		name := requirementChannelName(g, r.ChannelID)
+		if r.ChannelID == 0 {
+			name = chat
+		}
+		c := dg.HealthCheck{Key: "bot_access:" + chat, Status: dg.HealthOK}
+		switch {
+		case !running(g):
+			c.Status = dg.HealthSkipped
+		case r.Unverifiable:
+			c.Status = dg.HealthError
+			c.Message = fmt.Sprintf("The bot lost access to %s. Add it back as an admin so the requirement is checked again.", name)
+		case s.tg == nil:
+			c.Status = dg.HealthWarning
+			c.Message = "Bot access could not be checked right now."
+		default:
+			status, _, err := s.tg.GetBotMemberStatus(ctx, chat)
+			switch {
+			case err != nil:
+				c.Status = dg.HealthWarning
+				c.Message = fmt.Sprintf("Bot access to %s could not be checked: %v", name, err)
+			case status != "administrator" && status != "creator":
+				c.Status = dg.HealthError
+				c.Message = fmt.Sprintf("The bot is not an admin in %s. Add it as an admin so subscriptions and boosts can be checked.", name)
+			}
+		}
+		out = append(out, c)
+	}
+	if len(out) == 0 {
+		out = append(out, dg.HealthCheck{Key: "bot_access", Status: dg.HealthSkipped})
+	}
+	return out
+}
+
+// prizeCoverageCheck verifies every winner place gets a prize: places without a place-bound
+// prize must be covered by unassigned prize units.
+func prizeCoverageCheck(g *dg.Giveaway) dg.HealthCheck {
+	c := dg.HealthCheck{Key: "prizes", Status: dg.HealthOK}
+	covered := make(map[int]bool)
+	unassigned := 0
+	var unreachable []string
+	for _, p := range g.Prizes {
+		if p.Place == nil {
+			q := p.Quantity
+			if q <= 0 {
+				q = 1
+			}
+			unassigned += q
+			continue
+		}
+		if *p.Place > g.MaxWinnersCount {
+			unreachable = append(unreachable, strconv.Itoa(*p.Place))
+			continue
+		}
+		covered[*p.Place] = true
+	}
+	missing := g.MaxWinnersCount - len(covered) - unassigned
+	switch {
+	case len(g.Prizes) == 0:
+		c.Status = dg.HealthError
+		c.Message = "Add at least one prize."
+	case missing > 0:
+		c.Status = dg.HealthWarning
+		c.Message = fmt.Sprintf("%d of %d winners get no prize. Add prizes or reduce the winners count.", missing, g.MaxWinnersCount)
+	case len(unreachable) > 0:
+		c.Status = dg.HealthWarning
+		c.Message = fmt.Sprintf("Prizes for place %s are never awarded because there are only %d winners.", strings.Join(unreachable, ", "), g.MaxWinnersCount)
+	}
+	return c
+}
+
+// announcementCheck verifies the inline announcement message was prepared for sharing.
+func (s *Service) announcementCheck(ctx context.Context, g *dg.Giveaway) dg.HealthCheck {
+	c := dg.HealthCheck{Key: "announcement", Status: dg.HealthOK}
+	if !running(g) || s.rdb == nil {
+		c.Status = dg.HealthSkipped
+		return c
+	}
+	n, err := s.rdb.Exists(ctx, "giveaway:"+g.ID+":prepared_inline_message_id").Result()
+	switch {
+	case err != nil:
+		c.Status = dg.HealthWarning
+		c.Message = "The announcement could not be checked right now."
+	case n == 0:
+		c.Status = dg.HealthWarning
+		c.Message = "The announcement message is not prepared. Open the share dialog to prepare it."
+	}
+	return c
+}
+
+// endTimeCheck verifies the end time is after the start and still ahead for running giveaways.
+func endTimeCheck(g *dg.Giveaway, now time.Time) dg.HealthCheck {
+	c := dg.HealthCheck{Key: "end_time", Status: dg.HealthOK}
+	switch {
+	case !g.EndsAt.After(g.StartedAt):
+		c.Status = dg.HealthError
+		c.Message = "The end time is not after the start time."
+	case !running(g):
+		c.Status = dg.HealthSkipped
+	case !g.EndsAt.After(now):
+		c.Status = dg.HealthWarning
+		c.Message = "The end time has passed. The giveaway will be finished shortly."
+	case g.EndsAt.Sub(now) < time.Hour:
+		c.Status = dg.HealthWarning
+		c.Message = "The giveaway ends in less than an hour, leaving little time to join."
+	}
+	return c
+}
+
+// fundingCheck verifies the escrow deposit of an escrow-funded giveaway is complete.
+func (s *Service) fundingCheck(ctx context.Context, g *dg.Giveaway) (dg.HealthCheck, error) {
+	c := dg.HealthCheck{Key: "funding", Status: dg.HealthOK}
+	f, err := s.repo.GetFunding(ctx, g.ID)
+	if err != nil {
+		return c, err
+	}
+	switch {
+	case f == nil:
+		c.Status = dg.HealthSkipped
+	case f.Status == dg.FundingStatusExpired:
+		c.Status = dg.HealthError
+		c.Message = "The prize deposit was not received in time."
+	case f.Status != dg.FundingStatusFunded:
+		c.Status = dg.HealthError
+		c.Message = fmt.Sprintf("Send %d more nanoTON to %s with the comment %q to start the giveaway.", f.AmountNano-f.ReceivedNano, s.escrow, f.Memo)
+	}
+	return c, nil
+}
@@ -0,0 +1,101 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CreateInviteLink generates the startapp link of one source, e.g. a partner channel, so joins
+// through it are attributed to name. Creating an existing link returns it. Only the creator
+// can create links, up to dg.MaxInviteLinks per giveaway.
+func (s *Service) CreateInviteLink(ctx context.Context, id string, requesterID int64, name string) (*dg.InviteLink, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if err := dg.ValidateInviteName(g.ID, name); err != nil {
+		return nil, err
+	}
+	ok, err := s.repo.CreateInviteLink(ctx, g.ID, name, requesterID, dg.MaxInviteLinks)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("too many invite links")
+	}
+	links, err := s.repo.ListInviteLinks(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range links {
+		if links[i].Name == name {
+			s.applyInviteURLs(ctx, links[i:i+1])
+			return &links[i], nil
+		}
+	}
+	// Deleted by a concurrent request
+	return nil, errors.New("invite link not found")
+}
+
+// ListInviteLinks returns the invite links of a giveaway with the joins each drove, most
+// joins first. Only the creator can list them.
+func (s *Service) ListInviteLinks(ctx context.Context, id string, requesterID int64) ([]dg.InviteLink, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := s.repo.ListInviteLinks(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.applyInviteURLs(ctx, links)
+	return links, nil
+}
+
+// DeleteInviteLink removes an invite link. Joins already recorded through it keep their
+// attribution; later joins through it count as direct.
+func (s *Service) DeleteInviteLink(ctx context.Context, id string, requesterID int64, name string) error {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return err
+	}
+	ok, err := s.repo.DeleteInviteLink(ctx, g.ID, name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("invite link not found")
+	}
+	log.Printf("giveaway %s: creator %d deleted invite link %q", g.ID, requesterID, name)
+	return nil
+}
+
+// HasInviteLink reports whether the giveaway has an invite link named name. Lookup errors
+// count as no link.
+func (s *Service) HasInviteLink(ctx context.Context, id, name string) bool {
+	if name == "" {
+		return false
+	}
+	ok, err := s.repo.HasInviteLink(ctx, id, name)
+	return err == nil && ok
+}
+
+// applyInviteURLs fills the t.me links of links; they stay empty when the bot is unknown.
+func (s *Service) applyInviteURLs(ctx context.Context, links []dg.InviteLink) {
+	if s.tg == nil || s.rdb == nil || len(links) == 0 {
+		return
+	}
+	me, err := s.tg.GetBotMe(ctx, s.rdb)
+	if err != nil || me == nil || me.Username == "" {
+		return
+	}
+	for i := range links {
+		links[i].URL = fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, links[i].StartParam)
+	}
+}
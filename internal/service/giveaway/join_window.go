@@ -0,0 +1,88 @@
+package giveaway
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxJoinWindows bounds the windows a giveaway may define.
+const maxJoinWindows = 7
+
+// JoinWindowClosedError rejects a join outside the giveaway's join windows.
+type JoinWindowClosedError struct {
+	// OpensAt is the next window start, nil when no window opens within a week
+	OpensAt  *time.Time
+	Timezone string
+}
+
+func (e *JoinWindowClosedError) Error() string {
+	if e.OpensAt == nil {
+		return "joins are closed"
+	}
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return fmt.Sprintf("joins are closed, opens again at %s %s", e.OpensAt.In(loc).Format("2006-01-02 15:04"), loc)
+}
+
+// validateJoinWindows checks window times and days and defaults the time zone to UTC.
+func validateJoinWindows(g *dg.Giveaway) error {
+	if len(g.JoinWindows) == 0 {
+		g.JoinTimezone = ""
+		return nil
+	}
+	if len(g.JoinWindows) > maxJoinWindows {
+		return fmt.Errorf("at most %d join_windows allowed", maxJoinWindows)
+	}
+	if g.JoinTimezone == "" {
+		g.JoinTimezone = "UTC"
+	}
+	if _, err := time.LoadLocation(g.JoinTimezone); err != nil {
+		return errors.New("invalid join_timezone")
+	}
+	for _, w := range g.JoinWindows {
+		start, err := dg.ParseClock(w.Start)
+		if err != nil {
+			return fmt.Errorf("join_windows: %w", err)
+		}
+		end, err := dg.ParseClock(w.End)
+		if err != nil {
+			return fmt.Errorf("join_windows: %w", err)
+		}
+		if start == end {
+			return errors.New("join_windows: start and end must differ")
+		}
+		for _, d := range w.Days {
+			if d < 0 || d > 6 {
+				return errors.New("join_windows: days must be between 0 (Sunday) and 6 (Saturday)")
+			}
+		}
+	}
+	return nil
+}
+
+// joinWindowState evaluates the giveaway's join windows at now; nil when it has none.
+func joinWindowState(g *dg.Giveaway, now time.Time) *dg.JoinWindowState {
+	if len(g.JoinWindows) == 0 {
+		return nil
+	}
+	loc, err := time.LoadLocation(g.JoinTimezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	st := dg.JoinWindowAt(g.JoinWindows, loc, now)
+	return &st
+}
+
+// CheckJoinWindow returns a *JoinWindowClosedError when the giveaway does not accept joins at now.
+func CheckJoinWindow(g *dg.Giveaway, now time.Time) error {
+	st := joinWindowState(g, now)
+	if st == nil || st.Open {
+		return nil
+	}
+	return &JoinWindowClosedError{OpensAt: st.OpensAt, Timezone: g.JoinTimezone}
+}
@@ -0,0 +1,112 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// loadManagedGiveaway loads a giveaway for its creator or one of its managers.
+func (s *Service) loadManagedGiveaway(ctx context.Context, id string, requesterID int64) (*dg.Giveaway, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if !s.CanManage(ctx, g, requesterID) {
+		return nil, errors.New("forbidden")
+	}
+	return g, nil
+}
+
+// CanManage reports whether userID is the creator or a manager of g.
+func (s *Service) CanManage(ctx context.Context, g *dg.Giveaway, userID int64) bool {
+	if g == nil || userID == 0 {
+		return false
+	}
+	if g.CreatorID == userID {
+		return true
+	}
+	ok, err := s.repo.IsManager(ctx, g.ID, userID)
+	return err == nil && ok
+}
+
+// AddManager grants userID the manager role on a giveaway. The user must have opened the
+// app before. Only the creator can add managers, up to dg.MaxManagers.
+func (s *Service) AddManager(ctx context.Context, id string, requesterID, userID int64) ([]dg.Manager, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 {
+		return nil, errors.New("missing user_id")
+	}
+	if userID == g.CreatorID {
+		return nil, errors.New("creator cannot be a manager")
+	}
+	if s.users != nil {
+		if u, err := s.users.GetByID(ctx, userID); err != nil || u == nil {
+			return nil, errors.New("user not found")
+		}
+	}
+	ok, err := s.repo.AddManager(ctx, g.ID, userID, requesterID, dg.MaxManagers)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("too many managers")
+	}
+	log.Printf("giveaway %s: creator %d added manager %d", g.ID, requesterID, userID)
+	return s.repo.ListManagers(ctx, g.ID)
+}
+
+// RemoveManager revokes the manager role. The creator removes anyone; a manager can only
+// step down.
+func (s *Service) RemoveManager(ctx context.Context, id string, requesterID, userID int64) error {
+	if requesterID == userID {
+		if _, err := s.loadManagedGiveaway(ctx, id, requesterID); err != nil {
+			return err
+		}
+	} else if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return err
+	}
+	ok, err := s.repo.RemoveManager(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("manager not found")
+	}
+	log.Printf("giveaway %s: user %d removed manager %d", id, requesterID, userID)
+	return nil
+}
+
+// ListManagers returns the managers of a giveaway to its creator and managers.
+func (s *Service) ListManagers(ctx context.Context, id string, requesterID int64) ([]dg.Manager, error) {
+	g, err := s.loadManagedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListManagers(ctx, g.ID)
+}
+
+// ListManagedBy returns the published giveaways the user manages, newest first.
+func (s *Service) ListManagedBy(ctx context.Context, userID int64, limit, offset int) ([]dg.Giveaway, error) {
+	list, err := s.repo.ListManagedBy(ctx, userID, limit, offset)
+	return s.applyBadges(list), err
+}
+
+// IsEntrant reports whether the user joined the giveaway or won it.
+func (s *Service) IsEntrant(ctx context.Context, id string, userID int64) (bool, error) {
+	if ok, err := s.repo.IsWinner(ctx, id, userID); err != nil || ok {
+		return ok, err
+	}
+	return s.repo.IsParticipant(ctx, id, userID)
+}
@@ -0,0 +1,73 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxMemberDays bounds min_member_days of subscription requirements.
+const maxMemberDays = 3650
+
+func validateMemberDays(reqs []dg.Requirement) error {
+	for _, r := range reqs {
+		if r.MinMemberDays == 0 {
+			continue
+		}
+		if r.MinMemberDays < 0 || r.MinMemberDays > maxMemberDays {
+			return fmt.Errorf("min_member_days must be between 0 and %d", maxMemberDays)
+		}
+		if r.Type != dg.RequirementTypeSubscription {
+			return errors.New("min_member_days is only supported for subscription requirements")
+		}
+		// Membership events carry numeric chat ids only
+		if r.ChannelID == 0 {
+			return errors.New("min_member_days requires a channel_id")
+		}
+	}
+	return nil
+}
+
+// checkMemberDays fails a met subscription requirement when the bot saw the user join the
+// channel less than MinMemberDays ago, or leave it after the giveaway started. Users without
+// observed history were members before the bot tracked the channel and pass; from then on
+// their membership is judged continuously from the giveaway start until the draw.
+func (s *Service) checkMemberDays(ctx context.Context, userID int64, rqm *dg.Requirement, res CheckRequirementResult) CheckRequirementResult {
+	if rqm.MinMemberDays <= 0 || rqm.ID == 0 || res.Status != "success" {
+		return res
+	}
+	h, err := s.repo.GetMemberHistory(ctx, rqm.ID, userID)
+	if err != nil {
+		return CheckRequirementResult{Status: "failed", Error: err.Error()}
+	}
+	if h == nil {
+		return res
+	}
+	if h.LastLeftAt != nil && !h.LastLeftAt.Before(h.GiveawayStartedAt) {
+		return CheckRequirementResult{Status: "failed", Error: "left the channel during the giveaway"}
+	}
+	since := h.FirstSeenAt
+	if since == nil {
+		// Member again without an observed join: at best since the last leave
+		since = h.LastLeftAt
+	}
+	if since != nil && time.Since(*since) < time.Duration(rqm.MinMemberDays)*24*time.Hour {
+		return CheckRequirementResult{Status: "failed", Error: fmt.Sprintf("channel member for less than %d days", rqm.MinMemberDays)}
+	}
+	return res
+}
+
+// HandleMemberLeft records a user leaving a channel, reported by the bot, and starts the grace
+// period of giveaways with continuous membership that require the channel.
+func (s *Service) HandleMemberLeft(ctx context.Context, channelID, userID int64, at time.Time) error {
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	if err := s.repo.RecordMemberLeft(ctx, channelID, userID, at); err != nil {
+		return err
+	}
+	return s.recordMembershipLapses(ctx, channelID, userID, at)
+}
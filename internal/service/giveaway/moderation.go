@@ -0,0 +1,124 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/moderation"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+const moderationRemovedReason = "Removed by moderation"
+
+// WithModeration enables the content filter on create; without it texts are accepted as is.
+func (s *Service) WithModeration(f *moderation.Filter) *Service { s.moderation = f; return s }
+
+// moderationFields lists the creator-written texts of a giveaway. Channel titles come from
+// Telegram and are not checked.
+func moderationFields(g *dg.Giveaway) []moderation.Field {
+	fields := []moderation.Field{{Name: "title", Text: g.Title}, {Name: "description", Text: g.Description}}
+	for _, tag := range g.Languages() {
+		t := g.Translations[tag]
+		fields = append(fields,
+			moderation.Field{Name: fmt.Sprintf("translations.%s.title", tag), Text: t.Title},
+			moderation.Field{Name: fmt.Sprintf("translations.%s.description", tag), Text: t.Description})
+	}
+	for i, p := range g.Prizes {
+		fields = append(fields,
+			moderation.Field{Name: fmt.Sprintf("prizes[%d].title", i), Text: p.Title},
+			moderation.Field{Name: fmt.Sprintf("prizes[%d].description", i), Text: p.Description})
+	}
+	for i, p := range g.PrizePackages {
+		fields = append(fields, moderation.Field{Name: fmt.Sprintf("prize_packages[%d].label", i), Text: p.Label})
+		for j, it := range p.Items {
+			fields = append(fields,
+				moderation.Field{Name: fmt.Sprintf("prize_packages[%d].items[%d].title", i, j), Text: it.Title},
+				moderation.Field{Name: fmt.Sprintf("prize_packages[%d].items[%d].description", i, j), Text: it.Description})
+		}
+	}
+	for i, r := range g.Requirements {
+		name := r.Title
+		if r.Type == dg.RequirementTypeCustom {
+			name = r.ChannelTitle
+		}
+		fields = append(fields,
+			moderation.Field{Name: fmt.Sprintf("requirements[%d].name", i), Text: name},
+			moderation.Field{Name: fmt.Sprintf("requirements[%d].description", i), Text: r.Description})
+	}
+	return fields
+}
+
+// moderate rejects blocked content with every violation in the message and attaches
+// borderline matches to g so they are stored for admin review.
+func (s *Service) moderate(g *dg.Giveaway) error {
+	if s.moderation == nil {
+		return nil
+	}
+	res := s.moderation.Check(moderationFields(g)...)
+	if len(res.Blocked) > 0 {
+		msgs := make([]string, 0, len(res.Blocked))
+		for _, v := range res.Blocked {
+			msgs = append(msgs, v.Error())
+		}
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	g.ModerationFlags = g.ModerationFlags[:0]
+	for _, v := range res.Flagged {
+		g.ModerationFlags = append(g.ModerationFlags, dg.ModerationFlag{Field: v.Field, Rule: string(v.Rule), Match: v.Match, Lang: v.Lang})
+	}
+	return nil
+}
+
+// ListModerationFlags returns flagged content for platform review.
+func (s *Service) ListModerationFlags(ctx context.Context, status dg.ModerationStatus, limit, offset int) ([]dg.ModerationFlag, error) {
+	switch status {
+	case "", dg.ModerationPending, dg.ModerationApproved, dg.ModerationRemoved:
+	default:
+		return nil, errors.New("invalid status")
+	}
+	return s.repo.ListModerationFlags(ctx, status, limit, offset)
+}
+
+// ResolveModeration keeps a flagged giveaway or cancels it on behalf of a platform admin.
+// Removing cancels the giveaway with reason (or a default) and notifies participants.
+func (s *Service) ResolveModeration(ctx context.Context, id string, adminID int64, remove bool, reason string) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	status := dg.ModerationApproved
+	if remove {
+		status = dg.ModerationRemoved
+		reason = strings.TrimSpace(reason)
+		if reason == "" {
+			reason = moderationRemovedReason
+		}
+		if len([]rune(reason)) > maxCancelReasonLen {
+			return errors.New("reason too long")
+		}
+		ok, err := s.repo.Cancel(ctx, id, adminID, reason, notify.CancelledMessage(g, reason))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("transition not allowed")
+		}
+	}
+	n, err := s.repo.ResolveModerationFlags(ctx, id, status, adminID)
+	if err != nil {
+		return err
+	}
+	if n == 0 && !remove {
+		return errors.New("nothing to review")
+	}
+	return nil
+}
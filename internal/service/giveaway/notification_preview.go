@@ -0,0 +1,26 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+// NotificationPreview renders the start, finish and winner messages of a giveaway for its creator.
+func (s *Service) NotificationPreview(ctx context.Context, id string, requesterID int64) (*notify.Preview, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return s.ntf.Preview(ctx, g), nil
+}
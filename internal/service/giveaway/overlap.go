@@ -0,0 +1,91 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// maxOverlapGiveaways bounds one report to 10 pairs
+	maxOverlapGiveaways = 5
+	// overlapSampleTarget is the largest participant set intersected in full; bigger sets
+	// are sampled down to about this size
+	overlapSampleTarget = 100_000
+)
+
+// ParticipantOverlap reports how much the audiences of 2 to 5 giveaways owned by requesterID
+// overlap, pair by pair.
+func (s *Service) ParticipantOverlap(ctx context.Context, ids []string, requesterID int64) (*dg.OverlapReport, error) {
+	uniq := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		uniq = append(uniq, id)
+	}
+	if len(uniq) < 2 {
+		return nil, errors.New("at least 2 ids required")
+	}
+	if len(uniq) > maxOverlapGiveaways {
+		return nil, errors.New("too many ids")
+	}
+	rep := &dg.OverlapReport{SamplePct: 100}
+	largest := 0
+	for _, id := range uniq {
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if g == nil {
+			return nil, errors.New("not found")
+		}
+		if g.CreatorID != requesterID {
+			return nil, errors.New("forbidden")
+		}
+		rep.Giveaways = append(rep.Giveaways, dg.OverlapSet{GiveawayID: id, Title: g.Title, Participants: g.ParticipantsCount})
+		largest = max(largest, g.ParticipantsCount)
+	}
+	if largest > overlapSampleTarget {
+		rep.Sampled = true
+		rep.SamplePct = max(1, overlapSampleTarget*100/largest)
+	}
+	sizes, shared, unique, err := s.repo.ParticipantOverlap(ctx, uniq, rep.SamplePct)
+	if err != nil {
+		return nil, err
+	}
+	scale := 100 / float64(rep.SamplePct)
+	rep.Unique = int(math.Round(float64(unique) * scale))
+	rep.Pairs = make([]dg.OverlapPair, 0, len(uniq)*(len(uniq)-1)/2)
+	for i, a := range uniq {
+		for _, b := range uniq[i+1:] {
+			lo, hi := a, b
+			if hi < lo {
+				lo, hi = hi, lo
+			}
+			n := shared[lo][hi]
+			rep.Pairs = append(rep.Pairs, dg.OverlapPair{
+				A:      a,
+				B:      b,
+				Shared: int(math.Round(float64(n) * scale)),
+				AInB:   overlapPct(n, sizes[a]),
+				BInA:   overlapPct(n, sizes[b]),
+			})
+		}
+	}
+	return rep, nil
+}
+
+// overlapPct is n as a percentage of total, rounded to one decimal.
+func overlapPct(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(n)*1000/float64(total)) / 10
+}
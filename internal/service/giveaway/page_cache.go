@@ -0,0 +1,187 @@
+package giveaway
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// trendingKeyPrefix prefixes the hourly sorted sets of giveaway page views
+	trendingKeyPrefix = "giveaways:trending:"
+	// trendingHours is how many hourly buckets make up the trending ranking
+	trendingHours = 2
+)
+
+func trendingKey(t time.Time) string {
+	return trendingKeyPrefix + strconv.FormatInt(t.Unix()/3600, 10)
+}
+
+func pageCacheKey(id string) string { return "giveaway:{" + id + "}:page" }
+
+// cachedPage is a giveaway read kept for its page. It is gob encoded so fields hidden from
+// JSON, such as the creator, survive the round trip.
+type cachedPage struct {
+	Giveaway dg.Giveaway
+	Stamp    dg.Stamp
+}
+
+// countTrendingView adds a page view to the current hourly trending bucket.
+func (s *Service) countTrendingView(ctx context.Context, id string) {
+	if s.rdb == nil || id == "" {
+		return
+	}
+	key := trendingKey(time.Now())
+	pipe := s.rdb.Pipeline()
+	pipe.ZIncrBy(ctx, key, 1, id)
+	pipe.Expire(ctx, key, (trendingHours+1)*time.Hour)
+	_, _ = pipe.Exec(ctx)
+}
+
+// TrendingIDs returns up to n giveaway ids with the most page views over the last hours,
+// most viewed first.
+func (s *Service) TrendingIDs(ctx context.Context, n int) ([]string, error) {
+	if s.rdb == nil || n <= 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	keys := make([]string, 0, trendingHours)
+	for i := 0; i < trendingHours; i++ {
+		keys = append(keys, trendingKey(now.Add(-time.Duration(i)*time.Hour)))
+	}
+	zs, err := s.rdb.ZUnionWithScores(ctx, redis.ZStore{Keys: keys}).Result()
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(zs, func(i, j int) bool { return zs[i].Score > zs[j].Score })
+	if len(zs) > n {
+		zs = zs[:n]
+	}
+	ids := make([]string, 0, len(zs))
+	for _, z := range zs {
+		if id, ok := z.Member.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// GetByIDCached returns a giveaway like GetByID, from the page cache when it holds the
+// giveaway as currently stored. Only warmed giveaways are cached, see WarmPages. Edits and
+// status changes show up right away; participant counts and other details lag by up to the
+// cache TTL.
+func (s *Service) GetByIDCached(ctx context.Context, id string) (*dg.Giveaway, error) {
+	if s.rdb == nil || id == "" {
+		return s.GetByID(ctx, id)
+	}
+	key := pageCacheKey(id)
+	raw, err := s.hot(key).Get(ctx, key).Bytes()
+	if err != nil {
+		return s.GetByID(ctx, id)
+	}
+	var p cachedPage
+	if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&p); err != nil {
+		_ = s.hot(key).Del(ctx, key).Err()
+		return s.GetByID(ctx, id)
+	}
+	st, err := s.repo.GetStamp(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil || !sameStamp(*st, p.Stamp) {
+		_ = s.hot(key).Del(ctx, key).Err()
+		return s.GetByID(ctx, id)
+	}
+	g := &p.Giveaway
+	if running(g) {
+		g.JoinWindow = joinWindowState(g, time.Now())
+	}
+	return g, nil
+}
+
+func sameStamp(a, b dg.Stamp) bool {
+	return a.Version == b.Version && a.Status == b.Status && a.UpdatedAt.Equal(b.UpdatedAt)
+}
+
+// WarmPages rebuilds the page cache of the n trending giveaways for ttl and primes the jetton
+// metadata and channel details their pages use. Drafts and deleted giveaways are skipped.
+// Returns how many pages were cached.
+func (s *Service) WarmPages(ctx context.Context, n int, ttl time.Duration) (int, error) {
+	if s.rdb == nil {
+		return 0, errors.New("redis not configured")
+	}
+	ids, err := s.TrendingIDs(ctx, n)
+	if err != nil {
+		return 0, err
+	}
+	warmed := 0
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return warmed, ctx.Err()
+		}
+		ok, err := s.warmPage(ctx, id, ttl)
+		if err != nil {
+			log.Printf("warm giveaway %s: %v", id, err)
+			continue
+		}
+		if ok {
+			warmed++
+		}
+	}
+	return warmed, nil
+}
+
+func (s *Service) warmPage(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	// Stamp first: a change landing during the read leaves a stale stamp, not a stale page
+	st, err := s.repo.GetStamp(ctx, id)
+	if err != nil || st == nil {
+		return false, err
+	}
+	g, err := s.GetByID(ctx, id)
+	if err != nil || g == nil || g.Status == dg.GiveawayStatusDraft {
+		return false, err
+	}
+	s.primeEnrichment(ctx, g)
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cachedPage{Giveaway: *g, Stamp: *st}); err != nil {
+		return false, err
+	}
+	key := pageCacheKey(id)
+	if err := s.hot(key).Set(ctx, key, buf.Bytes(), ttl).Err(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// primeEnrichment loads the jetton metadata and channel details of g so page and requirement
+// check requests find them cached.
+func (s *Service) primeEnrichment(ctx context.Context, g *dg.Giveaway) {
+	seen := make(map[int64]bool)
+	prime := func(channelID int64) {
+		if s.channels == nil || channelID == 0 || seen[channelID] {
+			return
+		}
+		seen[channelID] = true
+		_, _ = s.channels.GetByID(ctx, channelID)
+	}
+	for _, r := range g.Requirements {
+		prime(r.ChannelID)
+		if r.Type == dg.RequirementTypeHoldJetton && r.JettonAddress != "" && s.ton != nil {
+			if _, err := s.ton.GetJettonMeta(ctx, r.JettonAddress); err != nil {
+				log.Printf("warm jetton %s: %v", r.JettonAddress, err)
+			}
+		}
+	}
+	for _, sp := range g.Sponsors {
+		prime(sp.ID)
+	}
+}
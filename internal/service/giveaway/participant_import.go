@@ -0,0 +1,150 @@
+package giveaway
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// participantImportBatch is how many participants are inserted per transaction
+	participantImportBatch = 500
+	// maxImportLineBytes bounds one NDJSON line
+	maxImportLineBytes = 64 << 10
+	// participantImportLockTTL releases the per-giveaway import lock if an instance dies mid-import
+	participantImportLockTTL = time.Hour
+	// participantImportProgressTTL keeps the last import report readable for a day
+	participantImportProgressTTL = 24 * time.Hour
+)
+
+func participantImportKey(id string) string { return "giveaway:" + id + ":participant_import" }
+
+// ImportParticipants streams NDJSON participants from r into a scheduled or active giveaway in
+// batches. Malformed lines are counted as invalid and skipped. Progress is saved after every batch
+// and can be read with ParticipantImportProgress; only one import runs per giveaway at a time.
+func (s *Service) ImportParticipants(ctx context.Context, id string, r io.Reader) (*dg.ParticipantImportProgress, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusScheduled && g.Status != dg.GiveawayStatusActive {
+		return nil, errors.New("giveaway is not running")
+	}
+	if s.rdb != nil {
+		lock := participantImportKey(id) + ":lock"
+		ok, err := s.rdb.SetNX(ctx, lock, "1", participantImportLockTTL).Result()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("import in progress")
+		}
+		defer s.rdb.Del(context.Background(), lock)
+	}
+
+	now := time.Now().UTC()
+	p := &dg.ParticipantImportProgress{GiveawayID: id, Status: dg.ParticipantImportRunning, StartedAt: now, UpdatedAt: now}
+	s.saveImportProgress(ctx, p)
+
+	batch := make([]dg.ImportedParticipant, 0, participantImportBatch)
+	flush := func() error {
+		n, err := s.repo.ImportParticipants(ctx, id, batch)
+		if err != nil {
+			return err
+		}
+		p.Imported += n
+		p.Skipped += len(batch) - n
+		batch = batch[:0]
+		s.saveImportProgress(ctx, p)
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 4096), maxImportLineBytes)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		p.Read++
+		var ip dg.ImportedParticipant
+		if err := json.Unmarshal(line, &ip); err != nil || ip.UserID <= 0 {
+			p.Invalid++
+			continue
+		}
+		// Joins cannot be dated in the future
+		if ip.JoinedAt != nil && ip.JoinedAt.After(now) {
+			ip.JoinedAt = nil
+		}
+		batch = append(batch, ip)
+		if len(batch) == participantImportBatch {
+			if err := flush(); err != nil {
+				return s.failImport(ctx, p, err)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return s.failImport(ctx, p, err)
+	}
+	if err := flush(); err != nil {
+		return s.failImport(ctx, p, err)
+	}
+	p.Status = dg.ParticipantImportDone
+	s.saveImportProgress(ctx, p)
+	log.Printf("giveaway %s: imported %d participants (%d skipped, %d invalid)", id, p.Imported, p.Skipped, p.Invalid)
+	return p, nil
+}
+
+// ParticipantImportProgress returns the running or last finished import of a giveaway, or nil.
+func (s *Service) ParticipantImportProgress(ctx context.Context, id string) (*dg.ParticipantImportProgress, error) {
+	if s.rdb == nil {
+		return nil, nil
+	}
+	raw, err := s.rdb.Get(ctx, participantImportKey(id)).Bytes()
+	if err != nil {
+		if err.Error() == "redis: nil" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var p dg.ParticipantImportProgress
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// failImport records why an import stopped. Batches inserted before the failure are kept.
+func (s *Service) failImport(ctx context.Context, p *dg.ParticipantImportProgress, cause error) (*dg.ParticipantImportProgress, error) {
+	p.Status = dg.ParticipantImportFailed
+	p.Error = cause.Error()
+	s.saveImportProgress(ctx, p)
+	return p, cause
+}
+
+func (s *Service) saveImportProgress(ctx context.Context, p *dg.ParticipantImportProgress) {
+	if s.rdb == nil {
+		return
+	}
+	p.UpdatedAt = time.Now().UTC()
+	b, err := json.Marshal(p)
+	if err != nil {
+		return
+	}
+	if err := s.rdb.Set(ctx, participantImportKey(p.GiveawayID), b, participantImportProgressTTL).Err(); err != nil {
+		log.Printf("participant import progress %s: %v", p.GiveawayID, err)
+	}
+}
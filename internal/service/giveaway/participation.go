@@ -0,0 +1,28 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListParticipating returns giveaways the user joined that are still running or awaiting results.
+func (s *Service) ListParticipating(ctx context.Context, userID int64, limit, offset int) ([]dg.Participation, error) {
+	if userID == 0 {
+		return nil, errors.New("unauthorized")
+	}
+	list, err := s.repo.ListParticipatingByUser(ctx, userID, limit, offset)
+	for i := range list {
+		s.ApplyBadges(&list[i].Giveaway)
+	}
+	return list, err
+}
+
+// ListParticipatedFinished returns completed giveaways the user joined.
+func (s *Service) ListParticipatedFinished(ctx context.Context, userID int64, limit, offset int) ([]dg.Participation, error) {
+	if userID == 0 {
+		return nil, errors.New("unauthorized")
+	}
+	return s.repo.ListParticipatedFinishedByUser(ctx, userID, limit, offset)
+}
@@ -0,0 +1,141 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/screening"
+)
+
+// maxReviewNoteLen bounds the note an admin leaves on a reviewed payout.
+const maxReviewNoteLen = 500
+
+// WithScreener enables wallet screening before payouts; without it payouts are held for review.
+func (s *Service) WithScreener(sc screening.Screener) *Service { s.screener = sc; return s }
+
+// PreparePayouts splits the escrow of every completed, funded giveaway among its winners and
+// screens each winner wallet. Flagged wallets, wallets that could not be screened and winners
+// without a wallet are held for admin review. Returns how many giveaways were prepared.
+func (s *Service) PreparePayouts(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListPayoutDueIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, id := range ids {
+		if err := s.preparePayouts(ctx, id); err != nil {
+			log.Printf("prepare payouts %s: %v", id, err)
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+func (s *Service) preparePayouts(ctx context.Context, id string) error {
+	f, err := s.repo.GetFunding(ctx, id)
+	if err != nil {
+		return err
+	}
+	if f == nil || f.Status != dg.FundingStatusFunded {
+		return nil
+	}
+	winners, err := s.repo.ListWinnersWithPrizes(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(winners) == 0 {
+		return nil
+	}
+	share := f.AmountNano / int64(len(winners))
+	rest := f.AmountNano % int64(len(winners))
+	payouts := make([]dg.Payout, 0, len(winners))
+	for i, w := range winners {
+		p := dg.Payout{GiveawayID: id, UserID: w.UserID, Place: w.Place, AmountNano: share}
+		// Places are ordered, so the remainder goes to the top places one nanoTON each
+		if int64(i) < rest {
+			p.AmountNano++
+		}
+		s.screenPayout(ctx, &p)
+		payouts = append(payouts, p)
+	}
+	return s.repo.CreatePayouts(ctx, payouts)
+}
+
+// screenPayout fills the wallet, screening outcome and resulting status of a payout.
+func (s *Service) screenPayout(ctx context.Context, p *dg.Payout) {
+	p.ScreenedAt = time.Now().UTC()
+	p.Status = dg.PayoutStatusHeld
+	if s.users != nil {
+		if u, err := s.users.GetByID(ctx, p.UserID); err == nil && u != nil {
+			p.WalletAddress = u.WalletAddress
+		}
+	}
+	if p.WalletAddress == "" {
+		p.Screening = dg.ScreeningNoWallet
+		p.ScreeningReason = "winner has no linked wallet"
+		return
+	}
+	if s.screener == nil {
+		p.Screening = dg.ScreeningError
+		p.ScreeningReason = "no screening provider configured"
+		return
+	}
+	res, err := s.screener.Screen(ctx, p.WalletAddress)
+	p.ScreeningProvider = res.Provider
+	switch {
+	case err != nil:
+		p.Screening = dg.ScreeningError
+		p.ScreeningReason = err.Error()
+	case res.Flagged:
+		p.Screening = dg.ScreeningFlagged
+		p.ScreeningReason = res.Reason
+	default:
+		p.Screening = dg.ScreeningClear
+		p.Status = dg.PayoutStatusReady
+	}
+}
+
+// ListPayouts returns payouts for platform review, optionally filtered by giveaway and status.
+func (s *Service) ListPayouts(ctx context.Context, giveawayID string, status dg.PayoutStatus, limit, offset int) ([]dg.Payout, error) {
+	switch status {
+	case "", dg.PayoutStatusReady, dg.PayoutStatusHeld, dg.PayoutStatusApproved, dg.PayoutStatusRejected:
+	default:
+		return nil, errors.New("invalid status")
+	}
+	return s.repo.ListPayouts(ctx, giveawayID, status, limit, offset)
+}
+
+// ReviewPayout approves or rejects a held payout on behalf of a platform admin.
+func (s *Service) ReviewPayout(ctx context.Context, id int64, adminID int64, approve bool, note string) (*dg.Payout, error) {
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > maxReviewNoteLen {
+		return nil, errors.New("note too long")
+	}
+	if !approve && note == "" {
+		return nil, errors.New("missing note")
+	}
+	p, err := s.repo.GetPayout(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, errors.New("not found")
+	}
+	status := dg.PayoutStatusRejected
+	if approve {
+		status = dg.PayoutStatusApproved
+	}
+	ok, err := s.repo.ReviewPayout(ctx, id, status, adminID, note)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("not held")
+	}
+	return s.repo.GetPayout(ctx, id)
+}
@@ -0,0 +1,162 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	"github.com/open-builders/giveaway-backend/internal/utils/random"
+)
+
+// maxPendingTTL bounds per-giveaway pending overrides.
+const maxPendingTTL = 90 * 24 * time.Hour
+
+const pendingExpiredReason = "Pending period expired"
+
+func validatePendingPolicy(ttlSec *int, action dg.PendingAction) error {
+	if ttlSec != nil && (*ttlSec < 0 || time.Duration(*ttlSec)*time.Second > maxPendingTTL) {
+		return errors.New("pending_ttl_sec must be between 0 and 90 days")
+	}
+	switch action {
+	case "", dg.PendingActionDraw, dg.PendingActionCancel:
+		return nil
+	}
+	return errors.New("invalid pending_action")
+}
+
+// SetPendingPolicy overrides the pending TTL and expiry action of a giveaway; only the creator can change it.
+func (s *Service) SetPendingPolicy(ctx context.Context, id string, requesterID int64, ttlSec *int, action dg.PendingAction) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	if err := validatePendingPolicy(ttlSec, action); err != nil {
+		return err
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return errors.New("forbidden")
+	}
+	return s.repo.SetPendingPolicy(ctx, id, ttlSec, action)
+}
+
+// ResolveExpiredPending applies the expiry action to every giveaway pending longer than its TTL.
+func (s *Service) ResolveExpiredPending(ctx context.Context) (int64, error) {
+	ids, err := s.repo.ListExpiredPendingIDs(ctx, int(s.pendingTTL/time.Second))
+	if err != nil {
+		return 0, err
+	}
+	var done int64
+	for _, id := range ids {
+		if err := s.resolvePending(ctx, id); err != nil {
+			if !errors.Is(err, errAwaitingConfirmation) {
+				log.Printf("resolve pending %s: %v", id, err)
+			}
+			continue
+		}
+		done++
+	}
+	return done, nil
+}
+
+// resolvePending completes a giveaway with the winners the creator already uploaded, or else
+// draws or cancels it according to its pending action.
+func (s *Service) resolvePending(ctx context.Context, id string) error {
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil || g.Status != dg.GiveawayStatusPending {
+		return nil
+	}
+	if ok, err := s.finishConfirmed(ctx, g, dg.FinishTriggerPendingExpiry); err != nil {
+		return err
+	} else if !ok {
+		return errAwaitingConfirmation
+	}
+	action := g.PendingAction
+	if action == "" {
+		action = s.pendingAction
+	}
+	if action == "" {
+		action = dg.PendingActionDraw
+	}
+
+	uploaded, err := s.repo.ListWinnersWithPrizes(ctx, id)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(uploaded) > 0:
+		// Creator picked winners but never confirmed; honour their choice
+		ok, err := s.repo.CompletePending(ctx, id)
+		if err != nil || !ok {
+			return err
+		}
+		action = dg.PendingActionDraw
+	case action == dg.PendingActionCancel:
+		ok, err := s.repo.Cancel(ctx, id, 0, pendingExpiredReason, notify.CancelledMessage(g, pendingExpiredReason))
+		if err != nil || !ok {
+			return err
+		}
+	default:
+		if err := s.drawPending(ctx, g); err != nil {
+			return err
+		}
+	}
+	if action == dg.PendingActionDraw {
+		if err := s.repo.RecordStatusChange(ctx, id, dg.GiveawayStatusPending, dg.GiveawayStatusCompleted, pendingExpiredReason, 0); err != nil {
+			log.Printf("status history %s: %v", id, err)
+		}
+	}
+	if s.ntf != nil {
+		go func(giv *dg.Giveaway) {
+			if action == dg.PendingActionDraw {
+				if w, err := s.repo.ListWinnersWithPrizes(context.Background(), giv.ID); err == nil && len(w) > 0 {
+					s.ntf.NotifyWinnersDM(context.Background(), giv, w)
+				}
+			}
+			s.ntf.NotifyCreatorPendingExpired(context.Background(), giv, action)
+		}(g)
+	}
+	return nil
+}
+
+// drawPending draws winners for an expired pending giveaway. Custom requirements cannot be
+// verified automatically and count as met; manual giveaways fall back to a uniform draw.
+func (s *Service) drawPending(ctx context.Context, g *dg.Giveaway) error {
+	participants, err := s.repo.ListParticipantEntries(ctx, g.ID)
+	if err != nil {
+		return err
+	}
+	strategy := g.WinnerStrategy
+	if strategy == dg.WinnerStrategyManual {
+		strategy = dg.WinnerStrategyRandom
+	}
+	seed, err := random.NewSeed()
+	if err != nil {
+		return err
+	}
+	var checks []dg.RequirementSnapshot
+	draw, err := runDraw(g, strategy, seed, participants, func(uid int64) bool {
+		ok, snap := s.checkRequirementsSnapshot(ctx, uid, g.Requirements)
+		checks = append(checks, snap...)
+		if len(g.Requirements) > 0 {
+			time.Sleep(50 * time.Millisecond)
+		}
+		return ok
+	})
+	if err != nil {
+		return err
+	}
+	draw.Checks = checks
+	return s.repo.FinishWithDraw(ctx, draw)
+}
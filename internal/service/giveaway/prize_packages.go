@@ -0,0 +1,36 @@
+package giveaway
+
+import (
+	"errors"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxPrizePackages bounds the prize packages of a giveaway.
+const maxPrizePackages = 10
+
+// validatePrizePackages checks that every package has items and a place range inside the
+// winner places that no other package covers.
+func validatePrizePackages(g *dg.Giveaway) error {
+	if len(g.PrizePackages) > maxPrizePackages {
+		return fmt.Errorf("at most %d prize_packages allowed", maxPrizePackages)
+	}
+	for i, p := range g.PrizePackages {
+		if p.Label == "" {
+			return errors.New("prize package label is required")
+		}
+		if len(p.Items) == 0 {
+			return fmt.Errorf("prize package %q has no items", p.Label)
+		}
+		if p.FromPlace < 1 || p.ToPlace < p.FromPlace || p.ToPlace > g.MaxWinnersCount {
+			return fmt.Errorf("prize package %q must cover places between 1 and %d", p.Label, g.MaxWinnersCount)
+		}
+		for _, q := range g.PrizePackages[:i] {
+			if p.FromPlace <= q.ToPlace && q.FromPlace <= p.ToPlace {
+				return fmt.Errorf("prize packages %q and %q overlap", q.Label, p.Label)
+			}
+		}
+	}
+	return nil
+}
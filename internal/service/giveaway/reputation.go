@@ -0,0 +1,272 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+const (
+	approvalRejectedReason = "Rejected by moderation"
+	approvalExpiredReason  = "The giveaway was not approved before it ended"
+	// maxReportReasonLen bounds the free-text reason of a giveaway report
+	maxReportReasonLen = 500
+)
+
+// WithReputation holds new giveaways of creators scoring below threshold for admin approval;
+// 0 disables the hold. Scores are kept up to date either way.
+func (s *Service) WithReputation(threshold int) *Service {
+	s.reputationThreshold = threshold
+	return s
+}
+
+// reputationScore maps creator behavior to 0..100. Cancellations weigh 40 points by share of
+// giveaways, reports 30 points reaching the maximum once 5% of participants reported, and open
+// prize disputes 30 points by share of winners. Creators without giveaways score 100.
+func reputationScore(st dg.ReputationStats) int {
+	penalty := 0.0
+	if st.Giveaways > 0 {
+		penalty += 0.4 * float64(st.Cancelled) / float64(st.Giveaways)
+	}
+	if st.Reports > 0 {
+		participants := math.Max(float64(st.Participants), 1)
+		penalty += 0.3 * math.Min(1, float64(st.Reports)/participants*20)
+	}
+	if st.Winners > 0 {
+		penalty += 0.3 * math.Min(1, float64(st.Disputes)/float64(st.Winners))
+	}
+	return int(math.Round(100 * math.Max(0, 1-penalty)))
+}
+
+// RefreshReputation recomputes and stores the reputation of a creator.
+func (s *Service) RefreshReputation(ctx context.Context, creatorID int64) (*dg.Reputation, error) {
+	st, err := s.repo.CreatorReputationStats(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	rep := &dg.Reputation{CreatorID: creatorID, Score: reputationScore(st), Stats: st, UpdatedAt: time.Now().UTC()}
+	if err := s.repo.SaveReputationScore(ctx, creatorID, rep.Score); err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
+
+// RefreshReputations recomputes every creator's score and cancels held giveaways that ended
+// before review. Returns how many creators were scored.
+func (s *Service) RefreshReputations(ctx context.Context) (int, error) {
+	ids, err := s.repo.ListCreatorIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, id := range ids {
+		if _, err := s.RefreshReputation(ctx, id); err != nil {
+			log.Printf("refresh reputation %d: %v", id, err)
+			continue
+		}
+		done++
+	}
+	s.expireUnapproved(ctx)
+	return done, nil
+}
+
+// ListReputations returns creators with the lowest scores first, each with fresh stats.
+func (s *Service) ListReputations(ctx context.Context, limit, offset int) ([]dg.Reputation, error) {
+	reps, err := s.repo.ListReputations(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	for i := range reps {
+		if reps[i].Stats, err = s.repo.CreatorReputationStats(ctx, reps[i].CreatorID); err != nil {
+			return nil, err
+		}
+	}
+	return reps, nil
+}
+
+// holdForApproval marks a new giveaway for admin approval when its creator scores below the
+// threshold; such giveaways are created scheduled.
+func (s *Service) holdForApproval(ctx context.Context, g *dg.Giveaway) error {
+	if s.reputationThreshold <= 0 {
+		return nil
+	}
+	rep, err := s.RefreshReputation(ctx, g.CreatorID)
+	if err != nil {
+		return err
+	}
+	if rep.Score < s.reputationThreshold {
+		g.Approval = &dg.Approval{Status: dg.ApprovalAwaiting, ReputationScore: rep.Score}
+	}
+	return nil
+}
+
+// requireApproved rejects activating a giveaway that still waits for admin approval.
+func (s *Service) requireApproved(ctx context.Context, id string) error {
+	a, err := s.repo.GetApproval(ctx, id)
+	if err != nil {
+		return err
+	}
+	if a != nil && a.Status == dg.ApprovalAwaiting {
+		return errors.New("awaiting approval")
+	}
+	return nil
+}
+
+// ReportGiveaway records a user's report of a giveaway and updates its creator's score.
+func (s *Service) ReportGiveaway(ctx context.Context, id string, reporterID int64, reason string) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	reason = strings.TrimSpace(reason)
+	if len([]rune(reason)) > maxReportReasonLen {
+		return errors.New("reason too long")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	if g.CreatorID == reporterID {
+		return errors.New("cannot report own giveaway")
+	}
+	ok, err := s.repo.CreateReport(ctx, id, reporterID, reason)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("already reported")
+	}
+	if _, err := s.RefreshReputation(ctx, g.CreatorID); err != nil {
+		log.Printf("refresh reputation %d: %v", g.CreatorID, err)
+	}
+	return nil
+}
+
+// DisputePrize lets a winner flag a claimed prize the creator has not delivered. The dispute
+// counts against the creator until the prize is marked delivered.
+func (s *Service) DisputePrize(ctx context.Context, id string, userID int64) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	state, err := s.winnerFulfillment(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	switch state {
+	case "":
+		return errors.New("not winner")
+	case dg.FulfillmentPending:
+		return errors.New("not claimed")
+	case dg.FulfillmentDelivered:
+		return errors.New("already delivered")
+	}
+	ok, err := s.repo.DisputePrize(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("already disputed")
+	}
+	if _, err := s.RefreshReputation(ctx, g.CreatorID); err != nil {
+		log.Printf("refresh reputation %d: %v", g.CreatorID, err)
+	}
+	return nil
+}
+
+// ListApprovals returns giveaways held for approval, awaiting ones when status is empty.
+func (s *Service) ListApprovals(ctx context.Context, status dg.ApprovalStatus, limit, offset int) ([]dg.Approval, error) {
+	switch status {
+	case "", dg.ApprovalAwaiting, dg.ApprovalApproved, dg.ApprovalRejected, dg.ApprovalExpired:
+	default:
+		return nil, errors.New("invalid status")
+	}
+	return s.repo.ListApprovals(ctx, status, limit, offset)
+}
+
+// ReviewApproval starts a held giveaway or rejects it on behalf of a platform admin.
+// Rejecting cancels the giveaway with reason (or a default).
+func (s *Service) ReviewApproval(ctx context.Context, id string, adminID int64, approve bool, reason string) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	a, err := s.repo.GetApproval(ctx, id)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		return errors.New("not found")
+	}
+	if a.Status != dg.ApprovalAwaiting {
+		return errors.New("nothing to review")
+	}
+	if approve {
+		ok, err := s.repo.ApproveGiveaway(ctx, id, adminID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("nothing to review")
+		}
+		return nil
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = approvalRejectedReason
+	}
+	if len([]rune(reason)) > maxCancelReasonLen {
+		return errors.New("reason too long")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	ok, err := s.repo.Cancel(ctx, id, adminID, reason, notify.CancelledMessage(g, reason))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("transition not allowed")
+	}
+	_, err = s.repo.SetApprovalStatus(ctx, id, dg.ApprovalRejected, adminID)
+	return err
+}
+
+// expireUnapproved cancels held giveaways whose end passed before an admin reviewed them.
+func (s *Service) expireUnapproved(ctx context.Context) {
+	ids, err := s.repo.ListUnapprovedExpiredIDs(ctx)
+	if err != nil {
+		log.Printf("list unapproved giveaways: %v", err)
+		return
+	}
+	for _, id := range ids {
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil || g == nil {
+			continue
+		}
+		if _, err := s.repo.Cancel(ctx, id, 0, approvalExpiredReason, notify.CancelledMessage(g, approvalExpiredReason)); err != nil {
+			log.Printf("cancel unapproved giveaway %s: %v", id, err)
+			continue
+		}
+		if _, err := s.repo.SetApprovalStatus(ctx, id, dg.ApprovalExpired, 0); err != nil {
+			log.Printf("expire approval %s: %v", id, err)
+		}
+	}
+}
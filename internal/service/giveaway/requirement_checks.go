@@ -0,0 +1,54 @@
+package giveaway
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
+)
+
+// maxConcurrentChecks bounds the checks of one evaluation running at once.
+const maxConcurrentChecks = 8
+
+// requirementCheckLimits bounds how many checks of one evaluation call a dependency at once,
+// so a giveaway with many channels stays within the Bot API and TON API rate limits.
+var requirementCheckLimits = map[requirements.Dependency]int{
+	requirements.DependencyTelegram: 4,
+	requirements.DependencyTON:      2,
+}
+
+// CheckAllRequirements checks every requirement for the user concurrently. Results are in
+// the order of reqs.
+func (s *Service) CheckAllRequirements(ctx context.Context, userID int64, reqs []dg.Requirement) []CheckRequirementResult {
+	out := make([]CheckRequirementResult, len(reqs))
+	sems := make(map[requirements.Dependency]chan struct{}, len(requirementCheckLimits))
+	for dep, n := range requirementCheckLimits {
+		sems[dep] = make(chan struct{}, n)
+	}
+	var eg errgroup.Group
+	eg.SetLimit(maxConcurrentChecks)
+	for i := range reqs {
+		rqm := &reqs[i]
+		sem := sems[requirements.DependencyOf(*rqm)]
+		if rqm.Unverifiable {
+			sem = nil
+		}
+		eg.Go(func() error {
+			if sem != nil {
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					out[i] = CheckRequirementResult{Status: "failed", Error: ctx.Err().Error()}
+					return nil
+				}
+			}
+			out[i] = s.CheckSingleRequirement(ctx, userID, rqm)
+			return nil
+		})
+	}
+	_ = eg.Wait()
+	return out
+}
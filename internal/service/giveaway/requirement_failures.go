@@ -0,0 +1,104 @@
+package giveaway
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// failureRatesKey caches the platform-wide failure rates by requirement type
+	failureRatesKey = "requirements:failure_rates"
+	failureRatesTTL = 10 * time.Minute
+	// failureRatesWindow limits platform-wide rates to users checked this recently
+	failureRatesWindow = 30 * 24 * time.Hour
+)
+
+// RecordRequirementChecks stores the outcome of checking reqs of a giveaway for a user, for
+// the failure analytics. results are the check results in the order of reqs. Best-effort:
+// failures are logged and never fail the check itself.
+func (s *Service) RecordRequirementChecks(ctx context.Context, giveawayID string, userID int64, reqs []dg.Requirement, results []CheckRequirementResult) {
+	outcomes := make([]dg.RequirementOutcome, 0, len(reqs))
+	for i, r := range reqs {
+		if r.ID == 0 || i >= len(results) {
+			continue
+		}
+		outcomes = append(outcomes, dg.RequirementOutcome{RequirementID: r.ID, Type: r.Type, Passed: results[i].Status == "success"})
+	}
+	if err := s.repo.RecordRequirementOutcomes(ctx, giveawayID, userID, outcomes); err != nil {
+		log.Printf("giveaway %s: record requirement outcomes of user %d: %v", giveawayID, userID, err)
+	}
+}
+
+// RequirementFailures returns how often users failed each requirement of a giveaway. Only the
+// creator can read it.
+func (s *Service) RequirementFailures(ctx context.Context, id string, requesterID int64) ([]dg.RequirementFailureStats, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.RequirementFailures(ctx, g.ID)
+}
+
+// GlobalRequirementFailures returns the platform-wide failure rates by requirement type over
+// the last 30 days, most failed first. Rates are cached in Redis for 10 minutes.
+func (s *Service) GlobalRequirementFailures(ctx context.Context) ([]dg.RequirementFailureStats, error) {
+	if s.rdb != nil {
+		if raw, err := s.rdb.Get(ctx, failureRatesKey).Bytes(); err == nil {
+			var cached []dg.RequirementFailureStats
+			if json.Unmarshal(raw, &cached) == nil {
+				return cached, nil
+			}
+		}
+	}
+	stats, err := s.repo.GlobalRequirementFailures(ctx, time.Now().Add(-failureRatesWindow))
+	if err != nil {
+		return nil, err
+	}
+	if s.rdb != nil {
+		if b, err := json.Marshal(stats); err == nil {
+			_ = s.rdb.Set(ctx, failureRatesKey, b, failureRatesTTL).Err()
+		}
+	}
+	return stats, nil
+}
+
+// RequirementHints warns about required requirements of g that many users fail, alone or
+// together, going by the platform-wide rates. It returns nil when the rates are unavailable.
+func (s *Service) RequirementHints(ctx context.Context, g *dg.Giveaway) []dg.RequirementHint {
+	if len(g.Requirements) == 0 {
+		return nil
+	}
+	global, err := s.GlobalRequirementFailures(ctx)
+	if err != nil {
+		log.Printf("requirement hints: %v", err)
+		return nil
+	}
+	return dg.FrictionHints(g.Requirements, global)
+}
+
+// recordUnmet records the requirement checks of CanJoin: required requirements of g missing
+// from unmet passed. Checks that could not run are left out.
+func (s *Service) recordUnmet(ctx context.Context, g *dg.Giveaway, userID int64, unmet []dg.UnmetRequirement) {
+	status := make(map[int64]string, len(unmet))
+	for _, u := range unmet {
+		status[u.ID] = u.Status
+	}
+	var reqs []dg.Requirement
+	var results []CheckRequirementResult
+	for _, r := range g.Requirements {
+		if r.IsOptional() || status[r.ID] == "error" {
+			continue
+		}
+		res := CheckRequirementResult{Status: "success"}
+		if _, failed := status[r.ID]; failed {
+			res.Status = "failed"
+		}
+		reqs = append(reqs, r)
+		results = append(results, res)
+	}
+	s.RecordRequirementChecks(ctx, g.ID, userID, reqs, results)
+}
@@ -0,0 +1,85 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// UpdateRequirements replaces the requirements of a giveaway that has not ended, e.g. to fix a
+// wrong channel, and records the change as a new revision. Requirements with an ID must be
+// ones the giveaway already has and keep their type and task claims; removed bonus tasks are
+// revoked at once. Bonus tasks of an active giveaway are then re-verified for every participant
+// in the background; mandatory requirements are checked again at the draw anyway.
+// Only the creator can edit them.
+func (s *Service) UpdateRequirements(ctx context.Context, id string, requesterID int64, reqs []dg.Requirement) (*dg.RequirementRevision, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	switch g.Status {
+	case dg.GiveawayStatusDraft, dg.GiveawayStatusScheduled, dg.GiveawayStatusActive:
+	default:
+		return nil, errors.New("giveaway ended")
+	}
+	current := make(map[int64]dg.Requirement, len(g.Requirements))
+	for _, r := range g.Requirements {
+		current[r.ID] = r
+	}
+	seen := make(map[int64]bool, len(reqs))
+	for i := range reqs {
+		if reqs[i].ID == 0 {
+			reqs[i].BundleID = 0
+			continue
+		}
+		cur, ok := current[reqs[i].ID]
+		if !ok {
+			return nil, errors.New("unknown requirement id")
+		}
+		if seen[reqs[i].ID] {
+			return nil, errors.New("duplicate requirement id")
+		}
+		seen[reqs[i].ID] = true
+		if cur.Type != reqs[i].Type {
+			return nil, errors.New("requirement type cannot change")
+		}
+		reqs[i].BundleID = cur.BundleID
+	}
+	if err := validateBonusTickets(reqs); err != nil {
+		return nil, err
+	}
+	if err := validateExistingMembers(reqs); err != nil {
+		return nil, err
+	}
+	if err := validateMemberDays(reqs); err != nil {
+		return nil, err
+	}
+	rev, err := s.repo.ReplaceRequirements(ctx, id, g.Requirements, reqs, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status == dg.GiveawayStatusActive {
+		go func() {
+			ctx := context.Background()
+			giv, err := s.repo.GetByID(ctx, id)
+			if err != nil || giv == nil {
+				log.Printf("requirements revision %s/%d: reload: %v", id, rev.Revision, err)
+				return
+			}
+			n := s.recheckGiveawayBonus(ctx, giv)
+			log.Printf("requirements revision %s/%d: tickets changed for %d participants", id, rev.Revision, n)
+		}()
+	}
+	return rev, nil
+}
+
+// RequirementRevisions returns the requirement revisions of a giveaway, newest first.
+// Only the creator can list them.
+func (s *Service) RequirementRevisions(ctx context.Context, id string, requesterID int64) ([]dg.RequirementRevision, error) {
+	if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRequirementRevisions(ctx, id)
+}
@@ -0,0 +1,118 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// maxReserveWinners bounds how many alternates are drawn after the winners
+	maxReserveWinners = 50
+	// Claim deadlines are counted from when a winner was drawn or promoted
+	minClaimDeadline = time.Hour
+	maxClaimDeadline = 30 * 24 * time.Hour
+
+	maxDisqualifyReasonLength = 200
+	missedClaimReason         = "missed the claim deadline"
+)
+
+func validateReserves(g *dg.Giveaway) error {
+	if g.ReserveWinnersCount < 0 || g.ReserveWinnersCount > maxReserveWinners {
+		return fmt.Errorf("reserve_winners_count must be between 0 and %d", maxReserveWinners)
+	}
+	if d := g.ClaimDeadlineSec; d != nil {
+		if dur := time.Duration(*d) * time.Second; dur < minClaimDeadline || dur > maxClaimDeadline {
+			return errors.New("claim_deadline_sec must be between 1 hour and 30 days")
+		}
+	}
+	return nil
+}
+
+// ListReserves returns the reserve winners of a giveaway to its creator and managers.
+func (s *Service) ListReserves(ctx context.Context, id string, requesterID int64) ([]dg.Reserve, error) {
+	if _, err := s.loadManagedGiveaway(ctx, id, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListReserves(ctx, id)
+}
+
+// DisqualifyWinner removes a winner of a completed giveaway and promotes the next reserve to
+// their place. Without reserves the place stays empty. Only the creator can disqualify.
+func (s *Service) DisqualifyWinner(ctx context.Context, id string, requesterID, winnerID int64, reason string) (*dg.Reserve, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status != dg.GiveawayStatusCompleted {
+		return nil, errors.New("not completed")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = "disqualified by the creator"
+	}
+	if utf8.RuneCountInString(reason) > maxDisqualifyReasonLength {
+		return nil, fmt.Errorf("reason exceeds %d characters", maxDisqualifyReasonLength)
+	}
+	payouts, err := s.repo.ListPayouts(ctx, id, "", 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(payouts) > 0 {
+		return nil, errors.New("payouts already prepared")
+	}
+	promoted, removed, err := s.repo.ReplaceWinner(ctx, id, winnerID, reason, requesterID, false)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, errors.New("not winner")
+	}
+	s.afterReplace(ctx, g, winnerID, promoted)
+	return promoted, nil
+}
+
+// PromoteMissedClaims replaces winners who missed the claim deadline with reserves and returns
+// how many were replaced. Winners stay when no reserve is left.
+func (s *Service) PromoteMissedClaims(ctx context.Context) (int, error) {
+	missed, err := s.repo.ListMissedClaims(ctx, time.Now().UTC(), 50)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, m := range missed {
+		promoted, removed, err := s.repo.ReplaceWinner(ctx, m.GiveawayID, m.UserID, missedClaimReason, 0, true)
+		if err != nil {
+			log.Printf("promote reserve %s: %v", m.GiveawayID, err)
+			continue
+		}
+		if !removed {
+			continue
+		}
+		if g, err := s.repo.GetByID(ctx, m.GiveawayID); err == nil && g != nil {
+			s.afterReplace(ctx, g, m.UserID, promoted)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// afterReplace refreshes win history caches and tells a promoted reserve they won.
+func (s *Service) afterReplace(ctx context.Context, g *dg.Giveaway, removedID int64, promoted *dg.Reserve) {
+	s.invalidateWins(ctx, removedID)
+	if promoted == nil {
+		return
+	}
+	s.invalidateWins(ctx, promoted.UserID)
+	if s.ntf != nil {
+		if err := s.ntf.SendWinnerDM(ctx, g, promoted.UserID); err != nil {
+			log.Printf("promoted reserve DM %s/%d: %v", g.ID, promoted.UserID, err)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// maxResultPostDelay bounds how long after completion results may be posted
+	maxResultPostDelay = 7 * 24 * time.Hour
+	// maxResultPostAttempts gives up on a channel the bot keeps failing to post in
+	maxResultPostAttempts = 5
+	// resultPostCaptionLimit is Telegram's caption limit
+	resultPostCaptionLimit = 1024
+)
+
+// loadOwnedGiveaway returns a giveaway of requesterID.
+func (s *Service) loadOwnedGiveaway(ctx context.Context, id string, requesterID int64) (*dg.Giveaway, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return g, nil
+}
+
+// SetResultPosts schedules the winners announcement in the chosen sponsor channels, delaySec after
+// the giveaway completes. It replaces posts not delivered yet; an empty list cancels them.
+func (s *Service) SetResultPosts(ctx context.Context, id string, requesterID int64, channelIDs []int64, delaySec int, imageURL string) ([]dg.ResultPost, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status == dg.GiveawayStatusCancelled {
+		return nil, errors.New("giveaway is cancelled")
+	}
+	if delaySec < 0 || time.Duration(delaySec)*time.Second > maxResultPostDelay {
+		return nil, fmt.Errorf("delay_sec must be between 0 and %d", int(maxResultPostDelay/time.Second))
+	}
+	imageURL = strings.TrimSpace(imageURL)
+	if imageURL != "" {
+		if u, err := url.Parse(imageURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return nil, errors.New("image_url must be an https URL")
+		}
+	}
+	sponsors := make(map[int64]bool, len(g.Sponsors))
+	for _, ch := range g.Sponsors {
+		if ch.ID != 0 {
+			sponsors[ch.ID] = true
+		}
+	}
+	posts := make([]dg.ResultPost, 0, len(channelIDs))
+	seen := make(map[int64]bool, len(channelIDs))
+	for _, chID := range channelIDs {
+		if !sponsors[chID] {
+			return nil, fmt.Errorf("channel %d is not a sponsor of this giveaway", chID)
+		}
+		if seen[chID] {
+			continue
+		}
+		seen[chID] = true
+		posts = append(posts, dg.ResultPost{ChannelID: chID, DelaySec: delaySec, ImageURL: imageURL})
+	}
+	if err := s.repo.ReplaceResultPosts(ctx, id, posts); err != nil {
+		return nil, err
+	}
+	return s.repo.ListResultPosts(ctx, id)
+}
+
+// ResultPosts lists the scheduled and delivered winners announcements of a giveaway for its creator.
+func (s *Service) ResultPosts(ctx context.Context, id string, requesterID int64) ([]dg.ResultPost, error) {
+	if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListResultPosts(ctx, id)
+}
+
+// PublishDueResultPosts posts winners announcements whose delay elapsed and returns how many were posted.
+func (s *Service) PublishDueResultPosts(ctx context.Context) (int, error) {
+	if s.ntf == nil {
+		return 0, nil
+	}
+	due, err := s.repo.ListDueResultPosts(ctx, time.Now().UTC(), 50)
+	if err != nil {
+		return 0, err
+	}
+	captions := make(map[string]string)
+	n := 0
+	for _, p := range due {
+		g, err := s.repo.GetByID(ctx, p.GiveawayID)
+		if err != nil || g == nil {
+			continue
+		}
+		caption, ok := captions[g.ID]
+		if !ok {
+			winners, err := s.repo.ListWinnersWithPrizes(ctx, g.ID)
+			if err != nil {
+				log.Printf("result post %s: %v", g.ID, err)
+				continue
+			}
+			caption = s.ntf.ResultsCaption(ctx, g, winners)
+			captions[g.ID] = caption
+		}
+		msgID, err := s.ntf.PostResults(ctx, p.ChannelID, g, p.ImageURL, caption)
+		if err != nil {
+			log.Printf("result post %s in channel %d: %v", g.ID, p.ChannelID, err)
+			if err := s.repo.MarkResultPostError(ctx, g.ID, p.ChannelID, err.Error(), maxResultPostAttempts); err != nil {
+				return n, err
+			}
+			continue
+		}
+		if err := s.repo.MarkResultPosted(ctx, g.ID, p.ChannelID, msgID); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// EditResultPost replaces the caption of a posted announcement. An empty caption re-renders the
+// winners list, e.g. after a reroll.
+func (s *Service) EditResultPost(ctx context.Context, id string, requesterID, channelID int64, caption string) (*dg.ResultPost, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.repo.GetResultPost(ctx, id, channelID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return nil, errors.New("not found")
+	}
+	if p.Status != dg.ResultPostPosted {
+		return nil, errors.New("not posted")
+	}
+	if s.ntf == nil {
+		return nil, errors.New("notifications disabled")
+	}
+	caption = strings.TrimSpace(caption)
+	if caption == "" {
+		winners, err := s.repo.ListWinnersWithPrizes(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		caption = s.ntf.ResultsCaption(ctx, g, winners)
+	}
+	if utf8.RuneCountInString(caption) > resultPostCaptionLimit {
+		return nil, fmt.Errorf("caption exceeds %d characters", resultPostCaptionLimit)
+	}
+	if err := s.ntf.EditResults(ctx, channelID, p.MessageID, g, caption); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DeleteResultPost removes a posted announcement from the channel, or cancels one not posted yet.
+func (s *Service) DeleteResultPost(ctx context.Context, id string, requesterID, channelID int64) error {
+	if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return err
+	}
+	p, err := s.repo.GetResultPost(ctx, id, channelID)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return errors.New("not found")
+	}
+	if p.Status != dg.ResultPostPosted {
+		return s.repo.DeleteResultPost(ctx, id, channelID)
+	}
+	if err := s.ntf.DeleteResults(ctx, channelID, p.MessageID); err != nil {
+		return err
+	}
+	return s.repo.MarkResultPostDeleted(ctx, id, channelID)
+}
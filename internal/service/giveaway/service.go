@@ -4,34 +4,72 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/big"
 	"strconv"
 	"strings"
 	"time"
 	"log"
 
 	"github.com/google/uuid"
+	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
 	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
 	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
 	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/service/analytics"
+	"github.com/open-builders/giveaway-backend/internal/service/moderation"
+	"github.com/open-builders/giveaway-backend/internal/service/screening"
 	channelsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
 	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
 	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
 	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
 	"github.com/open-builders/giveaway-backend/internal/utils/random"
+	"github.com/open-builders/giveaway-backend/internal/utils/richtext"
 	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
 )
 
 // Service contains business rules for giveaways.
 type Service struct {
 	repo     *repo.GiveawayRepository
-	tg       *tg.Client
+	tg       tg.API
 	ntf      *notify.Service
 	channels *channelsvc.Service
 	rdb      *redisp.Client
 	users    *usersvc.Service
 	ton      *tonb.Service
+	// Optional analytics buffer; nil drops events
+	analytics *analytics.Recorder
+	// Optional win history cache
+	wins *rcache.WinsCache
+	// Optional shards for the hot per-giveaway keys; without them those stay on rdb
+	shards *redisp.Shards
+	// Escrow wallet for on-chain prize funding; empty disables funding
+	escrow string
+	// Optional wallet screening before payouts
+	screener screening.Screener
+	// Optional content filter applied on create
+	moderation *moderation.Filter
+	// Pending expiry defaults; giveaways may override both
+	pendingTTL    time.Duration
+	pendingAction dg.PendingAction
+	// Pass requirements the bot can no longer verify instead of failing them
+	pauseUnverifiable bool
+	// Creators scoring below this need admin approval for new giveaways; 0 disables
+	reputationThreshold int
+	// HMAC key for join fingerprints; nil skips recording them
+	fingerprintKey []byte
+	// Completion SLA: giveaways unfinished this long after ends_at alert slaChatID; 0 disables
+	completionSLA time.Duration
+	slaChatID     int64
+	// Finishing giveaways above this many participants needs a co-admin confirmation; 0 disables
+	finishConfirmMin int
+	// How long before a pending giveaway auto-resolves its admins get a finish preview; 0 disables
+	finishPreviewLead time.Duration
+	// Optional file storage for cover uploads
+	covers storage.Storage
+	// Thresholds of the ending soon and just started list badges
+	badges dg.BadgeThresholds
 }
 
 func NewService(r *repo.GiveawayRepository, chs *channelsvc.Service) *Service {
@@ -39,7 +77,7 @@ func NewService(r *repo.GiveawayRepository, chs *channelsvc.Service) *Service {
 }
 
 // WithTelegram injects a Telegram client for requirements checks and enrichment.
-func (s *Service) WithTelegram(client *tg.Client) *Service { s.tg = client; return s }
+func (s *Service) WithTelegram(client tg.API) *Service { s.tg = client; return s }
 
 // WithNotifier injects notifications service for broadcasting updates.
 func (s *Service) WithNotifier(n *notify.Service) *Service { s.ntf = n; return s }
@@ -47,12 +85,34 @@ func (s *Service) WithNotifier(n *notify.Service) *Service { s.ntf = n; return s
 // WithRedis injects Redis client for requirement checks like boost.
 func (s *Service) WithRedis(rdb *redisp.Client) *Service { s.rdb = rdb; return s }
 
+// WithShards keeps the page cache and usage counters on the shard their key maps to.
+func (s *Service) WithShards(sh *redisp.Shards) *Service { s.shards = sh; return s }
+
+// hot returns the Redis instance holding a hot per-giveaway key.
+func (s *Service) hot(key string) *redisp.Client {
+	if s.shards != nil {
+		return s.shards.For(key)
+	}
+	return s.rdb
+}
+
 // WithUser injects user service for user-related checks.
 func (s *Service) WithUser(users *usersvc.Service) *Service { s.users = users; return s }
 
 // WithTonBalance injects TON balance service for on-chain checks.
 func (s *Service) WithTonBalance(ton *tonb.Service) *Service { s.ton = ton; return s }
 
+// WithAnalytics injects the recorder for funnel events.
+func (s *Service) WithAnalytics(rec *analytics.Recorder) *Service { s.analytics = rec; return s }
+
+// WithPendingPolicy sets how long giveaways may stay pending and what happens afterwards.
+// A zero ttl keeps pending giveaways until the creator acts.
+func (s *Service) WithPendingPolicy(ttl time.Duration, action dg.PendingAction) *Service {
+	s.pendingTTL = ttl
+	s.pendingAction = action
+	return s
+}
+
 // Create validates and persists a new giveaway.
 func (s *Service) Create(ctx context.Context, g *dg.Giveaway) (string, error) {
 	if g == nil {
@@ -61,28 +121,11 @@ func (s *Service) Create(ctx context.Context, g *dg.Giveaway) (string, error) {
 	if g.CreatorID == 0 {
 		return "", errors.New("missing creator_id")
 	}
-	if g.Title == "" {
-		return "", errors.New("missing title")
-	}
-	if g.EndsAt.Before(g.StartedAt) {
-		return "", errors.New("ends_at before started_at")
-	}
-	if g.StartedAt.Before(time.Now().Add(-1 * time.Hour)) {
-		return "", errors.New("started_at is too far in the past")
-	}
-	if g.EndsAt.Sub(g.StartedAt) < 5*time.Minute {
-		return "", errors.New("giveaway must last at least 5 minutes")
-	}
-	if g.MaxWinnersCount <= 0 {
-		return "", errors.New("winners_count must be > 0")
-	}
-	if g.Duration < 0 {
-		return "", errors.New("duration must be >= 0")
+	if err := s.validateNew(g); err != nil {
+		return "", err
 	}
-	// Validate maximum duration (2 months = 60 days = 5184000 seconds)
-	const maxDurationSeconds = 60 * 24 * 60 * 60 // 60 days in seconds
-	if g.Duration > maxDurationSeconds {
-		return "", errors.New("duration cannot exceed 2 months (60 days)")
+	if g.Status == dg.GiveawayStatusDraft {
+		return s.createDraft(ctx, g)
 	}
 
 	id := uuid.NewString()
@@ -96,13 +139,128 @@ func (s *Service) Create(ctx context.Context, g *dg.Giveaway) (string, error) {
 	}
 
 	g.Status = dg.GiveawayStatusActive
+	// Escrow-funded giveaways stay scheduled until the deposit is confirmed
+	if g.Funding != nil {
+		if err := s.prepareFunding(g.Funding); err != nil {
+			return "", err
+		}
+		g.Status = dg.GiveawayStatusScheduled
+	}
+	// Low-reputation creators wait for an admin to approve the giveaway
+	if err := s.holdForApproval(ctx, g); err != nil {
+		return "", err
+	}
+	if g.Approval != nil {
+		g.Status = dg.GiveawayStatusScheduled
+	}
+	// Catch double submissions from UI retries
+	dupKey, err := s.reserveDuplicate(ctx, g, g.AllowDuplicate)
+	if err != nil {
+		return "", err
+	}
 
 	if err := s.repo.Create(ctx, g); err != nil {
+		s.settleDuplicate(ctx, dupKey, "")
 		return "", err
 	}
+	s.settleDuplicate(ctx, dupKey, id)
+	s.trackEndsAt(ctx, g)
 	return id, nil
 }
 
+// validateNew checks the settings of a new giveaway or draft and sanitizes its texts.
+func (s *Service) validateNew(g *dg.Giveaway) error {
+	if g.Title == "" {
+		return errors.New("missing title")
+	}
+	if g.EndsAt.Before(g.StartedAt) {
+		return errors.New("ends_at before started_at")
+	}
+	if g.StartedAt.Before(time.Now().Add(-1 * time.Hour)) {
+		return errors.New("started_at is too far in the past")
+	}
+	if g.EndsAt.Sub(g.StartedAt) < 5*time.Minute {
+		return errors.New("giveaway must last at least 5 minutes")
+	}
+	if g.MaxWinnersCount <= 0 {
+		return errors.New("winners_count must be > 0")
+	}
+	if g.Duration < 0 {
+		return errors.New("duration must be >= 0")
+	}
+	// Validate maximum duration (2 months = 60 days = 5184000 seconds)
+	const maxDurationSeconds = 60 * 24 * 60 * 60 // 60 days in seconds
+	if g.Duration > maxDurationSeconds {
+		return errors.New("duration cannot exceed 2 months (60 days)")
+	}
+	if g.WinnerStrategy == "" {
+		g.WinnerStrategy = dg.WinnerStrategyRandom
+	}
+	if _, err := strategyFor(g.WinnerStrategy); err != nil {
+		return err
+	}
+	if err := validatePendingPolicy(g.PendingTTLSec, g.PendingAction); err != nil {
+		return err
+	}
+	if !g.WinnerOrder.Valid() {
+		return errors.New("invalid winner_order")
+	}
+	if err := validateTags(g); err != nil {
+		return err
+	}
+	if g.MaxParticipants != 0 && g.MaxParticipants < g.MaxWinnersCount {
+		return errors.New("max_participants must be at least winners_count")
+	}
+	if err := validateReserves(g); err != nil {
+		return err
+	}
+	if err := validateUnsubscribeGrace(g); err != nil {
+		return err
+	}
+	if err := validateBonusTickets(g.Requirements); err != nil {
+		return err
+	}
+	if err := validateExistingMembers(g.Requirements); err != nil {
+		return err
+	}
+	if err := validateMemberDays(g.Requirements); err != nil {
+		return err
+	}
+	if err := validateJoinWindows(g); err != nil {
+		return err
+	}
+	if err := validatePrizePackages(g); err != nil {
+		return err
+	}
+	if g.MaxPerFingerprint < 0 || g.MaxPerFingerprint > maxPerFingerprint {
+		return fmt.Errorf("max_accounts_per_fingerprint must be between 0 and %d", maxPerFingerprint)
+	}
+	// Check raw texts so links hidden in markup are seen too
+	if err := s.moderate(g); err != nil {
+		return err
+	}
+	// Descriptions accept limited Markdown/HTML; keep the sanitized form and its plain text
+	g.DescriptionHTML = richtext.Sanitize(g.Description)
+	g.Description = richtext.Plain(g.DescriptionHTML)
+	for i := range g.Prizes {
+		g.Prizes[i].DescriptionHTML = richtext.Sanitize(g.Prizes[i].Description)
+		g.Prizes[i].Description = richtext.Plain(g.Prizes[i].DescriptionHTML)
+	}
+	for i := range g.PrizePackages {
+		items := g.PrizePackages[i].Items
+		for j := range items {
+			items[j].DescriptionHTML = richtext.Sanitize(items[j].Description)
+			items[j].Description = richtext.Plain(items[j].DescriptionHTML)
+		}
+	}
+	for tag, t := range g.Translations {
+		t.DescriptionHTML = richtext.Sanitize(t.Description)
+		t.Description = richtext.Plain(t.DescriptionHTML)
+		g.Translations[tag] = t
+	}
+	return nil
+}
+
 // GetByID fetches giveaway by id.
 func (s *Service) GetByID(ctx context.Context, id string) (*dg.Giveaway, error) {
 	if id == "" {
@@ -150,6 +308,7 @@ func (s *Service) GetByID(ctx context.Context, id string) (*dg.Giveaway, error)
 						req.AvatarURL = ch.AvatarURL
 						req.ChannelUsername = ch.Username
 						req.ChannelID = ch.ID
+						req.ChannelStale = ch.Stale
 					}
 
 					req.AvatarURL = tgutils.BuildAvatarURL(key)
@@ -164,11 +323,15 @@ func (s *Service) GetByID(ctx context.Context, id string) (*dg.Giveaway, error)
 					req.AvatarURL = ch.AvatarURL
 					req.ChannelUsername = ch.Username
 					req.ChannelID = ch.ID
+					req.ChannelStale = ch.Stale
 				}
 				req.AvatarURL = tgutils.BuildAvatarURL(key)
 			}
 		}
 	}
+	if running(g) {
+		g.JoinWindow = joinWindowState(g, time.Now())
+	}
 	return g, nil
 }
 
@@ -177,7 +340,8 @@ func (s *Service) ListByCreator(ctx context.Context, creatorID int64, limit, off
 	if creatorID == 0 {
 		return nil, errors.New("missing creator_id")
 	}
-	return s.repo.ListByCreator(ctx, creatorID, limit, offset)
+	list, err := s.repo.ListByCreator(ctx, creatorID, limit, offset)
+	return s.applyBadges(list), err
 }
 
 // UpdateStatus changes the status with basic transition validation.
@@ -186,10 +350,19 @@ func (s *Service) UpdateStatus(ctx context.Context, id string, status dg.Giveawa
 		return errors.New("missing id")
 	}
 	switch status {
-	case dg.GiveawayStatusScheduled, dg.GiveawayStatusActive, dg.GiveawayStatusFinished, dg.GiveawayStatusCancelled, dg.GiveawayStatusPending, dg.GiveawayStatusCompleted:
+	case dg.GiveawayStatusScheduled, dg.GiveawayStatusActive, dg.GiveawayStatusFinished, dg.GiveawayStatusPending, dg.GiveawayStatusCompleted:
+	case dg.GiveawayStatusCancelled:
+		// Cancellation needs a reason and notifies participants; see Cancel
+		return errors.New("use cancel endpoint")
 	default:
 		return errors.New("invalid status")
 	}
+	// Drafts start through Publish, which sets their run time
+	if cur, err := s.repo.GetByID(ctx, id); err != nil {
+		return err
+	} else if cur != nil && cur.Status == dg.GiveawayStatusDraft {
+		return errors.New("use publish endpoint")
+	}
 	// Allow transition to completed only from pending
 	if status == dg.GiveawayStatusCompleted {
 		g, err := s.repo.GetByID(ctx, id)
@@ -202,10 +375,20 @@ func (s *Service) UpdateStatus(ctx context.Context, id string, status dg.Giveawa
 		if g.Status != dg.GiveawayStatusPending {
 			return errors.New("transition not allowed")
 		}
+		// Large giveaways wait for a co-admin to confirm the winners
+		if ok, err := s.finishConfirmed(ctx, g, dg.FinishTriggerCreatorConfirm); err != nil {
+			return err
+		} else if !ok {
+			return errAwaitingConfirmation
+		}
 		// Perform status update and then notify winners via DM
-		if err := s.repo.UpdateStatus(ctx, id, status); err != nil {
+		ok, err := s.repo.CompletePending(ctx, id)
+		if err != nil {
 			return err
 		}
+		if !ok {
+			return errors.New("transition not allowed")
+		}
 		if s.ntf != nil {
 			go func(giv *dg.Giveaway) {
 				// Refresh giveaway for any updated fields if needed
@@ -223,9 +406,84 @@ func (s *Service) UpdateStatus(ctx context.Context, id string, status dg.Giveawa
 		}
 		return nil
 	}
+	if status == dg.GiveawayStatusActive {
+		if err := s.requireFunded(ctx, id); err != nil {
+			return err
+		}
+		if err := s.requireApproved(ctx, id); err != nil {
+			return err
+		}
+	}
 	return s.repo.UpdateStatus(ctx, id, status)
 }
 
+// maxCancelReasonLen bounds the cancellation reason shown to participants.
+const maxCancelReasonLen = 500
+
+// Cancel cancels a giveaway on behalf of its creator. A reason is required; giveaways whose
+// winners were already drawn cannot be cancelled. Participants are notified by the broadcast worker.
+func (s *Service) Cancel(ctx context.Context, id string, requesterID int64, reason string) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return errors.New("missing reason")
+	}
+	if len([]rune(reason)) > maxCancelReasonLen {
+		return errors.New("reason too long")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return errors.New("forbidden")
+	}
+	switch {
+	case g.Status == dg.GiveawayStatusCancelled:
+		return errors.New("already cancelled")
+	case len(g.Winners) > 0 || g.Status == dg.GiveawayStatusCompleted || g.Status == dg.GiveawayStatusFinished:
+		return errors.New("winners already drawn")
+	}
+	ok, err := s.repo.Cancel(ctx, id, requesterID, reason, notify.CancelledMessage(g, reason))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Pending giveaways carry uploaded winners that GetByID does not load; otherwise
+		// we lost a race with the draw or another cancellation
+		if w, err := s.repo.ListWinnersWithPrizes(ctx, id); err == nil && len(w) > 0 {
+			return errors.New("winners already drawn")
+		}
+		return errors.New("transition not allowed")
+	}
+	g.Status = dg.GiveawayStatusCancelled
+	s.trackEndsAt(ctx, g)
+	return nil
+}
+
+// ListStatusHistory returns status changes with reasons; only the creator can view it.
+func (s *Service) ListStatusHistory(ctx context.Context, id string, requesterID int64) ([]dg.StatusChange, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.ListStatusHistory(ctx, id)
+}
+
 // Delete enforces ownership: only creator can delete, atomically.
 func (s *Service) Delete(ctx context.Context, id string, requesterID int64) error {
 	if id == "" {
@@ -251,8 +509,13 @@ func (s *Service) Delete(ctx context.Context, id string, requesterID int64) erro
 	return errors.New("forbidden")
 }
 
+// maxSourceRefLen bounds the free-form attribution reference stored with a participant.
+const maxSourceRefLen = 64
+
 // Join adds a user to giveaway participants, disallowing self-join (enforced in repo) and returns error if id empty.
-func (s *Service) Join(ctx context.Context, id string, userID int64) error {
+// source and ref record how the user arrived; unknown sources are stored as "unknown".
+// client is hashed into the join fingerprint.
+func (s *Service) Join(ctx context.Context, id string, userID int64, source dg.ParticipantSource, ref string, client JoinClient) error {
 	if id == "" {
 		return errors.New("missing id")
 	}
@@ -269,77 +532,49 @@ func (s *Service) Join(ctx context.Context, id string, userID int64) error {
 	if g.CreatorID == userID {
 		return errors.New("forbidden")
 	}
+	// Managers pick manual winners, so they cannot compete
+	if ok, err := s.repo.IsManager(ctx, g.ID, userID); err != nil {
+		return err
+	} else if ok {
+		return errors.New("forbidden")
+	}
+	if s.userBanned(ctx, userID) {
+		return errors.New("banned")
+	}
 	if g.Status != dg.GiveawayStatusActive {
 		return errors.New("join only allowed for active giveaways")
 	}
-	// Requirements check (TG errors treated as satisfied)
-	if s.tg != nil && len(g.Requirements) > 0 {
-		for _, req := range g.Requirements {
-			switch req.Type {
-			case dg.RequirementTypeSubscription:
-				chat := ""
-				if req.ChannelID != 0 {
-					chat = fmt.Sprintf("%d", req.ChannelID)
-				} else if req.ChannelUsername != "" {
-					chat = "@" + req.ChannelUsername
-				}
-				if chat == "" {
-					continue
-				}
-				ok, err := s.tg.CheckMembership(ctx, userID, chat)
-				if err != nil {
-					continue
-				}
-				if !ok {
-					return errors.New("requirements not satisfied")
-				}
-			case dg.RequirementTypeBoost:
-				chat := ""
-				if req.ChannelID != 0 {
-					chat = fmt.Sprintf("%d", req.ChannelID)
-				} else if req.ChannelUsername != "" {
-					chat = "@" + req.ChannelUsername
-				}
-				if chat == "" {
-					continue
-				}
-				// Prefer Redis-based check
-				if s.rdb != nil && req.ChannelID != 0 {
-					key := fmt.Sprintf("channel:%d:boost_users", req.ChannelID)
-					if ok, err := s.rdb.SIsMember(ctx, key, fmt.Sprintf("%d", userID)).Result(); err == nil {
-						if !ok {
-							return errors.New("requirements not satisfied")
-						}
-						continue
-					}
-				}
-				// Fallback to Telegram API
-				if s.tg != nil {
-					ok, err := s.tg.CheckBoost(ctx, userID, chat)
-					if err != nil {
-						continue
-					}
-					if !ok {
-						return errors.New("requirements not satisfied")
-					}
-				}
-			case dg.RequirementTypeAccountAge:
-				year := tgutils.EstimateAccountYear(userID)
-				if year == 0 {
-					continue // Cannot estimate, skip check
-				}
-				// Check minimum year (account must be registered in this year or later = not too old)
-				if req.AccountAgeMinYear > 0 && year < req.AccountAgeMinYear {
-					return errors.New("requirements not satisfied")
-				}
-				// Check maximum year (account must be registered in this year or earlier = not too new)
-				if req.AccountAgeMaxYear > 0 && year > req.AccountAgeMaxYear {
-					return errors.New("requirements not satisfied")
-				}
-			}
-		}
+	if err := CheckJoinWindow(g, time.Now()); err != nil {
+		return err
 	}
-	return s.repo.Join(ctx, id, userID)
+	// Required requirements go through their modules, as in CheckRequirements
+	if !s.CheckRequirements(ctx, userID, g.Requirements) {
+		return errors.New("requirements not satisfied")
+	}
+	if !source.Valid() {
+		source = dg.ParticipantSourceUnknown
+	}
+	ref = strings.TrimSpace(ref)
+	if r := []rune(ref); len(r) > maxSourceRefLen {
+		ref = string(r[:maxSourceRefLen])
+	}
+	joined, err := s.repo.Join(ctx, id, userID, source, ref)
+	if err != nil {
+		return err
+	}
+	if joined {
+		s.analytics.Record(ctx, analytics.EventGiveawayJoin, id, userID)
+		s.recordFingerprint(ctx, g, userID, client)
+		s.syncBonusTasks(ctx, id, userID, g.Requirements)
+	}
+	return nil
+}
+
+// RecordView buffers a giveaway page view for the analytics exporter and counts it towards
+// the trending giveaways.
+func (s *Service) RecordView(ctx context.Context, id string, userID int64) {
+	s.analytics.Record(ctx, analytics.EventGiveawayView, id, userID)
+	s.countTrendingView(ctx, id)
 }
 
 // FinishExpired marks all expired giveaways as finished; returns updated count.
@@ -354,8 +589,15 @@ func (s *Service) FinishExpired(ctx context.Context) (int64, error) {
 			// Continue on error to not block other giveaways
 			continue
 		}
+		// Completion lag for SLA monitoring
+		if err := s.repo.MarkFinished(ctx, id); err != nil {
+			log.Printf("mark finished %s: %v", id, err)
+		}
 		done++
 	}
+	if done > 0 {
+		s.pruneMemberJoins(ctx)
+	}
 	return done, nil
 }
 
@@ -378,7 +620,7 @@ func (s *Service) ReprocessCompletedNoWinners(ctx context.Context) (int64, error
 	return done, nil
 }
 
-// FinishOneWithDistribution finalizes one giveaway with distribution logic.
+// FinishOneWithDistribution finalizes one giveaway using its configured winner strategy.
 func (s *Service) FinishOneWithDistribution(ctx context.Context, id string) error {
 	if id == "" {
 		return errors.New("missing id")
@@ -391,58 +633,73 @@ func (s *Service) FinishOneWithDistribution(ctx context.Context, id string) erro
 		return errors.New("not found")
 	}
 	// If custom requirement exists, move to pending and return (winners will be uploaded manually)
+	manual := g.WinnerStrategy == dg.WinnerStrategyManual
 	for _, req := range g.Requirements {
 		if req.Type == dg.RequirementTypeCustom {
-			if err := s.repo.UpdateStatus(ctx, id, dg.GiveawayStatusPending); err != nil {
-				return err
-			}
-			// Notify creator that action is required
-			if s.ntf != nil {
-				go s.ntf.NotifyCreatorPending(context.Background(), g)
-			}
-			return nil
+			manual = true
+			break
 		}
 	}
+	if manual {
+		return s.moveToPending(ctx, g)
+	}
 
-	participants, err := s.repo.GetParticipants(ctx, id)
+	participants, err := s.repo.ListParticipantEntries(ctx, id)
 	if err != nil {
 		return err
 	}
-
-	// Shuffle participants securely
-	if err := random.Shuffle(participants); err != nil {
+	// Winners of earlier waves keep their places; the final draw fills the remaining slots
+	waveWinners, err := s.repo.ListWaveWinnerIDs(ctx, id)
+	if err != nil {
 		return err
 	}
+	remaining := g.MaxWinnersCount - len(waveWinners)
 
-	winnersCount := g.MaxWinnersCount
-	if winnersCount <= 0 {
-		winnersCount = 1
-	}
-
-	winners := make([]int64, 0, winnersCount)
-
-	for _, uid := range participants {
-		if s.CheckRequirements(ctx, uid, g.Requirements) {
-			winners = append(winners, uid)
-			if len(winners) >= winnersCount {
-				break
+	if len(waveWinners) > 0 && remaining <= 0 {
+		if err := s.repo.FinishWithWinners(ctx, id, nil); err != nil {
+			return err
+		}
+	} else {
+		seed, err := random.NewSeed()
+		if err != nil {
+			return err
+		}
+		gf := *g
+		if len(waveWinners) > 0 {
+			gf.MaxWinnersCount = remaining
+		}
+		var checks []dg.RequirementSnapshot
+		eligible, err := s.lapsedEligible(ctx, id, func(uid int64) bool {
+			ok, snap := s.checkRequirementsSnapshot(ctx, uid, g.Requirements)
+			checks = append(checks, snap...)
+			// Avoid rate limits by adding a small delay between checks
+			if len(g.Requirements) > 0 {
+				time.Sleep(50 * time.Millisecond)
 			}
+			return ok
+		})
+		if err != nil {
+			return err
 		}
-		// Avoid rate limits by adding a small delay between checks
-		if len(g.Requirements) > 0 {
-			time.Sleep(50 * time.Millisecond)
+		draw, err := runDraw(&gf, g.WinnerStrategy, seed, excludeParticipants(participants, waveWinners), eligible)
+		if errors.Is(err, errManualSelection) {
+			return s.moveToPending(ctx, g)
 		}
-	}
+		if err != nil {
+			return err
+		}
+		draw.Checks = checks
 
-	if err := s.repo.FinishWithWinners(ctx, id, winners); err != nil {
-		return err
+		if err := s.repo.FinishWithDraw(ctx, draw); err != nil {
+			return err
+		}
 	}
-	// Best-effort DM notification to winners only
+	// Best-effort DM notification to winners only; wave winners were notified when drawn
 	if s.ntf != nil {
 		go func(giv *dg.Giveaway) {
 			winners, err := s.repo.ListWinnersWithPrizes(context.Background(), giv.ID)
-			if err == nil && len(winners) > 0 {
-				s.ntf.NotifyWinnersDM(context.Background(), giv, winners)
+			if err == nil && len(winners) > len(waveWinners) {
+				s.ntf.NotifyWinnersDM(context.Background(), giv, winners[len(waveWinners):])
 			}
 			// Notify creator that giveaway is completed
 			s.ntf.NotifyCreatorCompleted(context.Background(), giv)
@@ -451,6 +708,18 @@ func (s *Service) FinishOneWithDistribution(ctx context.Context, id string) erro
 	return nil
 }
 
+// moveToPending parks a finished giveaway until the creator uploads winners manually.
+func (s *Service) moveToPending(ctx context.Context, g *dg.Giveaway) error {
+	if err := s.repo.UpdateStatus(ctx, g.ID, dg.GiveawayStatusPending); err != nil {
+		return err
+	}
+	// Notify creator that action is required
+	if s.ntf != nil {
+		go s.ntf.NotifyCreatorPending(context.Background(), g)
+	}
+	return nil
+}
+
 // FinalizePendingWithCandidates filters provided candidates by non-custom requirements and finalizes giveaway.
 func (s *Service) FinalizePendingWithCandidates(ctx context.Context, id string, requesterID int64, candidates []string) (int, int, error) {
 	if id == "" {
@@ -545,9 +814,10 @@ func (s *Service) ListFinishedByCreator(ctx context.Context, creatorID int64, li
 	return s.repo.ListFinishedByCreator(ctx, creatorID, limit, offset)
 }
 
-// ListActive returns active giveaways with default minParticipants when zero.
-func (s *Service) ListActive(ctx context.Context, limit, offset, minParticipants int) ([]dg.Giveaway, error) {
-	return s.repo.ListActive(ctx, limit, offset, minParticipants)
+// ListActive returns active giveaways matching f.
+func (s *Service) ListActive(ctx context.Context, limit, offset int, f dg.ActiveFilter) ([]dg.Giveaway, error) {
+	list, err := s.repo.ListActive(ctx, limit, offset, f)
+	return s.applyBadges(list), err
 }
 
 // GetUserRole returns the role of a given user in a giveaway context.
@@ -638,6 +908,7 @@ func (s *Service) FinalizeWithWinners(ctx context.Context, id string, winners []
 }
 
 // SetManualWinners stores winners and distributes prizes while keeping giveaway pending.
+// The creator and managers can set them.
 func (s *Service) SetManualWinners(ctx context.Context, id string, requesterID int64, winners []int64) error {
 	if id == "" {
 		return errors.New("missing id")
@@ -649,7 +920,7 @@ func (s *Service) SetManualWinners(ctx context.Context, id string, requesterID i
 	if g == nil {
 		return errors.New("not found")
 	}
-	if g.CreatorID != requesterID {
+	if !s.CanManage(ctx, g, requesterID) {
 		return errors.New("forbidden")
 	}
 	if string(g.Status) != "pending" {
@@ -658,7 +929,7 @@ func (s *Service) SetManualWinners(ctx context.Context, id string, requesterID i
 	if len(winners) == 0 {
 		return errors.New("Not enough winners")
 	}
-	// Keep only participants, dedupe
+	// Keep only participants who do not manage the giveaway, dedupe
 	filtered := make([]int64, 0, len(winners))
 	seen := make(map[int64]struct{}, len(winners))
 	for _, uid := range winners {
@@ -669,6 +940,9 @@ func (s *Service) SetManualWinners(ctx context.Context, id string, requesterID i
 			continue
 		}
 		seen[uid] = struct{}{}
+		if s.CanManage(ctx, g, uid) {
+			continue
+		}
 		ok, err := s.repo.IsParticipant(ctx, id, uid)
 		if err != nil || !ok {
 			continue
@@ -693,6 +967,34 @@ func (s *Service) ListWinnersWithPrizes(ctx context.Context, id string) ([]dg.Wi
 	return s.repo.ListWinnersWithPrizes(ctx, id)
 }
 
+// WinnerRequirementSnapshots returns the latest recorded requirement outcomes of winners, for
+// the requirement columns of winner exports.
+func (s *Service) WinnerRequirementSnapshots(ctx context.Context, id string, winners []dg.Winner) ([]dg.RequirementSnapshot, error) {
+	ids := make([]int64, len(winners))
+	for i, w := range winners {
+		ids[i] = w.UserID
+	}
+	return s.repo.ListUserRequirementSnapshots(ctx, id, ids)
+}
+
+// SourceBreakdown returns participant counts per join source; only the creator can view it.
+func (s *Service) SourceBreakdown(ctx context.Context, id string, requesterID int64) ([]dg.SourceStats, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.SourceBreakdown(ctx, id)
+}
+
 // ClearManualWinners removes all winners for a pending giveaway; only creator can perform.
 func (s *Service) ClearManualWinners(ctx context.Context, id string, requesterID int64) error {
 	if id == "" {
@@ -717,10 +1019,13 @@ func (s *Service) ClearManualWinners(ctx context.Context, id string, requesterID
 	return s.repo.ClearWinners(ctx, id)
 }
 
-// CheckRequirements verifies if a user meets all giveaway requirements.
-// It now iterates through all requirements using CheckSingleRequirement.
+// CheckRequirements verifies if a user meets all mandatory giveaway requirements.
+// It now iterates through all requirements using CheckSingleRequirement; optional ones are skipped.
 func (s *Service) CheckRequirements(ctx context.Context, uid int64, reqs []dg.Requirement) bool {
 	for _, req := range reqs {
+		if req.IsOptional() {
+			continue
+		}
 		res := s.CheckSingleRequirement(ctx, uid, &req)
 		if res.Status != "success" {
 			log.Printf("Requirement check failed for user=%d type=%s: error=%s", uid, req.Type, res.Error)
@@ -730,158 +1035,52 @@ func (s *Service) CheckRequirements(ctx context.Context, uid int64, reqs []dg.Re
 	return true
 }
 
-// CheckRequirementResult is the result of checking a single requirement.
-type CheckRequirementResult struct {
-	Status string
-	Error  string
+// checkRequirementsSnapshot works like CheckRequirements and also returns the outcome of
+// every evaluated requirement so it can be stored with the draw.
+func (s *Service) checkRequirementsSnapshot(ctx context.Context, uid int64, reqs []dg.Requirement) (bool, []dg.RequirementSnapshot) {
+	out := make([]dg.RequirementSnapshot, 0, len(reqs))
+	for i, req := range reqs {
+		if req.IsOptional() {
+			continue
+		}
+		res := s.CheckSingleRequirement(ctx, uid, &req)
+		out = append(out, dg.RequirementSnapshot{
+			UserID:           uid,
+			RequirementIndex: i,
+			RequirementType:  req.Type,
+			ChannelID:        req.ChannelID,
+			Status:           res.Status,
+			Error:            res.Error,
+			CheckedAt:        time.Now().UTC(),
+		})
+		if res.Status != "success" {
+			log.Printf("Requirement check failed for user=%d type=%s: error=%s", uid, req.Type, res.Error)
+			return false, out
+		}
+	}
+	return true, out
 }
 
-// CheckSingleRequirement verifies one requirement for the given user.
+// CheckRequirementResult is the result of checking a single requirement.
+type CheckRequirementResult = requirements.Result
+
+// CheckSingleRequirement verifies one requirement for the given user with its type's module.
 func (s *Service) CheckSingleRequirement(ctx context.Context, userID int64, rqm *dg.Requirement) CheckRequirementResult {
-	res := CheckRequirementResult{Status: "failed"}
-	switch rqm.Type {
-	case dg.RequirementTypeSubscription:
-		chat := ""
-		if rqm.ChannelID != 0 {
-			chat = fmt.Sprintf("%d", rqm.ChannelID)
-		} else if rqm.ChannelUsername != "" {
-			chat = "@" + rqm.ChannelUsername
-		}
-		if chat == "" {
-			res.Error = "invalid requirement: no channel"
-			return res
-		}
-		if s.tg == nil {
-			// If telegram client is missing, we cannot verify, assuming success to avoid blocking (or fail?)
-			// Assuming fail if strict, but preserving old behavior might be safer.
-			// However, handler logic implies check must pass.
-			res.Error = "telegram service not configured"
-			return res
-		}
-		ok, e := s.tg.CheckMembership(ctx, userID, chat)
-		if e != nil {
-			res.Error = e.Error()
-			return res
-		}
-		if ok {
-			res.Status = "success"
-		}
-		return res
-	case dg.RequirementTypeBoost:
-		chat := ""
-		if rqm.ChannelID != 0 {
-			chat = fmt.Sprintf("%d", rqm.ChannelID)
-		} else if rqm.ChannelUsername != "" {
-			chat = "@" + rqm.ChannelUsername
-		}
-		if chat == "" {
-			res.Error = "invalid requirement: no channel"
-			return res
-		}
-		// Prefer Redis-based boost check if available
-		if s.rdb != nil && rqm.ChannelID != 0 {
-			key := fmt.Sprintf("channel:%d:boost_users", rqm.ChannelID)
-			uid := fmt.Sprintf("%d", userID)
-			if ok, err := s.rdb.SIsMember(ctx, key, uid).Result(); err == nil && ok {
-				res.Status = "success"
-				return res
-			}
-		}
-		// Fallback to Telegram API check
-		if s.tg != nil {
-			ok, e := s.tg.CheckBoost(ctx, userID, chat)
-			if e != nil {
-				res.Error = e.Error()
-				return res
-			}
-			if ok {
-				res.Status = "success"
-			}
-		}
-		return res
-	case dg.RequirementTypeCustom:
-		res.Status = "success"
-		return res
-	case dg.RequirementTypePremium:
-		if s.users != nil {
-			if u, err := s.users.GetByID(ctx, userID); err == nil && u != nil && u.IsPremium {
-				res.Status = "success"
-				return res
-			}
-		}
-		return res
-	case dg.RequirementTypeHoldTON:
-		if s.users == nil || s.ton == nil {
-			res.Error = "ton service not configured"
-			return res
-		}
-		u, err := s.users.GetByID(ctx, userID)
-		if err != nil || u == nil || u.WalletAddress == "" {
-			res.Error = "wallet not linked"
-			return res
-		}
-		bal, err := s.ton.GetAddressBalanceNano(ctx, u.WalletAddress)
-		if err != nil {
-			res.Error = err.Error()
-			return res
-		}
-		if rqm.TonMinBalanceNano > 0 && bal >= rqm.TonMinBalanceNano {
-			res.Status = "success"
-		}
-		return res
-	case dg.RequirementTypeHoldJetton:
-		if s.users == nil || s.ton == nil {
-			res.Error = "ton service not configured"
-			return res
-		}
-		u, err := s.users.GetByID(ctx, userID)
-		if err != nil || u == nil || u.WalletAddress == "" {
-			res.Error = "wallet not linked"
-			return res
-		}
-		if rqm.JettonAddress == "" || rqm.JettonMinAmount <= 0 {
-			res.Error = "invalid jetton requirement"
-			return res
-		}
-		bal, err := s.ton.GetJettonBalanceNano(ctx, u.WalletAddress, rqm.JettonAddress)
-		if err != nil {
-			res.Error = err.Error()
-			return res
-		}
-		dec, derr := s.ton.GetJettonDecimals(ctx, rqm.JettonAddress)
-		if derr != nil {
-			res.Error = derr.Error()
-			return res
-		}
-		req := new(big.Int).SetInt64(rqm.JettonMinAmount)
-		pow10 := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(dec)), nil)
-		req.Mul(req, pow10)
-		balBI := new(big.Int).SetInt64(bal)
-		if balBI.Cmp(req) >= 0 {
-			res.Status = "success"
-		}
-		return res
-	case dg.RequirementTypeAccountAge:
-		// Estimate year from ID
-		year := tgutils.EstimateAccountYear(userID)
-		if year == 0 {
-			res.Error = "could not estimate account age"
-			return res
-		}
-		// Check minimum year (account cannot be older than this)
-		if rqm.AccountAgeMinYear > 0 && year < rqm.AccountAgeMinYear {
-			res.Error = fmt.Sprintf("account too old: registered ~%d, required >= %d", year, rqm.AccountAgeMinYear)
-			return res
-		}
-		// Check maximum year (account cannot be newer than this)
-		if rqm.AccountAgeMaxYear > 0 && year > rqm.AccountAgeMaxYear {
-			res.Error = fmt.Sprintf("account too new: registered ~%d, required <= %d", year, rqm.AccountAgeMaxYear)
-			return res
-		}
-		res.Status = "success"
-		return res
-	default:
-		res.Error = "unsupported requirement type"
-		return res
+	if rqm.Unverifiable {
+		return s.unverifiableResult(rqm)
+	}
+	return requirements.Check(ctx, s.requirementDeps(), userID, rqm)
+}
+
+// requirementDeps hands the configured clients to requirement checks.
+func (s *Service) requirementDeps() requirements.Deps {
+	return requirements.Deps{
+		Telegram: s.tg,
+		Redis:    s.rdb,
+		Users:    s.users,
+		TON:      s.ton,
+		Membership: func(ctx context.Context, userID int64, rqm *dg.Requirement, res CheckRequirementResult) CheckRequirementResult {
+			return s.checkMemberDays(ctx, userID, rqm, s.checkExistingMember(ctx, userID, rqm, res))
+		},
 	}
 }
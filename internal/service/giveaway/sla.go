@@ -0,0 +1,76 @@
+package giveaway
+
+import (
+	"context"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+const (
+	// slaAlertCooldown keeps one stuck giveaway from alerting on every check
+	slaAlertCooldown = 6 * time.Hour
+	// maxOverdueListed bounds the overdue giveaways listed in one alert or report
+	maxOverdueListed = 20
+)
+
+// WithCompletionSLA alerts chatID when a giveaway is still unfinished sla after it ended.
+// A zero sla disables alerts; lag is still reported.
+func (s *Service) WithCompletionSLA(sla time.Duration, chatID int64) *Service {
+	s.completionSLA = sla
+	s.slaChatID = chatID
+	return s
+}
+
+// CheckCompletionSLA alerts about giveaways overdue past the SLA that were not reported in
+// the last slaAlertCooldown and returns how many were reported.
+func (s *Service) CheckCompletionSLA(ctx context.Context) (int, error) {
+	if s.completionSLA <= 0 || s.slaChatID == 0 || s.ntf == nil {
+		return 0, nil
+	}
+	overdue, err := s.repo.ListOverdue(ctx, time.Now().UTC().Add(-s.completionSLA), maxOverdueListed)
+	if err != nil {
+		return 0, err
+	}
+	fresh := overdue[:0]
+	for _, l := range overdue {
+		if s.rdb != nil {
+			ok, err := s.rdb.SetNX(ctx, "giveaway:"+l.GiveawayID+":sla_alerted", "1", slaAlertCooldown).Result()
+			if err != nil {
+				log.Printf("sla alert dedup: %v", err)
+			} else if !ok {
+				continue
+			}
+		}
+		fresh = append(fresh, l)
+	}
+	if len(fresh) == 0 {
+		return 0, nil
+	}
+	if err := s.ntf.NotifyAdminCompletionSLA(ctx, s.slaChatID, s.completionSLA, fresh); err != nil {
+		// Retry these on the next check
+		if s.rdb != nil {
+			for _, l := range fresh {
+				_ = s.rdb.Del(ctx, "giveaway:"+l.GiveawayID+":sla_alerted").Err()
+			}
+		}
+		return 0, err
+	}
+	return len(fresh), nil
+}
+
+// CompletionSLAReport returns giveaways overdue right now and completion lag of the last day.
+func (s *Service) CompletionSLAReport(ctx context.Context) (*dg.CompletionSLAReport, error) {
+	now := time.Now().UTC()
+	rep := &dg.CompletionSLAReport{SLASec: int64(s.completionSLA / time.Second)}
+	overdue, err := s.repo.ListOverdue(ctx, now.Add(-s.completionSLA), maxOverdueListed)
+	if err != nil {
+		return nil, err
+	}
+	rep.Overdue = overdue
+	if rep.LastDay, err = s.repo.CompletionLagStats(ctx, now.Add(-24*time.Hour), s.completionSLA); err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
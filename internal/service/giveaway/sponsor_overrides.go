@@ -0,0 +1,91 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/service/moderation"
+)
+
+// maxSponsorTitleLen matches the longest channel title Telegram allows.
+const maxSponsorTitleLen = 128
+
+// SetSponsorOverride replaces the display title and avatar of a sponsor channel, e.g. after it
+// was renamed. A nil field keeps its override and an empty one restores the Telegram data.
+// The cached Telegram data stays untouched and admins see both. Only the creator can set it.
+func (s *Service) SetSponsorOverride(ctx context.Context, id string, requesterID, channelID int64, title, avatarURL *string) (*dg.ChannelInfo, error) {
+	g, err := s.loadOwnedGiveaway(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if title != nil {
+		t := strings.TrimSpace(*title)
+		if len([]rune(t)) > maxSponsorTitleLen {
+			return nil, errors.New("title is too long")
+		}
+		if s.moderation != nil && t != "" {
+			if res := s.moderation.Check(moderation.Field{Name: "title", Text: t}); len(res.Blocked) > 0 {
+				return nil, res.Blocked[0]
+			}
+		}
+		title = &t
+	}
+	if avatarURL != nil {
+		a := strings.TrimSpace(*avatarURL)
+		if a != "" {
+			if u, err := url.Parse(a); err != nil || u.Scheme != "https" || u.Host == "" {
+				return nil, errors.New("avatar_url must be an https URL")
+			}
+		}
+		avatarURL = &a
+	}
+	ok, err := s.repo.SetSponsorOverride(ctx, g.ID, channelID, title, avatarURL, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("sponsor not found")
+	}
+	log.Printf("giveaway %s: creator %d changed display of sponsor %d", g.ID, requesterID, channelID)
+	return s.displayedSponsor(ctx, g.ID, channelID)
+}
+
+// ClearSponsorOverride restores the Telegram title and avatar of a sponsor, for admins
+// reviewing overrides.
+func (s *Service) ClearSponsorOverride(ctx context.Context, id string, channelID, adminID int64) error {
+	empty := ""
+	ok, err := s.repo.SetSponsorOverride(ctx, id, channelID, &empty, &empty, adminID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("sponsor not found")
+	}
+	log.Printf("giveaway %s: admin %d cleared display override of sponsor %d", id, adminID, channelID)
+	return nil
+}
+
+// ListSponsorOverrides returns sponsors whose display the creator replaced, latest first.
+func (s *Service) ListSponsorOverrides(ctx context.Context, limit, offset int) ([]dg.SponsorOverride, error) {
+	return s.repo.ListSponsorOverrides(ctx, limit, offset)
+}
+
+// displayedSponsor returns a sponsor of a giveaway as users see it.
+func (s *Service) displayedSponsor(ctx context.Context, id string, channelID int64) (*dg.ChannelInfo, error) {
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g != nil {
+		for i := range g.Sponsors {
+			if g.Sponsors[i].ID == channelID {
+				return &g.Sponsors[i], nil
+			}
+		}
+	}
+	return nil, errors.New("sponsor not found")
+}
@@ -0,0 +1,53 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// maxStatusBatch bounds how many giveaways one status batch may ask for.
+const maxStatusBatch = 100
+
+// StatusBatch returns status summaries of up to maxStatusBatch giveaways in request order.
+// Duplicate and empty ids are ignored, and unknown ids are left out of the result.
+func (s *Service) StatusBatch(ctx context.Context, ids []string) ([]dg.StatusSummary, error) {
+	uniq := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		uniq = append(uniq, id)
+	}
+	if len(uniq) == 0 {
+		return nil, errors.New("missing ids")
+	}
+	if len(uniq) > maxStatusBatch {
+		return nil, errors.New("too many ids")
+	}
+	items, err := s.repo.ListStatusSummaries(ctx, uniq)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]dg.StatusSummary, len(items))
+	now := time.Now().UTC()
+	for _, it := range items {
+		if left := it.EndsAt.Sub(now); left > 0 {
+			it.TimeLeftSec = int64(left / time.Second)
+		}
+		byID[it.ID] = it
+	}
+	out := make([]dg.StatusSummary, 0, len(items))
+	for _, id := range uniq {
+		if it, ok := byID[id]; ok {
+			out = append(out, it)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,101 @@
+package giveaway
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/random"
+)
+
+// errManualSelection is returned by strategies that leave winner selection to the creator.
+var errManualSelection = errors.New("manual winner selection")
+
+// WinnerStrategy orders participants into a candidate queue. The completion flow walks
+// the queue and keeps the first candidates that still satisfy the requirements.
+// Participants are passed in canonical order (by user ID) and all randomness must come
+// from rng so a draw can be reproduced from its seed.
+type WinnerStrategy interface {
+	Candidates(rng *random.Seeded, participants []dg.Participant) ([]int64, error)
+}
+
+// strategyFor resolves the strategy configured for a giveaway; empty means uniform random.
+func strategyFor(name dg.WinnerStrategy) (WinnerStrategy, error) {
+	switch name {
+	case "", dg.WinnerStrategyRandom:
+		return uniformStrategy{}, nil
+	case dg.WinnerStrategyWeighted:
+		return weightedStrategy{}, nil
+	case dg.WinnerStrategyFirstN:
+		return firstNStrategy{}, nil
+	case dg.WinnerStrategyManual:
+		return manualStrategy{}, nil
+	}
+	return nil, errors.New("invalid winner_strategy")
+}
+
+// uniformStrategy gives every participant the same chance.
+type uniformStrategy struct{}
+
+func (uniformStrategy) Candidates(rng *random.Seeded, participants []dg.Participant) ([]int64, error) {
+	ids := participantIDs(participants)
+	random.ShuffleSeeded(rng, ids)
+	return ids, nil
+}
+
+// weightedStrategy draws without replacement proportionally to tickets
+// (Efraimidis–Spirakis: order by -ln(u)/w ascending).
+type weightedStrategy struct{}
+
+func (weightedStrategy) Candidates(rng *random.Seeded, participants []dg.Participant) ([]int64, error) {
+	type keyed struct {
+		id  int64
+		key float64
+	}
+	items := make([]keyed, 0, len(participants))
+	for _, p := range participants {
+		w := p.Tickets
+		if w <= 0 {
+			w = 1
+		}
+		u := rng.Float64()
+		// u is in [0,1); shift to (0,1] so the logarithm stays finite
+		items = append(items, keyed{id: p.UserID, key: -math.Log(1-u) / float64(w)})
+	}
+	sort.SliceStable(items, func(i, j int) bool { return items[i].key < items[j].key })
+	out := make([]int64, 0, len(items))
+	for _, it := range items {
+		out = append(out, it.id)
+	}
+	return out, nil
+}
+
+// firstNStrategy rewards the earliest joiners.
+type firstNStrategy struct{}
+
+func (firstNStrategy) Candidates(_ *random.Seeded, participants []dg.Participant) ([]int64, error) {
+	sorted := append([]dg.Participant(nil), participants...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].JoinedAt.Equal(sorted[j].JoinedAt) {
+			return sorted[i].UserID < sorted[j].UserID
+		}
+		return sorted[i].JoinedAt.Before(sorted[j].JoinedAt)
+	})
+	return participantIDs(sorted), nil
+}
+
+// manualStrategy never draws; the giveaway moves to pending for the creator to upload winners.
+type manualStrategy struct{}
+
+func (manualStrategy) Candidates(*random.Seeded, []dg.Participant) ([]int64, error) {
+	return nil, errManualSelection
+}
+
+func participantIDs(participants []dg.Participant) []int64 {
+	ids := make([]int64, 0, len(participants))
+	for _, p := range participants {
+		ids = append(ids, p.UserID)
+	}
+	return ids
+}
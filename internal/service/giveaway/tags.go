@@ -0,0 +1,25 @@
+package giveaway
+
+import (
+	"errors"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// validateTags normalizes the tags of g and checks them and its category against the vocabulary.
+func validateTags(g *dg.Giveaway) error {
+	if !g.Category.Valid() {
+		return errors.New("invalid category")
+	}
+	g.Tags = dg.NormalizeTags(g.Tags)
+	if len(g.Tags) > dg.MaxTags {
+		return fmt.Errorf("at most %d tags allowed", dg.MaxTags)
+	}
+	for _, t := range g.Tags {
+		if !dg.ValidTag(t) {
+			return fmt.Errorf("unknown tag %q", t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// ListTasks returns the bonus tasks of a giveaway with the participant's claim state and
+// ticket balance from the ledger. Non-participants see every task unclaimed.
+func (s *Service) ListTasks(ctx context.Context, id string, userID int64) ([]dg.Task, int, error) {
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if g == nil {
+		return nil, 0, errors.New("not found")
+	}
+	claims, err := s.repo.ListTaskClaims(ctx, id, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	claimed := make(map[int64]dg.TaskClaim, len(claims))
+	for _, c := range claims {
+		claimed[c.RequirementID] = c
+	}
+	tasks := make([]dg.Task, 0)
+	tickets := 0
+	if ok, err := s.repo.IsParticipant(ctx, id, userID); err != nil {
+		return nil, 0, err
+	} else if ok {
+		if tickets, err = s.repo.TicketBalance(ctx, id, userID); err != nil {
+			return nil, 0, err
+		}
+	}
+	for _, r := range g.Requirements {
+		if !r.IsBonus() {
+			continue
+		}
+		t := dg.Task{ID: r.ID, Type: r.Type, Title: r.ChannelTitle, Description: r.Description, BonusTickets: r.BonusTickets}
+		if c, ok := claimed[r.ID]; ok {
+			at := c.ClaimedAt
+			t.Claimed = true
+			t.ClaimedAt = &at
+			// Credited amount is fixed at claim time
+			t.BonusTickets = c.BonusTickets
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, tickets, nil
+}
+
+// ClaimTask re-verifies a single bonus task for a participant and credits its tickets at once.
+// Returns the task with its claim state and the participant's resulting ticket count.
+func (s *Service) ClaimTask(ctx context.Context, id string, userID, taskID int64) (*dg.Task, int, error) {
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if g == nil {
+		return nil, 0, errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusActive {
+		return nil, 0, errors.New("giveaway not active")
+	}
+	var req *dg.Requirement
+	for i := range g.Requirements {
+		if g.Requirements[i].ID == taskID && g.Requirements[i].IsBonus() {
+			req = &g.Requirements[i]
+			break
+		}
+	}
+	if req == nil {
+		return nil, 0, errors.New("task not found")
+	}
+	ok, err := s.repo.IsParticipant(ctx, id, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ok {
+		return nil, 0, errors.New("not participant")
+	}
+	res := s.CheckSingleRequirement(ctx, userID, req)
+	if res.Status != "success" {
+		if res.Error != "" {
+			return nil, 0, errors.New("check failed")
+		}
+		return nil, 0, errors.New("task not completed")
+	}
+	if _, err := s.repo.ClaimTask(ctx, id, userID, req.ID, req.BonusTickets); err != nil {
+		return nil, 0, err
+	}
+	tasks, tickets, err := s.ListTasks(ctx, id, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range tasks {
+		if tasks[i].ID == taskID {
+			return &tasks[i], tickets, nil
+		}
+	}
+	return nil, 0, errors.New("task not found")
+}
@@ -0,0 +1,85 @@
+package giveaway
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// unverifiableWarning is shown in check results for requirements passed while unverifiable.
+const unverifiableWarning = "The bot lost access to this channel, so this requirement is not checked until it is restored"
+
+// WithUnverifiablePolicy sets whether requirements the bot can no longer verify are passed
+// with a warning (pause) or keep failing until the bot is restored.
+func (s *Service) WithUnverifiablePolicy(pause bool) *Service {
+	s.pauseUnverifiable = pause
+	return s
+}
+
+// unverifiableResult is the check outcome for a requirement flagged as unverifiable. Optional ones
+// are never passed this way, so nobody earns tickets for a check that did not run.
+func (s *Service) unverifiableResult(rqm *dg.Requirement) CheckRequirementResult {
+	if s.pauseUnverifiable && !rqm.IsOptional() {
+		return CheckRequirementResult{Status: "success", Warning: unverifiableWarning}
+	}
+	reason := rqm.UnverifiableReason
+	if reason == "" {
+		reason = "bot has no access to the channel"
+	}
+	return CheckRequirementResult{Status: "failed", Error: "requirement cannot be verified: " + reason}
+}
+
+// HandleBotAccessLost flags the channel requirements of running giveaways as unverifiable after
+// the bot was removed or demoted in channelID, and tells each affected creator once.
+func (s *Service) HandleBotAccessLost(ctx context.Context, channelID int64, reason string) (int, error) {
+	ids, err := s.repo.MarkRequirementsUnverifiable(ctx, channelID, reason)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range ids {
+		g, err := s.repo.GetByID(ctx, id)
+		if err != nil || g == nil {
+			continue
+		}
+		if s.ntf != nil {
+			s.ntf.NotifyCreatorUnverifiable(ctx, g, requirementChannelName(g, channelID), s.pauseUnverifiable)
+		}
+	}
+	// Giveaways whose last verifiable channel was just lost go to review right away
+	if len(ids) > 0 {
+		if _, err := s.DetectDeadGiveaways(ctx); err != nil {
+			log.Printf("dead giveaway detection after channel %d: %v", channelID, err)
+		}
+	}
+	return len(ids), nil
+}
+
+// HandleBotAccessRestored re-enables checks of requirements on channelID once the bot is an admin again.
+func (s *Service) HandleBotAccessRestored(ctx context.Context, channelID int64) (int64, error) {
+	n, err := s.repo.ClearUnverifiableRequirements(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		log.Printf("channel %d: %d requirements verifiable again", channelID, n)
+	}
+	return n, nil
+}
+
+// requirementChannelName returns a readable name of the requirement channel for messages.
+func requirementChannelName(g *dg.Giveaway, channelID int64) string {
+	for _, r := range g.Requirements {
+		if r.ChannelID != channelID {
+			continue
+		}
+		if r.ChannelTitle != "" {
+			return r.ChannelTitle
+		}
+		if r.ChannelUsername != "" {
+			return "@" + r.ChannelUsername
+		}
+	}
+	return fmt.Sprintf("channel %d", channelID)
+}
@@ -0,0 +1,116 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// usageTTL keeps each hourly bucket for the longest window Usage reports.
+const usageTTL = (dg.MaxUsageHours + 1) * time.Hour
+
+func usageHour(t time.Time) int64 { return t.Unix() / 3600 }
+
+// The {id} hash tag keeps all buckets of a giveaway on one shard, so they are read in one
+// pipeline and the viewer estimates can be merged.
+func usageKey(id string, hour int64) string {
+	return "giveaway:{" + id + "}:usage:" + strconv.FormatInt(hour, 10)
+}
+
+func usageViewersKey(id string, hour int64) string {
+	return "giveaway:{" + id + "}:viewers:" + strconv.FormatInt(hour, 10)
+}
+
+// RecordUsage counts a request of metric against giveaway id in the current hourly bucket,
+// and as an error too when failed. Views by signed-in users also feed the distinct viewer
+// estimate. Best-effort: Redis failures are ignored.
+func (s *Service) RecordUsage(ctx context.Context, id string, metric dg.UsageMetric, userID int64, failed bool) {
+	if s.rdb == nil || id == "" {
+		return
+	}
+	hour := usageHour(time.Now())
+	key := usageKey(id, hour)
+	pipe := s.hot(key).Pipeline()
+	pipe.HIncrBy(ctx, key, string(metric), 1)
+	if failed {
+		pipe.HIncrBy(ctx, key, string(dg.UsageErrors), 1)
+	}
+	pipe.Expire(ctx, key, usageTTL)
+	if metric == dg.UsageViews && userID != 0 {
+		vkey := usageViewersKey(id, hour)
+		pipe.PFAdd(ctx, vkey, userID)
+		pipe.Expire(ctx, vkey, usageTTL)
+	}
+	_, _ = pipe.Exec(ctx)
+}
+
+// Usage returns the request counters of a giveaway over the last hours hours, the current
+// one included. Access: creator or platform admins.
+func (s *Service) Usage(ctx context.Context, id string, requesterID int64, admin bool, hours int) (*dg.Usage, error) {
+	if s.rdb == nil {
+		return nil, errors.New("redis not configured")
+	}
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID && !admin {
+		return nil, errors.New("forbidden")
+	}
+	if hours < 1 || hours > dg.MaxUsageHours {
+		return nil, errors.New("invalid hours")
+	}
+
+	last := usageHour(time.Now())
+	first := last - int64(hours) + 1
+	pipe := s.hot(usageKey(g.ID, last)).Pipeline()
+	counts := make([]*redis.MapStringStringCmd, 0, hours)
+	viewers := make([]*redis.IntCmd, 0, hours)
+	viewerKeys := make([]string, 0, hours)
+	for h := first; h <= last; h++ {
+		vkey := usageViewersKey(g.ID, h)
+		counts = append(counts, pipe.HGetAll(ctx, usageKey(g.ID, h)))
+		viewers = append(viewers, pipe.PFCount(ctx, vkey))
+		viewerKeys = append(viewerKeys, vkey)
+	}
+	// Distinct over the window, not the sum of hourly estimates
+	total := pipe.PFCount(ctx, viewerKeys...)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	u := &dg.Usage{Hours: hours, Series: make([]dg.UsageHour, 0, hours)}
+	for i := range counts {
+		m := counts[i].Val()
+		c := dg.UsageCounts{
+			Views:   usageCount(m, dg.UsageViews),
+			Viewers: viewers[i].Val(),
+			Joins:   usageCount(m, dg.UsageJoins),
+			Checks:  usageCount(m, dg.UsageChecks),
+			Errors:  usageCount(m, dg.UsageErrors),
+		}
+		u.Series = append(u.Series, dg.UsageHour{Hour: time.Unix((first+int64(i))*3600, 0).UTC(), UsageCounts: c})
+		u.Totals.Views += c.Views
+		u.Totals.Joins += c.Joins
+		u.Totals.Checks += c.Checks
+		u.Totals.Errors += c.Errors
+	}
+	u.Totals.Viewers = total.Val()
+	return u, nil
+}
+
+func usageCount(m map[string]string, metric dg.UsageMetric) int64 {
+	n, _ := strconv.ParseInt(m[string(metric)], 10, 64)
+	return n
+}
@@ -0,0 +1,18 @@
+package giveaway
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// Edit runs edit as the next version of the giveaway when pre still matches it, so two
+// members editing from the same version cannot both succeed; the loser gets "version conflict".
+// Only the creator may edit, and that is checked before the version is looked at, so others
+// cannot move it. Returns the version after the edit.
+func (s *Service) Edit(ctx context.Context, id string, requesterID int64, pre dg.EditPrecondition, edit func() error) (int64, error) {
+	if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return 0, err
+	}
+	return s.repo.EditVersion(ctx, id, pre, edit)
+}
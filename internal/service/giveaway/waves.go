@@ -0,0 +1,200 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/random"
+)
+
+// maxWaves bounds the wave schedule of one giveaway.
+const maxWaves = 30
+
+// WaveInput is one entry of a wave schedule submitted by the creator.
+type WaveInput struct {
+	DrawAt       time.Time
+	WinnersCount int
+}
+
+// waveCapable reports whether winners of g can be drawn automatically, which waves require.
+func waveCapable(g *dg.Giveaway) bool {
+	if g.WinnerStrategy == dg.WinnerStrategyManual {
+		return false
+	}
+	for _, r := range g.Requirements {
+		if r.Type == dg.RequirementTypeCustom {
+			return false
+		}
+	}
+	return true
+}
+
+// SetWaves replaces the wave schedule of a giveaway; only the creator can change it and only
+// until the first wave is drawn. Waves are sorted by draw time. An empty list removes waves.
+func (s *Service) SetWaves(ctx context.Context, id string, requesterID int64, in []WaveInput) ([]dg.Wave, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	if g.Status != dg.GiveawayStatusScheduled && g.Status != dg.GiveawayStatusActive {
+		return nil, errors.New("giveaway already ended")
+	}
+	if len(in) > 0 && !waveCapable(g) {
+		return nil, errors.New("waves require automatic winner selection")
+	}
+	if len(in) > maxWaves {
+		return nil, errors.New("too many waves")
+	}
+	now := time.Now().UTC()
+	total := 0
+	waves := make([]dg.Wave, 0, len(in))
+	for _, w := range in {
+		if w.WinnersCount <= 0 {
+			return nil, errors.New("winners_count must be positive")
+		}
+		if !w.DrawAt.After(now) || !w.DrawAt.Before(g.EndsAt) {
+			return nil, errors.New("draw_at must be between now and ends_at")
+		}
+		total += w.WinnersCount
+		waves = append(waves, dg.Wave{DrawAt: w.DrawAt.UTC(), WinnersCount: w.WinnersCount})
+	}
+	if total > g.MaxWinnersCount {
+		return nil, errors.New("waves exceed winners_count")
+	}
+	sort.SliceStable(waves, func(i, j int) bool { return waves[i].DrawAt.Before(waves[j].DrawAt) })
+	ok, err := s.repo.ReplaceWaves(ctx, id, waves)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("waves already started")
+	}
+	return s.repo.ListWaves(ctx, id)
+}
+
+// ListWaves returns the wave schedule of a giveaway with the winners drawn so far.
+func (s *Service) ListWaves(ctx context.Context, id string) ([]dg.Wave, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	return s.repo.ListWaves(ctx, id)
+}
+
+// DrawDueWaves draws every wave whose time has come; returns the number of drawn waves.
+func (s *Service) DrawDueWaves(ctx context.Context) (int64, error) {
+	waves, err := s.repo.ListDueWaves(ctx, time.Now().UTC(), 50)
+	if err != nil {
+		return 0, err
+	}
+	var done int64
+	for i := range waves {
+		if ctx.Err() != nil {
+			break
+		}
+		ok, err := s.drawWave(ctx, &waves[i])
+		if err != nil {
+			log.Printf("wave %s/%d: %v", waves[i].GiveawayID, waves[i].Number, err)
+			continue
+		}
+		if ok {
+			done++
+		}
+	}
+	return done, nil
+}
+
+// drawWave draws the winners of one wave from participants who have not won yet, using the
+// giveaway strategy and requirement checks like the final draw.
+func (s *Service) drawWave(ctx context.Context, w *dg.Wave) (bool, error) {
+	g, err := s.repo.GetByID(ctx, w.GiveawayID)
+	if err != nil {
+		return false, err
+	}
+	if g == nil || g.Status != dg.GiveawayStatusActive || !waveCapable(g) {
+		return false, nil
+	}
+	participants, err := s.eligibleForWave(ctx, g.ID)
+	if err != nil {
+		return false, err
+	}
+	seed, err := random.NewSeed()
+	if err != nil {
+		return false, err
+	}
+	gw := *g
+	gw.MaxWinnersCount = w.WinnersCount
+	// Reserves are drawn by the final draw only
+	gw.ReserveWinnersCount = 0
+	var checks []dg.RequirementSnapshot
+	eligible, err := s.lapsedEligible(ctx, g.ID, func(uid int64) bool {
+		ok, snap := s.checkRequirementsSnapshot(ctx, uid, g.Requirements)
+		checks = append(checks, snap...)
+		if len(g.Requirements) > 0 {
+			time.Sleep(50 * time.Millisecond)
+		}
+		return ok
+	})
+	if err != nil {
+		return false, err
+	}
+	draw, err := runDraw(&gw, g.WinnerStrategy, seed, participants, eligible)
+	if err != nil {
+		return false, err
+	}
+	draw.Checks = checks
+	ok, err := s.repo.RecordWaveDraw(ctx, w, draw)
+	if err != nil || !ok {
+		return false, err
+	}
+	if s.ntf != nil && len(draw.Winners) > 0 {
+		winners := make([]dg.Winner, 0, len(draw.Winners))
+		for _, uid := range draw.Winners {
+			winners = append(winners, dg.Winner{UserID: uid})
+		}
+		go s.ntf.NotifyWaveWinners(context.Background(), g, w.Number, winners)
+	}
+	return true, nil
+}
+
+// eligibleForWave returns participants that no earlier wave picked.
+func (s *Service) eligibleForWave(ctx context.Context, id string) ([]dg.Participant, error) {
+	participants, err := s.repo.ListParticipantEntries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	won, err := s.repo.ListWaveWinnerIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return excludeParticipants(participants, won), nil
+}
+
+func excludeParticipants(participants []dg.Participant, ids []int64) []dg.Participant {
+	if len(ids) == 0 {
+		return participants
+	}
+	skip := make(map[int64]struct{}, len(ids))
+	for _, uid := range ids {
+		skip[uid] = struct{}{}
+	}
+	out := make([]dg.Participant, 0, len(participants))
+	for _, p := range participants {
+		if _, ok := skip[p.UserID]; !ok {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// CheckWinner tells whether userID won giveaway id without exposing other winners. Users
+// who hide their profile or wins always get WinnerCheckHidden, so the answer never reveals
+// whether they won.
+func (s *Service) CheckWinner(ctx context.Context, id string, userID int64) (*dg.WinnerCheck, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	if userID <= 0 {
+		return nil, errors.New("invalid user_id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	res := &dg.WinnerCheck{GiveawayID: g.ID, UserID: userID, Status: g.Status}
+	if s.users != nil {
+		p, err := s.users.GetPublicProfile(ctx, userID, 0)
+		if err != nil {
+			return nil, err
+		}
+		if p != nil && (p.Hidden || p.WinsCount == nil) {
+			res.Result = dg.WinnerCheckHidden
+			return res, nil
+		}
+	}
+	winners, err := s.repo.ListWinnersWithPrizes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range winners {
+		if w.UserID == userID {
+			res.Result = dg.WinnerCheckWon
+			res.Place = w.Place
+			res.Prizes = w.Prizes
+			return res, nil
+		}
+	}
+	switch {
+	case g.Status == dg.GiveawayStatusCompleted || g.Status == dg.GiveawayStatusFinished:
+		res.Result = dg.WinnerCheckNotWon
+	case len(winners) > 0:
+		// Winner waves drew some winners already; the user may still win later
+		res.Result = dg.WinnerCheckNotWon
+	default:
+		res.Result = dg.WinnerCheckNotDrawn
+	}
+	return res, nil
+}
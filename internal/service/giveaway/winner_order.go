@@ -0,0 +1,20 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SetWinnerOrder changes how the giveaway's winners are listed; only the creator can change it.
+// It applies to every later read, announcement and export, also after completion.
+func (s *Service) SetWinnerOrder(ctx context.Context, id string, requesterID int64, order dg.WinnerOrder) error {
+	if !order.Valid() {
+		return errors.New("invalid winner_order")
+	}
+	if _, err := s.loadOwnedGiveaway(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return s.repo.SetWinnerOrder(ctx, id, order)
+}
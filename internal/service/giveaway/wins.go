@@ -0,0 +1,179 @@
+package giveaway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// WithWinsCache enables caching of win history pages.
+func (s *Service) WithWinsCache(c *rcache.WinsCache) *Service { s.wins = c; return s }
+
+// ListMyWins returns the giveaways the user won, newest first.
+func (s *Service) ListMyWins(ctx context.Context, userID int64, limit, offset int) ([]dg.Win, error) {
+	if userID == 0 {
+		return nil, errors.New("unauthorized")
+	}
+	if s.wins != nil {
+		if wins, err := s.wins.Get(ctx, userID, limit, offset); err == nil {
+			return wins, nil
+		}
+	}
+	wins, err := s.repo.ListWinsByUser(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	if wins == nil {
+		wins = []dg.Win{}
+	}
+	if s.wins != nil {
+		_ = s.wins.Set(ctx, userID, limit, offset, wins)
+	}
+	return wins, nil
+}
+
+// winnerFulfillment returns the fulfillment state of a winner, or "" when userID did not win.
+func (s *Service) winnerFulfillment(ctx context.Context, id string, userID int64) (dg.FulfillmentStatus, error) {
+	winners, err := s.repo.ListWinnersWithPrizes(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	for _, w := range winners {
+		if w.UserID == userID {
+			return w.Fulfillment, nil
+		}
+	}
+	return "", nil
+}
+
+// ClaimPrize lets a winner request their prize from the creator.
+func (s *Service) ClaimPrize(ctx context.Context, id string, userID int64) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusCompleted && g.Status != dg.GiveawayStatusFinished {
+		return errors.New("not completed")
+	}
+	state, err := s.winnerFulfillment(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if state == "" {
+		return errors.New("not winner")
+	}
+	if state != dg.FulfillmentPending {
+		return errors.New("already claimed")
+	}
+	if _, err := s.repo.ClaimPrize(ctx, id, userID); err != nil {
+		return err
+	}
+	s.invalidateWins(ctx, userID)
+	return nil
+}
+
+// MarkPrizeDelivered lets the creator confirm that a winner received their prizes.
+func (s *Service) MarkPrizeDelivered(ctx context.Context, id string, requesterID, winnerID int64) error {
+	if id == "" {
+		return errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return errors.New("forbidden")
+	}
+	state, err := s.winnerFulfillment(ctx, id, winnerID)
+	if err != nil {
+		return err
+	}
+	if state == "" {
+		return errors.New("not winner")
+	}
+	if state == dg.FulfillmentDelivered {
+		return errors.New("already delivered")
+	}
+	if _, err := s.repo.MarkPrizeDelivered(ctx, id, winnerID); err != nil {
+		return err
+	}
+	s.invalidateWins(ctx, winnerID)
+	return nil
+}
+
+func (s *Service) invalidateWins(ctx context.Context, userID int64) {
+	if s.wins != nil {
+		_ = s.wins.Invalidate(ctx, userID)
+	}
+}
+
+// SetPrizeContact records how a winner wants to be reached for delivery. Email needs an email
+// address; wallet uses the given address or else the one linked to the profile. Details of
+// other methods are dropped. The choice can be changed until the prize is delivered.
+func (s *Service) SetPrizeContact(ctx context.Context, id string, userID int64, c dg.ContactPreference) (*dg.ContactPreference, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusCompleted && g.Status != dg.GiveawayStatusFinished {
+		return nil, errors.New("not completed")
+	}
+	state, err := s.winnerFulfillment(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state == "" {
+		return nil, errors.New("not winner")
+	}
+	if state == dg.FulfillmentDelivered {
+		return nil, errors.New("already delivered")
+	}
+	switch c.Method {
+	case dg.ContactBotDM:
+		c.Email, c.WalletAddress = "", ""
+	case dg.ContactEmail:
+		if c.Email == "" {
+			return nil, errors.New("email required")
+		}
+		c.WalletAddress = ""
+	case dg.ContactWallet:
+		c.Email = ""
+		if c.WalletAddress == "" && s.users != nil {
+			if u, err := s.users.GetByID(ctx, userID); err == nil && u != nil {
+				c.WalletAddress = u.WalletAddress
+			}
+		}
+		if c.WalletAddress == "" {
+			return nil, errors.New("wallet required")
+		}
+	default:
+		return nil, errors.New("invalid contact method")
+	}
+	ok, err := s.repo.SetWinnerContact(ctx, id, userID, c)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("already delivered")
+	}
+	c.UpdatedAt = time.Now().UTC()
+	return &c, nil
+}
@@ -0,0 +1,262 @@
+package housekeeping
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	statsKey = "housekeeping:stats"
+	lockKey  = "housekeeping:lock"
+	lockTTL  = 10 * time.Minute
+	// scanCount is the SCAN page size; each page is checked against Postgres in one query
+	scanCount = 500
+	// avatarMaxAge matches the TTL the API caches channel avatars with
+	avatarMaxAge = 24 * time.Hour
+)
+
+// Key kinds reported in Result and Stats.
+const (
+	KindPreparedMessages = "prepared_messages"
+	KindExportTokens     = "export_tokens"
+	KindChannelAvatars   = "channel_avatars"
+)
+
+// Result describes one cleanup run.
+type Result struct {
+	RanAt      time.Time      `json:"ran_at"`
+	DurationMs int64          `json:"duration_ms"`
+	Scanned    int            `json:"scanned"`
+	Reclaimed  map[string]int `json:"reclaimed"`
+}
+
+// Stats is the last run plus reclaimed keys per kind since counters were created.
+type Stats struct {
+	LastRun *Result          `json:"last_run,omitempty"`
+	Totals  map[string]int64 `json:"totals"`
+}
+
+// Service removes orphaned Redis keys: prepared inline messages and export tokens of deleted
+// giveaways, keys that lost their TTL and stale channel avatars.
+type Service struct {
+	rdb       *redisp.Client
+	giveaways *repo.GiveawayRepository
+}
+
+func NewService(rdb *redisp.Client, giveaways *repo.GiveawayRepository) *Service {
+	return &Service{rdb: rdb, giveaways: giveaways}
+}
+
+// Run scans for orphaned keys and deletes them. Only one run proceeds at a time across
+// instances; others fail with "already running".
+func (s *Service) Run(ctx context.Context) (*Result, error) {
+	if s.rdb == nil {
+		return nil, errors.New("redis not configured")
+	}
+	ok, err := s.rdb.SetNX(ctx, lockKey, "1", lockTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("already running")
+	}
+	defer s.rdb.Del(context.Background(), lockKey)
+
+	start := time.Now()
+	res := &Result{RanAt: start.UTC(), Reclaimed: map[string]int{}}
+	steps := []struct {
+		kind    string
+		pattern string
+		orphans func(context.Context, []string) ([]string, error)
+	}{
+		{KindPreparedMessages, "giveaway:*:prepared_inline_message_id", s.orphanedPrepared},
+		{KindExportTokens, "export:giveaway:*", s.orphanedExports},
+		{KindChannelAvatars, "channel:*:avatar", s.staleAvatars},
+	}
+	for _, st := range steps {
+		scanned, reclaimed, err := s.sweep(ctx, st.pattern, st.orphans)
+		res.Scanned += scanned
+		res.Reclaimed[st.kind] = reclaimed
+		if err != nil {
+			return nil, err
+		}
+	}
+	res.DurationMs = time.Since(start).Milliseconds()
+	s.record(ctx, res)
+	return res, nil
+}
+
+// sweep walks keys matching pattern page by page and deletes what orphans selects.
+func (s *Service) sweep(ctx context.Context, pattern string, orphans func(context.Context, []string) ([]string, error)) (int, int, error) {
+	var cursor uint64
+	scanned, reclaimed := 0, 0
+	for {
+		keys, next, err := s.rdb.Scan(ctx, cursor, pattern, scanCount).Result()
+		if err != nil {
+			return scanned, reclaimed, err
+		}
+		scanned += len(keys)
+		if len(keys) > 0 {
+			del, err := orphans(ctx, keys)
+			if err != nil {
+				return scanned, reclaimed, err
+			}
+			if len(del) > 0 {
+				n, err := s.rdb.Del(ctx, del...).Result()
+				if err != nil {
+					return scanned, reclaimed, err
+				}
+				reclaimed += int(n)
+			}
+		}
+		if next == 0 {
+			return scanned, reclaimed, nil
+		}
+		cursor = next
+	}
+}
+
+// orphanedPrepared selects prepared message keys of giveaways that no longer exist.
+func (s *Service) orphanedPrepared(ctx context.Context, keys []string) ([]string, error) {
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = strings.TrimSuffix(strings.TrimPrefix(k, "giveaway:"), ":prepared_inline_message_id")
+	}
+	exists, err := s.existing(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for i, k := range keys {
+		if !exists[ids[i]] {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+// orphanedExports selects export tokens without a TTL or pointing at deleted giveaways.
+// Tokens normally expire on their own.
+func (s *Service) orphanedExports(ctx context.Context, keys []string) ([]string, error) {
+	pipe := s.rdb.Pipeline()
+	vals := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	for i, k := range keys {
+		vals[i] = pipe.Get(ctx, k)
+		ttls[i] = pipe.TTL(ctx, k)
+	}
+	// Keys may expire between SCAN and GET; missing ones read as empty
+	_, _ = pipe.Exec(ctx)
+	ids := make([]string, 0, len(keys))
+	for _, v := range vals {
+		if id := v.Val(); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	exists, err := s.existing(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for i, k := range keys {
+		id := vals[i].Val()
+		if id == "" {
+			continue
+		}
+		if ttls[i].Val() < 0 || !exists[id] {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+// staleAvatars selects avatar entries without a TTL, unreadable ones and ones fetched longer
+// ago than the cache TTL, e.g. written under an older, longer TTL.
+func (s *Service) staleAvatars(ctx context.Context, keys []string) ([]string, error) {
+	pipe := s.rdb.Pipeline()
+	vals := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	for i, k := range keys {
+		vals[i] = pipe.Get(ctx, k)
+		ttls[i] = pipe.TTL(ctx, k)
+	}
+	_, _ = pipe.Exec(ctx)
+	cutoff := time.Now().Add(-avatarMaxAge)
+	var out []string
+	for i, k := range keys {
+		raw := vals[i].Val()
+		if raw == "" {
+			continue
+		}
+		var e struct {
+			FetchedAt time.Time `json:"fetched_at"`
+		}
+		if ttls[i].Val() < 0 || json.Unmarshal([]byte(raw), &e) != nil || e.FetchedAt.Before(cutoff) {
+			out = append(out, k)
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) existing(ctx context.Context, ids []string) (map[string]bool, error) {
+	out := make(map[string]bool, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	items, err := s.giveaways.ListStatusSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range items {
+		out[it.ID] = true
+	}
+	return out, nil
+}
+
+// record stores the run in Redis so every instance reports the same metrics.
+func (s *Service) record(ctx context.Context, res *Result) {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return
+	}
+	pipe := s.rdb.Pipeline()
+	pipe.HSet(ctx, statsKey, "last_run", b)
+	for kind, n := range res.Reclaimed {
+		pipe.HIncrBy(ctx, statsKey, "total:"+kind, int64(n))
+	}
+	_, _ = pipe.Exec(ctx)
+}
+
+// Stats returns the last run and reclaimed key totals.
+func (s *Service) Stats(ctx context.Context) (*Stats, error) {
+	if s.rdb == nil {
+		return nil, errors.New("redis not configured")
+	}
+	m, err := s.rdb.HGetAll(ctx, statsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := &Stats{Totals: map[string]int64{KindPreparedMessages: 0, KindExportTokens: 0, KindChannelAvatars: 0}}
+	for k, v := range m {
+		if kind, ok := strings.CutPrefix(k, "total:"); ok {
+			n, _ := strconv.ParseInt(v, 10, 64)
+			out.Totals[kind] = n
+		}
+	}
+	if raw, ok := m["last_run"]; ok {
+		var r Result
+		if json.Unmarshal([]byte(raw), &r) == nil {
+			out.LastRun = &r
+		}
+	}
+	return out, nil
+}
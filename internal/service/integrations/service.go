@@ -0,0 +1,209 @@
+package integrations
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	maxKeysPerCreator = 5
+	maxKeyNameLength  = 64
+	keyPrefix         = "gk_"
+	// shownPrefixLength is how much of a key is kept in clear to tell keys apart
+	shownPrefixLength = 10
+	defaultPageSize   = 50
+	maxPageSize       = 100
+	// settleDelay hides items younger than this so transactions still committing are not passed by a cursor
+	settleDelay = 5 * time.Second
+)
+
+// Page is one response of a polling trigger. NextSince is passed as since on the next poll.
+type Page[T any] struct {
+	Items     []T    `json:"items"`
+	NextSince string `json:"next_since"`
+}
+
+// Service manages creator API keys and serves polling trigger feeds for no-code platforms.
+type Service struct {
+	repo *repo.IntegrationRepository
+}
+
+func NewService(r *repo.IntegrationRepository) *Service { return &Service{repo: r} }
+
+// CreateAPIKey issues a key for creatorID. The returned key is shown once; only its hash is stored.
+func (s *Service) CreateAPIKey(ctx context.Context, creatorID int64, name string) (*dg.APIKey, error) {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxKeyNameLength {
+		return nil, fmt.Errorf("name exceeds %d characters", maxKeyNameLength)
+	}
+	n, err := s.repo.CountAPIKeys(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= maxKeysPerCreator {
+		return nil, fmt.Errorf("at most %d api keys per creator", maxKeysPerCreator)
+	}
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+	key := keyPrefix + hex.EncodeToString(raw)
+	k := &dg.APIKey{
+		ID:        uuid.NewString(),
+		CreatorID: creatorID,
+		Name:      name,
+		Prefix:    key[:shownPrefixLength],
+		Key:       key,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.CreateAPIKey(ctx, k, hashKey(key)); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// ListAPIKeys returns the keys of creatorID without the secret part.
+func (s *Service) ListAPIKeys(ctx context.Context, creatorID int64) ([]dg.APIKey, error) {
+	return s.repo.ListAPIKeys(ctx, creatorID)
+}
+
+// DeleteAPIKey revokes a key of creatorID.
+func (s *Service) DeleteAPIKey(ctx context.Context, id string, creatorID int64) error {
+	ok, err := s.repo.DeleteAPIKey(ctx, id, creatorID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// Authenticate returns the creator owning key, or 0 when the key is unknown or revoked.
+func (s *Service) Authenticate(ctx context.Context, key string) (int64, error) {
+	if !strings.HasPrefix(key, keyPrefix) {
+		return 0, nil
+	}
+	return s.repo.ResolveAPIKey(ctx, hashKey(key))
+}
+
+// NewParticipants returns participants of creatorID's giveaways after since. Without since, the
+// latest participants are returned newest first as a sample and NextSince starts the feed.
+func (s *Service) NewParticipants(ctx context.Context, creatorID int64, since string, limit int) (*Page[dg.IntegrationParticipant], error) {
+	after, err := decodeCursor(since)
+	if err != nil {
+		return nil, err
+	}
+	until := time.Now().UTC().Add(-settleDelay)
+	items, err := s.repo.NewParticipants(ctx, creatorID, after, until, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	page := &Page[dg.IntegrationParticipant]{Items: make([]dg.IntegrationParticipant, 0, len(items))}
+	for _, p := range items {
+		page.Items = append(page.Items, dg.IntegrationParticipant{ID: p.GiveawayID + ":" + strconv.FormatInt(p.UserID, 10), CRMParticipant: p})
+	}
+	var next *dg.FeedCursor
+	if len(items) > 0 {
+		// Newest item: last when paging forward, first in the initial sample
+		p := items[len(items)-1]
+		if after == nil {
+			p = items[0]
+		}
+		next = &dg.FeedCursor{At: p.JoinedAt, GiveawayID: p.GiveawayID, Key: p.UserID}
+	}
+	page.NextSince = nextSince(since, after, next, until)
+	return page, nil
+}
+
+// NewWinners returns winners of creatorID's giveaways assigned after since, like NewParticipants.
+func (s *Service) NewWinners(ctx context.Context, creatorID int64, since string, limit int) (*Page[dg.IntegrationWinner], error) {
+	after, err := decodeCursor(since)
+	if err != nil {
+		return nil, err
+	}
+	until := time.Now().UTC().Add(-settleDelay)
+	items, err := s.repo.NewWinners(ctx, creatorID, after, until, pageSize(limit))
+	if err != nil {
+		return nil, err
+	}
+	page := &Page[dg.IntegrationWinner]{Items: make([]dg.IntegrationWinner, 0, len(items))}
+	for _, w := range items {
+		w.ID = w.GiveawayID + ":" + strconv.Itoa(w.Place)
+		page.Items = append(page.Items, w)
+	}
+	var next *dg.FeedCursor
+	if len(items) > 0 {
+		w := items[len(items)-1]
+		if after == nil {
+			w = items[0]
+		}
+		next = &dg.FeedCursor{At: w.AssignedAt, GiveawayID: w.GiveawayID, Key: int64(w.Place)}
+	}
+	page.NextSince = nextSince(since, after, next, until)
+	return page, nil
+}
+
+// nextSince keeps since when nothing new arrived; an empty initial sample starts the feed at until.
+func nextSince(since string, after, next *dg.FeedCursor, until time.Time) string {
+	switch {
+	case next != nil:
+		return encodeCursor(next)
+	case after != nil:
+		return since
+	default:
+		return encodeCursor(&dg.FeedCursor{At: until})
+	}
+}
+
+func pageSize(limit int) int {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	return min(limit, maxPageSize)
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeCursor renders c as an opaque token: base64url of "<unix nanos>.<key>.<giveaway id>".
+func encodeCursor(c *dg.FeedCursor) string {
+	raw := strconv.FormatInt(c.At.UnixNano(), 10) + "." + strconv.FormatInt(c.Key, 10) + "." + c.GiveawayID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (*dg.FeedCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid since")
+	}
+	parts := strings.SplitN(string(raw), ".", 3)
+	if len(parts) != 3 {
+		return nil, errors.New("invalid since")
+	}
+	ns, err1 := strconv.ParseInt(parts[0], 10, 64)
+	key, err2 := strconv.ParseInt(parts[1], 10, 64)
+	if err1 != nil || err2 != nil {
+		return nil, errors.New("invalid since")
+	}
+	return &dg.FeedCursor{At: time.Unix(0, ns).UTC(), GiveawayID: parts[2], Key: key}, nil
+}
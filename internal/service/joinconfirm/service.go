@@ -0,0 +1,106 @@
+// Package joinconfirm sends the optional bot message confirming a user joined a giveaway.
+package joinconfirm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+// MaxRulesLength bounds the rules quoted in the confirmation.
+const MaxRulesLength = 1000
+
+// Service manages per-giveaway join confirmation settings and sends the confirmations.
+type Service struct {
+	repo      *repo.JoinConfirmationRepository
+	giveaways *repo.GiveawayRepository
+	ntf       *notify.Service
+}
+
+func NewService(r *repo.JoinConfirmationRepository, giveaways *repo.GiveawayRepository, ntf *notify.Service) *Service {
+	return &Service{repo: r, giveaways: giveaways, ntf: ntf}
+}
+
+func (s *Service) owned(ctx context.Context, giveawayID string, requesterID int64) (*dg.Giveaway, error) {
+	g, err := s.giveaways.GetByID(ctx, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return g, nil
+}
+
+// Get returns the settings of a giveaway to its creator; giveaways never configured have them off.
+func (s *Service) Get(ctx context.Context, giveawayID string, requesterID int64) (*dg.JoinConfirmation, error) {
+	if _, err := s.owned(ctx, giveawayID, requesterID); err != nil {
+		return nil, err
+	}
+	jc, err := s.repo.Get(ctx, giveawayID)
+	if err != nil || jc != nil {
+		return jc, err
+	}
+	return &dg.JoinConfirmation{}, nil
+}
+
+// Set stores the settings of a giveaway. Access: creator only, until the giveaway ends.
+func (s *Service) Set(ctx context.Context, giveawayID string, requesterID int64, jc dg.JoinConfirmation) error {
+	jc.Rules = strings.TrimSpace(jc.Rules)
+	if utf8.RuneCountInString(jc.Rules) > MaxRulesLength {
+		return fmt.Errorf("rules exceed %d characters", MaxRulesLength)
+	}
+	g, err := s.owned(ctx, giveawayID, requesterID)
+	if err != nil {
+		return err
+	}
+	switch g.Status {
+	case dg.GiveawayStatusCompleted, dg.GiveawayStatusFinished, dg.GiveawayStatusCancelled:
+		return errors.New("giveaway ended")
+	}
+	return s.repo.Set(ctx, giveawayID, jc)
+}
+
+// HandleEvent sends the confirmation for a ParticipantJoined event delivered by the outbox.
+// Delivery is claimed before sending, so redelivered events do not repeat the message;
+// failures are logged because the join itself already happened.
+func (s *Service) HandleEvent(ctx context.Context, ev dg.Event) {
+	if ev.Type != dg.EventParticipantJoined || s.ntf == nil {
+		return
+	}
+	var p dg.ParticipantJoinedPayload
+	if err := json.Unmarshal(ev.Payload, &p); err != nil {
+		log.Printf("join confirmation: event %s: %v", ev.ID, err)
+		return
+	}
+	rules, ok, err := s.repo.ClaimDelivery(ctx, p.GiveawayID, p.UserID)
+	if err != nil {
+		log.Printf("join confirmation: giveaway %s user %d: %v", p.GiveawayID, p.UserID, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	g, err := s.giveaways.GetByID(ctx, p.GiveawayID)
+	if err != nil || g == nil {
+		log.Printf("join confirmation: giveaway %s: load: %v", p.GiveawayID, err)
+		return
+	}
+	if rules == "" {
+		rules = g.Rules
+	}
+	if err := s.ntf.SendJoinConfirmationDM(ctx, g, p.UserID, rules); err != nil {
+		log.Printf("join confirmation: giveaway %s user %d: %v", p.GiveawayID, p.UserID, err)
+	}
+}
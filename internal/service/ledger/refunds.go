@@ -0,0 +1,129 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/backoff"
+)
+
+const (
+	// maxRefundAttempts failed Telegram refunds credit the buyer's Stars account instead
+	maxRefundAttempts = 6
+	refundBatchSize   = 50
+	// refundLease hides claimed refunds from other workers while one is processed
+	refundLease = 10 * time.Minute
+)
+
+// Refunder returns Stars payments; *telegram.Client implements it.
+type Refunder interface {
+	RefundStarPayment(ctx context.Context, userID int64, chargeID string) error
+}
+
+// WithRefunder enables refunds through Telegram. Without it due refunds stay pending.
+func (s *Service) WithRefunder(r Refunder) *Service {
+	s.refunder = r
+	return s
+}
+
+// RecordPurchase books a Stars payment for extra tickets of an active giveaway the buyer
+// takes part in. Returns false when the charge was already recorded.
+func (s *Service) RecordPurchase(ctx context.Context, p dg.TicketPurchase) (bool, error) {
+	if p.ChargeID == "" || p.Stars <= 0 || p.Tickets <= 0 {
+		return false, errors.New("invalid purchase")
+	}
+	g, err := s.giveaways.GetByID(ctx, p.GiveawayID)
+	if err != nil {
+		return false, err
+	}
+	if g == nil {
+		return false, errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusActive {
+		return false, errors.New("giveaway not active")
+	}
+	ok, err := s.giveaways.IsParticipant(ctx, p.GiveawayID, p.UserID)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, errors.New("not participant")
+	}
+	return s.repo.RecordPurchase(ctx, p)
+}
+
+// ProcessRefunds attempts due refunds of cancelled giveaways. Failed attempts are retried with
+// growing delays; after maxRefundAttempts the Stars are credited to the buyer's ledger account.
+// Returns how many refunds were completed.
+func (s *Service) ProcessRefunds(ctx context.Context) (int, error) {
+	if s.refunder == nil {
+		return 0, nil
+	}
+	due, err := s.repo.ClaimDueRefunds(ctx, refundBatchSize, refundLease)
+	if err != nil {
+		return 0, err
+	}
+	done := 0
+	for _, rf := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		err := s.refunder.RefundStarPayment(ctx, rf.UserID, rf.ChargeID)
+		status := dg.RefundStatusRefunded
+		lastError := ""
+		switch {
+		case err == nil, strings.Contains(err.Error(), "CHARGE_ALREADY_REFUNDED"):
+		case rf.Attempts >= maxRefundAttempts:
+			status, lastError = dg.RefundStatusCredited, err.Error()
+		default:
+			next := time.Now().Add(backoff.Exponential(rf.Attempts, time.Minute, time.Hour))
+			if err := s.repo.RetryRefund(ctx, rf.ID, err.Error(), next); err != nil {
+				log.Printf("refund %d: %v", rf.ID, err)
+			}
+			continue
+		}
+		ok, err := s.repo.CompleteRefund(ctx, rf, status, lastError)
+		if err != nil {
+			// The refund stays pending; Telegram reports CHARGE_ALREADY_REFUNDED on the retry
+			log.Printf("refund %d: %v", rf.ID, err)
+			continue
+		}
+		if ok {
+			done++
+		}
+	}
+	return done, nil
+}
+
+// MyRefunds lists the refunds owed to a user, newest first.
+func (s *Service) MyRefunds(ctx context.Context, userID int64, limit, offset int) ([]dg.Refund, error) {
+	return s.repo.ListRefundsByUser(ctx, userID, limit, offset)
+}
+
+// GiveawayRefunds returns the refund counts and refunds of a giveaway for its creator or a
+// platform admin.
+func (s *Service) GiveawayRefunds(ctx context.Context, id string, viewerID int64, admin bool, limit, offset int) (dg.RefundSummary, []dg.Refund, error) {
+	g, err := s.giveaways.GetByID(ctx, id)
+	if err != nil {
+		return dg.RefundSummary{}, nil, err
+	}
+	if g == nil {
+		return dg.RefundSummary{}, nil, errors.New("not found")
+	}
+	if g.CreatorID != viewerID && !admin {
+		return dg.RefundSummary{}, nil, errors.New("forbidden")
+	}
+	sum, err := s.repo.RefundSummary(ctx, id)
+	if err != nil {
+		return dg.RefundSummary{}, nil, err
+	}
+	list, err := s.repo.ListRefundsByGiveaway(ctx, id, limit, offset)
+	if err != nil {
+		return dg.RefundSummary{}, nil, err
+	}
+	return sum, list, nil
+}
@@ -0,0 +1,133 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	// maxGrantTickets caps one manual grant or deduction
+	maxGrantTickets = 100
+	maxMemoLength   = 200
+	maxKeyLength    = 128
+)
+
+// Service records ticket and Stars movements in the ledger, refunds purchases of cancelled
+// giveaways and reconciles balances.
+type Service struct {
+	repo      *repo.LedgerRepository
+	giveaways *repo.GiveawayRepository
+	refunder  Refunder
+}
+
+func NewService(r *repo.LedgerRepository, giveaways *repo.GiveawayRepository) *Service {
+	return &Service{repo: r, giveaways: giveaways}
+}
+
+// Grant is a manual ticket change for one participant.
+type Grant struct {
+	GiveawayID string `json:"giveaway_id"`
+	UserID     int64  `json:"user_id"`
+	// Tickets is added when positive and deducted when negative
+	Tickets int64  `json:"tickets"`
+	Memo    string `json:"memo"`
+	// IdempotencyKey makes retries of the same grant post once
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+// GrantResult reports the participant's tickets after a grant.
+type GrantResult struct {
+	Posted  bool  `json:"posted"`
+	Tickets int64 `json:"tickets"`
+}
+
+// GrantTickets credits bonus tickets to a participant of an active giveaway, or deducts them
+// as an adjustment. Deductions never take the balance below zero.
+func (s *Service) GrantTickets(ctx context.Context, actorID int64, in Grant) (*GrantResult, error) {
+	in.Memo = strings.TrimSpace(in.Memo)
+	in.IdempotencyKey = strings.TrimSpace(in.IdempotencyKey)
+	switch {
+	case in.IdempotencyKey == "" || len(in.IdempotencyKey) > maxKeyLength:
+		return nil, fmt.Errorf("idempotency_key must be 1-%d characters", maxKeyLength)
+	case in.Tickets == 0 || in.Tickets < -maxGrantTickets || in.Tickets > maxGrantTickets:
+		return nil, fmt.Errorf("tickets must be between -%d and %d and not zero", maxGrantTickets, maxGrantTickets)
+	case in.Memo == "":
+		return nil, errors.New("memo is required")
+	case utf8.RuneCountInString(in.Memo) > maxMemoLength:
+		return nil, fmt.Errorf("memo exceeds %d characters", maxMemoLength)
+	}
+	g, err := s.giveaways.GetByID(ctx, in.GiveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.Status != dg.GiveawayStatusActive {
+		return nil, errors.New("giveaway not active")
+	}
+	ok, err := s.giveaways.IsParticipant(ctx, in.GiveawayID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("not participant")
+	}
+	account := dg.TicketAccount(in.GiveawayID, in.UserID)
+	e := dg.LedgerEntry{
+		// Scoped by admin so two admins cannot collide on a key
+		IdempotencyKey: fmt.Sprintf("grant:%d:%s", actorID, in.IdempotencyKey),
+		Asset:          dg.LedgerAssetTicket,
+		Source:         dg.LedgerSourceBonus,
+		Debit:          dg.LedgerIssuanceAccount,
+		Credit:         account,
+		Amount:         in.Tickets,
+		GiveawayID:     in.GiveawayID,
+		ActorID:        actorID,
+		Memo:           in.Memo,
+	}
+	if in.Tickets < 0 {
+		e.Source = dg.LedgerSourceAdjustment
+		e.Debit, e.Credit, e.Amount = account, dg.LedgerIssuanceAccount, -in.Tickets
+	}
+	posted, err := s.repo.GrantTickets(ctx, e, in.GiveawayID, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := s.repo.Balance(ctx, account, dg.LedgerAssetTicket)
+	if err != nil {
+		return nil, err
+	}
+	return &GrantResult{Posted: posted, Tickets: balance}, nil
+}
+
+// GiveawayEntries lists the ledger entries of a giveaway for its creator or a platform admin.
+func (s *Service) GiveawayEntries(ctx context.Context, id string, viewerID int64, admin bool, limit, offset int) ([]dg.LedgerEntry, error) {
+	g, err := s.giveaways.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != viewerID && !admin {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.ListByGiveaway(ctx, id, limit, offset)
+}
+
+// AccountEntries lists the entries of one account, e.g. tickets:<giveaway>:<user>.
+func (s *Service) AccountEntries(ctx context.Context, account string, limit, offset int) ([]dg.LedgerEntry, error) {
+	return s.repo.ListByAccount(ctx, account, limit, offset)
+}
+
+// Reconcile returns every balance that disagrees with its entries.
+func (s *Service) Reconcile(ctx context.Context) ([]dg.LedgerDiscrepancy, error) {
+	return s.repo.Reconcile(ctx)
+}
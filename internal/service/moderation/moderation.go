@@ -0,0 +1,265 @@
+// Package moderation screens user supplied giveaway texts for profanity, scam wording,
+// deny-listed links and impersonation before they are stored.
+package moderation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Rule names the kind of content a violation matched.
+type Rule string
+
+const (
+	RuleWord          Rule = "word"
+	RuleDomain        Rule = "domain"
+	RuleImpersonation Rule = "impersonation"
+)
+
+// Violation is one match found in a text field.
+type Violation struct {
+	Field string `json:"field"`
+	Rule  Rule   `json:"rule"`
+	Match string `json:"match"`
+	Lang  string `json:"lang,omitempty"`
+}
+
+// Error returns the message shown to the creator for a blocking violation.
+func (v Violation) Error() string {
+	switch v.Rule {
+	case RuleDomain:
+		return fmt.Sprintf("%s links to a blocked domain: %s", v.Field, v.Match)
+	case RuleImpersonation:
+		return fmt.Sprintf("%s impersonates an official account: %q", v.Field, v.Match)
+	}
+	return fmt.Sprintf("%s contains a blocked word: %q", v.Field, v.Match)
+}
+
+// Field is a named text to check, e.g. "title" or "prizes[0].description".
+type Field struct {
+	Name string
+	Text string
+}
+
+// Result splits violations into blocking ones and borderline ones that need admin review.
+type Result struct {
+	Blocked []Violation
+	Flagged []Violation
+}
+
+// Tier holds block and review entries of one rule.
+type Tier struct {
+	Block  []string `json:"block"`
+	Review []string `json:"review"`
+}
+
+// Rules is the filter configuration. Words are matched as whole words per language,
+// domains match the domain and its subdomains, impersonation entries are case-insensitive regexps.
+type Rules struct {
+	Words         map[string]Tier `json:"words"`
+	Domains       Tier            `json:"domains"`
+	Impersonation Tier            `json:"impersonation"`
+}
+
+// DefaultRules is used when no rules file is configured.
+var DefaultRules = Rules{
+	Words: map[string]Tier{
+		"en": {
+			Block:  []string{"fuck", "fucking", "shit", "bitch", "cunt", "seed phrase", "private key", "double your"},
+			Review: []string{"guaranteed", "airdrop", "send ton", "deposit first", "connect wallet"},
+		},
+		"ru": {
+			Block:  []string{"хуй", "пизда", "блять", "ебать", "сид фраза", "приватный ключ"},
+			Review: []string{"гарантированно", "аирдроп", "переведите", "подключите кошелек"},
+		},
+	},
+	Domains: Tier{
+		Block:  []string{"grabify.link", "iplogger.org", "iplogger.com", "2no.co"},
+		Review: []string{"bit.ly", "tinyurl.com", "cutt.ly"},
+	},
+	Impersonation: Tier{
+		Block:  []string{`official\s+telegram`, `telegram\s+(support|team|administration)`, `pavel\s+durov`, `@?durov\b`},
+		Review: []string{`\bofficial\b`, `\bverified\s+(giveaway|account)\b`, `\bton\s+foundation\b`},
+	},
+}
+
+// LoadRules reads rules from a JSON file; an empty path returns DefaultRules.
+func LoadRules(path string) (Rules, error) {
+	if path == "" {
+		return DefaultRules, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Rules{}, err
+	}
+	var r Rules
+	if err := json.Unmarshal(b, &r); err != nil {
+		return Rules{}, fmt.Errorf("parse moderation rules: %w", err)
+	}
+	return r, nil
+}
+
+type phrase struct {
+	lang  string
+	words []string
+	text  string
+}
+
+type pattern struct {
+	re   *regexp.Regexp
+	text string
+}
+
+// Filter checks texts against compiled rules. It is safe for concurrent use.
+type Filter struct {
+	blockWords, reviewWords       []phrase
+	blockDomains, reviewDomains   map[string]bool
+	blockPatterns, reviewPatterns []pattern
+}
+
+// urlPattern finds hosts in plain URLs, bare domains and HTML hrefs.
+var urlPattern = regexp.MustCompile(`(?i)(?:https?://)?((?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,})`)
+
+// NewFilter compiles rules; invalid impersonation patterns are reported.
+func NewFilter(r Rules) (*Filter, error) {
+	f := &Filter{blockDomains: domainSet(r.Domains.Block), reviewDomains: domainSet(r.Domains.Review)}
+	langs := make([]string, 0, len(r.Words))
+	for lang := range r.Words {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	for _, lang := range langs {
+		f.blockWords = append(f.blockWords, phrases(lang, r.Words[lang].Block)...)
+		f.reviewWords = append(f.reviewWords, phrases(lang, r.Words[lang].Review)...)
+	}
+	var err error
+	if f.blockPatterns, err = patterns(r.Impersonation.Block); err != nil {
+		return nil, err
+	}
+	if f.reviewPatterns, err = patterns(r.Impersonation.Review); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func domainSet(list []string) map[string]bool {
+	out := make(map[string]bool, len(list))
+	for _, d := range list {
+		if d = strings.Trim(strings.ToLower(strings.TrimSpace(d)), "."); d != "" {
+			out[d] = true
+		}
+	}
+	return out
+}
+
+func phrases(lang string, list []string) []phrase {
+	out := make([]phrase, 0, len(list))
+	for _, p := range list {
+		if w := tokenize(p); len(w) > 0 {
+			out = append(out, phrase{lang: lang, words: w, text: strings.Join(w, " ")})
+		}
+	}
+	return out
+}
+
+func patterns(list []string) ([]pattern, error) {
+	out := make([]pattern, 0, len(list))
+	for _, p := range list {
+		re, err := regexp.Compile(`(?i)` + p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid impersonation pattern %q: %w", p, err)
+		}
+		out = append(out, pattern{re: re, text: p})
+	}
+	return out, nil
+}
+
+// tokenize lowercases s and splits it into letter/digit words.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Check runs every field through the filter. Each field reports at most one violation per
+// matched entry; blocking matches are not repeated as flags.
+func (f *Filter) Check(fields ...Field) Result {
+	var res Result
+	for _, fl := range fields {
+		if strings.TrimSpace(fl.Text) == "" {
+			continue
+		}
+		words := tokenize(fl.Text)
+		for _, p := range f.blockWords {
+			if containsPhrase(words, p.words) {
+				res.Blocked = append(res.Blocked, Violation{Field: fl.Name, Rule: RuleWord, Match: p.text, Lang: p.lang})
+			}
+		}
+		for _, p := range f.reviewWords {
+			if containsPhrase(words, p.words) {
+				res.Flagged = append(res.Flagged, Violation{Field: fl.Name, Rule: RuleWord, Match: p.text, Lang: p.lang})
+			}
+		}
+		for _, m := range urlPattern.FindAllStringSubmatch(fl.Text, -1) {
+			host := strings.ToLower(m[1])
+			switch {
+			case matchDomain(f.blockDomains, host):
+				res.Blocked = append(res.Blocked, Violation{Field: fl.Name, Rule: RuleDomain, Match: host})
+			case matchDomain(f.reviewDomains, host):
+				res.Flagged = append(res.Flagged, Violation{Field: fl.Name, Rule: RuleDomain, Match: host})
+			}
+		}
+		blocked := false
+		for _, p := range f.blockPatterns {
+			if m := p.re.FindString(fl.Text); m != "" {
+				res.Blocked = append(res.Blocked, Violation{Field: fl.Name, Rule: RuleImpersonation, Match: m})
+				blocked = true
+			}
+		}
+		if blocked {
+			continue
+		}
+		for _, p := range f.reviewPatterns {
+			if m := p.re.FindString(fl.Text); m != "" {
+				res.Flagged = append(res.Flagged, Violation{Field: fl.Name, Rule: RuleImpersonation, Match: m})
+			}
+		}
+	}
+	return res
+}
+
+func containsPhrase(words, p []string) bool {
+	for i := 0; i+len(p) <= len(words); i++ {
+		match := true
+		for j := range p {
+			if words[i+j] != p[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// matchDomain reports whether host or one of its parent domains is in set.
+func matchDomain(set map[string]bool, host string) bool {
+	for host != "" {
+		if set[host] {
+			return true
+		}
+		i := strings.IndexByte(host, '.')
+		if i < 0 {
+			return false
+		}
+		host = host[i+1:]
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+// CancelledMessage renders the DM sent to participants of a cancelled giveaway.
+// It is rendered once when the cancellation is queued so every participant gets the same text.
+func CancelledMessage(g *dg.Giveaway, reason string) string {
+	return fmt.Sprintf("❌ Giveaway “%s” has been cancelled.\n\nReason: %s", escapeHTML(g.Title), escapeHTML(reason))
+}
+
+// DeadWarningMessage renders the DM sent to participants when a giveaway can no longer complete
+// normally and was queued for review.
+func DeadWarningMessage(g *dg.Giveaway, reason dg.DeadReason) string {
+	why := "the bot lost access to the giveaway channels"
+	if reason == dg.DeadCreatorBlockedBot {
+		why = "the organizer can no longer be reached by the bot"
+	}
+	return fmt.Sprintf("⚠️ Giveaway “%s” may be cancelled: %s.\n\nThe platform team is reviewing it; you will get a message if it is cancelled.", escapeHTML(g.Title), why)
+}
+
+// SendBroadcastDM delivers one queued broadcast message to a participant.
+func (s *Service) SendBroadcastDM(ctx context.Context, b *dg.Broadcast, userID int64) error {
+	if s == nil || s.tg == nil || b == nil {
+		return errors.New("notifications disabled")
+	}
+	// Broadcasts are bulk sends; they yield to winner DMs and announcements
+	return s.tg.SendMessage(tg.WithPriority(ctx, tg.PriorityDigest), userID, b.Text, "HTML", s.locale(ctx, userID).T("notify.open_giveaway"), s.buildStartAppURL(b.GiveawayID), true)
+}
@@ -0,0 +1,36 @@
+package notifications
+
+import (
+	"context"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+)
+
+// coverLinkTTL bounds the cover links handed to Telegram, which fetches them right away.
+const coverLinkTTL = time.Hour
+
+// WithCovers sends giveaway covers from files in launch announcements instead of the default
+// animation.
+func (s *Service) WithCovers(files storage.Storage) *Service {
+	s.covers = files
+	return s
+}
+
+// sendLaunchMedia posts text to chatID under the cover of g, or under the default launch
+// animation when g has none or its link cannot be signed.
+func (s *Service) sendLaunchMedia(ctx context.Context, g *dg.Giveaway, chatID int64, text, btnText, btnURL string) (int64, error) {
+	if g.Cover != nil && s.covers != nil {
+		url, err := s.covers.SignedURL(ctx, g.Cover.Key, coverLinkTTL)
+		if err == nil {
+			if g.Cover.Kind == dg.CoverPhoto {
+				return s.tg.SendPhoto(ctx, chatID, url, text, "HTML", btnText, btnURL)
+			}
+			return s.tg.SendAnimation(ctx, chatID, url, text, "HTML", btnText, btnURL)
+		}
+		log.Printf("giveaway %s: sign cover: %v", g.ID, err)
+	}
+	return s.tg.SendAnimation(ctx, chatID, s.tg.MediaURL("giveaway_started"), text, "HTML", btnText, btnURL)
+}
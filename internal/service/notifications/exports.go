@@ -0,0 +1,31 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SendExportReadyDM tells userID that the export of g is ready; the button downloads it.
+func (s *Service) SendExportReadyDM(ctx context.Context, g *dg.Giveaway, userID int64, url string) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	msg := fmt.Sprintf(loc.T("notify.export_ready"), escapeHTML(g.Title))
+	return s.tg.SendMessage(ctx, userID, msg, "HTML", loc.T("notify.export_download"), url, true)
+}
+
+// SendExportFailedDM tells userID that the export of g failed.
+func (s *Service) SendExportFailedDM(ctx context.Context, g *dg.Giveaway, userID int64) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	msg := fmt.Sprintf(loc.T("notify.export_failed"), escapeHTML(g.Title))
+	return s.tg.SendMessage(ctx, userID, msg, "HTML", loc.T("notify.view_giveaway"), s.buildStartAppURL(g.ID), true)
+}
@@ -0,0 +1,45 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SendFinishPreviewDM sends a giveaway admin the finish summary with a deep link to confirm or abort.
+func (s *Service) SendFinishPreviewDM(ctx context.Context, g *dg.Giveaway, userID int64, c *dg.FinishConfirmation, outcome string) error {
+	if s == nil || s.tg == nil || g == nil || c == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	var b strings.Builder
+	fmt.Fprintf(&b, loc.T("notify.finish_preview"), escapeHTML(g.Title), c.ParticipantsCount, g.MaxWinnersCount, escapeHTML(outcome))
+	button := loc.T("notify.review")
+	switch {
+	case c.Status != dg.FinishAwaiting:
+		button = loc.T("notify.open_giveaway")
+	case c.Required:
+		b.WriteString(loc.T("notify.finish_preview_required"))
+	default:
+		b.WriteString(loc.T("notify.finish_preview_auto"))
+	}
+	return s.tg.SendMessage(ctx, userID, b.String(), "HTML", button, s.buildStartAppParamURL("finish_"+g.ID), true)
+}
+
+// NotifyCreatorFinishDecision tells the creator that an admin confirmed or aborted the finish.
+func (s *Service) NotifyCreatorFinishDecision(ctx context.Context, g *dg.Giveaway, status dg.FinishConfirmationStatus) {
+	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
+		return
+	}
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	msg := fmt.Sprintf(loc.T("notify.finish_confirmed"), escapeHTML(g.Title))
+	if status == dg.FinishAborted {
+		msg = fmt.Sprintf(loc.T("notify.finish_aborted"), escapeHTML(g.Title))
+	}
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.open_giveaway"), s.buildStartAppURL(g.ID), true)
+}
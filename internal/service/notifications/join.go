@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// SendJoinConfirmationDM confirms to userID that they joined g. The message names the deadline,
+// quotes the creator's rules when set and, if the giveaway has bonus tasks, shares the
+// giveaway link to invite friends and points to the extra tickets.
+func (s *Service) SendJoinConfirmationDM(ctx context.Context, g *dg.Giveaway, userID int64, rules string) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	var b strings.Builder
+	fmt.Fprintf(&b, loc.T("notify.join_confirmed"), escapeHTML(g.Title), loc.FormatTime(g.EndsAt))
+	if rules = strings.TrimSpace(rules); rules != "" {
+		fmt.Fprintf(&b, loc.T("notify.join_rules"), escapeHTML(rules))
+	}
+	bonus := 0
+	for _, r := range g.Requirements {
+		bonus += r.BonusTickets
+	}
+	link := s.buildStartAppURL(g.ID)
+	if bonus > 0 && link != "" {
+		fmt.Fprintf(&b, loc.T("notify.join_bonus"), bonus, link)
+	}
+	return s.tg.SendMessage(ctx, userID, b.String(), "HTML", loc.T("notify.open_giveaway"), link, true)
+}
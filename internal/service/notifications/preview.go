@@ -0,0 +1,48 @@
+package notifications
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+)
+
+// MessagePreview is a message rendered exactly as it would be sent.
+type MessagePreview struct {
+	Text       string `json:"text"`
+	ParseMode  string `json:"parse_mode"`
+	ButtonText string `json:"button_text"`
+	ButtonURL  string `json:"button_url,omitempty"`
+}
+
+// Preview holds the start and finish channel posts and the winner DM of a giveaway.
+type Preview struct {
+	Start    MessagePreview `json:"start"`
+	Finish   MessagePreview `json:"finish"`
+	WinnerDM MessagePreview `json:"winner_dm"`
+}
+
+// Preview renders the giveaway's notifications with its current data. Before the draw the
+// finish post assumes every winner slot is filled.
+func (s *Service) Preview(ctx context.Context, g *dg.Giveaway) *Preview {
+	winners := len(g.Winners)
+	if winners == 0 {
+		winners = g.MaxWinnersCount
+	}
+	// The winner DM is shown in the creator's language; winners get it in their own
+	loc := i18n.Match()
+	if s != nil {
+		loc = s.locale(ctx, g.CreatorID)
+	}
+	p := &Preview{
+		Start:    MessagePreview{Text: buildStartMessage(g), ParseMode: "HTML", ButtonText: "Open Giveaway"},
+		Finish:   MessagePreview{Text: buildCompletedMessage(g, winners), ParseMode: "HTML", ButtonText: "View Results"},
+		WinnerDM: MessagePreview{Text: buildWinnerMessage(g, loc), ParseMode: "HTML", ButtonText: loc.T("notify.open_giveaway")},
+	}
+	if s != nil && s.tg != nil {
+		p.Start.ButtonURL = s.buildAnnouncementURL(ctx, g.ID)
+		p.Finish.ButtonURL = s.buildStartAppURL(g.ID)
+		p.WinnerDM.ButtonURL = p.Finish.ButtonURL
+	}
+	return p
+}
@@ -0,0 +1,70 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+// captionLimit is Telegram's caption length limit for media messages.
+const captionLimit = 1024
+
+// ResultsCaption renders the winners announcement posted to sponsor channels, in the giveaway's
+// winner order. Winners that do not fit the caption limit are summarized as "and N more".
+func (s *Service) ResultsCaption(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "🎉 Giveaway “%s” results\n\n", escapeHTML(g.Title))
+	if len(winners) == 0 {
+		b.WriteString("No winners were selected.")
+		return b.String()
+	}
+	b.WriteString("Winners:\n")
+	winners = s.orderWinners(ctx, g, winners)
+	for i, label := range s.winnerLabels(ctx, winners) {
+		// Places read out of sequence in other orders, so those are listed as bullets
+		line := fmt.Sprintf("%d. %s\n", winners[i].Place, label)
+		if g.WinnerOrder != "" && g.WinnerOrder != dg.WinnerOrderPlace {
+			line = "• " + label + "\n"
+		}
+		rest := fmt.Sprintf("… and %d more", len(winners)-i)
+		if utf8.RuneCountInString(b.String())+utf8.RuneCountInString(line)+utf8.RuneCountInString(rest) > captionLimit {
+			b.WriteString(rest)
+			return b.String()
+		}
+		b.WriteString(line)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// PostResults sends the winners announcement with an image and a results button to chatID and
+// returns the message id. An empty imageURL uses the default results image.
+func (s *Service) PostResults(ctx context.Context, chatID int64, g *dg.Giveaway, imageURL, caption string) (int64, error) {
+	if s == nil || s.tg == nil || g == nil {
+		return 0, errors.New("notifications disabled")
+	}
+	if imageURL == "" {
+		imageURL = s.tg.MediaURL("giveaway_results")
+	}
+	return s.tg.SendPhoto(tg.WithPriority(ctx, tg.PriorityAnnouncement), chatID, imageURL, caption, "HTML", "View Results", s.buildStartAppURL(g.ID))
+}
+
+// EditResults replaces the caption of a posted winners announcement.
+func (s *Service) EditResults(ctx context.Context, chatID, messageID int64, g *dg.Giveaway, caption string) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	return s.tg.EditMessageCaption(tg.WithPriority(ctx, tg.PriorityAnnouncement), chatID, messageID, caption, "HTML", "View Results", s.buildStartAppURL(g.ID))
+}
+
+// DeleteResults removes a posted winners announcement from the channel.
+func (s *Service) DeleteResults(ctx context.Context, chatID, messageID int64) error {
+	if s == nil || s.tg == nil {
+		return errors.New("notifications disabled")
+	}
+	return s.tg.DeleteMessage(ctx, chatID, messageID)
+}
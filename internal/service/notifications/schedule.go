@@ -0,0 +1,133 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+// PostScheduled publishes a content plan item to every sponsor channel of the giveaway.
+// When text is empty a default message for the item kind is used.
+// Returns an error only when no channel accepted the post.
+func (s *Service) PostScheduled(ctx context.Context, g *dg.Giveaway, kind dg.ScheduleItemKind, text string) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	if strings.TrimSpace(text) == "" {
+		text = s.buildScheduledMessage(ctx, g, kind)
+	}
+	if text == "" {
+		return errors.New("empty message")
+	}
+	btnText := "Open Giveaway"
+	if kind == dg.ScheduleKindResults {
+		btnText = "View Results"
+	}
+	btnURL := s.buildStartAppURL(g.ID)
+	// Midpoint and last-day posts are reminders; the others announce the giveaway or its results
+	prio := tg.PriorityAnnouncement
+	if kind == dg.ScheduleKindMidpoint || kind == dg.ScheduleKindLastDay {
+		prio = tg.PriorityReminder
+	}
+	ctx = tg.WithPriority(ctx, prio)
+
+	var lastErr error
+	sent := 0
+	for _, ch := range g.Sponsors {
+		if ch.ID == 0 {
+			continue
+		}
+		var msgID int64
+		var err error
+		media := kind == dg.ScheduleKindLaunch
+		if media {
+			msgID, err = s.sendLaunchMedia(ctx, g, ch.ID, text, btnText, btnURL)
+		} else {
+			msgID, err = s.tg.PostMessage(ctx, ch.ID, text, "HTML", btnText, btnURL)
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		s.recordPost(ctx, g, ch.ID, msgID, string(kind), media)
+		sent++
+	}
+	if sent == 0 {
+		if lastErr == nil {
+			lastErr = errors.New("no sponsor channels")
+		}
+		return lastErr
+	}
+	return nil
+}
+
+func (s *Service) buildScheduledMessage(ctx context.Context, g *dg.Giveaway, kind dg.ScheduleItemKind) string {
+	switch kind {
+	case dg.ScheduleKindLaunch:
+		return buildStartMessage(g)
+	case dg.ScheduleKindMidpoint:
+		return buildReminderMessage(g, "⏰ Halfway there!")
+	case dg.ScheduleKindLastDay:
+		return buildReminderMessage(g, "⌛ Last day to join!")
+	case dg.ScheduleKindResults:
+		if len(g.Winners) == 0 {
+			return buildCompletedMessage(g, 0)
+		}
+		var b strings.Builder
+		b.WriteString("🎉 Giveaway results\n\n")
+		if g.Title != "" {
+			b.WriteString("Title: ")
+			b.WriteString(escapeHTML(g.Title))
+			b.WriteString("\n")
+		}
+		b.WriteString("Winners: ")
+		b.WriteString(strings.Join(s.winnerLabels(ctx, s.orderWinners(ctx, g, g.Winners)), ", "))
+		return b.String()
+	}
+	return ""
+}
+
+func buildReminderMessage(g *dg.Giveaway, headline string) string {
+	var b strings.Builder
+	b.WriteString(headline)
+	b.WriteString("\n\n")
+	if g.Title != "" {
+		b.WriteString("Giveaway: ")
+		b.WriteString(escapeHTML(g.Title))
+		b.WriteString("\n")
+	}
+	b.WriteString(fmt.Sprintf("👥 Participants so far: %d\n", g.ParticipantsCount))
+	if left := time.Until(g.EndsAt); left > 0 {
+		b.WriteString("Time left: ")
+		b.WriteString(formatLeft(left))
+		b.WriteString("\n")
+	}
+	prizes := collectPrizeTitles(g)
+	if prizes != "" {
+		b.WriteString("Prizes: ")
+		b.WriteString(prizes)
+		b.WriteString("\n")
+	}
+	b.WriteString("\nThere is still time to join. Good luck!")
+	return b.String()
+}
+
+func formatLeft(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -2,53 +2,75 @@ package notifications
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
 	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	"github.com/open-builders/giveaway-backend/internal/platform/storage"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
 	"github.com/open-builders/giveaway-backend/internal/service/channels"
+	"github.com/open-builders/giveaway-backend/internal/service/requirements"
 	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
 	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+	"github.com/open-builders/giveaway-backend/internal/utils/richtext"
 )
 
 // Service formats and sends giveaway notifications to creator channels.
 type Service struct {
-	tg         *tg.Client
+	tg         tg.API
 	channels   *channels.Service
 	webAppBase string
 	rdb        *redisp.Client
 	users      *usersvc.Service
+	posts      *repo.DeletionRepository
+	covers     storage.Storage
 }
 
-func NewService(tgc *tg.Client, chs *channels.Service, webAppBaseURL string, rdb *redisp.Client, users *usersvc.Service) *Service {
+func NewService(tgc tg.API, chs *channels.Service, webAppBaseURL string, rdb *redisp.Client, users *usersvc.Service) *Service {
 	return &Service{tg: tgc, channels: chs, webAppBase: strings.TrimRight(webAppBaseURL, "/"), rdb: rdb, users: users}
 }
 
+// WithChannelPosts remembers channel posts so they can be removed when their giveaway is deleted.
+func (s *Service) WithChannelPosts(r *repo.DeletionRepository) *Service {
+	s.posts = r
+	return s
+}
+
+// recordPost remembers a channel post; failures are logged since the post is already out.
+func (s *Service) recordPost(ctx context.Context, g *dg.Giveaway, chatID, messageID int64, kind string, media bool) {
+	if s.posts == nil || messageID == 0 {
+		return
+	}
+	p := dg.ChannelPost{GiveawayID: g.ID, ChannelID: chatID, MessageID: messageID, Kind: kind, Media: media}
+	if err := s.posts.RecordPost(ctx, p); err != nil {
+		log.Printf("giveaway %s: record post in %d: %v", g.ID, chatID, err)
+	}
+}
+
 // NotifyStarted posts an announcement to all creator channels when a giveaway starts.
 func (s *Service) NotifyStarted(ctx context.Context, g *dg.Giveaway) {
 	if s == nil || s.tg == nil || s.channels == nil || g == nil || g.CreatorID == 0 {
 		return
 	}
+	ctx = tg.WithPriority(ctx, tg.PriorityAnnouncement)
 	// Build message
 	text := buildStartMessage(g)
-	animationID := s.tg.Media["giveaway_started"]
-
-	// Button URL: link to current bot username
-	btnURL := ""
-	if s.rdb != nil {
-		if me, err := s.tg.GetBotMe(ctx, s.rdb); err == nil && me != nil && me.Username != "" {
-			btnURL = fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, g.ID)
-		}
-	}
+	btnURL := s.buildAnnouncementURL(ctx, g.ID)
 	// Deliver to each creator channel
 	chs := g.Sponsors
 	for _, ch := range chs {
 		if ch.ID == 0 {
 			continue
 		}
-		_ = s.tg.SendAnimation(ctx, ch.ID, animationID, text, "HTML", "Open Giveaway", btnURL)
+		if msgID, err := s.sendLaunchMedia(ctx, g, ch.ID, text, "Open Giveaway", btnURL); err == nil {
+			s.recordPost(ctx, g, ch.ID, msgID, string(dg.ScheduleKindLaunch), true)
+		}
 	}
 }
 
@@ -57,8 +79,9 @@ func (s *Service) NotifyCompleted(ctx context.Context, g *dg.Giveaway, winnersSe
 	if s == nil || s.tg == nil || s.channels == nil || g == nil || g.CreatorID == 0 {
 		return
 	}
+	ctx = tg.WithPriority(ctx, tg.PriorityAnnouncement)
 	text := buildCompletedMessage(g, winnersSelected)
-	animationID := s.tg.Media["giveaway_finished"]
+	animationID := s.tg.MediaURL("giveaway_finished")
 
 	btnURL := s.buildStartAppURL(g.ID)
 	// Send to sponsor channels
@@ -66,10 +89,24 @@ func (s *Service) NotifyCompleted(ctx context.Context, g *dg.Giveaway, winnersSe
 		if ch.ID == 0 {
 			continue
 		}
-		_ = s.tg.SendAnimation(ctx, ch.ID, animationID, text, "HTML", "View Results", btnURL)
+		if msgID, err := s.tg.SendAnimation(ctx, ch.ID, animationID, text, "HTML", "View Results", btnURL); err == nil {
+			s.recordPost(ctx, g, ch.ID, msgID, string(dg.ScheduleKindResults), true)
+		}
 	}
 }
 
+// buildAnnouncementURL links the current bot's mini app with announcement attribution.
+func (s *Service) buildAnnouncementURL(ctx context.Context, id string) string {
+	if s.rdb == nil {
+		return ""
+	}
+	me, err := s.tg.GetBotMe(ctx, s.rdb)
+	if err != nil || me == nil || me.Username == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, dg.StartParam(id, dg.ParticipantSourceAnnouncement))
+}
+
 func (s *Service) buildWebAppURL(id string) string {
 	if s.webAppBase == "" {
 		return ""
@@ -78,6 +115,11 @@ func (s *Service) buildWebAppURL(id string) string {
 }
 
 func (s *Service) buildStartAppURL(id string) string {
+	return s.buildStartAppParamURL(id)
+}
+
+// buildStartAppParamURL builds a Mini App deep link carrying param as the start parameter.
+func (s *Service) buildStartAppParamURL(param string) string {
 	if s.tg == nil || s.rdb == nil {
 		return ""
 	}
@@ -87,7 +129,7 @@ func (s *Service) buildStartAppURL(id string) string {
 	if err != nil || me == nil || me.Username == "" {
 		return ""
 	}
-	return fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, id)
+	return fmt.Sprintf("https://t.me/%s?startapp=%s", me.Username, param)
 }
 
 // NotifyPending announces that winners will be selected manually (pending state).
@@ -95,6 +137,7 @@ func (s *Service) NotifyPending(ctx context.Context, g *dg.Giveaway) {
 	if s == nil || s.tg == nil || g == nil {
 		return
 	}
+	ctx = tg.WithPriority(ctx, tg.PriorityAnnouncement)
 	text := fmt.Sprintf("⏳ Giveaway “%s” is now pending.\nOwners are selecting winners manually. Results will be announced soon.", g.Title)
 	btnURL := s.buildStartAppURL(g.ID)
 	for _, ch := range g.Sponsors {
@@ -110,32 +153,8 @@ func (s *Service) NotifyWinnersSelected(ctx context.Context, g *dg.Giveaway, win
 	if s == nil || s.tg == nil || g == nil || len(winners) == 0 {
 		return
 	}
-	// Build winners list as usernames or tg:// links
-	names := make([]string, 0, len(winners))
-	for _, w := range winners {
-		label := ""
-		if s.users != nil {
-			if u, err := s.users.GetByID(ctx, w.UserID); err == nil && u != nil {
-				if u.Username != "" {
-					label = "@" + u.Username
-				} else {
-					display := u.FirstName
-					if display == "" && u.LastName != "" {
-						display = u.LastName
-					}
-					if display == "" {
-						display = "User"
-					}
-					label = fmt.Sprintf(`<a href="tg://user?id=%d">%s</a>`, w.UserID, escapeHTML(display))
-				}
-			}
-		}
-		if label == "" {
-			// Fallback: link with generic name
-			label = fmt.Sprintf(`<a href="tg://user?id=%d">%s</a>`, w.UserID, "User")
-		}
-		names = append(names, label)
-	}
+	ctx = tg.WithPriority(ctx, tg.PriorityAnnouncement)
+	names := s.winnerLabels(ctx, s.orderWinners(ctx, g, winners))
 	var b strings.Builder
 	b.WriteString("🎉 Giveaway completed!\n\n")
 	if g.Title != "" {
@@ -157,30 +176,107 @@ func (s *Service) NotifyWinnersSelected(ctx context.Context, g *dg.Giveaway, win
 	}
 
 	// DM winners with small delay between sends
-	startURL := s.buildStartAppURL(g.ID)
 	for i, w := range winners {
 		go func(idx int, uid int64) {
 			// Spread sends a bit to avoid burst
 			time.Sleep(time.Duration(250+idx*150) * time.Millisecond)
-			msg := fmt.Sprintf("🎉 You won in “%s”!\nOpen the app to view details.", g.Title)
-			_ = s.tg.SendMessage(context.Background(), uid, msg, "HTML", "Open Giveaway", startURL, true)
+			_ = s.SendWinnerDM(context.Background(), g, uid)
 		}(i, w.UserID)
 	}
 }
 
+// SendWinnerDM sends the winner notification to a single user and reports delivery errors.
+func (s *Service) SendWinnerDM(ctx context.Context, g *dg.Giveaway, userID int64) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	return s.tg.SendMessage(tg.WithPriority(ctx, tg.PriorityWinner), userID, buildWinnerMessage(g, loc), "HTML", loc.T("notify.open_giveaway"), s.buildStartAppURL(g.ID), true)
+}
+
+// SendMembershipLapseDM warns a participant who left a required channel that they will not be
+// drawn unless they re-subscribe before graceUntil.
+func (s *Service) SendMembershipLapseDM(ctx context.Context, g *dg.Giveaway, userID int64, channel string, graceUntil time.Time) error {
+	if s == nil || s.tg == nil || g == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	g = s.localized(ctx, g, userID)
+	when := loc.T("notify.lapse_draw")
+	if graceUntil.Before(g.EndsAt) {
+		when = loc.FormatTime(graceUntil) + " UTC"
+	}
+	msg := fmt.Sprintf(loc.T("notify.lapse"), escapeHTML(channel), escapeHTML(g.Title), when)
+	return s.tg.SendMessage(ctx, userID, msg, "HTML", loc.T("notify.open_giveaway"), s.buildStartAppURL(g.ID), true)
+}
+
+func buildWinnerMessage(g *dg.Giveaway, loc *i18n.Locale) string {
+	return fmt.Sprintf(loc.T("notify.winner"), g.Title)
+}
+
+// locale is the language of direct messages to userID: the language they picked in the
+// settings, then their Telegram language.
+func (s *Service) locale(ctx context.Context, userID int64) *i18n.Locale {
+	if s.users == nil {
+		return i18n.Match()
+	}
+	return s.users.Locale(ctx, userID)
+}
+
+// winnerLabels renders winners as @usernames or tg:// links for channel posts.
+func (s *Service) winnerLabels(ctx context.Context, winners []dg.Winner) []string {
+	names := make([]string, 0, len(winners))
+	for _, w := range winners {
+		label := ""
+		if s.users != nil {
+			if u, err := s.users.GetByID(ctx, w.UserID); err == nil && u != nil {
+				if u.Username != "" {
+					label = "@" + u.Username
+				} else {
+					display := u.FirstName
+					if display == "" && u.LastName != "" {
+						display = u.LastName
+					}
+					if display == "" {
+						display = "User"
+					}
+					label = fmt.Sprintf(`<a href="tg://user?id=%d">%s</a>`, w.UserID, escapeHTML(display))
+				}
+			}
+		}
+		if label == "" {
+			// Fallback: link with generic name
+			label = fmt.Sprintf(`<a href="tg://user?id=%d">%s</a>`, w.UserID, "User")
+		}
+		names = append(names, label)
+	}
+	return names
+}
+
+// orderWinners lists winners in the giveaway's display order.
+func (s *Service) orderWinners(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) []dg.Winner {
+	return dg.OrderWinners(winners, g.WinnerOrder, g.ID, func(userID int64) string {
+		if s.users != nil {
+			if u, err := s.users.GetByID(ctx, userID); err == nil && u != nil {
+				return dg.WinnerSortName(u.Username, u.FirstName, u.LastName)
+			}
+		}
+		return ""
+	})
+}
+
 // NotifyWinnersDM sends DM notifications to winners only (no channel posts).
 func (s *Service) NotifyWinnersDM(ctx context.Context, g *dg.Giveaway, winners []dg.Winner) {
 	if s == nil || s.tg == nil || g == nil || len(winners) == 0 {
 		return
 	}
 	// DM winners with small delay between sends
-	startURL := s.buildStartAppURL(g.ID)
 	for i, w := range winners {
 		go func(idx int, uid int64) {
 			// Spread sends a bit to avoid burst
 			time.Sleep(time.Duration(250+idx*150) * time.Millisecond)
-			msg := fmt.Sprintf("🎉 You won in “%s”!\nOpen the app to view details.", g.Title)
-			_ = s.tg.SendMessage(context.Background(), uid, msg, "HTML", "Open Giveaway", startURL, true)
+			_ = s.SendWinnerDM(context.Background(), g, uid)
 		}(i, w.UserID)
 	}
 }
@@ -190,10 +286,12 @@ func (s *Service) NotifyCreatorCompleted(ctx context.Context, g *dg.Giveaway) {
 	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
 		return
 	}
-	msg := fmt.Sprintf("✅ Your giveaway \"%s\" has been completed.\n\nWinners have been selected and notified.", g.Title)
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	msg := fmt.Sprintf(loc.T("notify.creator_completed"), g.Title)
 	btnURL := s.buildStartAppURL(g.ID)
 
-	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", "View Giveaway", btnURL, true)
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.view_giveaway"), btnURL, true)
 }
 
 // NotifyCreatorPending sends a DM to the giveaway creator when the giveaway is pending and requires action.
@@ -201,14 +299,95 @@ func (s *Service) NotifyCreatorPending(ctx context.Context, g *dg.Giveaway) {
 	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
 		return
 	}
-	msg := fmt.Sprintf("⏳ Your giveaway \"%s\" has ended and is now pending.\n\nAction required: Please review participants, verify custom requirements, and finalize the giveaway to distribute prizes.", g.Title)
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	msg := fmt.Sprintf(loc.T("notify.creator_pending"), g.Title)
 	btnURL := s.buildStartAppURL(g.ID)
-	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", "Open Giveaway", btnURL, true)
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.open_giveaway"), btnURL, true)
+}
+
+// NotifyCreatorPendingExpired tells the creator that the pending period ran out and what was done.
+func (s *Service) NotifyCreatorPendingExpired(ctx context.Context, g *dg.Giveaway, action dg.PendingAction) {
+	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
+		return
+	}
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	outcome := loc.T("notify.pending_expired_drawn")
+	if action == dg.PendingActionCancel {
+		outcome = loc.T("notify.pending_expired_cancelled")
+	}
+	msg := fmt.Sprintf(loc.T("notify.pending_expired"), escapeHTML(g.Title), outcome)
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.view_giveaway"), s.buildStartAppURL(g.ID), true)
+}
+
+// NotifyCreatorUnverifiable warns the creator that the bot lost access to a requirement channel.
+func (s *Service) NotifyCreatorUnverifiable(ctx context.Context, g *dg.Giveaway, channel string, paused bool) {
+	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
+		return
+	}
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	outcome := loc.T("notify.unverifiable_paused")
+	if !paused {
+		outcome = loc.T("notify.unverifiable_failing")
+	}
+	msg := fmt.Sprintf(loc.T("notify.unverifiable"), escapeHTML(channel), escapeHTML(g.Title), outcome)
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.open_giveaway"), s.buildStartAppURL(g.ID), true)
+}
+
+// NotifyCreatorFingerprintFlag tells the creator that several accounts joined from one device.
+func (s *Service) NotifyCreatorFingerprintFlag(ctx context.Context, g *dg.Giveaway, accounts int) {
+	if s == nil || s.tg == nil || g == nil || g.CreatorID == 0 {
+		return
+	}
+	loc := s.locale(ctx, g.CreatorID)
+	g = s.localized(ctx, g, g.CreatorID)
+	msg := fmt.Sprintf(loc.T("notify.fingerprint_flag"), accounts, escapeHTML(g.Title))
+	_ = s.tg.SendMessage(ctx, g.CreatorID, msg, "HTML", loc.T("notify.open_giveaway"), s.buildStartAppURL(g.ID), true)
+}
+
+// NotifyAdminCompletionSLA alerts the admin chat that giveaways are still unfinished past the SLA,
+// which usually means the expiry worker is stuck or blocked on locks.
+func (s *Service) NotifyAdminCompletionSLA(ctx context.Context, chatID int64, sla time.Duration, overdue []dg.CompletionLag) error {
+	if s == nil || s.tg == nil {
+		return errors.New("notifications disabled")
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "🚨 %d giveaway(s) are not finished %s after ending. The expiry worker may be stuck.\n", len(overdue), formatLeft(sla))
+	for _, l := range overdue {
+		fmt.Fprintf(&b, "\n• %s (<code>%s</code>, %s): %s late", escapeHTML(l.Title), l.GiveawayID, l.Status, formatLeft(time.Duration(l.LagSec)*time.Second))
+	}
+	return s.tg.SendMessage(ctx, chatID, b.String(), "HTML", "", "", true)
+}
+
+// DescriptionPreviewLimit caps the description part of announcements so the whole
+// post stays within Telegram's 1024 character caption limit.
+const DescriptionPreviewLimit = 300
+
+// RulesPreviewLimit caps the rules appended to announcements; the full text is in the app.
+const RulesPreviewLimit = 300
+
+// RulesBlock is the rules section of an announcement, or "" unless the creator asked to announce them.
+func RulesBlock(g *dg.Giveaway) string {
+	if g == nil || !g.AnnounceRules || g.Rules == "" {
+		return ""
+	}
+	rules := g.Rules
+	if utf8.RuneCountInString(rules) > RulesPreviewLimit {
+		rules = string([]rune(rules)[:RulesPreviewLimit]) + "…"
+	}
+	return "Rules:\n" + escapeHTML(rules) + "\n\n"
 }
 
 func buildStartMessage(g *dg.Giveaway) string {
 	var b strings.Builder
 	b.WriteString("🎁 Giveaway is live!\n\n")
+	// Description is stored as sanitized Telegram HTML and can be posted as is
+	if g.DescriptionHTML != "" {
+		b.WriteString(richtext.Truncate(g.DescriptionHTML, DescriptionPreviewLimit))
+		b.WriteString("\n\n")
+	}
 	b.WriteString("Details:\n")
 	// Subscribe line: from sponsors list usernames if present
 	subs := collectSponsorsUsernames(g)
@@ -237,6 +416,7 @@ func buildStartMessage(g *dg.Giveaway) string {
 		b.WriteString(req)
 		b.WriteString("\n")
 	}
+	b.WriteString(RulesBlock(g))
 	b.WriteString("Participants can now join this giveaway. Good luck!")
 	return b.String()
 }
@@ -288,10 +468,13 @@ func collectSponsorsUsernames(g *dg.Giveaway) string {
 }
 
 func collectPrizeTitles(g *dg.Giveaway) string {
-	if g == nil || len(g.Prizes) == 0 {
+	if g == nil || len(g.Prizes)+len(g.PrizePackages) == 0 {
 		return ""
 	}
-	titles := make([]string, 0, len(g.Prizes))
+	titles := make([]string, 0, len(g.PrizePackages)+len(g.Prizes))
+	for _, p := range g.PrizePackages {
+		titles = append(titles, p.Name())
+	}
 	for _, p := range g.Prizes {
 		if p.Title != "" {
 			titles = append(titles, p.Title)
@@ -305,64 +488,35 @@ func buildRequirementsBlock(g *dg.Giveaway) string {
 		return ""
 	}
 	var b strings.Builder
+	bonus := 0
+	var optional []dg.Requirement
 	for _, r := range g.Requirements {
-		switch r.Type {
-		case dg.RequirementTypeSubscription:
-			if r.ChannelUsername != "" {
-				b.WriteString("• Subscribe to @")
-				b.WriteString(r.ChannelUsername)
-			} else if r.ChannelTitle != "" {
-				b.WriteString("• Subscribe to ")
-				b.WriteString(r.ChannelTitle)
-			} else {
-				b.WriteString("• Subscribe to the channel")
-			}
-			b.WriteString("\n")
-		case dg.RequirementTypeBoost:
-			if r.ChannelUsername != "" {
-				b.WriteString("• Boost @")
-				b.WriteString(r.ChannelUsername)
-			} else {
-				b.WriteString("• Boost the channel")
-			}
-			b.WriteString("\n")
-		case dg.RequirementTypeHoldTON:
-			if r.TonMinBalanceNano > 0 {
-				// Convert nano to TON with 9 decimals
-				tons := float64(r.TonMinBalanceNano) / 1_000_000_000
-				b.WriteString(fmt.Sprintf("• Minimum TON balance: %.4f TON\n", tons))
-			}
-		case dg.RequirementTypeHoldJetton:
-			if r.JettonAddress != "" {
-				if r.JettonMinAmount > 0 {
-					b.WriteString(fmt.Sprintf("• Hold jetton %s ≥ %d\n", r.JettonAddress, r.JettonMinAmount))
-				} else {
-					b.WriteString(fmt.Sprintf("• Hold jetton %s\n", r.JettonAddress))
-				}
-			}
-		case dg.RequirementTypeCustom:
-			if r.Title != "" || r.Description != "" {
-				b.WriteString("• ")
-				if r.Title != "" {
-					b.WriteString(r.Title)
-					if r.Description != "" {
-						b.WriteString(": ")
-						b.WriteString(r.Description)
-					}
-				} else {
-					b.WriteString(r.Description)
-				}
-				b.WriteString("\n")
-			}
-		case dg.RequirementTypeAccountAge:
-			if r.AccountAgeMinYear > 0 && r.AccountAgeMaxYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered between %d and %d\n", r.AccountAgeMaxYear, r.AccountAgeMinYear))
-			} else if r.AccountAgeMinYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered in %d or earlier\n", r.AccountAgeMinYear))
-			} else if r.AccountAgeMaxYear > 0 {
-				b.WriteString(fmt.Sprintf("• Account registered in %d or later\n", r.AccountAgeMaxYear))
-			}
+		if r.IsBonus() {
+			// Optional tasks are listed in the app, the post only hints at them
+			bonus++
+			continue
+		}
+		if r.Optional {
+			optional = append(optional, r)
+			continue
+		}
+		writeRequirementLine(&b, r)
+	}
+	if len(optional) > 0 {
+		b.WriteString("Optional:\n")
+		for _, r := range optional {
+			writeRequirementLine(&b, r)
 		}
 	}
+	if bonus > 0 {
+		b.WriteString(fmt.Sprintf("• Optional: %d bonus task(s) in the app for extra tickets\n", bonus))
+	}
 	return b.String()
 }
+
+// writeRequirementLine appends the bullet describing r, if its type has one.
+func writeRequirementLine(b *strings.Builder, r dg.Requirement) {
+	if line := requirements.RenderText(r); line != "" {
+		b.WriteString("• " + line + "\n")
+	}
+}
@@ -0,0 +1,44 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// supportExcerptRunes bounds the message text quoted in support notifications.
+const supportExcerptRunes = 300
+
+// Support ticket events a participant, creator or admin is told about.
+const (
+	SupportOpened    = "opened"
+	SupportReply     = "reply"
+	SupportEscalated = "escalated"
+	SupportResolved  = "resolved"
+)
+
+// SendSupportDM tells userID about activity on a support ticket; text is quoted for openings
+// and replies. The button deep-links to the ticket.
+func (s *Service) SendSupportDM(ctx context.Context, t *dg.SupportTicket, userID int64, event, text string) error {
+	if s == nil || s.tg == nil || t == nil {
+		return errors.New("notifications disabled")
+	}
+	loc := s.locale(ctx, userID)
+	title := escapeHTML(t.GiveawayTitle)
+	var msg string
+	switch event {
+	case SupportOpened, SupportReply:
+		if utf8.RuneCountInString(text) > supportExcerptRunes {
+			text = string([]rune(text)[:supportExcerptRunes]) + "…"
+		}
+		msg = fmt.Sprintf(loc.T("notify.support_"+event), t.ID, title, escapeHTML(text))
+	case SupportEscalated, SupportResolved:
+		msg = fmt.Sprintf(loc.T("notify.support_"+event), t.ID, title)
+	default:
+		return fmt.Errorf("unknown support event %q", event)
+	}
+	return s.tg.SendMessage(ctx, userID, msg, "HTML", loc.T("notify.support_button"), s.buildStartAppParamURL(fmt.Sprintf("support_%d", t.ID)), true)
+}
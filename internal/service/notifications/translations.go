@@ -0,0 +1,21 @@
+package notifications
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// localized returns g with the title and description in the language of userID: the one they
+// picked in the settings, then their Telegram language. Without a matching translation g is
+// returned as is.
+func (s *Service) localized(ctx context.Context, g *dg.Giveaway, userID int64) *dg.Giveaway {
+	if s.users == nil || len(g.Translations) == 0 {
+		return g
+	}
+	u, err := s.users.GetByID(ctx, userID)
+	if err != nil || u == nil {
+		return g
+	}
+	return g.Localized(u.PreferredLanguage, u.LanguageCode)
+}
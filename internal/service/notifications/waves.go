@@ -0,0 +1,40 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+// NotifyWaveWinners announces the winners of one wave in sponsor channels and DMs them.
+func (s *Service) NotifyWaveWinners(ctx context.Context, g *dg.Giveaway, wave int, winners []dg.Winner) {
+	if s == nil || s.tg == nil || g == nil || len(winners) == 0 {
+		return
+	}
+	ctx = tg.WithPriority(ctx, tg.PriorityAnnouncement)
+	names := s.winnerLabels(ctx, s.orderWinners(ctx, g, winners))
+	var b strings.Builder
+	fmt.Fprintf(&b, "🎉 Wave %d winners of “%s”!\n\n", wave, escapeHTML(g.Title))
+	b.WriteString("Winners: ")
+	b.WriteString(strings.Join(names, ", "))
+	b.WriteString("\n\nThe giveaway is still running. Prizes are assigned when it ends.")
+	text := b.String()
+	btnURL := s.buildStartAppURL(g.ID)
+	for _, ch := range g.Sponsors {
+		if ch.ID == 0 {
+			continue
+		}
+		_ = s.tg.SendMessage(ctx, ch.ID, text, "HTML", "Open Giveaway", btnURL, true)
+	}
+	for i, w := range winners {
+		go func(idx int, uid int64) {
+			// Spread sends a bit to avoid burst
+			time.Sleep(time.Duration(250+idx*150) * time.Millisecond)
+			_ = s.SendWinnerDM(context.Background(), g, uid)
+		}(i, w.UserID)
+	}
+}
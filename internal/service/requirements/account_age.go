@@ -0,0 +1,66 @@
+package requirements
+
+import (
+	"context"
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	tgutils "github.com/open-builders/giveaway-backend/internal/utils/telegram"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(accountAge{}) }
+
+// accountAge limits the registration year of participants, estimated from their user id.
+type accountAge struct{}
+
+func (accountAge) Name() dg.RequirementType { return dg.RequirementTypeAccountAge }
+
+func (accountAge) Validate(v *validate.Errors, i int, r dg.Requirement) {
+	field := validate.Field("requirements", i, "account_age")
+	if r.AccountAgeMinYear <= 0 && r.AccountAgeMaxYear <= 0 {
+		v.Add(field, "account_age_missing")
+	} else if r.AccountAgeMinYear > 0 && r.AccountAgeMaxYear > 0 && r.AccountAgeMinYear > r.AccountAgeMaxYear {
+		v.Add(field, "account_age_range")
+	}
+}
+
+func (accountAge) Enrich(_ context.Context, _ Env, r *dg.Requirement) error {
+	*r = dg.Requirement{
+		Type:              dg.RequirementTypeAccountAge,
+		AccountAgeMinYear: r.AccountAgeMinYear,
+		AccountAgeMaxYear: r.AccountAgeMaxYear,
+		Title:             r.Title,
+		Description:       r.Description,
+	}
+	return nil
+}
+
+func (accountAge) Dependency() Dependency { return DependencyNone }
+
+func (accountAge) Check(_ context.Context, _ Deps, userID int64, r *dg.Requirement) Result {
+	year := tgutils.EstimateAccountYear(userID)
+	if year == 0 {
+		return failed("could not estimate account age")
+	}
+	// The minimum year rejects older accounts, the maximum newer ones
+	if r.AccountAgeMinYear > 0 && year < r.AccountAgeMinYear {
+		return failed(fmt.Sprintf("account too old: registered ~%d, required >= %d", year, r.AccountAgeMinYear))
+	}
+	if r.AccountAgeMaxYear > 0 && year > r.AccountAgeMaxYear {
+		return failed(fmt.Sprintf("account too new: registered ~%d, required <= %d", year, r.AccountAgeMaxYear))
+	}
+	return passed()
+}
+
+func (accountAge) RenderText(r dg.Requirement) string {
+	switch {
+	case r.AccountAgeMinYear > 0 && r.AccountAgeMaxYear > 0:
+		return fmt.Sprintf("Account registered between %d and %d", r.AccountAgeMaxYear, r.AccountAgeMinYear)
+	case r.AccountAgeMinYear > 0:
+		return fmt.Sprintf("Account registered in %d or earlier", r.AccountAgeMinYear)
+	case r.AccountAgeMaxYear > 0:
+		return fmt.Sprintf("Account registered in %d or later", r.AccountAgeMaxYear)
+	}
+	return ""
+}
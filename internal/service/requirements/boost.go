@@ -0,0 +1,80 @@
+package requirements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(boost{}) }
+
+// boost asks participants to boost a channel.
+type boost struct{}
+
+func (boost) Name() dg.RequirementType { return dg.RequirementTypeBoost }
+
+func (boost) Validate(*validate.Errors, int, dg.Requirement) {}
+
+// Enrich needs the channel: boost links are built from its details.
+func (boost) Enrich(ctx context.Context, env Env, r *dg.Requirement) error {
+	in := *r
+	if env.Channels == nil || in.ChannelID == 0 {
+		return errors.New("invalid requirement")
+	}
+	*r = dg.Requirement{Type: dg.RequirementTypeBoost, ChannelTitle: in.Title, Description: in.Description}
+	ch, err := env.Channels.GetByID(ctx, in.ChannelID, env.CreatorID)
+	if err != nil {
+		return err
+	}
+	if ch != nil {
+		r.ChannelID = ch.ID
+		r.ChannelUsername = ch.Username
+		r.ChannelTitle = ch.Title
+		if in.ChannelUsername != "" {
+			r.ChannelURL = "https://t.me/boost/" + ch.Username
+		} else {
+			r.ChannelURL = "https://t.me/c/" + strings.TrimPrefix(strconv.FormatInt(ch.ID, 10), "-100") + "?boost"
+		}
+		r.AvatarURL = ch.AvatarURL
+	}
+	return nil
+}
+
+func (boost) Dependency() Dependency { return DependencyTelegram }
+
+// Check prefers the boosters the bot tracks in Redis and falls back to the Telegram API.
+func (boost) Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result {
+	c := chat(r)
+	if c == "" {
+		return failed("invalid requirement: no channel")
+	}
+	if d.Redis != nil && r.ChannelID != 0 {
+		key := fmt.Sprintf("channel:%d:boost_users", r.ChannelID)
+		if ok, err := d.Redis.SIsMember(ctx, key, fmt.Sprintf("%d", userID)).Result(); err == nil && ok {
+			return passed()
+		}
+	}
+	res := Result{Status: "failed"}
+	if d.Telegram != nil {
+		ok, err := d.Telegram.CheckBoost(ctx, userID, c)
+		if err != nil {
+			return failed(err.Error())
+		}
+		if ok {
+			res = passed()
+		}
+	}
+	return res
+}
+
+func (boost) RenderText(r dg.Requirement) string {
+	if r.ChannelUsername != "" {
+		return "Boost @" + r.ChannelUsername
+	}
+	return "Boost the channel"
+}
@@ -0,0 +1,18 @@
+package requirements
+
+import (
+	"fmt"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+)
+
+// chat identifies the requirement channel for Bot API calls: the numeric id, else @username.
+func chat(r *dg.Requirement) string {
+	if r.ChannelID != 0 {
+		return fmt.Sprintf("%d", r.ChannelID)
+	}
+	if r.ChannelUsername != "" {
+		return "@" + r.ChannelUsername
+	}
+	return ""
+}
@@ -0,0 +1,37 @@
+package requirements
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(custom{}) }
+
+// custom is a free-form task the bot cannot verify; the creator picks winners who did it.
+type custom struct{}
+
+func (custom) Name() dg.RequirementType { return dg.RequirementTypeCustom }
+
+func (custom) Validate(*validate.Errors, int, dg.Requirement) {}
+
+// Enrich stores the task name as the channel title, where clients read it.
+func (custom) Enrich(_ context.Context, _ Env, r *dg.Requirement) error {
+	*r = dg.Requirement{Type: dg.RequirementTypeCustom, ChannelTitle: r.Title, Description: r.Description}
+	return nil
+}
+
+func (custom) Dependency() Dependency { return DependencyNone }
+
+func (custom) Check(context.Context, Deps, int64, *dg.Requirement) Result { return passed() }
+
+func (custom) RenderText(r dg.Requirement) string {
+	switch {
+	case r.Title != "" && r.Description != "":
+		return r.Title + ": " + r.Description
+	case r.Title != "":
+		return r.Title
+	}
+	return r.Description
+}
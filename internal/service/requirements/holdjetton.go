@@ -0,0 +1,71 @@
+package requirements
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(holdJetton{}) }
+
+// holdJetton asks for a minimum jetton amount, in whole tokens, on the participant's linked wallet.
+type holdJetton struct{}
+
+func (holdJetton) Name() dg.RequirementType { return dg.RequirementTypeHoldJetton }
+
+func (holdJetton) Validate(v *validate.Errors, i int, r dg.Requirement) {
+	v.NotNegative(validate.Field("requirements", i, "jetton_min_amount"), r.JettonMinAmount)
+}
+
+func (holdJetton) Enrich(_ context.Context, _ Env, r *dg.Requirement) error {
+	*r = dg.Requirement{
+		Type:            dg.RequirementTypeHoldJetton,
+		JettonAddress:   r.JettonAddress,
+		JettonMinAmount: r.JettonMinAmount,
+		Title:           r.Title,
+		Description:     r.Description,
+	}
+	return nil
+}
+
+func (holdJetton) Dependency() Dependency { return DependencyTON }
+
+func (holdJetton) Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result {
+	if d.Users == nil || d.TON == nil {
+		return failed("ton service not configured")
+	}
+	u, err := d.Users.GetByID(ctx, userID)
+	if err != nil || u == nil || u.WalletAddress == "" {
+		return failed("wallet not linked")
+	}
+	if r.JettonAddress == "" || r.JettonMinAmount <= 0 {
+		return failed("invalid jetton requirement")
+	}
+	bal, err := d.TON.GetJettonBalanceNano(ctx, u.WalletAddress, r.JettonAddress)
+	if err != nil {
+		return failed(err.Error())
+	}
+	dec, err := d.TON.GetJettonDecimals(ctx, r.JettonAddress)
+	if err != nil {
+		return failed(err.Error())
+	}
+	need := new(big.Int).SetInt64(r.JettonMinAmount)
+	need.Mul(need, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(dec)), nil))
+	if big.NewInt(bal).Cmp(need) >= 0 {
+		return passed()
+	}
+	return Result{Status: "failed"}
+}
+
+func (holdJetton) RenderText(r dg.Requirement) string {
+	switch {
+	case r.JettonAddress == "":
+		return ""
+	case r.JettonMinAmount > 0:
+		return fmt.Sprintf("Hold jetton %s ≥ %d", r.JettonAddress, r.JettonMinAmount)
+	}
+	return "Hold jetton " + r.JettonAddress
+}
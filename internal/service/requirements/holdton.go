@@ -0,0 +1,55 @@
+package requirements
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(holdTON{}) }
+
+// holdTON asks for a minimum TON balance on the participant's linked wallet.
+type holdTON struct{}
+
+func (holdTON) Name() dg.RequirementType { return dg.RequirementTypeHoldTON }
+
+func (holdTON) Validate(v *validate.Errors, i int, r dg.Requirement) {
+	v.NotNegative(validate.Field("requirements", i, "ton_min_balance_nano"), r.TonMinBalanceNano)
+}
+
+func (holdTON) Enrich(_ context.Context, _ Env, r *dg.Requirement) error {
+	*r = dg.Requirement{Type: dg.RequirementTypeHoldTON, TonMinBalanceNano: r.TonMinBalanceNano, Title: r.Title, Description: r.Description}
+	return nil
+}
+
+func (holdTON) Dependency() Dependency { return DependencyTON }
+
+func (holdTON) Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result {
+	if d.Users == nil || d.TON == nil {
+		return failed("ton service not configured")
+	}
+	u, err := d.Users.GetByID(ctx, userID)
+	if err != nil || u == nil || u.WalletAddress == "" {
+		return failed("wallet not linked")
+	}
+	bal, err := d.TON.GetAddressBalanceNano(ctx, u.WalletAddress)
+	if err != nil {
+		return failed(err.Error())
+	}
+	if r.TonMinBalanceNano > 0 && bal >= r.TonMinBalanceNano {
+		return passed()
+	}
+	return Result{Status: "failed"}
+}
+
+// RenderText shows the balance in TON rounded to 3 decimals without trailing zeros.
+func (holdTON) RenderText(r dg.Requirement) string {
+	if r.TonMinBalanceNano <= 0 {
+		return ""
+	}
+	tons := math.Round(float64(r.TonMinBalanceNano)/1_000_000_000*1000) / 1000
+	return "Minimum TON balance: " + strconv.FormatFloat(tons, 'f', -1, 64) + " TON"
+}
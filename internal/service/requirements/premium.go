@@ -0,0 +1,36 @@
+package requirements
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+func init() { Register(premium{}) }
+
+// premium asks participants to have Telegram Premium.
+type premium struct{}
+
+func (premium) Name() dg.RequirementType { return dg.RequirementTypePremium }
+
+func (premium) Validate(*validate.Errors, int, dg.Requirement) {}
+
+// Enrich keeps only the optional name and description shown in the UI.
+func (premium) Enrich(_ context.Context, _ Env, r *dg.Requirement) error {
+	*r = dg.Requirement{Type: dg.RequirementTypePremium, Title: r.Title, Description: r.Description}
+	return nil
+}
+
+func (premium) Dependency() Dependency { return DependencyNone }
+
+func (premium) Check(ctx context.Context, d Deps, userID int64, _ *dg.Requirement) Result {
+	if d.Users != nil {
+		if u, err := d.Users.GetByID(ctx, userID); err == nil && u != nil && u.IsPremium {
+			return passed()
+		}
+	}
+	return Result{Status: "failed"}
+}
+
+func (premium) RenderText(dg.Requirement) string { return "Telegram Premium user" }
@@ -0,0 +1,119 @@
+// Package requirements holds one module per giveaway requirement type. Each module validates
+// what creators submit, enriches it when the giveaway is created, checks it for a participant
+// and renders it for announcements, so a new type only needs a new file that registers itself.
+package requirements
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	chsvc "github.com/open-builders/giveaway-backend/internal/service/channels"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
+	usersvc "github.com/open-builders/giveaway-backend/internal/service/user"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// Type implements one requirement type.
+type Type interface {
+	// Name is the requirement type the module handles.
+	Name() dg.RequirementType
+	// Validate reports invalid fields of requirement i of a create payload.
+	Validate(v *validate.Errors, i int, r dg.Requirement)
+	// Enrich keeps the fields the type uses from a submitted requirement and fills in what
+	// can be looked up, e.g. channel details. The creator's name for it arrives in Title.
+	Enrich(ctx context.Context, env Env, r *dg.Requirement) error
+	// Check verifies the requirement for a participant.
+	Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result
+	// Dependency is the external service Check calls, or DependencyNone.
+	Dependency() Dependency
+	// RenderText describes the requirement in one line for announcements, or "" to leave it out.
+	RenderText(r dg.Requirement) string
+}
+
+// Result is the outcome of checking one requirement.
+type Result struct {
+	Status string
+	Error  string
+	// Warning is set when the requirement could not be verified and was passed anyway
+	Warning string
+}
+
+// Env is what enrichment on create may use.
+type Env struct {
+	// Channels resolves channel details; nil when Telegram is not configured
+	Channels *chsvc.Service
+	// CreatorID is the user creating the giveaway
+	CreatorID int64
+}
+
+// Dependency names an external service requirement checks call, so callers can bound how
+// many checks hit it at once.
+type Dependency string
+
+const (
+	// DependencyNone marks checks answered from local data
+	DependencyNone Dependency = ""
+	// DependencyTelegram marks checks calling the Bot API
+	DependencyTelegram Dependency = "telegram"
+	// DependencyTON marks checks calling the TON API
+	DependencyTON Dependency = "ton"
+)
+
+// Deps are the services checks may use. Checks needing a missing one fail.
+type Deps struct {
+	Telegram tg.API
+	Redis    *redisp.Client
+	Users    *usersvc.Service
+	TON      *tonb.Service
+	// Membership applies the bot's observed membership history to a met subscription check
+	Membership func(ctx context.Context, userID int64, r *dg.Requirement, res Result) Result
+}
+
+var registry = map[dg.RequirementType]Type{}
+
+// Register adds a requirement type; registering a name twice panics.
+func Register(t Type) {
+	if _, dup := registry[t.Name()]; dup {
+		panic("requirements: duplicate type " + string(t.Name()))
+	}
+	registry[t.Name()] = t
+}
+
+// Lookup returns the module of a requirement type.
+func Lookup(name dg.RequirementType) (Type, bool) {
+	t, ok := registry[name]
+	return t, ok
+}
+
+// Check verifies r with its module; unknown types fail.
+func Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result {
+	t, ok := registry[r.Type]
+	if !ok {
+		return Result{Status: "failed", Error: "unsupported requirement type"}
+	}
+	return t.Check(ctx, d, userID, r)
+}
+
+// DependencyOf returns the dependency checks of r call; unknown types call none.
+func DependencyOf(r dg.Requirement) Dependency {
+	t, ok := registry[r.Type]
+	if !ok {
+		return DependencyNone
+	}
+	return t.Dependency()
+}
+
+// RenderText describes r in one line, or "" for unknown types and those not announced.
+func RenderText(r dg.Requirement) string {
+	t, ok := registry[r.Type]
+	if !ok {
+		return ""
+	}
+	return t.RenderText(r)
+}
+
+func failed(err string) Result { return Result{Status: "failed", Error: err} }
+
+func passed() Result { return Result{Status: "success"} }
@@ -0,0 +1,89 @@
+package requirements
+
+import (
+	"context"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/utils/validate"
+)
+
+// maxMemberDays bounds min_member_days.
+const maxMemberDays = 3650
+
+func init() { Register(subscription{}) }
+
+// subscription asks participants to be members of a channel.
+type subscription struct{}
+
+func (subscription) Name() dg.RequirementType { return dg.RequirementTypeSubscription }
+
+func (subscription) Validate(v *validate.Errors, i int, r dg.Requirement) {
+	v.Between(validate.Field("requirements", i, "min_member_days"), int64(r.MinMemberDays), 0, maxMemberDays)
+}
+
+func (subscription) Enrich(ctx context.Context, env Env, r *dg.Requirement) error {
+	in := *r
+	*r = dg.Requirement{
+		Type:                dg.RequirementTypeSubscription,
+		ChannelTitle:        in.Title,
+		Description:         in.Description,
+		ExistingMembersOnly: in.ExistingMembersOnly,
+		MinMemberDays:       in.MinMemberDays,
+	}
+	if env.Channels == nil || in.ChannelID == 0 {
+		// Nothing to look up: store what we have
+		r.ChannelID = in.ChannelID
+		r.ChannelUsername = in.ChannelUsername
+		r.AvatarURL = in.AvatarURL
+		return nil
+	}
+	ch, err := env.Channels.GetByID(ctx, in.ChannelID, env.CreatorID)
+	if err != nil {
+		return err
+	}
+	if ch != nil {
+		r.ChannelID = ch.ID
+		r.ChannelUsername = ch.Username
+		r.ChannelTitle = ch.Title
+		r.ChannelURL = ch.URL
+		r.AvatarURL = ch.AvatarURL
+	}
+	if r.ChannelURL == "" {
+		r.ChannelURL = "https://t.me/" + r.ChannelUsername
+	}
+	return nil
+}
+
+func (subscription) Dependency() Dependency { return DependencyTelegram }
+
+func (subscription) Check(ctx context.Context, d Deps, userID int64, r *dg.Requirement) Result {
+	c := chat(r)
+	if c == "" {
+		return failed("invalid requirement: no channel")
+	}
+	if d.Telegram == nil {
+		return failed("telegram service not configured")
+	}
+	ok, err := d.Telegram.CheckMembership(ctx, userID, c)
+	if err != nil {
+		return failed(err.Error())
+	}
+	res := Result{Status: "failed"}
+	if ok {
+		res = passed()
+	}
+	if d.Membership != nil {
+		res = d.Membership(ctx, userID, r, res)
+	}
+	return res
+}
+
+func (subscription) RenderText(r dg.Requirement) string {
+	switch {
+	case r.ChannelUsername != "":
+		return "Subscribe to @" + r.ChannelUsername
+	case r.ChannelTitle != "":
+		return "Subscribe to " + r.ChannelTitle
+	}
+	return "Subscribe to the channel"
+}
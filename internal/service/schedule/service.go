@@ -0,0 +1,201 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+const (
+	maxItemsPerGiveaway = 20
+	maxTextLength       = 4096
+	maxDeliveryAttempts = 3
+	// claimLease is how long a claimed item may stay processing before another tick retries it
+	claimLease = 10 * time.Minute
+)
+
+// ItemInput describes a requested content plan entry. ScheduledAt is optional for
+// lifecycle kinds and derived from the giveaway timeline when omitted.
+type ItemInput struct {
+	Kind        dg.ScheduleItemKind
+	ScheduledAt *time.Time
+	Text        string
+}
+
+// Service manages giveaway content plans and delivers due posts.
+type Service struct {
+	repo      *repo.ScheduleRepository
+	giveaways *repo.GiveawayRepository
+	ntf       *notify.Service
+}
+
+func NewService(r *repo.ScheduleRepository, giveaways *repo.GiveawayRepository, ntf *notify.Service) *Service {
+	return &Service{repo: r, giveaways: giveaways, ntf: ntf}
+}
+
+// List returns the content plan of a giveaway owned by requesterID.
+func (s *Service) List(ctx context.Context, giveawayID string, requesterID int64) ([]dg.ScheduleItem, error) {
+	if _, err := s.loadOwned(ctx, giveawayID, requesterID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByGiveaway(ctx, giveawayID)
+}
+
+// Replace validates the requested plan and replaces all not yet delivered items.
+func (s *Service) Replace(ctx context.Context, giveawayID string, requesterID int64, in []ItemInput) ([]dg.ScheduleItem, error) {
+	g, err := s.loadOwned(ctx, giveawayID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	switch g.Status {
+	case dg.GiveawayStatusCompleted, dg.GiveawayStatusFinished, dg.GiveawayStatusCancelled:
+		return nil, errors.New("giveaway is not editable")
+	case dg.GiveawayStatusDraft:
+		// Offsets are resolved against the run time, which is set on publish
+		return nil, errors.New("publish the draft first")
+	}
+	if len(in) > maxItemsPerGiveaway {
+		return nil, fmt.Errorf("too many schedule items (max %d)", maxItemsPerGiveaway)
+	}
+	items := make([]dg.ScheduleItem, 0, len(in))
+	for i, it := range in {
+		item, err := resolveItem(g, it)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+		items = append(items, item)
+	}
+	if err := s.repo.ReplacePending(ctx, giveawayID, items); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByGiveaway(ctx, giveawayID)
+}
+
+// Cancel cancels a single pending item.
+func (s *Service) Cancel(ctx context.Context, giveawayID string, requesterID int64, itemID int64) error {
+	if _, err := s.loadOwned(ctx, giveawayID, requesterID); err != nil {
+		return err
+	}
+	ok, err := s.repo.Cancel(ctx, giveawayID, itemID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// RunDue delivers all due items and returns how many were sent successfully.
+func (s *Service) RunDue(ctx context.Context) (int, error) {
+	if _, err := s.repo.CancelStale(ctx, claimLease); err != nil {
+		return 0, err
+	}
+	items, err := s.repo.ClaimDue(ctx, time.Now().UTC(), 50, maxDeliveryAttempts, claimLease)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, it := range items {
+		if err := s.deliver(ctx, it); err != nil {
+			log.Printf("schedule item %d (%s) failed: %v", it.ID, it.GiveawayID, err)
+			if mErr := s.repo.MarkFailed(ctx, it.ID, err.Error(), maxDeliveryAttempts); mErr != nil {
+				log.Printf("schedule item %d mark failed: %v", it.ID, mErr)
+			}
+			continue
+		}
+		if err := s.repo.MarkSent(ctx, it.ID); err != nil {
+			log.Printf("schedule item %d mark sent: %v", it.ID, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (s *Service) deliver(ctx context.Context, it dg.ScheduleItem) error {
+	g, err := s.giveaways.GetByID(ctx, it.GiveawayID)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return errors.New("giveaway not found")
+	}
+	if it.Kind == dg.ScheduleKindResults {
+		winners, err := s.giveaways.ListWinnersWithPrizes(ctx, g.ID)
+		if err != nil {
+			return err
+		}
+		g.Winners = winners
+	}
+	sendCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	return s.ntf.PostScheduled(sendCtx, g, it.Kind, it.Text)
+}
+
+func (s *Service) loadOwned(ctx context.Context, giveawayID string, requesterID int64) (*dg.Giveaway, error) {
+	if giveawayID == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.giveaways.GetByID(ctx, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return g, nil
+}
+
+// resolveItem fills default times for lifecycle kinds and validates the entry against the giveaway timeline.
+func resolveItem(g *dg.Giveaway, in ItemInput) (dg.ScheduleItem, error) {
+	text := strings.TrimSpace(in.Text)
+	if utf8.RuneCountInString(text) > maxTextLength {
+		return dg.ScheduleItem{}, fmt.Errorf("text exceeds %d characters", maxTextLength)
+	}
+	var at time.Time
+	switch in.Kind {
+	case dg.ScheduleKindLaunch:
+		at = g.StartedAt
+	case dg.ScheduleKindMidpoint:
+		at = g.StartedAt.Add(g.EndsAt.Sub(g.StartedAt) / 2)
+	case dg.ScheduleKindLastDay:
+		at = g.EndsAt.Add(-24 * time.Hour)
+		if at.Before(g.StartedAt) {
+			at = g.StartedAt
+		}
+	case dg.ScheduleKindResults:
+		at = g.EndsAt
+	case dg.ScheduleKindCustom:
+		if in.ScheduledAt == nil {
+			return dg.ScheduleItem{}, errors.New("scheduled_at is required for custom items")
+		}
+		if text == "" {
+			return dg.ScheduleItem{}, errors.New("text is required for custom items")
+		}
+	default:
+		return dg.ScheduleItem{}, fmt.Errorf("unsupported kind %q", in.Kind)
+	}
+	if in.ScheduledAt != nil {
+		at = in.ScheduledAt.UTC()
+	}
+	if in.Kind == dg.ScheduleKindResults {
+		if at.Before(g.EndsAt) {
+			return dg.ScheduleItem{}, errors.New("results can not be posted before the giveaway ends")
+		}
+	} else if at.Before(g.StartedAt) || at.After(g.EndsAt) {
+		return dg.ScheduleItem{}, errors.New("scheduled_at must be within the giveaway run")
+	}
+	return dg.ScheduleItem{Kind: in.Kind, ScheduledAt: at.UTC(), Text: text}, nil
+}
@@ -0,0 +1,35 @@
+package screening
+
+import (
+	"context"
+
+	tonb "github.com/open-builders/giveaway-backend/internal/service/tonbalance"
+)
+
+// DenyListStore looks up addresses in the deny-list; addresses are in normalized raw form.
+type DenyListStore interface {
+	IsDenied(ctx context.Context, address string) (bool, string, error)
+}
+
+// DenyList flags wallets listed in the screening_denylist table.
+type DenyList struct {
+	store DenyListStore
+}
+
+func NewDenyList(store DenyListStore) *DenyList { return &DenyList{store: store} }
+
+func (d *DenyList) Name() string { return "denylist" }
+
+func (d *DenyList) Screen(ctx context.Context, address string) (Result, error) {
+	denied, reason, err := d.store.IsDenied(ctx, tonb.NormalizeAddress(address))
+	if err != nil {
+		return Result{}, err
+	}
+	if !denied {
+		return Result{Provider: d.Name()}, nil
+	}
+	if reason == "" {
+		reason = "address is deny-listed"
+	}
+	return Result{Flagged: true, Reason: reason, Provider: d.Name()}, nil
+}
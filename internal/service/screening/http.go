@@ -0,0 +1,55 @@
+package screening
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPProvider asks an external screening service about a wallet.
+// It POSTs {"address": "..."} and expects {"flagged": bool, "reason": "..."} back.
+type HTTPProvider struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+func NewHTTPProvider(endpoint, token string) *HTTPProvider {
+	return &HTTPProvider{endpoint: endpoint, token: token, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (p *HTTPProvider) Name() string { return "http" }
+
+func (p *HTTPProvider) Screen(ctx context.Context, address string) (Result, error) {
+	body, err := json.Marshal(map[string]string{"address": address})
+	if err != nil {
+		return Result{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("screening http %d", resp.StatusCode)
+	}
+	var out struct {
+		Flagged bool   `json:"flagged"`
+		Reason  string `json:"reason"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return Result{}, err
+	}
+	return Result{Flagged: out.Flagged, Reason: out.Reason, Provider: p.Name()}, nil
+}
@@ -0,0 +1,48 @@
+// Package screening checks winner wallets against sanctions and risk sources before payouts.
+package screening
+
+import (
+	"context"
+	"strings"
+)
+
+// Result is the verdict of one screening.
+type Result struct {
+	Flagged  bool
+	Reason   string
+	Provider string
+}
+
+// Screener checks a wallet address. An error means the wallet could not be screened;
+// callers must not treat it as clear.
+type Screener interface {
+	Name() string
+	Screen(ctx context.Context, address string) (Result, error)
+}
+
+// Chain runs screeners in order and stops at the first flag or error.
+type Chain []Screener
+
+func (c Chain) Name() string {
+	names := make([]string, 0, len(c))
+	for _, s := range c {
+		names = append(names, s.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (c Chain) Screen(ctx context.Context, address string) (Result, error) {
+	for _, s := range c {
+		res, err := s.Screen(ctx, address)
+		if err != nil {
+			return Result{Provider: s.Name()}, err
+		}
+		if res.Flagged {
+			if res.Provider == "" {
+				res.Provider = s.Name()
+			}
+			return res, nil
+		}
+	}
+	return Result{Provider: c.Name()}, nil
+}
@@ -0,0 +1,127 @@
+package shortlink
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"log"
+	"math/big"
+	"strconv"
+	"strings"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/service/analytics"
+)
+
+const codeLength = 10
+
+// Target is an outbound URL to wrap.
+type Target struct {
+	Kind     dg.ShortLinkKind
+	Position int
+	URL      string
+}
+
+// Service wraps requirement and sponsor URLs into /l/:code links and counts click-throughs.
+type Service struct {
+	repo      *repo.ShortLinkRepository
+	giveaways *repo.GiveawayRepository
+	baseURL   string
+	analytics *analytics.Recorder
+}
+
+func NewService(r *repo.ShortLinkRepository, giveaways *repo.GiveawayRepository, baseURL string) *Service {
+	return &Service{repo: r, giveaways: giveaways, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// WithAnalytics adds clicks to the exported conversion funnel.
+func (s *Service) WithAnalytics(rec *analytics.Recorder) *Service {
+	s.analytics = rec
+	return s
+}
+
+// Code derives a stable code for a target so repeated wraps reuse the same link.
+func Code(giveawayID string, kind dg.ShortLinkKind, position int, url string) string {
+	sum := sha256.Sum256([]byte(giveawayID + "\n" + string(kind) + "\n" + strconv.Itoa(position) + "\n" + url))
+	code := new(big.Int).SetBytes(sum[:]).Text(62)
+	return code[:codeLength]
+}
+
+// Wrap returns short URLs for targets in the same order, creating missing links.
+// userID is embedded for click attribution. When links cannot be stored the
+// original URLs are returned so callers never end up with dead links.
+func (s *Service) Wrap(ctx context.Context, giveawayID string, targets []Target, userID int64) []string {
+	out := make([]string, len(targets))
+	links := make([]dg.ShortLink, 0, len(targets))
+	for i, t := range targets {
+		out[i] = t.URL
+		if t.URL == "" {
+			continue
+		}
+		links = append(links, dg.ShortLink{
+			Code:       Code(giveawayID, t.Kind, t.Position, t.URL),
+			GiveawayID: giveawayID,
+			Kind:       t.Kind,
+			Position:   t.Position,
+			URL:        t.URL,
+		})
+	}
+	if s == nil || len(links) == 0 {
+		return out
+	}
+	if err := s.repo.EnsureMany(ctx, links); err != nil {
+		log.Printf("short links for %s: %v", giveawayID, err)
+		return out
+	}
+	suffix := ""
+	if userID != 0 {
+		suffix = "?u=" + strconv.FormatInt(userID, 10)
+	}
+	j := 0
+	for i, t := range targets {
+		if t.URL == "" {
+			continue
+		}
+		out[i] = s.baseURL + "/l/" + links[j].Code + suffix
+		j++
+	}
+	return out
+}
+
+// Resolve returns the target URL for code and records the click. Recording is best-effort.
+func (s *Service) Resolve(ctx context.Context, code string, userID int64) (string, error) {
+	if code == "" {
+		return "", errors.New("missing code")
+	}
+	l, err := s.repo.Get(ctx, code)
+	if err != nil {
+		return "", err
+	}
+	if l == nil {
+		return "", errors.New("not found")
+	}
+	if err := s.repo.RecordClick(ctx, code, userID); err != nil {
+		log.Printf("short link click %s: %v", code, err)
+	}
+	s.analytics.Record(ctx, analytics.EventLinkClick, l.GiveawayID, userID)
+	return l.URL, nil
+}
+
+// Stats returns click counts per link of a giveaway owned by requesterID.
+func (s *Service) Stats(ctx context.Context, giveawayID string, requesterID int64) ([]dg.ShortLinkStats, error) {
+	if giveawayID == "" {
+		return nil, errors.New("missing id")
+	}
+	g, err := s.giveaways.GetByID(ctx, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.Stats(ctx, giveawayID)
+}
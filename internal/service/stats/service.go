@@ -0,0 +1,110 @@
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	redisp "github.com/open-builders/giveaway-backend/internal/platform/redis"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	platformKey  = "stats:platform"
+	breakdownKey = "stats:platform:breakdown"
+	week         = 7 * 24 * time.Hour
+)
+
+// Service serves platform statistics from Redis; a worker recomputes them periodically.
+type Service struct {
+	repo *repo.GiveawayRepository
+	rdb  *redisp.Client
+	ttl  time.Duration
+	// shards, when set, hold the snapshots instead of rdb; they are read on every landing page hit
+	shards *redisp.Shards
+}
+
+// NewService creates the stats service. ttl bounds how long a snapshot is served if the
+// worker stops; reads after that recompute from Postgres.
+func NewService(r *repo.GiveawayRepository, rdb *redisp.Client, ttl time.Duration) *Service {
+	if ttl <= 0 {
+		ttl = time.Hour
+	}
+	return &Service{repo: r, rdb: rdb, ttl: ttl}
+}
+
+// WithShards keeps the snapshots on the shard their key maps to.
+func (s *Service) WithShards(sh *redisp.Shards) *Service {
+	s.shards = sh
+	return s
+}
+
+// client returns the Redis instance holding key, nil without Redis.
+func (s *Service) client(key string) *redisp.Client {
+	if s.shards != nil {
+		return s.shards.For(key)
+	}
+	return s.rdb
+}
+
+// Refresh recomputes both snapshots and stores them.
+func (s *Service) Refresh(ctx context.Context) error {
+	b, err := s.repo.PlatformBreakdown(ctx, time.Now().UTC().Add(-week))
+	if err != nil {
+		return err
+	}
+	s.store(ctx, platformKey, b.PlatformStats)
+	s.store(ctx, breakdownKey, b)
+	return nil
+}
+
+// Platform returns the public counters, computing them when no snapshot is cached.
+func (s *Service) Platform(ctx context.Context) (*dg.PlatformStats, error) {
+	var out dg.PlatformStats
+	if s.load(ctx, platformKey, &out) {
+		return &out, nil
+	}
+	out, err := s.repo.PlatformStats(ctx, time.Now().UTC().Add(-week))
+	if err != nil {
+		return nil, err
+	}
+	s.store(ctx, platformKey, out)
+	return &out, nil
+}
+
+// Breakdown returns the admin detail, computing it when no snapshot is cached.
+func (s *Service) Breakdown(ctx context.Context) (*dg.PlatformBreakdown, error) {
+	var out dg.PlatformBreakdown
+	if s.load(ctx, breakdownKey, &out) {
+		return &out, nil
+	}
+	b, err := s.repo.PlatformBreakdown(ctx, time.Now().UTC().Add(-week))
+	if err != nil {
+		return nil, err
+	}
+	s.store(ctx, breakdownKey, b)
+	return b, nil
+}
+
+func (s *Service) load(ctx context.Context, key string, v any) bool {
+	rdb := s.client(key)
+	if rdb == nil {
+		return false
+	}
+	b, err := rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
+
+func (s *Service) store(ctx context.Context, key string, v any) {
+	rdb := s.client(key)
+	if rdb == nil {
+		return
+	}
+	if b, err := json.Marshal(v); err == nil {
+		_ = rdb.Set(ctx, key, b, s.ttl).Err()
+	}
+}
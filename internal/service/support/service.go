@@ -0,0 +1,262 @@
+package support
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+)
+
+const (
+	maxSubjectLength = 120
+	maxBodyLength    = 2000
+)
+
+// Service runs support tickets between participants, giveaway creators and platform admins.
+type Service struct {
+	repo      *repo.SupportRepository
+	giveaways *repo.GiveawayRepository
+	ntf       *notify.Service
+}
+
+func NewService(r *repo.SupportRepository, giveaways *repo.GiveawayRepository, ntf *notify.Service) *Service {
+	return &Service{repo: r, giveaways: giveaways, ntf: ntf}
+}
+
+// Viewer is the user acting on a ticket.
+type Viewer struct {
+	UserID int64
+	Admin  bool
+}
+
+// OpenInput is a new ticket.
+type OpenInput struct {
+	Category dg.SupportCategory
+	Subject  string
+	Message  string
+}
+
+func validateText(subject, body string, withSubject bool) error {
+	if withSubject {
+		if subject == "" {
+			return errors.New("subject is required")
+		}
+		if utf8.RuneCountInString(subject) > maxSubjectLength {
+			return fmt.Errorf("subject exceeds %d characters", maxSubjectLength)
+		}
+	}
+	if body == "" {
+		return errors.New("message is required")
+	}
+	if utf8.RuneCountInString(body) > maxBodyLength {
+		return fmt.Errorf("message exceeds %d characters", maxBodyLength)
+	}
+	return nil
+}
+
+// Open starts a ticket about a giveaway the user takes part in and notifies the creator.
+func (s *Service) Open(ctx context.Context, giveawayID string, userID int64, in OpenInput) (*dg.SupportTicket, error) {
+	in.Subject = strings.TrimSpace(in.Subject)
+	in.Message = strings.TrimSpace(in.Message)
+	switch in.Category {
+	case dg.SupportCategoryPrizeNotReceived, dg.SupportCategoryWrongPrize, dg.SupportCategoryOther:
+	default:
+		return nil, errors.New("invalid category")
+	}
+	if err := validateText(in.Subject, in.Message, true); err != nil {
+		return nil, err
+	}
+	g, err := s.giveaways.GetByID(ctx, giveawayID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID == userID {
+		return nil, errors.New("creator cannot open tickets")
+	}
+	ok, err := s.giveaways.IsParticipant(ctx, giveawayID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("not participant")
+	}
+	t := &dg.SupportTicket{
+		GiveawayID:    giveawayID,
+		GiveawayTitle: g.Title,
+		CreatorID:     g.CreatorID,
+		UserID:        userID,
+		Category:      in.Category,
+		Subject:       in.Subject,
+	}
+	if err := s.repo.Open(ctx, t, in.Message); err != nil {
+		return nil, err
+	}
+	s.notify(ctx, t, g.CreatorID, notify.SupportOpened, in.Message)
+	return s.repo.GetByID(ctx, t.ID)
+}
+
+// role is the side v is on for ticket t, or "" when v may not see it. Creators answering their
+// own giveaway's tickets act as the creator even if they are admins.
+func role(t *dg.SupportTicket, v Viewer) dg.SupportRole {
+	switch {
+	case t.UserID == v.UserID:
+		return dg.SupportRoleParticipant
+	case t.CreatorID == v.UserID:
+		return dg.SupportRoleCreator
+	case v.Admin:
+		return dg.SupportRoleAdmin
+	}
+	return ""
+}
+
+func (s *Service) load(ctx context.Context, id int64, v Viewer) (*dg.SupportTicket, dg.SupportRole, error) {
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if t == nil {
+		return nil, "", errors.New("not found")
+	}
+	r := role(t, v)
+	if r == "" {
+		return nil, "", errors.New("forbidden")
+	}
+	return t, r, nil
+}
+
+// Get returns a ticket with its messages to the participant, the creator or an admin.
+func (s *Service) Get(ctx context.Context, id int64, v Viewer) (*dg.SupportTicket, error) {
+	t, _, err := s.load(ctx, id, v)
+	return t, err
+}
+
+// Reply adds a message and notifies the other side. A participant's message makes the ticket
+// wait for the creator again, or for admins once escalated; a creator's or admin's message
+// makes it wait for the participant. Creators cannot reply to escalated tickets, and replying
+// to a resolved ticket reopens it.
+func (s *Service) Reply(ctx context.Context, id int64, v Viewer, body string) (*dg.SupportMessage, error) {
+	body = strings.TrimSpace(body)
+	if err := validateText("", body, false); err != nil {
+		return nil, err
+	}
+	t, r, err := s.load(ctx, id, v)
+	if err != nil {
+		return nil, err
+	}
+	status := dg.SupportStatusAnswered
+	switch {
+	case r == dg.SupportRoleParticipant && t.EscalatedAt != nil:
+		status = dg.SupportStatusEscalated
+	case r == dg.SupportRoleParticipant:
+		status = dg.SupportStatusOpen
+	case r == dg.SupportRoleCreator && t.Status == dg.SupportStatusEscalated:
+		return nil, errors.New("ticket escalated")
+	}
+	m, err := s.repo.AddMessage(ctx, id, v.UserID, r, body, status)
+	if err != nil {
+		return nil, err
+	}
+	if r == dg.SupportRoleParticipant {
+		// Escalated tickets are watched in the admin queue; the creator still hears about them
+		s.notify(ctx, t, t.CreatorID, notify.SupportReply, body)
+	} else {
+		s.notify(ctx, t, t.UserID, notify.SupportReply, body)
+	}
+	return m, nil
+}
+
+// Escalate hands a ticket over to platform admins. Access: platform admins.
+func (s *Service) Escalate(ctx context.Context, id int64, v Viewer) error {
+	if !v.Admin {
+		return errors.New("forbidden")
+	}
+	t, _, err := s.load(ctx, id, v)
+	if err != nil {
+		return err
+	}
+	ok, err := s.repo.Escalate(ctx, id, v.UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not escalatable")
+	}
+	s.notify(ctx, t, t.UserID, notify.SupportEscalated, "")
+	s.notify(ctx, t, t.CreatorID, notify.SupportEscalated, "")
+	return nil
+}
+
+// Resolve closes a ticket. The participant and admins can always resolve; the creator only
+// while the ticket is not escalated.
+func (s *Service) Resolve(ctx context.Context, id int64, v Viewer) error {
+	t, r, err := s.load(ctx, id, v)
+	if err != nil {
+		return err
+	}
+	if r == dg.SupportRoleCreator && t.Status == dg.SupportStatusEscalated {
+		return errors.New("ticket escalated")
+	}
+	ok, err := s.repo.Resolve(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("already resolved")
+	}
+	if r != dg.SupportRoleParticipant {
+		s.notify(ctx, t, t.UserID, notify.SupportResolved, "")
+	}
+	if r != dg.SupportRoleCreator {
+		s.notify(ctx, t, t.CreatorID, notify.SupportResolved, "")
+	}
+	return nil
+}
+
+// Mine lists the tickets the user opened.
+func (s *Service) Mine(ctx context.Context, userID int64, limit, offset int) ([]dg.SupportTicket, error) {
+	return s.repo.ListByUser(ctx, userID, limit, offset)
+}
+
+// ForGiveaway lists the tickets of a giveaway for its creator or an admin.
+func (s *Service) ForGiveaway(ctx context.Context, id string, v Viewer, status dg.SupportStatus, limit, offset int) ([]dg.SupportTicket, error) {
+	g, err := s.giveaways.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, errors.New("not found")
+	}
+	if g.CreatorID != v.UserID && !v.Admin {
+		return nil, errors.New("forbidden")
+	}
+	return s.repo.ListByGiveaway(ctx, id, status, limit, offset)
+}
+
+// Queue lists unresolved tickets of all giveaways for platform admins, e.g. the escalated ones.
+func (s *Service) Queue(ctx context.Context, status dg.SupportStatus, limit, offset int) ([]dg.SupportTicket, error) {
+	return s.repo.ListUnresolved(ctx, status, limit, offset)
+}
+
+// Counters counts unresolved tickets per giveaway of a creator for the dashboard.
+func (s *Service) Counters(ctx context.Context, creatorID int64) ([]dg.SupportCounter, error) {
+	return s.repo.CountersByCreator(ctx, creatorID)
+}
+
+// notify sends a support DM; failures are logged because the ticket change already happened.
+func (s *Service) notify(ctx context.Context, t *dg.SupportTicket, userID int64, event, text string) {
+	if s.ntf == nil || userID == 0 {
+		return
+	}
+	if err := s.ntf.SendSupportDM(ctx, t, userID, event, text); err != nil {
+		log.Printf("support ticket %d: notify %d: %v", t.ID, userID, err)
+	}
+}
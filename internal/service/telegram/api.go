@@ -0,0 +1,33 @@
+package telegram
+
+import (
+	"context"
+
+	rplatform "github.com/open-builders/giveaway-backend/internal/platform/redis"
+)
+
+// API is the subset of the Bot API used by services. *Client implements it; services
+// depend on the interface so they can run against a client backed by recorded fixtures.
+type API interface {
+	GetBotMe(ctx context.Context, rdb *rplatform.Client) (*BotMe, error)
+	GetPublicChannelInfo(ctx context.Context, username string) (*PublicChannelInfo, error)
+	GetPublicChannelInfoByID(ctx context.Context, id int64) (*PublicChannelInfo, error)
+	CheckMembership(ctx context.Context, userID int64, chatID string) (bool, error)
+	CheckBoost(ctx context.Context, userID int64, chatID string) (bool, error)
+	GetBotMemberStatus(ctx context.Context, chat string) (string, bool, error)
+	GetChatAdministrators(ctx context.Context, chat string) ([]int64, error)
+	SendMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string, disablePreview bool) error
+	PostMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string) (int64, error)
+	SendAnimation(ctx context.Context, chatID int64, animation string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error)
+	SendPhoto(ctx context.Context, chatID int64, photo string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error)
+	EditMessageCaption(ctx context.Context, chatID int64, messageID int64, caption string, parseMode string, buttonText string, buttonURL string) error
+	EditMessageText(ctx context.Context, chatID int64, messageID int64, text string, parseMode string, buttonText string, buttonURL string) error
+	DeleteMessage(ctx context.Context, chatID int64, messageID int64) error
+	SavePreparedInlineMessageArticle(ctx context.Context, userID int64, title string, messageHTML string, buttonText string, buttonURL string) (string, error)
+	MediaURL(key string) string
+}
+
+var _ API = (*Client)(nil)
+
+// MediaURL returns the configured animation URL for key, or empty when unknown.
+func (c *Client) MediaURL(key string) string { return c.Media[key] }
@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/open-builders/giveaway-backend/internal/utils/circuit"
+)
+
+// ChatInfoBreaker is an API whose chat-info lookups go through a per-chat circuit breaker:
+// after repeated failures (deleted chat, bot removed) the last good info is returned with
+// Stale set instead of calling Telegram again. Other API calls pass through unchanged.
+type ChatInfoBreaker struct {
+	API
+	breaker *circuit.Breaker[string, PublicChannelInfo]
+}
+
+var errEmptyChatInfo = errors.New("empty chat info")
+
+func NewChatInfoBreaker(api API) *ChatInfoBreaker {
+	return &ChatInfoBreaker{API: api, breaker: circuit.New[string, PublicChannelInfo]()}
+}
+
+func (b *ChatInfoBreaker) GetPublicChannelInfo(ctx context.Context, username string) (*PublicChannelInfo, error) {
+	key := "@" + strings.ToLower(strings.TrimPrefix(username, "@"))
+	return b.do(key, func() (*PublicChannelInfo, error) { return b.API.GetPublicChannelInfo(ctx, username) })
+}
+
+func (b *ChatInfoBreaker) GetPublicChannelInfoByID(ctx context.Context, id int64) (*PublicChannelInfo, error) {
+	return b.do(strconv.FormatInt(id, 10), func() (*PublicChannelInfo, error) { return b.API.GetPublicChannelInfoByID(ctx, id) })
+}
+
+func (b *ChatInfoBreaker) do(key string, fetch func() (*PublicChannelInfo, error)) (*PublicChannelInfo, error) {
+	info, stale, err := b.breaker.Do(key, func() (PublicChannelInfo, error) {
+		info, err := fetch()
+		if err != nil {
+			return PublicChannelInfo{}, err
+		}
+		if info == nil {
+			return PublicChannelInfo{}, errEmptyChatInfo
+		}
+		return *info, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	info.Stale = stale
+	return &info, nil
+}
@@ -36,13 +36,26 @@ func NewClientFromEnv() *Client {
 	}
 	cdnURL = strings.TrimRight(cdnURL, "/")
 
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	// Offline modes: replay recorded Bot API responses, or record live ones for later replay
+	if dir := os.Getenv("TELEGRAM_REPLAY_DIR"); dir != "" {
+		fixtures, err := LoadFixtures(dir)
+		if err != nil {
+			log.Fatalf("telegram replay fixtures: %v", err)
+		}
+		httpClient.Transport = NewReplayTransport(fixtures)
+	} else if dir := os.Getenv("TELEGRAM_RECORD_DIR"); dir != "" {
+		httpClient.Transport = NewRecordingTransport(http.DefaultTransport, dir)
+	}
+
 	return &Client{
-		httpClient: &http.Client{Timeout: 10 * time.Second},
+		httpClient: httpClient,
 		token:      os.Getenv("TELEGRAM_BOT_TOKEN"),
 		logger:     log.New(os.Stdout, "[TelegramClient] ", log.LstdFlags),
 		Media: map[string]string{
 			"giveaway_started":  fmt.Sprintf("%s/Giveaway.mp4", cdnURL),
 			"giveaway_finished": fmt.Sprintf("%s/Giveaway.mp4", cdnURL),
+			"giveaway_results":  fmt.Sprintf("%s/GiveawayResults.png", cdnURL),
 		},
 	}
 }
@@ -81,21 +94,51 @@ type BotMe struct {
 	IsBot    bool   `json:"is_bot"`
 }
 
-// SetBotMe fetches current bot info via getMe and stores it in Redis.
-// Also sets a convenience key for username.
-func (c *Client) SetBotMe(ctx context.Context, rdb *rplatform.Client) error {
+// GetMe calls getMe, which fails when the bot token is invalid.
+func (c *Client) GetMe(ctx context.Context) (*BotMe, error) {
 	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/getMe", c.token)
 	var resp tgResponse[user]
 	if err := c.makeRequest(ctx, http.MethodGet, endpoint, nil, &resp); err != nil {
-		return err
+		return nil, err
 	}
 	if !resp.Ok || resp.Result.ID == 0 {
 		if resp.Description != "" {
-			return fmt.Errorf("getMe failed: %s", resp.Description)
+			return nil, fmt.Errorf("getMe failed: %s", resp.Description)
 		}
-		return fmt.Errorf("getMe failed")
+		return nil, fmt.Errorf("getMe failed")
+	}
+	return &BotMe{ID: resp.Result.ID, Username: resp.Result.Username, IsBot: resp.Result.IsBot}, nil
+}
+
+// WebhookInfo is the bot's webhook registration as reported by getWebhookInfo.
+type WebhookInfo struct {
+	URL                  string `json:"url"`
+	PendingUpdateCount   int    `json:"pending_update_count"`
+	LastErrorDate        int64  `json:"last_error_date,omitempty"`
+	LastErrorMessage     string `json:"last_error_message,omitempty"`
+	HasCustomCertificate bool   `json:"has_custom_certificate"`
+}
+
+// GetWebhookInfo returns the bot's webhook registration; URL is empty when none is set.
+func (c *Client) GetWebhookInfo(ctx context.Context) (*WebhookInfo, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/getWebhookInfo", c.token)
+	var resp tgResponse[WebhookInfo]
+	if err := c.makeRequest(ctx, http.MethodGet, endpoint, nil, &resp); err != nil {
+		return nil, err
+	}
+	if !resp.Ok {
+		return nil, fmt.Errorf("getWebhookInfo failed: %s", resp.Description)
+	}
+	return &resp.Result, nil
+}
+
+// SetBotMe fetches current bot info via getMe and stores it in Redis.
+// Also sets a convenience key for username.
+func (c *Client) SetBotMe(ctx context.Context, rdb *rplatform.Client) error {
+	me, err := c.GetMe(ctx)
+	if err != nil {
+		return err
 	}
-	me := BotMe{ID: resp.Result.ID, Username: resp.Result.Username, IsBot: resp.Result.IsBot}
 	payload, err := json.Marshal(me)
 	if err != nil {
 		return err
@@ -142,6 +185,8 @@ type PublicChannelInfo struct {
 	ChannelURL string `json:"channel_url"`
 	AvatarURL  string `json:"avatar_url"`
 	Title      string `json:"title"`
+	// Stale marks info served from memory while the chat keeps failing
+	Stale bool `json:"stale,omitempty"`
 }
 
 // GetPublicChannelInfo fetches public info by @username using Telegram API.
@@ -298,6 +343,17 @@ func (c *Client) GetFilePath(ctx context.Context, fileID string) (string, error)
 // If buttonText and buttonURL are non-empty, an inline keyboard with a single button is attached.
 // parseMode can be "HTML" or "MarkdownV2"; empty means no parse mode.
 func (c *Client) SendMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string, disablePreview bool) error {
+	_, err := c.sendMessage(ctx, chatID, text, parseMode, buttonText, buttonURL, disablePreview)
+	return err
+}
+
+// PostMessage sends a text post without link preview and returns its message id, so channel
+// posts can be edited or deleted later.
+func (c *Client) PostMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string) (int64, error) {
+	return c.sendMessage(ctx, chatID, text, parseMode, buttonText, buttonURL, true)
+}
+
+func (c *Client) sendMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string, disablePreview bool) (int64, error) {
 	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", c.token)
 	data := url.Values{
 		"chat_id": {fmt.Sprintf("%d", chatID)},
@@ -315,19 +371,20 @@ func (c *Client) SendMessage(ctx context.Context, chatID int64, text string, par
 			escapeJSON(buttonText), escapeJSON(buttonURL))
 		data.Set("reply_markup", markup)
 	}
-	var resp tgResponse[map[string]any]
+	var resp tgResponse[sentMessage]
 	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
-		return err
+		return 0, err
 	}
 	if !resp.Ok {
-		return fmt.Errorf("telegram sendMessage error: %s", resp.Description)
+		return 0, fmt.Errorf("telegram sendMessage error: %s", resp.Description)
 	}
-	return nil
+	return resp.Result.MessageID, nil
 }
 
-// SendAnimation sends an animation (GIF) to a chat/channel with optional caption and inline button.
-// animation can be a file_id or an HTTP URL. parseMode can be "HTML" or "MarkdownV2".
-func (c *Client) SendAnimation(ctx context.Context, chatID int64, animation string, caption string, parseMode string, buttonText string, buttonURL string) error {
+// SendAnimation sends an animation (GIF) to a chat/channel with optional caption and inline button
+// and returns the message id. animation can be a file_id or an HTTP URL. parseMode can be "HTML"
+// or "MarkdownV2".
+func (c *Client) SendAnimation(ctx context.Context, chatID int64, animation string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error) {
 	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendAnimation", c.token)
 	data := url.Values{
 		"chat_id":   {fmt.Sprintf("%d", chatID)},
@@ -344,20 +401,141 @@ func (c *Client) SendAnimation(ctx context.Context, chatID int64, animation stri
 			escapeJSON(buttonText), escapeJSON(buttonURL))
 		data.Set("reply_markup", markup)
 	}
-	var resp tgResponse[map[string]any]
+	var resp tgResponse[sentMessage]
+	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
+		return 0, err
+	}
+	if !resp.Ok {
+		return 0, fmt.Errorf("telegram sendAnimation error: %s", resp.Description)
+	}
+	return resp.Result.MessageID, nil
+}
+
+// sentMessage is the subset of a sent Message needed to edit or delete it later.
+type sentMessage struct {
+	MessageID int64 `json:"message_id"`
+}
+
+// SendPhoto sends a photo with optional caption and inline button and returns the message id.
+// photo can be a file_id or an HTTP URL.
+func (c *Client) SendPhoto(ctx context.Context, chatID int64, photo string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendPhoto", c.token)
+	data := url.Values{
+		"chat_id": {fmt.Sprintf("%d", chatID)},
+		"photo":   {photo},
+	}
+	if caption != "" {
+		data.Set("caption", caption)
+	}
+	if parseMode != "" {
+		data.Set("parse_mode", parseMode)
+	}
+	if buttonText != "" && buttonURL != "" {
+		markup := fmt.Sprintf(`{"inline_keyboard":[[{"text":"%s","url":"%s"}]]}`,
+			escapeJSON(buttonText), escapeJSON(buttonURL))
+		data.Set("reply_markup", markup)
+	}
+	var resp tgResponse[sentMessage]
+	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
+		return 0, err
+	}
+	if !resp.Ok {
+		return 0, fmt.Errorf("telegram sendPhoto error: %s", resp.Description)
+	}
+	return resp.Result.MessageID, nil
+}
+
+// EditMessageCaption replaces the caption and button of a media message sent by the bot.
+func (c *Client) EditMessageCaption(ctx context.Context, chatID int64, messageID int64, caption string, parseMode string, buttonText string, buttonURL string) error {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/editMessageCaption", c.token)
+	data := url.Values{
+		"chat_id":    {fmt.Sprintf("%d", chatID)},
+		"message_id": {strconv.FormatInt(messageID, 10)},
+		"caption":    {caption},
+	}
+	if parseMode != "" {
+		data.Set("parse_mode", parseMode)
+	}
+	if buttonText != "" && buttonURL != "" {
+		markup := fmt.Sprintf(`{"inline_keyboard":[[{"text":"%s","url":"%s"}]]}`,
+			escapeJSON(buttonText), escapeJSON(buttonURL))
+		data.Set("reply_markup", markup)
+	}
+	var resp tgResponse[json.RawMessage]
 	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
 		return err
 	}
 	if !resp.Ok {
-		return fmt.Errorf("telegram sendAnimation error: %s", resp.Description)
+		return fmt.Errorf("telegram editMessageCaption error: %s", resp.Description)
+	}
+	return nil
+}
+
+// EditMessageText replaces the text and button of a text message sent by the bot.
+func (c *Client) EditMessageText(ctx context.Context, chatID int64, messageID int64, text string, parseMode string, buttonText string, buttonURL string) error {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/editMessageText", c.token)
+	data := url.Values{
+		"chat_id":                  {fmt.Sprintf("%d", chatID)},
+		"message_id":               {strconv.FormatInt(messageID, 10)},
+		"text":                     {text},
+		"disable_web_page_preview": {"true"},
+	}
+	if parseMode != "" {
+		data.Set("parse_mode", parseMode)
+	}
+	if buttonText != "" && buttonURL != "" {
+		markup := fmt.Sprintf(`{"inline_keyboard":[[{"text":"%s","url":"%s"}]]}`,
+			escapeJSON(buttonText), escapeJSON(buttonURL))
+		data.Set("reply_markup", markup)
+	}
+	var resp tgResponse[json.RawMessage]
+	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return fmt.Errorf("telegram editMessageText error: %s", resp.Description)
+	}
+	return nil
+}
+
+// DeleteMessage deletes a message sent by the bot.
+func (c *Client) DeleteMessage(ctx context.Context, chatID int64, messageID int64) error {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/deleteMessage", c.token)
+	data := url.Values{
+		"chat_id":    {fmt.Sprintf("%d", chatID)},
+		"message_id": {strconv.FormatInt(messageID, 10)},
+	}
+	var resp tgResponse[bool]
+	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return fmt.Errorf("telegram deleteMessage error: %s", resp.Description)
+	}
+	return nil
+}
+
+// RefundStarPayment returns a Telegram Stars payment to the user who made it.
+func (c *Client) RefundStarPayment(ctx context.Context, userID int64, chargeID string) error {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/refundStarPayment", c.token)
+	data := url.Values{
+		"user_id":                    {strconv.FormatInt(userID, 10)},
+		"telegram_payment_charge_id": {chargeID},
+	}
+	var resp tgResponse[bool]
+	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return fmt.Errorf("telegram refundStarPayment error: %s", resp.Description)
 	}
 	return nil
 }
 
 // escapeJSON performs a minimal escape for quotes and backslashes used in inline JSON strings.
 func escapeJSON(s string) string {
-	s = strings.ReplaceAll(s, `\\`, `\\\\`)
-	s = strings.ReplaceAll(s, `"`, `\\\"`)
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
 	return s
 }
 
@@ -594,6 +772,52 @@ func (c *Client) GetBotMemberStatus(ctx context.Context, chat string) (string, b
 	return status, can, nil
 }
 
+// GetChatMemberCount returns the number of members of a chat.
+func (c *Client) GetChatMemberCount(ctx context.Context, chat string) (int, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/getChatMemberCount", c.token)
+	var result tgResponse[int]
+	if err := c.makeRequest(ctx, http.MethodGet, endpoint, url.Values{"chat_id": {chat}}, &result); err != nil {
+		return 0, fmt.Errorf("getChatMemberCount: %w", err)
+	}
+	if !result.Ok {
+		return 0, fmt.Errorf("telegram API error: %s", result.Description)
+	}
+	return result.Result, nil
+}
+
+// GetChatAdministrators returns the user ids of the human administrators of a chat, given as a
+// numeric id or @username. Bots are left out.
+func (c *Client) GetChatAdministrators(ctx context.Context, chat string) ([]int64, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/getChatAdministrators", c.token)
+	data := url.Values{"chat_id": {chat}}
+	var response struct {
+		Ok     bool   `json:"ok"`
+		Error  string `json:"error"`
+		Result []struct {
+			User struct {
+				ID    int64 `json:"id"`
+				IsBot bool  `json:"is_bot"`
+			} `json:"user"`
+		} `json:"result"`
+	}
+	if err := c.makeRequest(ctx, http.MethodGet, endpoint, data, &response); err != nil {
+		return nil, fmt.Errorf("failed to get chat administrators: %w", err)
+	}
+	if !response.Ok {
+		if strings.Contains(response.Error, "Too Many Requests") {
+			return nil, fmt.Errorf("rate limit exceeded")
+		}
+		return nil, fmt.Errorf("telegram API error: %s", response.Error)
+	}
+	out := make([]int64, 0, len(response.Result))
+	for _, m := range response.Result {
+		if !m.User.IsBot {
+			out = append(out, m.User.ID)
+		}
+	}
+	return out, nil
+}
+
 // SavePreparedInlineMessageArticle calls savePreparedInlineMessage with an InlineQueryResultArticle payload
 // and returns the prepared inline message ID. See Telegram docs:
 // https://core.telegram.org/bots/api#savepreparedinlinemessage
@@ -703,8 +927,8 @@ func (c *Client) SavePreparedInlineMessageArticle(ctx context.Context, userID in
 
 // SavePreparedInlineMessageGif creates a prepared inline message using an animated GIF with a caption.
 // This mimics SendAnimation used elsewhere, but via savePreparedInlineMessage with InlineQueryResultGif.
-func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64, gifURL string, thumbnailURL string, captionHTML string, buttonText string, buttonURL string) (string, error) {
-	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/savePreparedInlineMessage", c.token)
+// Returns the prepared message ID and its expiration_date; the expiry is zero when Telegram omits it.
+func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64, gifURL string, thumbnailURL string, captionHTML string, buttonText string, buttonURL string) (string, time.Time, error) {
 	// Inline keyboard
 	var replyMarkup any
 	if buttonText != "" && buttonURL != "" {
@@ -747,9 +971,55 @@ func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64,
 	if replyMarkup != nil {
 		result["reply_markup"] = replyMarkup
 	}
+	return c.savePreparedResult(ctx, userID, result, "gif", gifURL)
+}
+
+// preparedMediaURLField names the media URL field of the InlineQueryResult types used by
+// SavePreparedInlineMessageMedia.
+var preparedMediaURLField = map[string]string{
+	"photo":     "photo_url",
+	"gif":       "gif_url",
+	"mpeg4_gif": "mpeg4_url",
+}
+
+// SavePreparedInlineMessageMedia creates a prepared inline message showing the file at mediaURL
+// with a caption. kind is the InlineQueryResult type: "photo", "gif" or "mpeg4_gif". mediaURL
+// doubles as the thumbnail. Returns the prepared message ID and its expiration_date like
+// SavePreparedInlineMessageGif.
+func (c *Client) SavePreparedInlineMessageMedia(ctx context.Context, userID int64, kind string, mediaURL string, captionHTML string, buttonText string, buttonURL string) (string, time.Time, error) {
+	field, ok := preparedMediaURLField[kind]
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("unsupported inline media type %q", kind)
+	}
+	result := map[string]any{
+		"type":          kind,
+		"id":            fmt.Sprintf("m-%d-%d", userID, time.Now().UnixNano()),
+		field:           mediaURL,
+		"thumbnail_url": mediaURL,
+	}
+	if captionHTML != "" {
+		result["caption"] = captionHTML
+		result["parse_mode"] = "HTML"
+	}
+	if buttonText != "" && buttonURL != "" {
+		result["reply_markup"] = map[string]any{
+			"inline_keyboard": [][]map[string]string{
+				{
+					{"text": buttonText, "url": buttonURL},
+				},
+			},
+		}
+	}
+	return c.savePreparedResult(ctx, userID, result, kind, mediaURL)
+}
+
+// savePreparedResult saves an InlineQueryResult payload as a prepared inline message for
+// userID. label and mediaURL only appear in logs.
+func (c *Client) savePreparedResult(ctx context.Context, userID int64, result map[string]any, label string, mediaURL string) (string, time.Time, error) {
+	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/savePreparedInlineMessage", c.token)
 	body, err := json.Marshal(result)
 	if err != nil {
-		return "", err
+		return "", time.Time{}, err
 	}
 	data := url.Values{
 		"user_id": {fmt.Sprintf("%d", userID)},
@@ -760,7 +1030,7 @@ func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64,
 	data.Set("allow_group_chats", "true")
 	data.Set("allow_channel_chats", "true")
 	if c.logger != nil {
-		c.logger.Printf("Telegram: savePreparedInlineMessage (gif) request user_id=%d gif_url=%q", userID, gifURL)
+		c.logger.Printf("Telegram: savePreparedInlineMessage (%s) request user_id=%d media_url=%q", label, userID, mediaURL)
 	}
 	var resp struct {
 		Ok          bool           `json:"ok"`
@@ -769,9 +1039,9 @@ func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64,
 	}
 	if err := c.makeRequest(ctx, http.MethodPost, endpoint, data, &resp); err != nil {
 		if c.logger != nil {
-			c.logger.Printf("Telegram: savePreparedInlineMessage (gif) error: %v", err)
+			c.logger.Printf("Telegram: savePreparedInlineMessage (%s) error: %v", label, err)
 		}
-		return "", err
+		return "", time.Time{}, err
 	}
 	if !resp.Ok {
 		if resp.Description == "" {
@@ -779,31 +1049,35 @@ func (c *Client) SavePreparedInlineMessageGif(ctx context.Context, userID int64,
 		}
 		if c.logger != nil {
 			raw, _ := json.Marshal(resp.Result)
-			c.logger.Printf("Telegram: savePreparedInlineMessage (gif) failed: %s; result=%s", resp.Description, string(raw))
+			c.logger.Printf("Telegram: savePreparedInlineMessage (%s) failed: %s; result=%s", label, resp.Description, string(raw))
 		}
-		return "", fmt.Errorf(resp.Description)
+		return "", time.Time{}, fmt.Errorf(resp.Description)
 	}
 	if resp.Result == nil {
-		return "", fmt.Errorf("empty result")
+		return "", time.Time{}, fmt.Errorf("empty result")
+	}
+	var expiresAt time.Time
+	if v, ok := resp.Result["expiration_date"].(float64); ok && v > 0 {
+		expiresAt = time.Unix(int64(v), 0).UTC()
 	}
 	// Extract ID
 	for _, key := range []string{"id", "inline_message_id", "prepared_inline_message_id"} {
 		if v, ok := resp.Result[key]; ok {
 			if s, ok := v.(string); ok && s != "" {
 				if c.logger != nil {
-					c.logger.Printf("Telegram: savePreparedInlineMessage (gif) success, %s=%s", key, s)
+					c.logger.Printf("Telegram: savePreparedInlineMessage (%s) success, %s=%s", label, key, s)
 				}
-				return s, nil
+				return s, expiresAt, nil
 			}
 		}
 	}
 	// Fallback
 	for _, v := range resp.Result {
 		if s, ok := v.(string); ok && s != "" {
-			return s, nil
+			return s, expiresAt, nil
 		}
 	}
-	return "", fmt.Errorf("prepared inline message id not found")
+	return "", time.Time{}, fmt.Errorf("prepared inline message id not found")
 }
 
 // UploadAnimation uploads a local animation file to Telegram via multipart/form-data and returns the file_id.
@@ -0,0 +1,364 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// sentCall is one Bot API request as the client sent it.
+type sentCall struct {
+	method string
+	params map[string]string
+}
+
+// capturingTransport records Bot API requests before they are answered by the replay transport.
+type capturingTransport struct {
+	next  http.RoundTripper
+	mu    sync.Mutex
+	calls []sentCall
+}
+
+func (t *capturingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method, params, err := describeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if method != "" {
+		// describeRequest drops volatile params; keep them here so payloads can be asserted
+		if form, err := requestForm(req); err != nil {
+			return nil, err
+		} else if form.Has("result") {
+			params["result"] = form.Get("result")
+		}
+		t.mu.Lock()
+		t.calls = append(t.calls, sentCall{method: method, params: params})
+		t.mu.Unlock()
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *capturingTransport) methodCalls(method string) []sentCall {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []sentCall
+	for _, c := range t.calls {
+		if c.method == method {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// requestForm returns the form of a POST request and puts its body back for the next transport.
+func requestForm(req *http.Request) (url.Values, error) {
+	if req.Method != http.MethodPost || req.Body == nil {
+		return req.URL.Query(), nil
+	}
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(raw))
+	return url.ParseQuery(string(raw))
+}
+
+func newReplayClient(t *testing.T) (*Client, *capturingTransport) {
+	t.Helper()
+	fixtures, err := LoadFixtures("testdata/fixtures")
+	if err != nil {
+		t.Fatalf("load fixtures: %v", err)
+	}
+	tr := &capturingTransport{next: NewReplayTransport(fixtures)}
+	return &Client{httpClient: &http.Client{Transport: tr}, token: "TEST"}, tr
+}
+
+func assertParams(t *testing.T, got sentCall, want map[string]string) {
+	t.Helper()
+	for k, v := range want {
+		if got.params[k] != v {
+			t.Errorf("%s param %s = %q, want %q", got.method, k, got.params[k], v)
+		}
+	}
+}
+
+func TestReplayCheckMembership(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     int64
+		chat       string
+		want       bool
+		wantErr    string
+		wantGetCh  map[string]string
+		wantMember map[string]string
+	}{
+		{
+			name:       "member by id",
+			userID:     1000000001,
+			chat:       "-1001000000001",
+			want:       true,
+			wantMember: map[string]string{"chat_id": "-1001000000001", "user_id": "1000000001"},
+		},
+		{
+			name:       "left member",
+			userID:     1000000002,
+			chat:       "-1001000000001",
+			want:       false,
+			wantMember: map[string]string{"chat_id": "-1001000000001", "user_id": "1000000002"},
+		},
+		{
+			name:       "username resolved through getChat",
+			userID:     1000000001,
+			chat:       "@demo_channel",
+			want:       true,
+			wantGetCh:  map[string]string{"chat_id": "@demo_channel"},
+			wantMember: map[string]string{"chat_id": "-1001000000001", "user_id": "1000000001"},
+		},
+		{
+			name:      "unknown channel",
+			userID:    1000000001,
+			chat:      "@missing_channel",
+			wantErr:   "chat not found",
+			wantGetCh: map[string]string{"chat_id": "@missing_channel"},
+		},
+		{
+			name:    "invalid chat id",
+			userID:  1000000001,
+			chat:    "demo",
+			wantErr: "invalid chat ID format",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, tr := newReplayClient(t)
+			got, err := c.CheckMembership(context.Background(), tt.userID, tt.chat)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if got != tt.want {
+				t.Fatalf("member = %v, want %v", got, tt.want)
+			}
+			checkCalls(t, tr, "getChat", tt.wantGetCh)
+			checkCalls(t, tr, "getChatMember", tt.wantMember)
+		})
+	}
+}
+
+// checkCalls asserts that method was sent once with want, or never when want is nil.
+func checkCalls(t *testing.T, tr *capturingTransport, method string, want map[string]string) {
+	t.Helper()
+	calls := tr.methodCalls(method)
+	if want == nil {
+		if len(calls) != 0 {
+			t.Fatalf("%s sent %d times, want none", method, len(calls))
+		}
+		return
+	}
+	if len(calls) != 1 {
+		t.Fatalf("%s sent %d times, want 1", method, len(calls))
+	}
+	assertParams(t, calls[0], want)
+}
+
+func TestReplayCheckBoost(t *testing.T) {
+	tests := []struct {
+		name   string
+		userID int64
+		chat   string
+		want   bool
+	}{
+		{name: "no boosts", userID: 1000000001, chat: "-1001000000001", want: false},
+		{name: "active boost", userID: 1000000003, chat: "-1001000000001", want: true},
+		{name: "username", userID: 1000000003, chat: "@demo_channel", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, tr := newReplayClient(t)
+			got, err := c.CheckBoost(context.Background(), tt.userID, tt.chat)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("boosted = %v, want %v", got, tt.want)
+			}
+			checkCalls(t, tr, "getUserChatBoosts", map[string]string{
+				"chat_id": "-1001000000001",
+				"user_id": strconv.FormatInt(tt.userID, 10),
+			})
+		})
+	}
+}
+
+func TestReplayGetChat(t *testing.T) {
+	tests := []struct {
+		name      string
+		username  string
+		wantID    int64
+		wantTitle string
+		wantErr   string
+	}{
+		{name: "found", username: "demo_channel", wantID: -1001000000001, wantTitle: "Demo Channel"},
+		{name: "with at sign", username: "@demo_channel", wantID: -1001000000001, wantTitle: "Demo Channel"},
+		{name: "not found", username: "missing_channel", wantErr: "Bad Request: chat not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, tr := newReplayClient(t)
+			info, err := c.GetPublicChannelInfo(context.Background(), tt.username)
+			checkCalls(t, tr, "getChat", map[string]string{"chat_id": "@" + strings.TrimPrefix(tt.username, "@")})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if info.ID != tt.wantID || info.Title != tt.wantTitle || info.Type != "channel" {
+				t.Fatalf("info = %+v", info)
+			}
+			if info.ChannelURL != "https://t.me/demo_channel" {
+				t.Fatalf("channel url = %q", info.ChannelURL)
+			}
+		})
+	}
+}
+
+func TestReplaySendMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		parseMode  string
+		buttonText string
+		buttonURL  string
+		noPreview  bool
+		want       map[string]string
+		absent     []string
+	}{
+		{
+			name: "plain",
+			want: map[string]string{"chat_id": "-1001000000001", "text": "hello"},
+			absent: []string{
+				"parse_mode", "reply_markup", "disable_web_page_preview",
+			},
+		},
+		{
+			name:       "html with button and no preview",
+			parseMode:  "HTML",
+			buttonText: `Join "now"`,
+			buttonURL:  "https://t.me/giveaway_tool_bot?startapp=abc",
+			noPreview:  true,
+			want: map[string]string{
+				"chat_id":                  "-1001000000001",
+				"text":                     "hello",
+				"parse_mode":               "HTML",
+				"disable_web_page_preview": "true",
+				"reply_markup":             `{"inline_keyboard":[[{"text":"Join \"now\"","url":"https://t.me/giveaway_tool_bot?startapp=abc"}]]}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, tr := newReplayClient(t)
+			if err := c.SendMessage(context.Background(), -1001000000001, "hello", tt.parseMode, tt.buttonText, tt.buttonURL, tt.noPreview); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			checkCalls(t, tr, "sendMessage", tt.want)
+			sent := tr.methodCalls("sendMessage")[0]
+			for _, k := range tt.absent {
+				if _, ok := sent.params[k]; ok {
+					t.Errorf("sendMessage sent %s", k)
+				}
+			}
+			if markup, ok := sent.params["reply_markup"]; ok && !json.Valid([]byte(markup)) {
+				t.Errorf("reply_markup is not JSON: %s", markup)
+			}
+		})
+	}
+
+	c, _ := newReplayClient(t)
+	id, err := c.PostMessage(context.Background(), -1001000000001, "hello", "", "", "")
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	if id != 1 {
+		t.Fatalf("message id = %d, want 1", id)
+	}
+}
+
+func TestReplaySavePreparedInlineMessage(t *testing.T) {
+	const userID = 1000000001
+	wantExpiry := time.Unix(1735776000, 0).UTC()
+	tests := []struct {
+		name       string
+		save       func(c *Client) (string, time.Time, error)
+		wantExpiry time.Time
+		wantResult map[string]any
+	}{
+		{
+			name: "article",
+			save: func(c *Client) (string, time.Time, error) {
+				id, err := c.SavePreparedInlineMessageArticle(context.Background(), userID, "Title", "<b>Hi</b>", "Open", "https://t.me/x")
+				return id, time.Time{}, err
+			},
+			wantResult: map[string]any{"type": "article", "title": "Title"},
+		},
+		{
+			name: "gif by url",
+			save: func(c *Client) (string, time.Time, error) {
+				return c.SavePreparedInlineMessageGif(context.Background(), userID, "https://cdn.example/g.mp4", "", "cap", "Open", "https://t.me/x")
+			},
+			wantExpiry: wantExpiry,
+			wantResult: map[string]any{"type": "mpeg4_gif", "mpeg4_url": "https://cdn.example/g.mp4", "thumbnail_url": "https://cdn.example/g.mp4", "caption": "cap", "parse_mode": "HTML"},
+		},
+		{
+			name: "photo media",
+			save: func(c *Client) (string, time.Time, error) {
+				return c.SavePreparedInlineMessageMedia(context.Background(), userID, "photo", "https://cdn.example/p.png", "", "", "")
+			},
+			wantExpiry: wantExpiry,
+			wantResult: map[string]any{"type": "photo", "photo_url": "https://cdn.example/p.png", "thumbnail_url": "https://cdn.example/p.png"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, tr := newReplayClient(t)
+			id, expiresAt, err := tt.save(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if id != "prepared-demo-1" {
+				t.Fatalf("id = %q", id)
+			}
+			if !expiresAt.Equal(tt.wantExpiry) {
+				t.Fatalf("expires = %v, want %v", expiresAt, tt.wantExpiry)
+			}
+			checkCalls(t, tr, "savePreparedInlineMessage", map[string]string{
+				"user_id":             "1000000001",
+				"allow_user_chats":    "true",
+				"allow_group_chats":   "true",
+				"allow_channel_chats": "true",
+			})
+			var result map[string]any
+			if err := json.Unmarshal([]byte(tr.methodCalls("savePreparedInlineMessage")[0].params["result"]), &result); err != nil {
+				t.Fatalf("result param: %v", err)
+			}
+			for k, v := range tt.wantResult {
+				if result[k] != v {
+					t.Errorf("result %s = %v, want %v", k, result[k], v)
+				}
+			}
+		})
+	}
+}
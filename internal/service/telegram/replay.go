@@ -0,0 +1,210 @@
+package telegram
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Fixture is one recorded Bot API interaction. Params holds the request parameters
+// (query or form) without the bot token. A fixture with nil Params matches any call
+// of its method and serves as a catch-all default.
+type Fixture struct {
+	Method string            `json:"method"`
+	Params map[string]string `json:"params,omitempty"`
+	Status int               `json:"status"`
+	Body   json.RawMessage   `json:"body"`
+}
+
+// volatileParams change on every call and are ignored when matching fixtures.
+var volatileParams = map[string]bool{"result": true}
+
+// LoadFixtures reads every *.json file in dir.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Fixture, 0, len(paths))
+	for _, p := range paths {
+		raw, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		var f Fixture
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		if f.Status == 0 {
+			f.Status = http.StatusOK
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// ReplayTransport answers Bot API requests from fixtures without touching the network.
+// Requests without a matching fixture fail, so payload changes surface as errors.
+type ReplayTransport struct {
+	fixtures []Fixture
+}
+
+func NewReplayTransport(fixtures []Fixture) *ReplayTransport {
+	return &ReplayTransport{fixtures: fixtures}
+}
+
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method, params, err := describeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if method == "" {
+		return nil, fmt.Errorf("telegram replay: network disabled for %s", req.URL.Host)
+	}
+	var fallback *Fixture
+	for i := range t.fixtures {
+		f := &t.fixtures[i]
+		if f.Method != method {
+			continue
+		}
+		if f.Params == nil {
+			if fallback == nil {
+				fallback = f
+			}
+			continue
+		}
+		if paramsEqual(f.Params, params) {
+			return fixtureResponse(req, f), nil
+		}
+	}
+	if fallback != nil {
+		return fixtureResponse(req, fallback), nil
+	}
+	return nil, fmt.Errorf("telegram replay: no fixture for %s %v", method, params)
+}
+
+// RecordingTransport forwards requests to next and stores each interaction as a fixture in dir.
+type RecordingTransport struct {
+	next http.RoundTripper
+	dir  string
+	mu   sync.Mutex
+}
+
+func NewRecordingTransport(next http.RoundTripper, dir string) *RecordingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &RecordingTransport{next: next, dir: dir}
+}
+
+func (t *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method, params, err := describeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || method == "" {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if json.Valid(body) {
+		t.save(Fixture{Method: method, Params: params, Status: resp.StatusCode, Body: body})
+	}
+	return resp, nil
+}
+
+func (t *RecordingTransport) save(f Fixture) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	raw, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(t.dir, 0o755); err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(t.dir, f.Method+"-"+paramsKey(f.Params)+".json"), raw, 0o644)
+}
+
+// describeRequest extracts the Bot API method and parameters from a request.
+// Non Bot API requests (e.g. avatar HEAD checks) yield an empty method.
+func describeRequest(req *http.Request) (string, map[string]string, error) {
+	var method string
+	if parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/"); len(parts) == 2 && strings.HasPrefix(parts[0], "bot") {
+		method = parts[1]
+	}
+	values := req.URL.Query()
+	if req.Body != nil && req.Method == http.MethodPost {
+		raw, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(raw))
+		form, err := url.ParseQuery(string(raw))
+		if err != nil {
+			return "", nil, err
+		}
+		for k, v := range form {
+			values[k] = v
+		}
+	}
+	params := make(map[string]string, len(values))
+	for k := range values {
+		if volatileParams[k] {
+			continue
+		}
+		params[k] = values.Get(k)
+	}
+	return method, params, nil
+}
+
+func paramsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func paramsKey(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\n", k, params[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+func fixtureResponse(req *http.Request, f *Fixture) *http.Response {
+	return &http.Response{
+		StatusCode: f.Status,
+		Status:     fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)),
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(f.Body)),
+		Request:    req,
+	}
+}
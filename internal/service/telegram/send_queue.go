@@ -0,0 +1,299 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// Priority orders outbound bot messages; lower values are sent first.
+type Priority int
+
+const (
+	PriorityWinner       Priority = iota // winner DMs
+	PriorityAnnouncement                 // channel announcements and result posts
+	PriorityReminder                     // reminders and personal notices
+	PriorityDigest                       // bulk broadcasts and digests
+	numPriorities
+)
+
+var priorityNames = [numPriorities]string{"winner", "announcement", "reminder", "digest"}
+
+func (p Priority) String() string {
+	if p < 0 || p >= numPriorities {
+		return "unknown"
+	}
+	return priorityNames[p]
+}
+
+type priorityKey struct{}
+
+// WithPriority marks messages sent with ctx through a SendQueue. Unmarked messages are reminders.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+func priorityFrom(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok && p >= 0 && p < numPriorities {
+		return p
+	}
+	return PriorityReminder
+}
+
+// ErrSendQueueFull is returned for reminders and digests while their queue is full; winner DMs
+// and announcements wait for room instead.
+var ErrSendQueueFull = errors.New("telegram send queue full")
+
+// SendQueueOptions tunes a SendQueue; zero values use the defaults.
+type SendQueueOptions struct {
+	// Messages per second across all chats (default 25, below Telegram's 30)
+	RatePerSec int
+	// Queued messages per priority (default 1000)
+	Size int
+	// Gap between messages to one private chat (default 1s) and to one group or channel (default 3s)
+	PrivateInterval time.Duration
+	GroupInterval   time.Duration
+	// Messages in flight at once (default 4)
+	Concurrency int
+}
+
+type sendJob struct {
+	ctx    context.Context
+	chatID int64
+	send   func(ctx context.Context) (int64, error)
+	done   chan sendResult
+}
+
+// sendResult carries the sent message id, when the call returns one, or the error.
+type sendResult struct {
+	messageID int64
+	err       error
+}
+
+// SendQueue is an API that sends messages, captions and photos through per-priority queues with
+// a global rate and per-chat pacing, so winner DMs are not starved behind bulk broadcasts. Calls
+// block until their message is sent. Other API calls pass through unchanged.
+type SendQueue struct {
+	API
+	opts SendQueueOptions
+
+	mu      sync.Mutex
+	queues  [numPriorities][]*sendJob
+	room    *sync.Cond
+	nextAt  map[int64]time.Time
+	wake    chan struct{}
+	started bool
+}
+
+// NewSendQueue wraps api; call Start to begin sending.
+func NewSendQueue(api API, opts SendQueueOptions) *SendQueue {
+	if opts.RatePerSec <= 0 {
+		opts.RatePerSec = 25
+	}
+	if opts.Size <= 0 {
+		opts.Size = 1000
+	}
+	if opts.PrivateInterval <= 0 {
+		opts.PrivateInterval = time.Second
+	}
+	if opts.GroupInterval <= 0 {
+		opts.GroupInterval = 3 * time.Second
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	q := &SendQueue{API: api, opts: opts, nextAt: make(map[int64]time.Time), wake: make(chan struct{}, 1)}
+	q.room = sync.NewCond(&q.mu)
+	return q
+}
+
+// Depth returns the number of queued messages per priority name.
+func (q *SendQueue) Depth() map[string]int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make(map[string]int, numPriorities)
+	for p := range q.queues {
+		out[Priority(p).String()] = len(q.queues[p])
+	}
+	return out
+}
+
+// Start dispatches queued messages until ctx is cancelled. Messages still queued then fail.
+func (q *SendQueue) Start(ctx context.Context) {
+	q.mu.Lock()
+	q.started = true
+	q.mu.Unlock()
+	log.Println("Starting telegram send queue...")
+	every := time.Second / time.Duration(q.opts.RatePerSec)
+	slots := make(chan struct{}, q.opts.Concurrency)
+	for {
+		job, wait := q.next(time.Now())
+		if job == nil {
+			if wait <= 0 {
+				wait = time.Minute
+			}
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				q.drain(ctx.Err())
+				log.Println("Stopping telegram send queue...")
+				return
+			case <-q.wake:
+			case <-timer.C:
+			}
+			timer.Stop()
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			job.done <- sendResult{err: ctx.Err()}
+			q.drain(ctx.Err())
+			log.Println("Stopping telegram send queue...")
+			return
+		case slots <- struct{}{}:
+		}
+		go func(j *sendJob) {
+			defer func() { <-slots }()
+			id, err := j.send(j.ctx)
+			j.done <- sendResult{messageID: id, err: err}
+		}(job)
+		time.Sleep(every)
+	}
+}
+
+// next pops the highest-priority job whose chat is ready at now. When none is, it returns how
+// long until the earliest queued chat is ready.
+func (q *SendQueue) next(now time.Time) (*sendJob, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var wait time.Duration
+	for p := range q.queues {
+		jobs := q.queues[p]
+		for i := 0; i < len(jobs); i++ {
+			j := jobs[i]
+			// Callers that gave up are dropped
+			if j.ctx.Err() != nil {
+				jobs = append(jobs[:i], jobs[i+1:]...)
+				q.queues[p] = jobs
+				i--
+				q.room.Broadcast()
+				continue
+			}
+			at := q.nextAt[j.chatID]
+			if at.After(now) {
+				if d := at.Sub(now); wait <= 0 || d < wait {
+					wait = d
+				}
+				continue
+			}
+			q.queues[p] = append(jobs[:i], jobs[i+1:]...)
+			q.nextAt[j.chatID] = now.Add(q.interval(j.chatID))
+			q.room.Broadcast()
+			return j, 0
+		}
+	}
+	// Forget pacing of chats that are ready again
+	for chatID, at := range q.nextAt {
+		if !at.After(now) {
+			delete(q.nextAt, chatID)
+		}
+	}
+	return nil, wait
+}
+
+func (q *SendQueue) interval(chatID int64) time.Duration {
+	if chatID < 0 {
+		return q.opts.GroupInterval
+	}
+	return q.opts.PrivateInterval
+}
+
+func (q *SendQueue) drain(err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for p := range q.queues {
+		for _, j := range q.queues[p] {
+			j.done <- sendResult{err: err}
+		}
+		q.queues[p] = nil
+	}
+	q.started = false
+	q.room.Broadcast()
+}
+
+// enqueue queues send for chatID at the priority of ctx and waits for the result. Without a
+// running dispatcher the message is sent directly.
+func (q *SendQueue) enqueue(ctx context.Context, chatID int64, send func(ctx context.Context) (int64, error)) (int64, error) {
+	p := priorityFrom(ctx)
+	j := &sendJob{ctx: ctx, chatID: chatID, send: send, done: make(chan sendResult, 1)}
+	q.mu.Lock()
+	for q.started && len(q.queues[p]) >= q.opts.Size {
+		if p >= PriorityReminder {
+			q.mu.Unlock()
+			return 0, ErrSendQueueFull
+		}
+		if err := ctx.Err(); err != nil {
+			q.mu.Unlock()
+			return 0, err
+		}
+		q.room.Wait()
+	}
+	if !q.started {
+		q.mu.Unlock()
+		return send(ctx)
+	}
+	q.queues[p] = append(q.queues[p], j)
+	q.mu.Unlock()
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	select {
+	case r := <-j.done:
+		return r.messageID, r.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (q *SendQueue) SendMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string, disablePreview bool) error {
+	_, err := q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return 0, q.API.SendMessage(ctx, chatID, text, parseMode, buttonText, buttonURL, disablePreview)
+	})
+	return err
+}
+
+func (q *SendQueue) PostMessage(ctx context.Context, chatID int64, text string, parseMode string, buttonText string, buttonURL string) (int64, error) {
+	return q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return q.API.PostMessage(ctx, chatID, text, parseMode, buttonText, buttonURL)
+	})
+}
+
+func (q *SendQueue) SendAnimation(ctx context.Context, chatID int64, animation string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error) {
+	return q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return q.API.SendAnimation(ctx, chatID, animation, caption, parseMode, buttonText, buttonURL)
+	})
+}
+
+func (q *SendQueue) SendPhoto(ctx context.Context, chatID int64, photo string, caption string, parseMode string, buttonText string, buttonURL string) (int64, error) {
+	return q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return q.API.SendPhoto(ctx, chatID, photo, caption, parseMode, buttonText, buttonURL)
+	})
+}
+
+func (q *SendQueue) EditMessageCaption(ctx context.Context, chatID int64, messageID int64, caption string, parseMode string, buttonText string, buttonURL string) error {
+	_, err := q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return 0, q.API.EditMessageCaption(ctx, chatID, messageID, caption, parseMode, buttonText, buttonURL)
+	})
+	return err
+}
+
+func (q *SendQueue) EditMessageText(ctx context.Context, chatID int64, messageID int64, text string, parseMode string, buttonText string, buttonURL string) error {
+	_, err := q.enqueue(ctx, chatID, func(ctx context.Context) (int64, error) {
+		return 0, q.API.EditMessageText(ctx, chatID, messageID, text, parseMode, buttonText, buttonURL)
+	})
+	return err
+}
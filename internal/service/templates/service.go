@@ -0,0 +1,130 @@
+// Package templates stores named giveaway configurations creators start new giveaways from.
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	repo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	maxNameLength          = 64
+	maxTemplatesPerCreator = 50
+	// maxConfigBytes bounds a stored configuration
+	maxConfigBytes = 64 << 10
+)
+
+// Service manages giveaway templates. Configurations are validated by the caller; the service
+// only stores them for their creator.
+type Service struct {
+	repo *repo.TemplateRepository
+}
+
+func NewService(r *repo.TemplateRepository) *Service {
+	return &Service{repo: r}
+}
+
+// Create saves config under name for creatorID.
+func (s *Service) Create(ctx context.Context, creatorID int64, name string, config json.RawMessage) (*dg.Template, error) {
+	name, err := checkTemplate(name, config)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.repo.CountByCreator(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= maxTemplatesPerCreator {
+		return nil, fmt.Errorf("too many templates (max %d)", maxTemplatesPerCreator)
+	}
+	now := time.Now().UTC()
+	t := &dg.Template{
+		ID:        uuid.NewString(),
+		CreatorID: creatorID,
+		Name:      name,
+		Config:    config,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// List returns the templates of creatorID by name.
+func (s *Service) List(ctx context.Context, creatorID int64) ([]dg.Template, error) {
+	return s.repo.ListByCreator(ctx, creatorID)
+}
+
+// Get returns a template to its creator.
+func (s *Service) Get(ctx context.Context, id string, requesterID int64) (*dg.Template, error) {
+	return s.loadOwned(ctx, id, requesterID)
+}
+
+// Update replaces the name and configuration of a template. Giveaways created from it earlier
+// keep their settings.
+func (s *Service) Update(ctx context.Context, id string, requesterID int64, name string, config json.RawMessage) (*dg.Template, error) {
+	t, err := s.loadOwned(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Name, err = checkTemplate(name, config); err != nil {
+		return nil, err
+	}
+	t.Config = config
+	t.UpdatedAt = time.Now().UTC()
+	if err := s.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Delete removes a template; giveaways created from it are kept.
+func (s *Service) Delete(ctx context.Context, id string, requesterID int64) error {
+	if _, err := s.loadOwned(ctx, id, requesterID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// checkTemplate returns the trimmed name after checking name and config limits.
+func checkTemplate(name string, config json.RawMessage) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("name is required")
+	}
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return "", fmt.Errorf("name exceeds %d characters", maxNameLength)
+	}
+	if len(config) > maxConfigBytes {
+		return "", errors.New("template too large")
+	}
+	return name, nil
+}
+
+func (s *Service) loadOwned(ctx context.Context, id string, requesterID int64) (*dg.Template, error) {
+	if id == "" {
+		return nil, errors.New("missing id")
+	}
+	t, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.New("not found")
+	}
+	if t.CreatorID != requesterID {
+		return nil, errors.New("forbidden")
+	}
+	return t, nil
+}
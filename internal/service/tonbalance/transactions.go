@@ -0,0 +1,135 @@
+package tonbalance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	tongo "github.com/tonkeeper/tongo/ton"
+)
+
+// Transfer is an incoming TON transfer with its text comment.
+type Transfer struct {
+	Hash       string
+	Lt         int64
+	Account    string // receiving account, raw form
+	Sender     string // raw form; empty for external messages
+	AmountNano int64
+	Comment    string
+	At         time.Time
+}
+
+type tonapiTx struct {
+	Hash    string `json:"hash"`
+	Lt      int64  `json:"lt"`
+	Success bool   `json:"success"`
+	Utime   int64  `json:"utime"`
+	Account struct {
+		Address string `json:"address"`
+	} `json:"account"`
+	InMsg *struct {
+		Value         stringOrNumber `json:"value"`
+		DecodedOpName string         `json:"decoded_op_name"`
+		DecodedBody   struct {
+			Text string `json:"text"`
+		} `json:"decoded_body"`
+		Source *struct {
+			Address string `json:"address"`
+		} `json:"source"`
+	} `json:"in_msg"`
+}
+
+// transfer converts a successful TonAPI transaction carrying value into a Transfer.
+func (t tonapiTx) transfer() (Transfer, bool) {
+	if !t.Success || t.InMsg == nil {
+		return Transfer{}, false
+	}
+	amount, err := strconv.ParseInt(string(t.InMsg.Value), 10, 64)
+	if err != nil || amount <= 0 {
+		return Transfer{}, false
+	}
+	out := Transfer{Hash: t.Hash, Lt: t.Lt, Account: NormalizeAddress(t.Account.Address), AmountNano: amount, At: time.Unix(t.Utime, 0).UTC()}
+	if t.InMsg.Source != nil {
+		out.Sender = NormalizeAddress(t.InMsg.Source.Address)
+	}
+	if t.InMsg.DecodedOpName == "text_comment" {
+		out.Comment = strings.TrimSpace(t.InMsg.DecodedBody.Text)
+	}
+	return out, true
+}
+
+// NormalizeAddress returns the lowercased raw form (workchain:hex) of an address,
+// falling back to the lowercased input when it cannot be parsed.
+func NormalizeAddress(address string) string {
+	if addr, err := tongo.ParseAccountID(address); err == nil {
+		return strings.ToLower(addr.ToRaw())
+	}
+	return strings.ToLower(address)
+}
+
+func (s *Service) getJSON(ctx context.Context, path string, out any) error {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, s.tonapiBase+path, nil)
+	req.Header.Set("Accept", "application/json")
+	if s.tonapiToken != "" {
+		req.Header.Set("Authorization", "Bearer "+s.tonapiToken)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("tonapi http %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// Ping checks that TonAPI answers and reports its REST API online.
+func (s *Service) Ping(ctx context.Context) error {
+	var status struct {
+		RestOnline bool `json:"rest_online"`
+	}
+	if err := s.getJSON(ctx, "/v2/status", &status); err != nil {
+		return err
+	}
+	if !status.RestOnline {
+		return fmt.Errorf("tonapi rest offline")
+	}
+	return nil
+}
+
+// ListIncomingTransfers returns the latest incoming transfers to address, newest first.
+func (s *Service) ListIncomingTransfers(ctx context.Context, address string, limit int) ([]Transfer, error) {
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+	var out struct {
+		Transactions []tonapiTx `json:"transactions"`
+	}
+	path := "/v2/blockchain/accounts/" + url.PathEscape(address) + "/transactions?limit=" + strconv.Itoa(limit)
+	if err := s.getJSON(ctx, path, &out); err != nil {
+		return nil, err
+	}
+	transfers := make([]Transfer, 0, len(out.Transactions))
+	for _, t := range out.Transactions {
+		if tr, ok := t.transfer(); ok {
+			transfers = append(transfers, tr)
+		}
+	}
+	return transfers, nil
+}
+
+// GetTransfer looks up a transaction by hash; ok is false when it is not an incoming transfer.
+func (s *Service) GetTransfer(ctx context.Context, hash string) (Transfer, bool, error) {
+	var t tonapiTx
+	if err := s.getJSON(ctx, "/v2/blockchain/transactions/"+url.PathEscape(hash), &t); err != nil {
+		return Transfer{}, false, err
+	}
+	tr, ok := t.transfer()
+	return tr, ok, nil
+}
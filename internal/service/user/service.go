@@ -8,6 +8,7 @@ import (
 	rcache "github.com/open-builders/giveaway-backend/internal/cache/redis"
 	domain "github.com/open-builders/giveaway-backend/internal/domain/user"
 	pgrepo "github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
 )
 
 // Service orchestrates user access with repository and cache.
@@ -119,3 +120,115 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 func (s *Service) List(ctx context.Context, limit, offset int) ([]domain.User, error) {
 	return s.repo.List(ctx, limit, offset)
 }
+
+// GetPublicProfile returns the profile card of a user as seen by viewerID, applying the
+// owner's privacy settings. The owner always sees the full card with the settings.
+func (s *Service) GetPublicProfile(ctx context.Context, id, viewerID int64) (*domain.PublicProfile, error) {
+	if id == 0 {
+		return nil, errors.New("missing id")
+	}
+	p, privacy, err := s.repo.GetPublicProfile(ctx, id)
+	if err != nil || p == nil {
+		return p, err
+	}
+	if viewerID == id {
+		p.Privacy = privacy
+		return p, nil
+	}
+	if privacy.HideProfile {
+		return &domain.PublicProfile{ID: p.ID, Verified: p.Verified, Hidden: true}, nil
+	}
+	if privacy.HideWins {
+		p.WinsCount = nil
+	}
+	return p, nil
+}
+
+// SetPrivacy updates the profile privacy settings of a user.
+func (s *Service) SetPrivacy(ctx context.Context, id int64, p domain.Privacy) error {
+	if id == 0 {
+		return errors.New("missing id")
+	}
+	ok, err := s.repo.SetPrivacy(ctx, id, p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// NotificationPrefs returns the optional bot messages a user receives; unknown users get the defaults.
+func (s *Service) NotificationPrefs(ctx context.Context, id int64) (domain.NotificationPrefs, error) {
+	p, err := s.repo.GetNotificationPrefs(ctx, id)
+	if err != nil {
+		return domain.NotificationPrefs{}, err
+	}
+	if p == nil {
+		return domain.NotificationPrefs{JoinConfirmations: true}, nil
+	}
+	return *p, nil
+}
+
+// SetNotificationPrefs updates the optional bot messages a user receives.
+func (s *Service) SetNotificationPrefs(ctx context.Context, id int64, p domain.NotificationPrefs) error {
+	if id == 0 {
+		return errors.New("missing id")
+	}
+	ok, err := s.repo.SetNotificationPrefs(ctx, id, p)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("not found")
+	}
+	return nil
+}
+
+// SetLanguage stores the language the user picked for bot messages and localized fields.
+// An empty tag goes back to the Telegram client language. Returns the stored tag.
+func (s *Service) SetLanguage(ctx context.Context, id int64, tag string) (string, error) {
+	if id == 0 {
+		return "", errors.New("missing id")
+	}
+	if tag != "" {
+		loc, ok := i18n.Lookup(tag)
+		if !ok {
+			return "", errors.New("unsupported language")
+		}
+		tag = loc.Tag
+	}
+	ok, err := s.repo.SetPreferredLanguage(ctx, id, tag)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errors.New("not found")
+	}
+	if s.cache != nil {
+		if u, err := s.repo.GetByID(ctx, id); err == nil && u != nil {
+			_ = s.cache.Set(ctx, u)
+		}
+	}
+	return tag, nil
+}
+
+// PreferredLanguage returns the language the user picked in the settings, or "".
+func (s *Service) PreferredLanguage(ctx context.Context, id int64) string {
+	u, err := s.GetByID(ctx, id)
+	if err != nil || u == nil {
+		return ""
+	}
+	return u.PreferredLanguage
+}
+
+// Locale returns the locale to write to the user in: the picked language, then the Telegram
+// client language, then English.
+func (s *Service) Locale(ctx context.Context, id int64) *i18n.Locale {
+	u, err := s.GetByID(ctx, id)
+	if err != nil || u == nil {
+		return i18n.Match()
+	}
+	return i18n.Match(u.PreferredLanguage, u.LanguageCode)
+}
@@ -0,0 +1,14 @@
+// Package backoff computes retry delays for work queues that retry failed items later.
+package backoff
+
+import "time"
+
+// Exponential is the delay after the given number of failed attempts: base after the first,
+// doubling with each further one, up to limit.
+func Exponential(attempts int, base, limit time.Duration) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < limit; i++ {
+		d *= 2
+	}
+	return min(d, limit)
+}
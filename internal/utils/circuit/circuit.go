@@ -0,0 +1,88 @@
+package circuit
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned while a key's circuit is open and no earlier value is known.
+var ErrOpen = errors.New("circuit open")
+
+const (
+	// failureThreshold consecutive failures open the circuit; each further failure doubles the cool-down
+	failureThreshold = 3
+	minCoolDown      = time.Minute
+	maxCoolDown      = time.Hour
+)
+
+type state[T any] struct {
+	failures  int
+	openUntil time.Time
+	last      T
+	hasLast   bool
+}
+
+// Breaker tracks failures per key and remembers the last value fetched successfully, so a
+// key that keeps failing (deleted chat, bot kicked) is served from memory instead of being
+// fetched on every read.
+type Breaker[K comparable, T any] struct {
+	mu     sync.Mutex
+	states map[K]*state[T]
+}
+
+func New[K comparable, T any]() *Breaker[K, T] {
+	return &Breaker[K, T]{states: make(map[K]*state[T])}
+}
+
+// Do calls fetch for key unless its circuit is open. When the circuit is open or fetch fails,
+// the last good value is returned with stale set; without one the error (or ErrOpen) is returned.
+func (b *Breaker[K, T]) Do(key K, fetch func() (T, error)) (v T, stale bool, err error) {
+	now := time.Now()
+	b.mu.Lock()
+	st := b.states[key]
+	if st != nil && now.Before(st.openUntil) {
+		defer b.mu.Unlock()
+		if st.hasLast {
+			return st.last, true, nil
+		}
+		return v, false, ErrOpen
+	}
+	b.mu.Unlock()
+
+	v, err = fetch()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	st = b.states[key]
+	if err == nil {
+		b.states[key] = &state[T]{last: v, hasLast: true}
+		return v, false, nil
+	}
+	if st == nil {
+		st = &state[T]{}
+		b.states[key] = st
+	}
+	st.failures++
+	if st.failures >= failureThreshold {
+		coolDown := maxCoolDown
+		if shift := st.failures - failureThreshold; shift < 6 {
+			coolDown = min(minCoolDown<<shift, maxCoolDown)
+		}
+		st.openUntil = now.Add(coolDown)
+	}
+	if st.hasLast {
+		return st.last, true, nil
+	}
+	return v, false, err
+}
+
+// Reset closes the circuit for key, e.g. after fresh data arrived from another source.
+func (b *Breaker[K, T]) Reset(key K) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if st := b.states[key]; st != nil {
+		st.failures = 0
+		st.openUntil = time.Time{}
+	}
+}
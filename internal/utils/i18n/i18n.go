@@ -0,0 +1,92 @@
+// Package i18n holds the locales the backend can render user facing text in: translated
+// strings plus number and date conventions. Unknown keys fall back to English and then to
+// the key itself, so a missing translation never breaks output.
+package i18n
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Locale is a supported language with its formatting conventions.
+type Locale struct {
+	Tag string
+	// DecimalSeparator is the decimal mark; locales using a comma list values with ';'
+	// so spreadsheet applications split columns correctly.
+	DecimalSeparator rune
+	ListSeparator    rune
+	// DateLayout formats timestamps, which are always rendered in UTC
+	DateLayout string
+	messages   map[string]string
+}
+
+// DefaultTag is used when no requested locale is supported.
+const DefaultTag = "en"
+
+var locales = map[string]*Locale{
+	"en": {Tag: "en", DecimalSeparator: '.', ListSeparator: ',', DateLayout: "2006-01-02 15:04", messages: en},
+	"ru": {Tag: "ru", DecimalSeparator: ',', ListSeparator: ';', DateLayout: "02.01.2006 15:04", messages: ru},
+}
+
+// normalize reduces a language tag like "ru-RU" or "pt_BR" to its primary language.
+func normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// Lookup returns the locale for tag and whether it is supported.
+func Lookup(tag string) (*Locale, bool) {
+	l, ok := locales[normalize(tag)]
+	return l, ok
+}
+
+// Match returns the first supported locale among tags, or the default locale.
+func Match(tags ...string) *Locale {
+	for _, t := range tags {
+		if l, ok := Lookup(t); ok {
+			return l
+		}
+	}
+	return locales[DefaultTag]
+}
+
+// Tags lists the supported language tags in alphabetical order.
+func Tags() []string {
+	out := make([]string, 0, len(locales))
+	for tag := range locales {
+		out = append(out, tag)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// T returns the translation of key.
+func (l *Locale) T(key string) string {
+	if v, ok := l.messages[key]; ok {
+		return v
+	}
+	if v, ok := en[key]; ok {
+		return v
+	}
+	return key
+}
+
+// FormatTime renders t in UTC using the locale date layout; the zero time renders empty.
+func (l *Locale) FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(l.DateLayout)
+}
+
+// FormatDecimal replaces the '.' decimal mark of a formatted number with the locale's.
+func (l *Locale) FormatDecimal(s string) string {
+	if l.DecimalSeparator == '.' {
+		return s
+	}
+	return strings.Replace(s, ".", string(l.DecimalSeparator), 1)
+}
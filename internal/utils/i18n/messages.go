@@ -0,0 +1,167 @@
+package i18n
+
+var en = map[string]string{
+	// Winners CSV export
+	"csv.place":             "place",
+	"csv.user_id":           "user_id",
+	"csv.username":          "username",
+	"csv.first_name":        "first_name",
+	"csv.last_name":         "last_name",
+	"csv.wallet_address":    "wallet_address",
+	"csv.source":            "source",
+	"csv.prize_title":       "prize_title",
+	"csv.prize_description": "prize_description",
+	"csv.prize_package":     "prize_package",
+	"csv.prize_quantity":    "prize_quantity",
+	"csv.won_at":            "won_at",
+	"csv.contact_method":    "contact_method",
+	"csv.contact_details":   "contact_details",
+	// Per-requirement columns: requirement number and label
+	"csv.requirement_status":     "req%d_status (%s)",
+	"csv.requirement_checked_at": "req%d_checked_at (%s)",
+	// Payload validation; the first verb is the field
+	"validate.required":            "%s is required",
+	"validate.not_negative":        "%s cannot be negative",
+	"validate.min":                 "%s must be at least %d",
+	"validate.max":                 "%s cannot exceed %d",
+	"validate.between":             "%s must be between %d and %d",
+	"validate.max_length":          "%s is too long (max %d characters)",
+	"validate.duration_max":        "%s cannot exceed 2 months (60 days)",
+	"validate.duration_min":        "%s must be at least 5 minutes",
+	"validate.account_age_missing": "%s: set account_age_min_year or account_age_max_year",
+	"validate.account_age_range":   "%s: account_age_min_year cannot be greater than account_age_max_year",
+	"validate.prize_units_max":     "%s: total quantity cannot exceed %d units per winner",
+	"validate.integer":             "%s must be an integer",
+	"validate.one_of":              "%s must be one of: %s",
+	"validate.requires":            "%s requires %s to be set",
+	"validate.email":               "%s must be a valid email address",
+	"validate.language":            "%s is not a language code",
+	"validate.duplicate":           "%s is listed more than once",
+	"validate.places_overlap":      "%s overlaps the places of prize_packages[%d]",
+	// Direct messages to users; buttons first
+	"notify.open_giveaway":             "Open Giveaway",
+	"notify.view_giveaway":             "View Giveaway",
+	"notify.review":                    "Review",
+	"notify.winner":                    "🎉 You won in “%s”!\nOpen the app to view details.",
+	"notify.lapse":                     "⚠️ You left %s, which the giveaway \"%s\" requires.\n\nSubscribe again before %s to stay in the draw; otherwise you will be skipped.",
+	"notify.lapse_draw":                "the draw",
+	"notify.creator_completed":         "✅ Your giveaway \"%s\" has been completed.\n\nWinners have been selected and notified.",
+	"notify.creator_pending":           "⏳ Your giveaway \"%s\" has ended and is now pending.\n\nAction required: Please review participants, verify custom requirements, and finalize the giveaway to distribute prizes.",
+	"notify.pending_expired":           "⌛ The pending period of your giveaway \"%s\" has expired.\n\n%s",
+	"notify.pending_expired_drawn":     "Winners were drawn automatically from participants meeting the verifiable requirements.",
+	"notify.pending_expired_cancelled": "The giveaway was cancelled and participants have been notified.",
+	"notify.unverifiable":              "⚠️ The bot was removed or lost admin rights in %s, used by your giveaway \"%s\".\n\n%s",
+	"notify.unverifiable_paused":       "Requirements on this channel are skipped for new participants until the bot is restored as an admin.",
+	"notify.unverifiable_failing":      "Participants cannot meet requirements on this channel until the bot is restored as an admin.",
+	"notify.fingerprint_flag":          "⚠️ %d accounts joined your giveaway \"%s\" from the same device. The extra joins are flagged for your review in the giveaway's participant flags.",
+	"notify.finish_confirmed":          "✅ An admin confirmed finishing your giveaway \"%s\".",
+	"notify.finish_aborted":            "⛔ An admin aborted finishing your giveaway \"%s\". It stays pending until you confirm winners again.",
+	"notify.finish_preview":            "📋 Giveaway “%s” is about to finish.\n\nParticipants: %d\nWinner places: %d\n%s",
+	"notify.finish_preview_required":   "\n\nAn admin must confirm before the giveaway is finished.",
+	"notify.finish_preview_auto":       "\n\nOpen the review to abort the automatic finish if something is wrong.",
+	"notify.support_button":            "Open Ticket",
+	"notify.support_opened":            "🆘 New support ticket #%d about your giveaway \"%s\":\n\n%s",
+	"notify.support_reply":             "💬 New reply in support ticket #%d about the giveaway \"%s\":\n\n%s",
+	"notify.support_escalated":         "⚠️ Support ticket #%d about the giveaway \"%s\" was escalated to the platform team.",
+	"notify.support_resolved":          "✅ Support ticket #%d about the giveaway \"%s\" was marked as resolved.",
+	"notify.export_ready":              "📄 The export of the giveaway \"%s\" is ready. The link is valid for 24 hours.",
+	"notify.export_download":           "Download CSV",
+	"notify.export_failed":             "⚠️ The export of the giveaway \"%s\" failed. Please try again.",
+	"notify.join_confirmed":            "✅ You joined the giveaway \"%s\".\n\nWinners are drawn on %s UTC.",
+	"notify.join_rules":                "\n\n📜 Rules:\n%s",
+	"notify.join_bonus":                "\n\n🎟 Earn up to %d bonus tickets with the giveaway's extra tasks, and invite friends with this link: %s",
+
+	// Requirement failure analytics and creation hints
+	"requirement.subscription":                 "channel subscription",
+	"requirement.boost":                        "boost",
+	"requirement.custom":                       "custom",
+	"requirement.premium":                      "Telegram Premium",
+	"requirement.holdton":                      "TON balance",
+	"requirement.holdjetton":                   "jetton balance",
+	"requirement.account_age":                  "account age",
+	"analytics.requirement_failed":             "%d%% of users failed the %s requirement",
+	"analytics.hint_high_friction":             "%d%% of users fail %s requirements across giveaways, so expect fewer participants",
+	"analytics.hint_high_friction_combination": "About %d%% of users are expected to fail at least one of these requirements: %s",
+}
+
+var ru = map[string]string{
+	"csv.place":             "Место",
+	"csv.user_id":           "ID пользователя",
+	"csv.username":          "Имя пользователя",
+	"csv.first_name":        "Имя",
+	"csv.last_name":         "Фамилия",
+	"csv.wallet_address":    "Адрес кошелька",
+	"csv.source":            "Источник",
+	"csv.prize_title":       "Приз",
+	"csv.prize_description": "Описание приза",
+	"csv.prize_package":     "Пакет призов",
+	"csv.prize_quantity":    "Количество",
+	"csv.won_at":            "Дата победы",
+	"csv.contact_method":    "Способ связи",
+	"csv.contact_details":   "Контакт",
+	// Per-requirement columns: requirement number and label
+	"csv.requirement_status":     "Условие %d (%s): статус",
+	"csv.requirement_checked_at": "Условие %d (%s): проверено",
+	// Payload validation
+	"validate.required":            "Поле %s обязательно",
+	"validate.not_negative":        "Поле %s не может быть отрицательным",
+	"validate.min":                 "Поле %s должно быть не меньше %d",
+	"validate.max":                 "Поле %s не может быть больше %d",
+	"validate.between":             "Поле %s должно быть от %d до %d",
+	"validate.max_length":          "Поле %s слишком длинное (максимум %d символов)",
+	"validate.duration_max":        "Поле %s не может превышать 2 месяца (60 дней)",
+	"validate.duration_min":        "Поле %s должно быть не меньше 5 минут",
+	"validate.account_age_missing": "%s: укажите account_age_min_year или account_age_max_year",
+	"validate.account_age_range":   "%s: account_age_min_year не может быть больше account_age_max_year",
+	"validate.prize_units_max":     "%s: общее количество не может превышать %d единиц на победителя",
+	"validate.integer":             "Поле %s должно быть целым числом",
+	"validate.one_of":              "Поле %s должно быть одним из: %s",
+	"validate.requires":            "Поле %s требует заполнить %s",
+	"validate.email":               "Поле %s должно быть корректным адресом электронной почты",
+	"validate.language":            "Поле %s не является кодом языка",
+	"validate.duplicate":           "Поле %s указано несколько раз",
+	"validate.places_overlap":      "Поле %s пересекается с местами prize_packages[%d]",
+	// Direct messages
+	"notify.open_giveaway":             "Открыть розыгрыш",
+	"notify.view_giveaway":             "Посмотреть розыгрыш",
+	"notify.review":                    "Проверить",
+	"notify.winner":                    "🎉 Вы победили в розыгрыше «%s»!\nОткройте приложение, чтобы узнать подробности.",
+	"notify.lapse":                     "⚠️ Вы отписались от %s — это условие розыгрыша «%s».\n\nПодпишитесь снова до %s, чтобы остаться в розыгрыше, иначе вас пропустят.",
+	"notify.lapse_draw":                "подведения итогов",
+	"notify.creator_completed":         "✅ Ваш розыгрыш «%s» завершён.\n\nПобедители выбраны и получили уведомления.",
+	"notify.creator_pending":           "⏳ Ваш розыгрыш «%s» закончился и ожидает подтверждения.\n\nТребуется действие: проверьте участников и пользовательские условия, затем завершите розыгрыш, чтобы раздать призы.",
+	"notify.pending_expired":           "⌛ Срок подтверждения вашего розыгрыша «%s» истёк.\n\n%s",
+	"notify.pending_expired_drawn":     "Победители выбраны автоматически среди участников, выполнивших проверяемые условия.",
+	"notify.pending_expired_cancelled": "Розыгрыш отменён, участники получили уведомления.",
+	"notify.unverifiable":              "⚠️ Бота удалили или лишили прав администратора в %s, который используется в вашем розыгрыше «%s».\n\n%s",
+	"notify.unverifiable_paused":       "Условия по этому каналу не проверяются у новых участников, пока бот снова не станет администратором.",
+	"notify.unverifiable_failing":      "Участники не смогут выполнить условия по этому каналу, пока бот снова не станет администратором.",
+	"notify.fingerprint_flag":          "⚠️ %d аккаунтов присоединились к вашему розыгрышу «%s» с одного устройства. Лишние участия отмечены для проверки в флагах участников.",
+	"notify.finish_confirmed":          "✅ Администратор подтвердил завершение вашего розыгрыша «%s».",
+	"notify.finish_aborted":            "⛔ Администратор отменил завершение вашего розыгрыша «%s». Он будет ожидать, пока вы снова не подтвердите победителей.",
+	"notify.finish_preview":            "📋 Розыгрыш «%s» скоро завершится.\n\nУчастников: %d\nПризовых мест: %d\n%s",
+	"notify.finish_preview_required":   "\n\nПеред завершением розыгрыша его должен подтвердить администратор.",
+	"notify.finish_preview_auto":       "\n\nОткройте проверку, чтобы отменить автоматическое завершение, если что-то не так.",
+	"notify.support_button":            "Открыть обращение",
+	"notify.support_opened":            "🆘 Новое обращение #%d по вашему розыгрышу «%s»:\n\n%s",
+	"notify.support_reply":             "💬 Новый ответ в обращении #%d по розыгрышу «%s»:\n\n%s",
+	"notify.support_escalated":         "⚠️ Обращение #%d по розыгрышу «%s» передано команде платформы.",
+	"notify.support_resolved":          "✅ Обращение #%d по розыгрышу «%s» отмечено как решённое.",
+	"notify.export_ready":              "📄 Выгрузка розыгрыша «%s» готова. Ссылка действует 24 часа.",
+	"notify.export_download":           "Скачать CSV",
+	"notify.export_failed":             "⚠️ Не удалось выгрузить розыгрыш «%s». Попробуйте ещё раз.",
+	"notify.join_confirmed":            "✅ Вы участвуете в розыгрыше «%s».\n\nПобедители будут выбраны %s UTC.",
+	"notify.join_rules":                "\n\n📜 Правила:\n%s",
+	"notify.join_bonus":                "\n\n🎟 Получите до %d бонусных билетов за дополнительные задания и приглашайте друзей по этой ссылке: %s",
+
+	"requirement.subscription":                 "подписка на канал",
+	"requirement.boost":                        "буст",
+	"requirement.custom":                       "своё условие",
+	"requirement.premium":                      "Telegram Premium",
+	"requirement.holdton":                      "баланс TON",
+	"requirement.holdjetton":                   "баланс жетонов",
+	"requirement.account_age":                  "возраст аккаунта",
+	"analytics.requirement_failed":             "%d%% пользователей не выполнили условие «%s»",
+	"analytics.hint_high_friction":             "%d%% пользователей не выполняют условие «%s» в других розыгрышах, участников может быть меньше",
+	"analytics.hint_high_friction_combination": "Около %d%% пользователей не выполнят хотя бы одно из условий: %s",
+}
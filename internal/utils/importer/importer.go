@@ -0,0 +1,334 @@
+// Package importer translates giveaway definitions exported by other giveaway bots into a
+// neutral Definition, driven by field mappers that can be configured without code changes.
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Mapper names where each field lives in an exported definition. JSON fields are dotted paths
+// from the root object; Prize* and Channel are relative to one item of the Prizes and Channels
+// arrays. Channel may be empty when channels are plain strings. CSV files use the same paths
+// as column headers, with item fields joined to their array path ("prizes.title").
+type Mapper struct {
+	Name             string `json:"name"`
+	Title            string `json:"title"`
+	Description      string `json:"description,omitempty"`
+	Duration         string `json:"duration,omitempty"` // seconds
+	EndsAt           string `json:"ends_at,omitempty"`  // RFC 3339, "2006-01-02 15:04" UTC or unix seconds
+	Winners          string `json:"winners,omitempty"`
+	Prizes           string `json:"prizes,omitempty"`
+	PrizeTitle       string `json:"prize_title,omitempty"`
+	PrizeDescription string `json:"prize_description,omitempty"`
+	PrizeQuantity    string `json:"prize_quantity,omitempty"`
+	Channels         string `json:"channels,omitempty"`
+	Channel          string `json:"channel,omitempty"`
+}
+
+// Prize is an imported prize.
+type Prize struct {
+	Title       string
+	Description string
+	Quantity    int
+}
+
+// Definition is an imported giveaway. Channels hold @usernames without the @ or numeric ids.
+// Warnings list what was ignored or defaulted during translation.
+type Definition struct {
+	Title        string
+	Description  string
+	Duration     int64
+	WinnersCount int
+	Prizes       []Prize
+	Channels     []string
+	Warnings     []string
+}
+
+func (d *Definition) warn(format string, args ...any) {
+	d.Warnings = append(d.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Builtin returns the mappers shipped with the service: "native" reads this API's own create
+// payload and "generic" a flat layout most bot exports can be reshaped into.
+func Builtin() map[string]Mapper {
+	return map[string]Mapper{
+		"native": {
+			Name: "native", Title: "title", Description: "description", Duration: "duration", Winners: "winners_count",
+			Prizes: "prizes", PrizeTitle: "title", PrizeDescription: "description", PrizeQuantity: "quantity",
+			Channels: "requirements", Channel: "channel_username",
+		},
+		"generic": {
+			Name: "generic", Title: "title", Description: "description", Duration: "duration", EndsAt: "ends_at", Winners: "winners",
+			Prizes: "prizes", PrizeTitle: "title", PrizeDescription: "description", PrizeQuantity: "quantity",
+			Channels: "channels",
+		},
+	}
+}
+
+// LoadMappers returns the built-in mappers plus those in the JSON array at path, which
+// override built-ins of the same name. An empty path yields the built-ins.
+func LoadMappers(path string) (map[string]Mapper, error) {
+	out := Builtin()
+	if path == "" {
+		return out, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var list []Mapper
+	if err := json.Unmarshal(b, &list); err != nil {
+		return nil, fmt.Errorf("import mappers: %w", err)
+	}
+	for i, m := range list {
+		if m.Name == "" || m.Title == "" {
+			return nil, fmt.Errorf("import mappers[%d]: name and title are required", i)
+		}
+		out[m.Name] = m
+	}
+	return out, nil
+}
+
+// Names lists mapper names in order.
+func Names(mappers map[string]Mapper) []string {
+	out := make([]string, 0, len(mappers))
+	for name := range mappers {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ParseJSON translates a JSON object using m.
+func ParseJSON(m Mapper, body []byte, now time.Time) (*Definition, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var root map[string]any
+	if err := dec.Decode(&root); err != nil {
+		return nil, errors.New("invalid json")
+	}
+	d := &Definition{}
+	used := map[string]bool{}
+	get := func(path string) any {
+		if path == "" {
+			return nil
+		}
+		used[strings.SplitN(path, ".", 2)[0]] = true
+		return lookup(root, path)
+	}
+	d.Title = str(get(m.Title))
+	d.Description = str(get(m.Description))
+	setTiming(d, str(get(m.Duration)), str(get(m.EndsAt)), now)
+	setWinners(d, str(get(m.Winners)))
+	if items, ok := get(m.Prizes).([]any); ok {
+		for _, it := range items {
+			obj, _ := it.(map[string]any)
+			d.addPrize(str(lookup(obj, m.PrizeTitle)), str(lookup(obj, m.PrizeDescription)), str(lookup(obj, m.PrizeQuantity)))
+		}
+	}
+	if items, ok := get(m.Channels).([]any); ok {
+		for _, it := range items {
+			v := it
+			if m.Channel != "" {
+				obj, _ := it.(map[string]any)
+				v = lookup(obj, m.Channel)
+			}
+			d.addChannel(str(v))
+		}
+	}
+	var unused []string
+	for k := range root {
+		if !used[k] {
+			unused = append(unused, k)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		d.warn("ignored fields: %s", strings.Join(unused, ", "))
+	}
+	return d, nil
+}
+
+// ParseCSV translates a CSV file using m. Giveaway fields are read from the first row that
+// has them; every row may add one prize and one channel.
+func ParseCSV(m Mapper, body []byte, now time.Time) (*Definition, error) {
+	r := csv.NewReader(bytes.NewReader(body))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, errors.New("invalid csv")
+	}
+	if len(rows) < 2 {
+		return nil, errors.New("csv needs a header and at least one row")
+	}
+	col := map[string]int{}
+	for i, h := range rows[0] {
+		col[strings.TrimSpace(h)] = i
+	}
+	used := map[string]bool{}
+	first := func(name string) string {
+		if name == "" {
+			return ""
+		}
+		i, ok := col[name]
+		if !ok {
+			return ""
+		}
+		used[name] = true
+		for _, row := range rows[1:] {
+			if i < len(row) && strings.TrimSpace(row[i]) != "" {
+				return strings.TrimSpace(row[i])
+			}
+		}
+		return ""
+	}
+	cell := func(row []string, name string) string {
+		i, ok := col[name]
+		if name == "" || !ok || i >= len(row) {
+			return ""
+		}
+		used[name] = true
+		return strings.TrimSpace(row[i])
+	}
+	d := &Definition{}
+	d.Title = first(m.Title)
+	d.Description = first(m.Description)
+	setTiming(d, first(m.Duration), first(m.EndsAt), now)
+	setWinners(d, first(m.Winners))
+	prizeCol := func(field string) string { return joinPath(m.Prizes, field) }
+	channelCol := joinPath(m.Channels, m.Channel)
+	for _, row := range rows[1:] {
+		if t := cell(row, prizeCol(m.PrizeTitle)); t != "" {
+			d.addPrize(t, cell(row, prizeCol(m.PrizeDescription)), cell(row, prizeCol(m.PrizeQuantity)))
+		}
+		if ch := cell(row, channelCol); ch != "" {
+			d.addChannel(ch)
+		}
+	}
+	var unused []string
+	for _, h := range rows[0] {
+		if h = strings.TrimSpace(h); h != "" && !used[h] {
+			unused = append(unused, h)
+		}
+	}
+	if len(unused) > 0 {
+		d.warn("ignored columns: %s", strings.Join(unused, ", "))
+	}
+	return d, nil
+}
+
+func setTiming(d *Definition, duration, endsAt string, now time.Time) {
+	if n, err := strconv.ParseInt(duration, 10, 64); err == nil && n > 0 {
+		d.Duration = n
+		return
+	}
+	if endsAt == "" {
+		d.warn("no duration or end time found")
+		return
+	}
+	at, ok := parseTime(endsAt)
+	if !ok {
+		d.warn("unreadable end time %q", endsAt)
+		return
+	}
+	if !at.After(now) {
+		d.warn("end time %s has passed", at.UTC().Format(time.RFC3339))
+		return
+	}
+	d.Duration = int64(at.Sub(now) / time.Second)
+}
+
+func setWinners(d *Definition, winners string) {
+	n, err := strconv.Atoi(winners)
+	if err != nil || n <= 0 {
+		d.WinnersCount = 1
+		d.warn("winners count missing, defaulted to 1")
+		return
+	}
+	d.WinnersCount = n
+}
+
+func (d *Definition) addPrize(title, description, quantity string) {
+	if title == "" {
+		d.warn("skipped a prize without a title")
+		return
+	}
+	q, err := strconv.Atoi(quantity)
+	if err != nil || q <= 0 {
+		q = 1
+	}
+	d.Prizes = append(d.Prizes, Prize{Title: title, Description: description, Quantity: q})
+}
+
+// addChannel normalizes @name, t.me links and numeric ids.
+func (d *Definition) addChannel(v string) {
+	v = strings.TrimSpace(v)
+	for _, p := range []string{"https://", "http://", "t.me/", "telegram.me/", "@"} {
+		v = strings.TrimPrefix(v, p)
+	}
+	v = strings.TrimSuffix(v, "/")
+	if v == "" {
+		return
+	}
+	if strings.ContainsAny(v, "/?+ ") {
+		d.warn("skipped channel %q: not a public username or id", v)
+		return
+	}
+	d.Channels = append(d.Channels, v)
+}
+
+func parseTime(s string) (time.Time, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(n, 0), true
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func lookup(obj map[string]any, path string) any {
+	if obj == nil || path == "" {
+		return nil
+	}
+	var cur any = obj
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil
+		}
+		cur = m[part]
+	}
+	return cur
+}
+
+func str(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case json.Number:
+		return t.String()
+	case bool:
+		return strconv.FormatBool(t)
+	}
+	return ""
+}
+
+func joinPath(base, field string) string {
+	if field == "" {
+		return base
+	}
+	return base + "." + field
+}
@@ -0,0 +1,108 @@
+package openapi
+
+import (
+	"bufio"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// SourceDocs reads type and field doc comments from the Go sources of the module in root, so
+// the comments on request and response structs become descriptions in the document. Types
+// outside the module get none.
+func SourceDocs(root string) (func(t reflect.Type, field string) string, error) {
+	module, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	var mu sync.Mutex
+	parsed := map[string]map[string]string{}
+	return func(t reflect.Type, field string) string {
+		pkg := t.PkgPath()
+		if pkg != module && !strings.HasPrefix(pkg, module+"/") {
+			return ""
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		docs, ok := parsed[pkg]
+		if !ok {
+			docs = packageDocs(filepath.Join(root, strings.TrimPrefix(pkg, module)))
+			parsed[pkg] = docs
+		}
+		key := t.Name()
+		if field != "" {
+			key += "." + field
+		}
+		return docs[key]
+	}, nil
+}
+
+func modulePath(goMod string) (string, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(sc.Text()), "module "); ok {
+			return strings.TrimSpace(rest), nil
+		}
+	}
+	return "", sc.Err()
+}
+
+// packageDocs maps "Type" and "Type.Field" to their comments for the package in dir.
+func packageDocs(dir string) map[string]string {
+	docs := map[string]string{}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return docs
+	}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					doc := ts.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					docs[ts.Name.Name] = commentText(doc)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					for _, f := range st.Fields.List {
+						text := commentText(f.Doc)
+						if text == "" {
+							text = commentText(f.Comment)
+						}
+						for _, n := range f.Names {
+							docs[ts.Name.Name+"."+n.Name] = text
+						}
+					}
+				}
+			}
+		}
+	}
+	return docs
+}
+
+func commentText(g *ast.CommentGroup) string {
+	if g == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(g.Text()), " ")
+}
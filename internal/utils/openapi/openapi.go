@@ -0,0 +1,322 @@
+// Package openapi builds an OpenAPI 3.0 document from the Go request and response types of
+// the HTTP API, so typed clients are generated from the same structs the handlers encode.
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Param is a path or query parameter.
+type Param struct {
+	Name string
+	// In is "path" or "query"
+	In string
+	// Type is the JSON schema type: "string" (default), "integer" or "boolean"
+	Type        string
+	Description string
+}
+
+// Endpoint describes one route. Request and Response hold a value of the body type and are
+// nil when there is no body.
+type Endpoint struct {
+	Method string
+	// Path uses Fiber syntax, e.g. /giveaways/:id; undeclared path params are strings
+	Path    string
+	Summary string
+	Tag     string
+	Params  []Param
+	// OperationID names the generated client method
+	OperationID string
+	Request     any
+	Response    any
+	// OptionalBody marks a request body the client may leave out
+	OptionalBody bool
+	// Status is the success status; 200 by default, 204 without a response body
+	Status int
+	// Public endpoints skip init-data authentication
+	Public bool
+}
+
+// Spec describes the API a document is built for.
+type Spec struct {
+	Title   string
+	Version string
+	// Server is the base path of every endpoint, e.g. /api/v1
+	Server    string
+	Endpoints []Endpoint
+	// Error is the body of failed requests, listed as the default response
+	Error any
+	// Docs returns the doc comment of a type (field "") or of one of its fields; optional
+	Docs func(t reflect.Type, field string) string
+}
+
+// initDataHeader carries the Telegram init-data that authenticates mini app calls.
+const initDataHeader = "X-Telegram-Init-Data"
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	rawJSONType = reflect.TypeOf(json.RawMessage{})
+	pathParam   = regexp.MustCompile(`:([A-Za-z_][A-Za-z0-9_]*)`)
+	nonWord     = regexp.MustCompile(`[^A-Za-z0-9]+`)
+)
+
+// Build renders spec as indented JSON. Map keys are sorted, so the output is stable.
+func Build(spec Spec) ([]byte, error) {
+	b := &builder{schemas: map[string]any{}, names: map[reflect.Type]string{}, docs: spec.Docs}
+	paths := map[string]map[string]any{}
+	for _, e := range spec.Endpoints {
+		path := pathParam.ReplaceAllString(e.Path, "{$1}")
+		if paths[path] == nil {
+			paths[path] = map[string]any{}
+		}
+		paths[path][strings.ToLower(e.Method)] = b.operation(e, spec.Error)
+	}
+	doc := map[string]any{
+		"openapi": "3.0.3",
+		"info":    map[string]any{"title": spec.Title, "version": spec.Version},
+		"servers": []any{map[string]any{"url": spec.Server}},
+		"paths":   paths,
+		"security": []any{
+			map[string]any{"initData": []string{}},
+		},
+		"components": map[string]any{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]any{
+				"initData": map[string]any{"type": "apiKey", "in": "header", "name": initDataHeader},
+			},
+		},
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+type builder struct {
+	schemas map[string]any
+	names   map[reflect.Type]string
+	docs    func(t reflect.Type, field string) string
+}
+
+func (b *builder) operation(e Endpoint, errBody any) map[string]any {
+	op := map[string]any{}
+	if e.Summary != "" {
+		op["summary"] = e.Summary
+	}
+	if e.Tag != "" {
+		op["tags"] = []string{e.Tag}
+	}
+	if e.OperationID != "" {
+		op["operationId"] = e.OperationID
+	}
+	if e.Public {
+		op["security"] = []any{}
+	}
+	if params := b.params(e); len(params) > 0 {
+		op["parameters"] = params
+	}
+	if e.Request != nil {
+		op["requestBody"] = map[string]any{
+			"required": !e.OptionalBody,
+			"content":  jsonContent(b.schema(reflect.TypeOf(e.Request))),
+		}
+	}
+	status := e.Status
+	if status == 0 {
+		status = http.StatusOK
+		if e.Response == nil {
+			status = http.StatusNoContent
+		}
+	}
+	ok := map[string]any{"description": http.StatusText(status)}
+	if e.Response != nil {
+		ok["content"] = jsonContent(b.schema(reflect.TypeOf(e.Response)))
+	}
+	responses := map[string]any{strconv.Itoa(status): ok}
+	if errBody != nil {
+		responses["default"] = map[string]any{
+			"description": "Error",
+			"content":     jsonContent(b.schema(reflect.TypeOf(errBody))),
+		}
+	}
+	op["responses"] = responses
+	return op
+}
+
+// params lists the declared parameters plus any path parameter left undeclared.
+func (b *builder) params(e Endpoint) []any {
+	declared := map[string]bool{}
+	var out []any
+	add := func(p Param) {
+		typ := p.Type
+		if typ == "" {
+			typ = "string"
+		}
+		m := map[string]any{"name": p.Name, "in": p.In, "schema": map[string]any{"type": typ}}
+		if p.In == "path" {
+			m["required"] = true
+		}
+		if p.Description != "" {
+			m["description"] = p.Description
+		}
+		out = append(out, m)
+	}
+	for _, p := range e.Params {
+		if p.In == "path" {
+			declared[p.Name] = true
+		}
+	}
+	for _, m := range pathParam.FindAllStringSubmatch(e.Path, -1) {
+		if !declared[m[1]] {
+			add(Param{Name: m[1], In: "path"})
+		}
+	}
+	for _, p := range e.Params {
+		add(p)
+	}
+	return out
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// schema returns the schema of t; named structs are registered as components and referenced.
+func (b *builder) schema(t reflect.Type) map[string]any {
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case rawJSONType:
+		return map[string]any{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		s := b.schema(t.Elem())
+		if _, ref := s["$ref"]; ref {
+			return map[string]any{"allOf": []any{s}, "nullable": true}
+		}
+		s["nullable"] = true
+		return s
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return map[string]any{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+		return map[string]any{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + b.register(t)}
+	default:
+		return map[string]any{}
+	}
+}
+
+// register adds the component for t once and returns its name.
+func (b *builder) register(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+	name := b.name(t)
+	b.names[t] = name
+	// Register before building so recursive types end in a reference
+	b.schemas[name] = map[string]any{}
+	s := b.object(t)
+	if doc := b.doc(t, ""); doc != "" {
+		s["description"] = doc
+	}
+	b.schemas[name] = s
+	return name
+}
+
+// name is the type name for the dto package and the package name plus the type name
+// otherwise, e.g. GiveawayPrizeSummary. Names already starting with the package name are
+// kept unless the package name is the whole name, so dto.Giveaway and the domain Giveaway
+// (GiveawayGiveaway) never collide.
+func (b *builder) name(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	name := nonWord.ReplaceAllString(t.Name(), "")
+	if pkg == "dto" || pkg == "" {
+		return name
+	}
+	prefix := strings.ToUpper(pkg[:1]) + pkg[1:]
+	if !strings.HasPrefix(name, prefix) || name == prefix {
+		name = prefix + name
+	}
+	return name
+}
+
+// object describes the fields of struct t the way encoding/json writes them.
+func (b *builder) object(t reflect.Type) map[string]any {
+	props := map[string]any{}
+	var required []string
+	b.fields(t, props, &required)
+	s := map[string]any{"type": "object", "properties": props}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func (b *builder) fields(t reflect.Type, props map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				b.fields(ft, props, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s := b.schema(f.Type)
+		if doc := b.doc(t, f.Name); doc != "" {
+			if _, ref := s["$ref"]; ref {
+				s = map[string]any{"allOf": []any{s}}
+			}
+			s["description"] = doc
+		}
+		props[name] = s
+		if !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Pointer {
+			*required = append(*required, name)
+		}
+	}
+}
+
+func (b *builder) doc(t reflect.Type, field string) string {
+	if b.docs == nil {
+		return ""
+	}
+	return b.docs(t, field)
+}
@@ -0,0 +1,332 @@
+// Package richtext sanitizes user supplied descriptions. Input may mix a small
+// Markdown subset with HTML; output is always the subset of HTML that Telegram
+// accepts with parse_mode=HTML, so the stored value can be posted as is and
+// rendered by the web app with the same whitelist.
+package richtext
+
+import (
+	"html"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	nethtml "golang.org/x/net/html"
+)
+
+// allowedTags maps accepted input tags to their canonical Telegram tag.
+var allowedTags = map[string]string{
+	"b": "b", "strong": "b",
+	"i": "i", "em": "i",
+	"u": "u", "ins": "u",
+	"s": "s", "strike": "s", "del": "s",
+	"code":       "code",
+	"pre":        "pre",
+	"a":          "a",
+	"blockquote": "blockquote",
+	"tg-spoiler": "tg-spoiler",
+}
+
+// blockTags end with a line break when their markup is dropped.
+var blockTags = map[string]bool{"p": true, "div": true, "li": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true}
+
+// droppedTags lose their content together with the markup.
+var droppedTags = map[string]bool{"script": true, "style": true, "iframe": true, "object": true, "template": true}
+
+// Sanitize converts Markdown and HTML input into whitelisted Telegram HTML.
+// Unknown tags are removed while their text is kept; attributes other than a
+// safe href are dropped and every tag is closed.
+func Sanitize(input string) string {
+	input = strings.TrimSpace(strings.ReplaceAll(input, "\r\n", "\n"))
+	if input == "" {
+		return ""
+	}
+	s := &sanitizer{}
+	z := nethtml.NewTokenizer(strings.NewReader(input))
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		tok := z.Token()
+		switch tt {
+		case nethtml.TextToken:
+			if s.dropDepth > 0 {
+				continue
+			}
+			if s.inCode() {
+				s.out.WriteString(html.EscapeString(tok.Data))
+			} else {
+				s.out.WriteString(markdown(tok.Data, !s.isOpen("a")))
+			}
+		case nethtml.StartTagToken, nethtml.SelfClosingTagToken:
+			s.start(tok, tt == nethtml.SelfClosingTagToken)
+		case nethtml.EndTagToken:
+			s.end(tok.Data)
+		}
+	}
+	s.closeAll()
+	return strings.TrimSpace(s.out.String())
+}
+
+// Plain returns sanitized HTML as plain text for clients and exports that cannot render markup.
+func Plain(sanitized string) string {
+	if sanitized == "" {
+		return ""
+	}
+	var b strings.Builder
+	z := nethtml.NewTokenizer(strings.NewReader(sanitized))
+	for {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		if tt == nethtml.TextToken {
+			b.WriteString(z.Token().Data)
+		}
+	}
+	return b.String()
+}
+
+// Truncate shortens sanitized HTML to at most limit visible characters, ending with an
+// ellipsis and closing any open tags. Telegram counts caption and message limits on
+// the text without markup, so this is the length that matters for announcements.
+func Truncate(sanitized string, limit int) string {
+	if limit <= 0 || utf8.RuneCountInString(Plain(sanitized)) <= limit {
+		return sanitized
+	}
+	s := &sanitizer{}
+	left := limit - 1 // room for the ellipsis
+	z := nethtml.NewTokenizer(strings.NewReader(sanitized))
+	for left > 0 {
+		tt := z.Next()
+		if tt == nethtml.ErrorToken {
+			break
+		}
+		tok := z.Token()
+		switch tt {
+		case nethtml.TextToken:
+			r := []rune(tok.Data)
+			if len(r) > left {
+				r = r[:left]
+			}
+			left -= len(r)
+			s.out.WriteString(html.EscapeString(string(r)))
+		case nethtml.StartTagToken:
+			s.start(tok, false)
+		case nethtml.EndTagToken:
+			s.end(tok.Data)
+		}
+	}
+	s.out.WriteString("…")
+	s.closeAll()
+	return s.out.String()
+}
+
+type sanitizer struct {
+	out       strings.Builder
+	open      []string
+	dropDepth int
+}
+
+func (s *sanitizer) inCode() bool {
+	for _, t := range s.open {
+		if t == "code" || t == "pre" {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *sanitizer) start(tok nethtml.Token, selfClosing bool) {
+	name := tok.Data
+	if droppedTags[name] {
+		if !selfClosing {
+			s.dropDepth++
+		}
+		return
+	}
+	if s.dropDepth > 0 {
+		return
+	}
+	if name == "br" {
+		s.out.WriteString("\n")
+		return
+	}
+	if name == "span" && attr(tok, "class") == "tg-spoiler" {
+		name = "tg-spoiler"
+	}
+	canon, ok := allowedTags[name]
+	if !ok || selfClosing {
+		return
+	}
+	switch canon {
+	case "a":
+		href := safeURL(attr(tok, "href"))
+		if href == "" || s.isOpen("a") {
+			return
+		}
+		s.out.WriteString(`<a href="` + html.EscapeString(href) + `">`)
+	default:
+		s.out.WriteString("<" + canon + ">")
+	}
+	s.open = append(s.open, canon)
+}
+
+func (s *sanitizer) end(name string) {
+	if droppedTags[name] {
+		if s.dropDepth > 0 {
+			s.dropDepth--
+		}
+		return
+	}
+	if s.dropDepth > 0 {
+		return
+	}
+	if blockTags[name] {
+		s.out.WriteString("\n")
+		return
+	}
+	if name == "span" {
+		// Only spoiler spans are kept; a stray </span> closes the innermost spoiler
+		name = "tg-spoiler"
+	}
+	canon, ok := allowedTags[name]
+	if !ok || !s.isOpen(canon) {
+		return
+	}
+	// Close everything above the matching tag so the output stays well nested
+	for len(s.open) > 0 {
+		top := s.open[len(s.open)-1]
+		s.open = s.open[:len(s.open)-1]
+		s.out.WriteString("</" + top + ">")
+		if top == canon {
+			return
+		}
+	}
+}
+
+func (s *sanitizer) closeAll() {
+	for i := len(s.open) - 1; i >= 0; i-- {
+		s.out.WriteString("</" + s.open[i] + ">")
+	}
+	s.open = nil
+}
+
+func (s *sanitizer) isOpen(tag string) bool {
+	for _, t := range s.open {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(tok nethtml.Token, key string) string {
+	for _, a := range tok.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// safeURL accepts absolute http(s) and tg:// links only.
+func safeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return ""
+		}
+	case "tg":
+	default:
+		return ""
+	}
+	return u.String()
+}
+
+// mdDelims are the inline Markdown markers, longest first so "**" wins over "*".
+var mdDelims = []struct{ marker, tag string }{
+	{"**", "b"},
+	{"__", "u"},
+	{"~~", "s"},
+	{"||", "tg-spoiler"},
+	{"*", "i"},
+	{"_", "i"},
+}
+
+// markdown converts inline Markdown in a plain text run to escaped HTML.
+func markdown(text string, links bool) string {
+	var b strings.Builder
+	for i := 0; i < len(text); {
+		if text[i] == '`' {
+			if j := strings.IndexByte(text[i+1:], '`'); j > 0 {
+				b.WriteString("<code>" + html.EscapeString(text[i+1:i+1+j]) + "</code>")
+				i += j + 2
+				continue
+			}
+		}
+		if text[i] == '[' && links {
+			if label, href, n, ok := mdLink(text[i:]); ok {
+				b.WriteString(`<a href="` + html.EscapeString(href) + `">` + markdown(label, false) + "</a>")
+				i += n
+				continue
+			}
+		}
+		if n, ok := mdEmphasis(&b, text, i, links); ok {
+			i += n
+			continue
+		}
+		b.WriteString(html.EscapeString(text[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+// mdEmphasis renders a delimited span starting at text[i] and reports the bytes consumed.
+func mdEmphasis(b *strings.Builder, text string, i int, links bool) (int, bool) {
+	for _, d := range mdDelims {
+		if !strings.HasPrefix(text[i:], d.marker) {
+			continue
+		}
+		rest := text[i+len(d.marker):]
+		j := strings.Index(rest, d.marker)
+		if j <= 0 || rest[0] == ' ' || rest[j-1] == ' ' {
+			continue
+		}
+		// Underscores inside identifiers such as snake_case are literal
+		if d.marker == "_" {
+			after := i + len(d.marker) + j + len(d.marker)
+			if (i > 0 && isWordByte(text[i-1])) || (after < len(text) && isWordByte(text[after])) {
+				continue
+			}
+		}
+		b.WriteString("<" + d.tag + ">" + markdown(rest[:j], links) + "</" + d.tag + ">")
+		return len(d.marker)*2 + j, true
+	}
+	return 0, false
+}
+
+// mdLink parses [label](url) at the start of text.
+func mdLink(text string) (label, href string, n int, ok bool) {
+	mid := strings.Index(text, "](")
+	if mid <= 1 || strings.ContainsAny(text[1:mid], "[]\n") {
+		return "", "", 0, false
+	}
+	end := strings.IndexByte(text[mid+2:], ')')
+	if end <= 0 {
+		return "", "", 0, false
+	}
+	href = safeURL(text[mid+2 : mid+2+end])
+	if href == "" {
+		return "", "", 0, false
+	}
+	return text[1:mid], href, mid + 2 + end + 1, true
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
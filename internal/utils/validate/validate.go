@@ -0,0 +1,95 @@
+// Package validate collects field-level errors of a request payload, so clients get every
+// problem at once instead of the first one, with messages in the request locale.
+package validate
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/open-builders/giveaway-backend/internal/utils/i18n"
+)
+
+// FieldError is one failed rule. Field is the JSON path, e.g. "prizes[0].title"; Code is
+// stable for clients, Message is localized.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Errors accumulates failed rules. The zero value is not usable; call New.
+type Errors struct {
+	loc  *i18n.Locale
+	list []FieldError
+}
+
+// New starts a validation rendering messages in loc (English when nil).
+func New(loc *i18n.Locale) *Errors {
+	if loc == nil {
+		loc = i18n.Match()
+	}
+	return &Errors{loc: loc}
+}
+
+// Add records a failed rule. The message is the "validate.<code>" translation formatted
+// with field followed by args.
+func (v *Errors) Add(field, code string, args ...any) {
+	msg := fmt.Sprintf(v.loc.T("validate."+code), append([]any{field}, args...)...)
+	v.list = append(v.list, FieldError{Field: field, Code: code, Message: msg})
+}
+
+// Check records code when ok is false.
+func (v *Errors) Check(ok bool, field, code string, args ...any) {
+	if !ok {
+		v.Add(field, code, args...)
+	}
+}
+
+// Required rejects an empty string.
+func (v *Errors) Required(field, value string) {
+	v.Check(value != "", field, "required")
+}
+
+// NotNegative rejects values below zero.
+func (v *Errors) NotNegative(field string, value int64) {
+	v.Check(value >= 0, field, "not_negative")
+}
+
+// Min rejects values below min.
+func (v *Errors) Min(field string, value, min int64) {
+	v.Check(value >= min, field, "min", min)
+}
+
+// Max rejects values above max.
+func (v *Errors) Max(field string, value, max int64) {
+	v.Check(value <= max, field, "max", max)
+}
+
+// Between rejects values outside [min, max].
+func (v *Errors) Between(field string, value, min, max int64) {
+	v.Check(value >= min && value <= max, field, "between", min, max)
+}
+
+// MaxLen rejects strings longer than max characters.
+func (v *Errors) MaxLen(field, value string, max int) {
+	v.Check(utf8.RuneCountInString(value) <= max, field, "max_length", max)
+}
+
+// OK reports whether no rule failed.
+func (v *Errors) OK() bool { return len(v.list) == 0 }
+
+// List returns the failed rules in the order they were checked.
+func (v *Errors) List() []FieldError { return v.list }
+
+// Error returns the first message, for clients that only read a single error.
+func (v *Errors) Error() string {
+	if len(v.list) == 0 {
+		return ""
+	}
+	return v.list[0].Message
+}
+
+// Field formats a JSON path element of a list, e.g. Field("prizes", 0, "title").
+func Field(list string, i int, name string) string {
+	return fmt.Sprintf("%s[%d].%s", list, i, name)
+}
@@ -0,0 +1,122 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/platform/redis"
+	"github.com/open-builders/giveaway-backend/internal/service/analytics"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+const (
+	analyticsGroup    = "analytics_exporters"
+	analyticsConsumer = "analytics_exporter_1"
+)
+
+// AnalyticsWorker ships buffered analytics events to the configured sink in batches.
+// Entries are acknowledged only after the sink accepted them; a failed batch stays
+// in the consumer's pending list and is retried before new entries are read.
+type AnalyticsWorker struct {
+	rdb       *redis.Client
+	sink      analytics.Sink
+	interval  time.Duration
+	batchSize int64
+}
+
+func NewAnalyticsWorker(rdb *redis.Client, sink analytics.Sink, interval time.Duration, batchSize int) *AnalyticsWorker {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &AnalyticsWorker{rdb: rdb, sink: sink, interval: interval, batchSize: int64(batchSize)}
+}
+
+// Start runs the export loop until ctx is cancelled.
+func (w *AnalyticsWorker) Start(ctx context.Context) {
+	err := w.rdb.XGroupCreateMkStream(ctx, analytics.Stream, analyticsGroup, "0").Err()
+	if err != nil && err.Error() != "BUSYGROUP Consumer Group name already exists" {
+		log.Printf("analytics: create consumer group: %v", err)
+	}
+	log.Printf("Starting analytics worker (sink=%s)...", w.sink.Name())
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping analytics worker...")
+			return
+		default:
+		}
+		n, err := w.exportBatch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("analytics export error: %v", err)
+		}
+		if err != nil || n < int(w.batchSize) {
+			// Let events accumulate into reasonably sized batches
+			select {
+			case <-ctx.Done():
+			case <-time.After(w.interval):
+			}
+		}
+	}
+}
+
+// exportBatch retries this consumer's pending entries first, then reads new ones.
+func (w *AnalyticsWorker) exportBatch(ctx context.Context) (int, error) {
+	msgs, err := w.read(ctx, "0")
+	if err != nil {
+		return 0, err
+	}
+	if len(msgs) == 0 {
+		if msgs, err = w.read(ctx, ">"); err != nil {
+			return 0, err
+		}
+	}
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+	ids := make([]string, 0, len(msgs))
+	events := make([]analytics.Event, 0, len(msgs))
+	for _, m := range msgs {
+		ids = append(ids, m.ID)
+		if e, ok := analytics.DecodeStreamValues(m.Values); ok {
+			events = append(events, e)
+		}
+	}
+	if err := w.sink.Write(ctx, events); err != nil {
+		return 0, err
+	}
+	if err := w.rdb.XAck(ctx, analytics.Stream, analyticsGroup, ids...).Err(); err != nil {
+		return 0, err
+	}
+	// Delivered entries are no longer needed in the buffer
+	_ = w.rdb.XDel(ctx, analytics.Stream, ids...).Err()
+	return len(msgs), nil
+}
+
+func (w *AnalyticsWorker) read(ctx context.Context, start string) ([]go_redis.XMessage, error) {
+	res, err := w.rdb.XReadGroup(ctx, &go_redis.XReadGroupArgs{
+		Group:    analyticsGroup,
+		Consumer: analyticsConsumer,
+		Streams:  []string{analytics.Stream, start},
+		Count:    w.batchSize,
+		Block:    -1, // never block; the loop paces itself
+	}).Result()
+	if errors.Is(err, go_redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out []go_redis.XMessage
+	for _, s := range res {
+		out = append(out, s.Messages...)
+	}
+	return out, nil
+}
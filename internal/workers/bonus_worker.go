@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// BonusWorker periodically re-checks bonus tasks and updates participant tickets.
+type BonusWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewBonusWorker(svc *gsvc.Service, interval time.Duration) *BonusWorker {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &BonusWorker{svc: svc, interval: interval}
+}
+
+// Start runs the re-check loop until ctx is cancelled.
+func (w *BonusWorker) Start(ctx context.Context) {
+	log.Println("Starting bonus tickets worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping bonus tickets worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.RecheckBonusTickets(ctx); err != nil {
+				log.Printf("bonus worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("bonus worker updated tickets of %d participants", n)
+			}
+		}
+	}
+}
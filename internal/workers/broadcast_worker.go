@@ -0,0 +1,84 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	notify "github.com/open-builders/giveaway-backend/internal/service/notifications"
+	tg "github.com/open-builders/giveaway-backend/internal/service/telegram"
+)
+
+// BroadcastWorker delivers queued giveaway broadcasts to participants in small batches,
+// keeping the bot well below Telegram's global send limit.
+type BroadcastWorker struct {
+	repo     *postgres.BroadcastRepository
+	ntf      *notify.Service
+	interval time.Duration
+	batch    int
+}
+
+func NewBroadcastWorker(repo *postgres.BroadcastRepository, ntf *notify.Service, interval time.Duration, batch int) *BroadcastWorker {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	if batch <= 0 {
+		batch = 20
+	}
+	return &BroadcastWorker{repo: repo, ntf: ntf, interval: interval, batch: batch}
+}
+
+// Start runs the delivery loop until ctx is cancelled.
+func (w *BroadcastWorker) Start(ctx context.Context) {
+	log.Println("Starting broadcast worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping broadcast worker...")
+			return
+		case <-ticker.C:
+			if err := w.step(ctx); err != nil {
+				log.Printf("broadcast worker error: %v", err)
+			}
+		}
+	}
+}
+
+// step sends one batch of the oldest pending broadcast. Failed sends are counted and
+// skipped: users who blocked the bot must not stall the queue. A full send queue ends the
+// batch early; the rest is retried on the next tick.
+func (w *BroadcastWorker) step(ctx context.Context) error {
+	b, err := w.repo.NextPending(ctx)
+	if err != nil || b == nil {
+		return err
+	}
+	ids, err := w.repo.ParticipantsAfter(ctx, b.GiveawayID, b.LastUserID, w.batch)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		log.Printf("broadcast %d (%s) for giveaway %s done: %d sent, %d failed", b.ID, b.Kind, b.GiveawayID, b.Sent, b.Failed)
+		return w.repo.Finish(ctx, b.ID)
+	}
+	sent, failed := 0, 0
+	var last int64
+	for _, uid := range ids {
+		if err := w.ntf.SendBroadcastDM(ctx, b, uid); err != nil {
+			if errors.Is(err, tg.ErrSendQueueFull) {
+				break
+			}
+			failed++
+		} else {
+			sent++
+		}
+		last = uid
+	}
+	if last == 0 {
+		return nil
+	}
+	return w.repo.Advance(ctx, b.ID, last, sent, failed)
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// CacheWarmWorker periodically rebuilds the page cache of the trending giveaways.
+type CacheWarmWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+	top      int
+}
+
+func NewCacheWarmWorker(svc *gsvc.Service, interval time.Duration, top int) *CacheWarmWorker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if top <= 0 {
+		top = 50
+	}
+	return &CacheWarmWorker{svc: svc, interval: interval, top: top}
+}
+
+// Start warms right away, so a fresh deploy serves trending pages from cache, and then on
+// every tick until ctx is cancelled. Pages live for two ticks, so one failed run leaves them
+// cached.
+func (w *CacheWarmWorker) Start(ctx context.Context) {
+	log.Println("Starting cache warm worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.svc.WarmPages(ctx, w.top, 2*w.interval); err != nil {
+			log.Printf("cache warm worker error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping cache warm worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
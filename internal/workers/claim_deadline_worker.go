@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// ClaimDeadlineWorker replaces winners who missed the claim deadline with reserve winners.
+type ClaimDeadlineWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewClaimDeadlineWorker(svc *gsvc.Service, interval time.Duration) *ClaimDeadlineWorker {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	return &ClaimDeadlineWorker{svc: svc, interval: interval}
+}
+
+// Start runs the promotion loop until ctx is cancelled.
+func (w *ClaimDeadlineWorker) Start(ctx context.Context) {
+	log.Println("Starting claim deadline worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping claim deadline worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.PromoteMissedClaims(ctx); err != nil {
+				log.Printf("claim deadline worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("claim deadline worker promoted %d reserves", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/service/crm"
+)
+
+// CRMWebhookWorker posts new participants to creator CRM webhooks in batches.
+type CRMWebhookWorker struct {
+	svc      *crm.Service
+	interval time.Duration
+}
+
+func NewCRMWebhookWorker(svc *crm.Service, interval time.Duration) *CRMWebhookWorker {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &CRMWebhookWorker{svc: svc, interval: interval}
+}
+
+// Start runs the delivery loop until ctx is cancelled.
+func (w *CRMWebhookWorker) Start(ctx context.Context) {
+	log.Println("Starting CRM webhook worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping CRM webhook worker...")
+			return
+		case <-ticker.C:
+			if _, err := w.svc.DeliverDue(ctx); err != nil {
+				log.Printf("CRM webhook worker error: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// DeadGiveawayWorker periodically queues giveaways that can no longer complete for admin review.
+type DeadGiveawayWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewDeadGiveawayWorker(svc *gsvc.Service, interval time.Duration) *DeadGiveawayWorker {
+	if interval <= 0 {
+		interval = 10 * time.Minute
+	}
+	return &DeadGiveawayWorker{svc: svc, interval: interval}
+}
+
+// Start runs the detection loop until ctx is cancelled.
+func (w *DeadGiveawayWorker) Start(ctx context.Context) {
+	log.Println("Starting dead giveaway worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping dead giveaway worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.DetectDeadGiveaways(ctx); err != nil {
+				log.Printf("dead giveaway worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("dead giveaway worker queued %d giveaways for review", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	deletionsvc "github.com/open-builders/giveaway-backend/internal/service/deletion"
+)
+
+// DeletionWorker cleans up after deleted giveaways.
+type DeletionWorker struct {
+	svc      *deletionsvc.Service
+	interval time.Duration
+}
+
+func NewDeletionWorker(svc *deletionsvc.Service, interval time.Duration) *DeletionWorker {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &DeletionWorker{svc: svc, interval: interval}
+}
+
+// Start runs the deletion loop until ctx is cancelled.
+func (w *DeletionWorker) Start(ctx context.Context) {
+	log.Println("Starting deletion worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping deletion worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.ProcessDue(ctx); err != nil {
+				log.Printf("deletion worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("deletion worker completed %d deletions", n)
+			}
+		}
+	}
+}
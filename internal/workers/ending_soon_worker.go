@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// EndingSoonWorker periodically rebuilds the Redis set behind the ending soon feed.
+type EndingSoonWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewEndingSoonWorker(svc *gsvc.Service, interval time.Duration) *EndingSoonWorker {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	return &EndingSoonWorker{svc: svc, interval: interval}
+}
+
+// Start syncs once right away and then on every tick until ctx is cancelled.
+func (w *EndingSoonWorker) Start(ctx context.Context) {
+	log.Println("Starting ending soon worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.svc.SyncEndingSoon(ctx); err != nil {
+			log.Printf("ending soon worker error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping ending soon worker...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
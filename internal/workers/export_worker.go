@@ -0,0 +1,47 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/service/exports"
+)
+
+// ExportWorker renders queued giveaway exports into file storage.
+type ExportWorker struct {
+	svc      *exports.Service
+	interval time.Duration
+}
+
+func NewExportWorker(svc *exports.Service, interval time.Duration) *ExportWorker {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	return &ExportWorker{svc: svc, interval: interval}
+}
+
+// Start runs queued jobs on every tick until ctx is cancelled. A tick works through the
+// queue, one job at a time.
+func (w *ExportWorker) Start(ctx context.Context) {
+	log.Println("Starting export worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping export worker...")
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				ran, err := w.svc.Step(ctx)
+				if err != nil {
+					log.Printf("export worker error: %v", err)
+				}
+				if !ran {
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// FundingWorker periodically credits escrow deposits, cancels giveaways that ended unfunded
+// and prepares screened payouts for completed ones.
+type FundingWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewFundingWorker(svc *gsvc.Service, interval time.Duration) *FundingWorker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &FundingWorker{svc: svc, interval: interval}
+}
+
+// Start runs the polling loop until ctx is cancelled.
+func (w *FundingWorker) Start(ctx context.Context) {
+	log.Println("Starting funding worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping funding worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.PollFunding(ctx); err != nil {
+				log.Printf("funding worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("funding worker funded %d giveaways", n)
+			}
+			if n, err := w.svc.PreparePayouts(ctx); err != nil {
+				log.Printf("funding worker payouts error: %v", err)
+			} else if n > 0 {
+				log.Printf("funding worker prepared payouts for %d giveaways", n)
+			}
+		}
+	}
+}
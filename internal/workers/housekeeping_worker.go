@@ -0,0 +1,45 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/open-builders/giveaway-backend/internal/service/housekeeping"
+)
+
+// HousekeepingWorker periodically removes orphaned Redis keys.
+type HousekeepingWorker struct {
+	svc      *housekeeping.Service
+	interval time.Duration
+}
+
+func NewHousekeepingWorker(svc *housekeeping.Service, interval time.Duration) *HousekeepingWorker {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &HousekeepingWorker{svc: svc, interval: interval}
+}
+
+// Start runs the cleanup loop until ctx is cancelled.
+func (w *HousekeepingWorker) Start(ctx context.Context) {
+	log.Println("Starting housekeeping worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping housekeeping worker...")
+			return
+		case <-ticker.C:
+			res, err := w.svc.Run(ctx)
+			if err != nil {
+				log.Printf("housekeeping worker error: %v", err)
+				continue
+			}
+			if n := res.Reclaimed[housekeeping.KindPreparedMessages] + res.Reclaimed[housekeeping.KindExportTokens] + res.Reclaimed[housekeeping.KindChannelAvatars]; n > 0 {
+				log.Printf("housekeeping worker reclaimed %d of %d keys", n, res.Scanned)
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	dg "github.com/open-builders/giveaway-backend/internal/domain/giveaway"
+	"github.com/open-builders/giveaway-backend/internal/platform/eventbus"
+	"github.com/open-builders/giveaway-backend/internal/repository/postgres"
+)
+
+const (
+	outboxBatchSize = 100
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// OutboxWorker relays domain events from the outbox table to the event bus and to in-process
+// subscribers. Without a bus, events only reach the subscribers.
+type OutboxWorker struct {
+	repo        *postgres.OutboxRepository
+	bus         eventbus.Bus
+	subscribers []func(context.Context, dg.Event)
+	interval    time.Duration
+}
+
+func NewOutboxWorker(repo *postgres.OutboxRepository, bus eventbus.Bus, interval time.Duration) *OutboxWorker {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &OutboxWorker{repo: repo, bus: bus, interval: interval}
+}
+
+// Subscribe adds an in-process consumer called once the bus accepted an event. Subscribers
+// handle their own errors; they see an event again only if the outbox redelivers it.
+func (w *OutboxWorker) Subscribe(fn func(context.Context, dg.Event)) *OutboxWorker {
+	w.subscribers = append(w.subscribers, fn)
+	return w
+}
+
+// Start runs the relay loop until ctx is cancelled.
+func (w *OutboxWorker) Start(ctx context.Context) {
+	log.Println("Starting outbox worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	lastPurge := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping outbox worker...")
+			return
+		case <-ticker.C:
+			w.drain(ctx)
+			if time.Since(lastPurge) >= time.Hour {
+				lastPurge = time.Now()
+				if n, err := w.repo.DeletePublishedBefore(ctx, time.Now().Add(-outboxRetention)); err != nil {
+					log.Printf("outbox purge error: %v", err)
+				} else if n > 0 {
+					log.Printf("outbox purged %d published events", n)
+				}
+			}
+		}
+	}
+}
+
+// drain publishes full batches back to back so a backlog clears within one tick.
+func (w *OutboxWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := w.repo.PublishPending(ctx, outboxBatchSize, w.publish)
+		if err != nil {
+			log.Printf("outbox worker error: %v", err)
+			return
+		}
+		if n < outboxBatchSize {
+			return
+		}
+	}
+}
+
+func (w *OutboxWorker) publish(ctx context.Context, e dg.Event) error {
+	if w.bus != nil {
+		if err := w.bus.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	for _, fn := range w.subscribers {
+		fn(ctx, e)
+	}
+	return nil
+}
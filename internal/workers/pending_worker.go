@@ -0,0 +1,48 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// PendingWorker resolves giveaways that stayed pending longer than their pending TTL, after
+// previewing the finish to their admins.
+type PendingWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewPendingWorker(svc *gsvc.Service, interval time.Duration) *PendingWorker {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	return &PendingWorker{svc: svc, interval: interval}
+}
+
+// Start runs the expiry loop until ctx is cancelled.
+func (w *PendingWorker) Start(ctx context.Context) {
+	log.Println("Starting pending expiry worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping pending expiry worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.PreviewPendingFinishes(ctx); err != nil {
+				log.Printf("finish preview error: %v", err)
+			} else if n > 0 {
+				log.Printf("sent finish previews for %d pending giveaways", n)
+			}
+			if n, err := w.svc.ResolveExpiredPending(ctx); err != nil {
+				log.Printf("pending worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("resolved %d expired pending giveaways", n)
+			}
+		}
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"time"
 
 	"github.com/open-builders/giveaway-backend/internal/platform/redis"
-	"github.com/open-builders/giveaway-backend/internal/repository/postgres"
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
 	go_redis "github.com/redis/go-redis/v9"
 )
 
@@ -16,14 +16,14 @@ const consumerGroup = "giveaway_backend_consumers"
 const consumerName = "giveaway_worker_1"
 
 type RedisStreamWorker struct {
-	rdb  *redis.Client
-	repo *postgres.GiveawayRepository
+	rdb *redis.Client
+	svc *gsvc.Service
 }
 
-func NewRedisStreamWorker(rdb *redis.Client, repo *postgres.GiveawayRepository) *RedisStreamWorker {
+func NewRedisStreamWorker(rdb *redis.Client, svc *gsvc.Service) *RedisStreamWorker {
 	return &RedisStreamWorker{
-		rdb:  rdb,
-		repo: repo,
+		rdb: rdb,
+		svc: svc,
 	}
 }
 
@@ -77,25 +77,87 @@ func (w *RedisStreamWorker) processMessage(ctx context.Context, values map[strin
 		return
 	}
 
-	if eventType == "bot_removed" {
-		channelIDStr, ok := values["channel_id"].(string)
-		if !ok {
-			log.Printf("Invalid channel_id in bot_removed event: %v", values)
-			return
-		}
+	switch eventType {
+	case "bot_removed", "bot_demoted", "bot_added", "bot_promoted", "member_joined", "member_left":
+	case "bot_blocked", "bot_unblocked":
+		w.processBotBlocked(ctx, eventType == "bot_blocked", values)
+		return
+	default:
+		return
+	}
+
+	channelIDStr, ok := values["channel_id"].(string)
+	if !ok {
+		log.Printf("Invalid channel_id in %s event: %v", eventType, values)
+		return
+	}
+
+	channelID, err := strconv.ParseInt(channelIDStr, 10, 64)
+	if err != nil {
+		log.Printf("Error parsing channel_id: %v", err)
+		return
+	}
+
+	if eventType == "member_joined" || eventType == "member_left" {
+		w.processMemberEvent(ctx, eventType, channelID, values)
+		return
+	}
+
+	log.Printf("Processing %s event for channel %d", eventType, channelID)
 
-		channelID, err := strconv.ParseInt(channelIDStr, 10, 64)
+	switch eventType {
+	case "bot_removed", "bot_demoted":
+		reason := "bot was removed from the channel"
+		if eventType == "bot_demoted" {
+			reason = "bot lost admin rights in the channel"
+		}
+		n, err := w.svc.HandleBotAccessLost(ctx, channelID, reason)
 		if err != nil {
-			log.Printf("Error parsing channel_id: %v", err)
+			log.Printf("Error marking requirements unverifiable for channel %d: %v", channelID, err)
 			return
 		}
+		log.Printf("Marked requirements unverifiable in %d giveaways for channel %d", n, channelID)
+	case "bot_added", "bot_promoted":
+		if _, err := w.svc.HandleBotAccessRestored(ctx, channelID); err != nil {
+			log.Printf("Error restoring requirements for channel %d: %v", channelID, err)
+		}
+	}
+}
 
-		log.Printf("Processing bot_removed event for channel %d", channelID)
-
-		if err := w.repo.RemoveRequirementsByChannelID(ctx, channelID); err != nil {
-			log.Printf("Error removing requirements for channel %d: %v", channelID, err)
-		} else {
-			log.Printf("Successfully removed requirements for channel %d", channelID)
+// processMemberEvent records a user joining or leaving a channel; "date" is the optional unix time of the change.
+func (w *RedisStreamWorker) processMemberEvent(ctx context.Context, eventType string, channelID int64, values map[string]interface{}) {
+	userIDStr, _ := values["user_id"].(string)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil || userID == 0 {
+		log.Printf("Invalid user_id in %s event: %v", eventType, values)
+		return
+	}
+	var at time.Time
+	if ds, ok := values["date"].(string); ok {
+		if sec, err := strconv.ParseInt(ds, 10, 64); err == nil && sec > 0 {
+			at = time.Unix(sec, 0).UTC()
+		}
+	}
+	if eventType == "member_left" {
+		if err := w.svc.HandleMemberLeft(ctx, channelID, userID, at); err != nil {
+			log.Printf("Error recording leave of user %d in channel %d: %v", userID, channelID, err)
 		}
+		return
+	}
+	if err := w.svc.HandleMemberJoined(ctx, channelID, userID, at); err != nil {
+		log.Printf("Error recording join of user %d in channel %d: %v", userID, channelID, err)
+	}
+}
+
+// processBotBlocked records a user blocking or unblocking the bot in private chat.
+func (w *RedisStreamWorker) processBotBlocked(ctx context.Context, blocked bool, values map[string]interface{}) {
+	userIDStr, _ := values["user_id"].(string)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil || userID == 0 {
+		log.Printf("Invalid user_id in bot block event: %v", values)
+		return
+	}
+	if err := w.svc.HandleCreatorBotBlocked(ctx, userID, blocked); err != nil {
+		log.Printf("Error recording bot block state of user %d: %v", userID, err)
 	}
 }
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	ledgersvc "github.com/open-builders/giveaway-backend/internal/service/ledger"
+)
+
+// RefundWorker returns ticket purchases of cancelled giveaways.
+type RefundWorker struct {
+	svc      *ledgersvc.Service
+	interval time.Duration
+}
+
+func NewRefundWorker(svc *ledgersvc.Service, interval time.Duration) *RefundWorker {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &RefundWorker{svc: svc, interval: interval}
+}
+
+// Start runs the refund loop until ctx is cancelled.
+func (w *RefundWorker) Start(ctx context.Context) {
+	log.Println("Starting refund worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping refund worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.ProcessRefunds(ctx); err != nil {
+				log.Printf("refund worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("refund worker completed %d refunds", n)
+			}
+		}
+	}
+}
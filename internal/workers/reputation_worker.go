@@ -0,0 +1,43 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// ReputationWorker periodically recomputes creator reputation scores and cancels held
+// giveaways that ended before an admin reviewed them.
+type ReputationWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewReputationWorker(svc *gsvc.Service, interval time.Duration) *ReputationWorker {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	return &ReputationWorker{svc: svc, interval: interval}
+}
+
+// Start runs the scoring loop until ctx is cancelled.
+func (w *ReputationWorker) Start(ctx context.Context) {
+	log.Println("Starting reputation worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping reputation worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.RefreshReputations(ctx); err != nil {
+				log.Printf("reputation worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("reputation worker scored %d creators", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// ResultPostWorker posts winners announcements to sponsor channels once their delay elapsed.
+type ResultPostWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewResultPostWorker(svc *gsvc.Service, interval time.Duration) *ResultPostWorker {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &ResultPostWorker{svc: svc, interval: interval}
+}
+
+// Start runs the posting loop until ctx is cancelled.
+func (w *ResultPostWorker) Start(ctx context.Context) {
+	log.Println("Starting result post worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping result post worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.PublishDueResultPosts(ctx); err != nil {
+				log.Printf("result post worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("result post worker posted %d announcements", n)
+			}
+		}
+	}
+}
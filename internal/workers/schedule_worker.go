@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	schedulesvc "github.com/open-builders/giveaway-backend/internal/service/schedule"
+)
+
+// ScheduleWorker periodically delivers due content plan items.
+type ScheduleWorker struct {
+	svc      *schedulesvc.Service
+	interval time.Duration
+}
+
+func NewScheduleWorker(svc *schedulesvc.Service, interval time.Duration) *ScheduleWorker {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &ScheduleWorker{svc: svc, interval: interval}
+}
+
+// Start runs the delivery loop until ctx is cancelled.
+func (w *ScheduleWorker) Start(ctx context.Context) {
+	log.Println("Starting schedule worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping schedule worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.RunDue(ctx); err != nil {
+				log.Printf("schedule worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("schedule worker sent %d posts", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// CompletionSLAWorker periodically alerts admins about giveaways left unfinished past the SLA.
+type CompletionSLAWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewCompletionSLAWorker(svc *gsvc.Service, interval time.Duration) *CompletionSLAWorker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &CompletionSLAWorker{svc: svc, interval: interval}
+}
+
+// Start runs the check loop until ctx is cancelled.
+func (w *CompletionSLAWorker) Start(ctx context.Context) {
+	log.Println("Starting completion SLA worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping completion SLA worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.CheckCompletionSLA(ctx); err != nil {
+				log.Printf("completion SLA worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("completion SLA worker alerted %d overdue giveaways", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	statssvc "github.com/open-builders/giveaway-backend/internal/service/stats"
+)
+
+// StatsWorker periodically recomputes the cached platform statistics.
+type StatsWorker struct {
+	svc      *statssvc.Service
+	interval time.Duration
+}
+
+func NewStatsWorker(svc *statssvc.Service, interval time.Duration) *StatsWorker {
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	return &StatsWorker{svc: svc, interval: interval}
+}
+
+// Start refreshes the statistics right away and then on every tick until ctx is cancelled.
+func (w *StatsWorker) Start(ctx context.Context) {
+	log.Println("Starting stats worker...")
+	if err := w.svc.Refresh(ctx); err != nil {
+		log.Printf("stats worker error: %v", err)
+	}
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping stats worker...")
+			return
+		case <-ticker.C:
+			if err := w.svc.Refresh(ctx); err != nil {
+				log.Printf("stats worker error: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package workers
+
+import (
+	"context"
+	"log"
+	"time"
+
+	gsvc "github.com/open-builders/giveaway-backend/internal/service/giveaway"
+)
+
+// WaveWorker periodically draws due winner waves.
+type WaveWorker struct {
+	svc      *gsvc.Service
+	interval time.Duration
+}
+
+func NewWaveWorker(svc *gsvc.Service, interval time.Duration) *WaveWorker {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &WaveWorker{svc: svc, interval: interval}
+}
+
+// Start runs the draw loop until ctx is cancelled.
+func (w *WaveWorker) Start(ctx context.Context) {
+	log.Println("Starting wave worker...")
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Stopping wave worker...")
+			return
+		case <-ticker.C:
+			if n, err := w.svc.DrawDueWaves(ctx); err != nil {
+				log.Printf("wave worker error: %v", err)
+			} else if n > 0 {
+				log.Printf("wave worker drew %d waves", n)
+			}
+		}
+	}
+}
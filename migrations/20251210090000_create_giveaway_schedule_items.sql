@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_schedule_items (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('launch','midpoint','last_day','results','custom')),
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    text TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','processing','sent','failed','cancelled')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    sent_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_schedule_items_giveaway_idx ON giveaway_schedule_items (giveaway_id);
+CREATE INDEX IF NOT EXISTS giveaway_schedule_items_due_idx ON giveaway_schedule_items (scheduled_at) WHERE status = 'pending';
+
+DROP TRIGGER IF EXISTS giveaway_schedule_items_set_updated_at ON giveaway_schedule_items;
+CREATE TRIGGER giveaway_schedule_items_set_updated_at
+  BEFORE UPDATE ON giveaway_schedule_items
+  FOR EACH ROW
+  EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS giveaway_schedule_items_set_updated_at ON giveaway_schedule_items;
+DROP TABLE IF EXISTS giveaway_schedule_items;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaways
+  ADD COLUMN IF NOT EXISTS winner_strategy TEXT NOT NULL DEFAULT 'random';
+
+ALTER TABLE giveaways
+  DROP CONSTRAINT IF EXISTS giveaways_winner_strategy_check;
+ALTER TABLE giveaways
+  ADD CONSTRAINT giveaways_winner_strategy_check CHECK (winner_strategy IN ('random','weighted','first_n','manual'));
+
+ALTER TABLE giveaway_participants
+  ADD COLUMN IF NOT EXISTS tickets INT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_participants
+  DROP COLUMN IF EXISTS tickets;
+ALTER TABLE giveaways
+  DROP CONSTRAINT IF EXISTS giveaways_winner_strategy_check;
+ALTER TABLE giveaways
+  DROP COLUMN IF EXISTS winner_strategy;
+-- +goose StatementEnd
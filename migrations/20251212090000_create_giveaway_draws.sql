@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_draws (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    strategy TEXT NOT NULL,
+    seed TEXT NOT NULL,
+    participants_hash TEXT NOT NULL,
+    participants_count INT NOT NULL DEFAULT 0,
+    skipped BIGINT[] NOT NULL DEFAULT '{}',
+    winners BIGINT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_draws_giveaway_idx ON giveaway_draws (giveaway_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_draws;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_requirement_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    draw_id BIGINT NULL REFERENCES giveaway_draws(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    requirement_index INT NOT NULL,
+    requirement_type TEXT NOT NULL,
+    channel_id BIGINT NULL,
+    status TEXT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_requirement_snapshots_giveaway_idx ON giveaway_requirement_snapshots (giveaway_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_requirement_snapshots;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS short_links (
+    code TEXT PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('requirement', 'sponsor')),
+    position INT NOT NULL,
+    url TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS short_links_giveaway_idx ON short_links (giveaway_id);
+
+CREATE TABLE IF NOT EXISTS short_link_clicks (
+    id BIGSERIAL PRIMARY KEY,
+    code TEXT NOT NULL REFERENCES short_links(code) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL DEFAULT 0,
+    clicked_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS short_link_clicks_code_idx ON short_link_clicks (code);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS short_link_clicks;
+DROP TABLE IF EXISTS short_links;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaway_participants
+  ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'unknown',
+  ADD COLUMN IF NOT EXISTS source_ref TEXT;
+
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  ADD CONSTRAINT giveaway_participants_source_check CHECK (source IN ('announcement','inline','explore','direct','unknown'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  DROP COLUMN IF EXISTS source_ref,
+  DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
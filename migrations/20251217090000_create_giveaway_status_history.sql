@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    from_status TEXT,
+    to_status TEXT NOT NULL,
+    reason TEXT,
+    actor_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS giveaway_status_history_giveaway_idx ON giveaway_status_history (giveaway_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_status_history;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaways
+  ADD COLUMN IF NOT EXISTS pending_since TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS pending_ttl_sec INT,
+  ADD COLUMN IF NOT EXISTS pending_action TEXT;
+
+ALTER TABLE giveaways
+  DROP CONSTRAINT IF EXISTS giveaways_pending_action_check;
+ALTER TABLE giveaways
+  ADD CONSTRAINT giveaways_pending_action_check CHECK (pending_action IS NULL OR pending_action IN ('draw','cancel'));
+
+-- Giveaways already waiting count from their last update
+UPDATE giveaways SET pending_since = updated_at WHERE status = 'pending' AND pending_since IS NULL;
+
+CREATE INDEX IF NOT EXISTS giveaways_pending_since_idx ON giveaways (pending_since) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaways_pending_since_idx;
+ALTER TABLE giveaways
+  DROP CONSTRAINT IF EXISTS giveaways_pending_action_check;
+ALTER TABLE giveaways
+  DROP COLUMN IF EXISTS pending_action,
+  DROP COLUMN IF EXISTS pending_ttl_sec,
+  DROP COLUMN IF EXISTS pending_since;
+-- +goose StatementEnd
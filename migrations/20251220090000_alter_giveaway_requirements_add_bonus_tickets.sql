@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaway_requirements
+    ADD COLUMN IF NOT EXISTS bonus_tickets INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_requirements
+    DROP COLUMN IF EXISTS bonus_tickets;
+-- +goose StatementEnd
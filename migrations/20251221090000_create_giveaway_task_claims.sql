@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_task_claims (
+    giveaway_id TEXT NOT NULL,
+    user_id BIGINT NOT NULL,
+    requirement_id BIGINT NOT NULL REFERENCES giveaway_requirements(id) ON DELETE CASCADE,
+    bonus_tickets INT NOT NULL,
+    claimed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, user_id, requirement_id),
+    FOREIGN KEY (giveaway_id, user_id) REFERENCES giveaway_participants(giveaway_id, user_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_task_claims;
+-- +goose StatementEnd
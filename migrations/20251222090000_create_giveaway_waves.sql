@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_waves (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    number INT NOT NULL CHECK (number > 0),
+    draw_at TIMESTAMPTZ NOT NULL,
+    winners_count INT NOT NULL CHECK (winners_count > 0),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','drawn','skipped')),
+    draw_id BIGINT NULL REFERENCES giveaway_draws(id) ON DELETE SET NULL,
+    drawn_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (giveaway_id, number)
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_waves_due_idx ON giveaway_waves (draw_at) WHERE status = 'pending';
+
+-- Winners drawn by a wave keep its number; NULL means the final draw
+ALTER TABLE giveaway_winners ADD COLUMN IF NOT EXISTS wave INT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_winners DROP COLUMN IF EXISTS wave;
+DROP TABLE IF EXISTS giveaway_waves;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_verified BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS hide_profile BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS hide_wins BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS hide_wins,
+    DROP COLUMN IF EXISTS hide_profile,
+    DROP COLUMN IF EXISTS is_verified;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaway_winners
+    ADD COLUMN IF NOT EXISTS fulfillment_status TEXT NOT NULL DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMPTZ NULL;
+
+ALTER TABLE giveaway_winners
+    DROP CONSTRAINT IF EXISTS giveaway_winners_fulfillment_status_check;
+ALTER TABLE giveaway_winners
+    ADD CONSTRAINT giveaway_winners_fulfillment_status_check CHECK (fulfillment_status IN ('pending','claimed','delivered'));
+
+-- Win history is read per user, newest first
+CREATE INDEX IF NOT EXISTS giveaway_winners_user_idx ON giveaway_winners (user_id, assigned_at DESC);
+CREATE INDEX IF NOT EXISTS giveaway_winner_prizes_user_idx ON giveaway_winner_prizes (user_id, giveaway_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_winner_prizes_user_idx;
+DROP INDEX IF EXISTS giveaway_winners_user_idx;
+ALTER TABLE giveaway_winners
+    DROP CONSTRAINT IF EXISTS giveaway_winners_fulfillment_status_check;
+ALTER TABLE giveaway_winners
+    DROP COLUMN IF EXISTS delivered_at,
+    DROP COLUMN IF EXISTS claimed_at,
+    DROP COLUMN IF EXISTS fulfillment_status;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_funding (
+    giveaway_id TEXT PRIMARY KEY REFERENCES giveaways(id) ON DELETE CASCADE,
+    memo TEXT NOT NULL UNIQUE,
+    amount_nano BIGINT NOT NULL CHECK (amount_nano > 0),
+    received_nano BIGINT NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'awaiting' CHECK (status IN ('awaiting','funded','expired')),
+    funded_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_funding_awaiting_idx ON giveaway_funding (created_at) WHERE status = 'awaiting';
+
+-- One row per incoming escrow transfer; the hash keeps polling and webhooks idempotent
+CREATE TABLE IF NOT EXISTS giveaway_funding_deposits (
+    tx_hash TEXT PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    lt BIGINT NOT NULL,
+    sender TEXT NOT NULL DEFAULT '',
+    amount_nano BIGINT NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_funding_deposits_giveaway_idx ON giveaway_funding_deposits (giveaway_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_funding_deposits;
+DROP TABLE IF EXISTS giveaway_funding;
+-- +goose StatementEnd
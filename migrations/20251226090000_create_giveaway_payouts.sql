@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_payouts (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    place INT NOT NULL,
+    wallet_address TEXT NOT NULL DEFAULT '',
+    amount_nano BIGINT NOT NULL CHECK (amount_nano >= 0),
+    status TEXT NOT NULL CHECK (status IN ('ready','held','approved','rejected')),
+    screening_result TEXT NOT NULL CHECK (screening_result IN ('clear','flagged','error','no_wallet')),
+    screening_reason TEXT NOT NULL DEFAULT '',
+    screening_provider TEXT NOT NULL DEFAULT '',
+    screened_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    reviewed_by BIGINT NULL,
+    reviewed_at TIMESTAMPTZ NULL,
+    review_note TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (giveaway_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_payouts_held_idx ON giveaway_payouts (created_at) WHERE status = 'held';
+
+-- Wallets that must never receive payouts; addresses are stored in raw form (workchain:hex, lowercase)
+CREATE TABLE IF NOT EXISTS screening_denylist (
+    address TEXT PRIMARY KEY,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS screening_denylist;
+DROP TABLE IF EXISTS giveaway_payouts;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_moderation_flags (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    field TEXT NOT NULL,
+    rule TEXT NOT NULL,
+    match TEXT NOT NULL,
+    lang TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','approved','removed')),
+    reviewed_by BIGINT NULL,
+    reviewed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS giveaway_moderation_flags_giveaway_idx ON giveaway_moderation_flags (giveaway_id);
+CREATE INDEX IF NOT EXISTS giveaway_moderation_flags_pending_idx ON giveaway_moderation_flags (created_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_moderation_flags;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Set when the bot loses access to the requirement channel; cleared when access returns
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS unverifiable_since TIMESTAMPTZ NULL;
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS unverifiable_reason TEXT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS unverifiable_reason;
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS unverifiable_since;
+-- +goose StatementEnd
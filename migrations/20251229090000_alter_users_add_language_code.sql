@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Telegram client language from init data; default locale for exports and messages
+ALTER TABLE users ADD COLUMN IF NOT EXISTS language_code TEXT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS language_code;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_reports (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    reporter_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (giveaway_id, reporter_id)
+);
+
+-- Winners may dispute a claimed prize the creator has not delivered
+ALTER TABLE giveaway_winners ADD COLUMN IF NOT EXISTS disputed_at TIMESTAMPTZ NULL;
+
+-- Giveaways of low-reputation creators stay scheduled until an admin approves them
+CREATE TABLE IF NOT EXISTS giveaway_approvals (
+    giveaway_id TEXT PRIMARY KEY REFERENCES giveaways(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'awaiting' CHECK (status IN ('awaiting','approved','rejected','expired')),
+    reputation_score INT NOT NULL,
+    reviewed_by BIGINT NULL,
+    reviewed_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS giveaway_approvals_status_idx ON giveaway_approvals (status, created_at);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS reputation_score INT NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS reputation_updated_at TIMESTAMPTZ NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS reputation_updated_at;
+ALTER TABLE users DROP COLUMN IF EXISTS reputation_score;
+DROP TABLE IF EXISTS giveaway_approvals;
+ALTER TABLE giveaway_winners DROP COLUMN IF EXISTS disputed_at;
+DROP TABLE IF EXISTS giveaway_reports;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Subscription requirements may only accept users who were channel members before the giveaway started
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS existing_members_only BOOLEAN NOT NULL DEFAULT false;
+
+-- Moment membership is judged against, taken per channel when the giveaway activates
+CREATE TABLE IF NOT EXISTS giveaway_member_snapshots (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    channel_id BIGINT NOT NULL,
+    taken_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, channel_id)
+);
+
+-- Latest join the bot observed per channel member, from chat_member updates
+CREATE TABLE IF NOT EXISTS channel_member_joins (
+    channel_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (channel_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS channel_member_joins;
+DROP TABLE IF EXISTS giveaway_member_snapshots;
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS existing_members_only;
+-- +goose StatementEnd
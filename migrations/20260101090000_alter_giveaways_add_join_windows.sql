@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Daily windows during which joins are accepted, as [{"start":"09:00","end":"21:00","days":[1,2,3]}]
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS join_windows JSONB NULL;
+-- IANA time zone the windows are defined in
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS join_timezone TEXT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS join_timezone;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS join_windows;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optional cap of accounts joining from one device fingerprint; NULL disables it
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS max_per_fingerprint INT NULL;
+
+-- Keyed hash of IP + user agent taken at join; raw values are never stored
+ALTER TABLE giveaway_participants ADD COLUMN IF NOT EXISTS fingerprint TEXT NULL;
+ALTER TABLE giveaway_participants ADD COLUMN IF NOT EXISTS fingerprint_flagged BOOLEAN NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS giveaway_participants_fingerprint_idx ON giveaway_participants (giveaway_id, fingerprint) WHERE fingerprint IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_participants_fingerprint_idx;
+ALTER TABLE giveaway_participants DROP COLUMN IF EXISTS fingerprint_flagged;
+ALTER TABLE giveaway_participants DROP COLUMN IF EXISTS fingerprint;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS max_per_fingerprint;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS campaigns (
+    id TEXT PRIMARY KEY,
+    creator_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS campaigns_creator_idx ON campaigns (creator_id, created_at DESC);
+
+-- A giveaway belongs to at most one campaign
+CREATE TABLE IF NOT EXISTS campaign_giveaways (
+    campaign_id TEXT NOT NULL REFERENCES campaigns(id) ON DELETE CASCADE,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (campaign_id, giveaway_id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS campaign_giveaways_giveaway_idx ON campaign_giveaways (giveaway_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS campaign_giveaways;
+DROP TABLE IF EXISTS campaigns;
+-- +goose StatementEnd
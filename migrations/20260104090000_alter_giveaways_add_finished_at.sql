@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Set when the expiry worker finishes a giveaway; finished_at - ends_at is the completion lag
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS finished_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS giveaways_finished_at_idx ON giveaways (finished_at) WHERE finished_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaways_finished_at_idx;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS finished_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Set when the user blocks the bot in private chat; cleared when they unblock it
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bot_blocked_at TIMESTAMPTZ;
+-- Set once when a running giveaway is detected as unable to complete and queued for review
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS dead_since TIMESTAMPTZ;
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS dead_reason TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS dead_reason;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS dead_since;
+ALTER TABLE users DROP COLUMN IF EXISTS bot_blocked_at;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Participants bulk-imported by platform admins when migrating from another platform
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  ADD CONSTRAINT giveaway_participants_source_check CHECK (source IN ('announcement','inline','explore','direct','unknown','import'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE giveaway_participants SET source = 'unknown' WHERE source = 'import';
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  ADD CONSTRAINT giveaway_participants_source_check CHECK (source IN ('announcement','inline','explore','direct','unknown'));
+-- +goose StatementEnd
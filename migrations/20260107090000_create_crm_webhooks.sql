@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Creator endpoints that receive new participants of their giveaways in batches
+CREATE TABLE IF NOT EXISTS crm_webhooks (
+    id TEXT PRIMARY KEY,
+    creator_id BIGINT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    -- Delivery cursor: the last participant sent, ordered by (joined_at, giveaway_id, user_id)
+    cursor_joined_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    cursor_giveaway_id TEXT NOT NULL DEFAULT '',
+    cursor_user_id BIGINT NOT NULL DEFAULT 0,
+    delivered BIGINT NOT NULL DEFAULT 0,
+    -- Circuit breaker: consecutive failed deliveries and when the next attempt is allowed
+    failures INT NOT NULL DEFAULT 0,
+    open_until TIMESTAMPTZ,
+    last_error TEXT NOT NULL DEFAULT '',
+    last_delivery_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS crm_webhooks_creator_idx ON crm_webhooks (creator_id);
+CREATE INDEX IF NOT EXISTS giveaway_participants_joined_idx ON giveaway_participants (joined_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_participants_joined_idx;
+DROP TABLE IF EXISTS crm_webhooks;
+-- +goose StatementEnd
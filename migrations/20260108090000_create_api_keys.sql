@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Creator API keys for integration polling endpoints; only the SHA-256 of the key is stored
+CREATE TABLE IF NOT EXISTS api_keys (
+    id TEXT PRIMARY KEY,
+    creator_id BIGINT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    key_hash TEXT NOT NULL UNIQUE,
+    prefix TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS api_keys_creator_idx ON api_keys (creator_id);
+CREATE INDEX IF NOT EXISTS giveaway_winners_assigned_idx ON giveaway_winners (assigned_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_winners_assigned_idx;
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Winners announcements posted to sponsor channels a creator-chosen delay after a giveaway completes
+CREATE TABLE IF NOT EXISTS giveaway_result_posts (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    channel_id BIGINT NOT NULL,
+    delay_sec INT NOT NULL DEFAULT 0,
+    image_url TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting','posted','failed','deleted')),
+    message_id BIGINT,
+    attempts INT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    posted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, channel_id)
+);
+CREATE INDEX IF NOT EXISTS giveaway_result_posts_waiting_idx ON giveaway_result_posts (giveaway_id) WHERE status = 'waiting';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_result_posts;
+-- +goose StatementEnd
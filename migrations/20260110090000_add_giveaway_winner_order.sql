@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How winners are listed in responses, announcements and exports; NULL lists them by place
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS winner_order TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS winner_order;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS reserve_winners_count INT NOT NULL DEFAULT 0;
+-- Winners who did not claim within this many seconds of winning are replaced by a reserve; NULL disables
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS claim_deadline_sec INT;
+
+-- Alternates drawn after the winners, promoted in position order
+CREATE TABLE IF NOT EXISTS giveaway_reserves (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    position INT NOT NULL CHECK (position > 0),
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'reserve' CHECK (status IN ('reserve','promoted')),
+    promoted_place INT,
+    replaced_user_id BIGINT,
+    promoted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, position)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_reserves;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS claim_deadline_sec;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS reserve_winners_count;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Subscription requirements may ask for membership of at least this many days; 0 disables
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS min_member_days INT NOT NULL DEFAULT 0;
+
+-- Membership observed by the bot from chat_member updates, per channel member.
+-- first_seen_at starts the current membership and is NULL after a leave until the next join.
+CREATE TABLE IF NOT EXISTS channel_member_history (
+    channel_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    first_seen_at TIMESTAMPTZ,
+    last_left_at TIMESTAMPTZ,
+    PRIMARY KEY (channel_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS channel_member_history;
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS min_member_days;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Participants leaving a subscription channel get this many seconds to come back before they are disqualified; NULL disables
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS unsubscribe_grace_sec INT;
+
+-- Participants who left a required channel of a running giveaway; a row is removed when they
+-- re-subscribe within the grace period and otherwise keeps them out of the draw
+CREATE TABLE IF NOT EXISTS giveaway_membership_lapses (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    channel_id BIGINT NOT NULL,
+    left_at TIMESTAMPTZ NOT NULL,
+    grace_until TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (giveaway_id, user_id, channel_id)
+);
+CREATE INDEX IF NOT EXISTS giveaway_membership_lapses_member_idx ON giveaway_membership_lapses (channel_id, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_membership_lapses;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS unsubscribe_grace_sec;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Finish previews sent to giveaway admins before a pending giveaway auto-resolves or after the
+-- creator confirms winners; one open request per giveaway
+CREATE TABLE IF NOT EXISTS giveaway_finish_confirmations (
+    giveaway_id TEXT PRIMARY KEY REFERENCES giveaways(id) ON DELETE CASCADE,
+    trigger TEXT NOT NULL CHECK (trigger IN ('pending_expiry','creator_confirm')),
+    status TEXT NOT NULL DEFAULT 'awaiting' CHECK (status IN ('awaiting','confirmed','aborted')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    participants_count INT NOT NULL DEFAULT 0,
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    decided_by BIGINT,
+    decided_at TIMESTAMPTZ
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_finish_confirmations;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Last resolved avatar per channel; served when Redis has nothing and Telegram fails
+CREATE TABLE IF NOT EXISTS channel_avatars (
+    channel_id BIGINT PRIMARY KEY,
+    username TEXT NOT NULL DEFAULT '',
+    big_file_id TEXT NOT NULL,
+    big_file_unique_id TEXT NOT NULL,
+    file_path TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_channel_avatars_username ON channel_avatars (lower(username)) WHERE username <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS channel_avatars;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Creator-chosen display order and bonus-only requirements that never block joining
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0;
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS optional BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Existing giveaways keep their insertion order
+UPDATE giveaway_requirements r SET position = o.pos
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY giveaway_id ORDER BY id) - 1 AS pos
+    FROM giveaway_requirements
+) o
+WHERE r.id = o.id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS optional;
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Language picked in the app settings; NULL follows the Telegram client language
+ALTER TABLE users ADD COLUMN IF NOT EXISTS preferred_language TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS preferred_language;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Double-entry ledger of tickets and Stars: every row moves amount from debit to credit
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id BIGSERIAL PRIMARY KEY,
+    idempotency_key TEXT NOT NULL UNIQUE,
+    asset TEXT NOT NULL CHECK (asset IN ('ticket','star')),
+    source TEXT NOT NULL,
+    debit_account TEXT NOT NULL,
+    credit_account TEXT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    -- No foreign keys: entries outlive deleted giveaways for audits
+    giveaway_id TEXT NULL,
+    actor_id BIGINT NULL,
+    memo TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (debit_account <> credit_account)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_debit_idx ON ledger_entries (debit_account);
+CREATE INDEX IF NOT EXISTS ledger_entries_credit_idx ON ledger_entries (credit_account);
+CREATE INDEX IF NOT EXISTS ledger_entries_giveaway_idx ON ledger_entries (giveaway_id, id) WHERE giveaway_id IS NOT NULL;
+
+-- Running balances of user-held accounts; system accounts are derived from entries
+CREATE TABLE IF NOT EXISTS ledger_balances (
+    account TEXT NOT NULL,
+    asset TEXT NOT NULL,
+    balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (account, asset)
+);
+
+-- Existing participants: their joining ticket and the bonus tasks claimed so far
+INSERT INTO ledger_entries (idempotency_key, asset, source, debit_account, credit_account, amount, giveaway_id, actor_id, created_at)
+SELECT 'base:' || p.giveaway_id || ':' || p.user_id, 'ticket', 'base', 'system:issuance',
+       'tickets:' || p.giveaway_id || ':' || p.user_id, 1, p.giveaway_id, p.user_id, p.joined_at
+FROM giveaway_participants p
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+INSERT INTO ledger_entries (idempotency_key, asset, source, debit_account, credit_account, amount, giveaway_id, created_at)
+SELECT 'task:' || c.giveaway_id || ':' || c.user_id || ':' || c.requirement_id || ':' || (extract(epoch FROM c.claimed_at) * 1000000)::bigint,
+       'ticket', 'task', 'system:issuance', 'tickets:' || c.giveaway_id || ':' || c.user_id, c.bonus_tickets, c.giveaway_id, c.claimed_at
+FROM giveaway_task_claims c
+WHERE c.bonus_tickets > 0
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+INSERT INTO ledger_balances (account, asset, balance)
+SELECT credit_account, 'ticket', SUM(amount) FROM ledger_entries
+WHERE asset = 'ticket' AND credit_account LIKE 'tickets:%'
+GROUP BY credit_account
+ON CONFLICT (account, asset) DO UPDATE SET balance = EXCLUDED.balance, updated_at = now();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ledger_balances;
+DROP TABLE IF EXISTS ledger_entries;
+-- +goose StatementEnd
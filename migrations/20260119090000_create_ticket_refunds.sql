@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ledger_entries ADD COLUMN IF NOT EXISTS external_ref TEXT NULL;
+
+-- One refund per Stars purchase of a cancelled giveaway, retried by the refund worker
+CREATE TABLE IF NOT EXISTS ticket_refunds (
+    id BIGSERIAL PRIMARY KEY,
+    purchase_entry_id BIGINT NOT NULL UNIQUE REFERENCES ledger_entries(id),
+    giveaway_id TEXT NOT NULL,
+    user_id BIGINT NOT NULL,
+    charge_id TEXT NOT NULL,
+    stars BIGINT NOT NULL CHECK (stars > 0),
+    tickets BIGINT NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending','refunded','credited')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS ticket_refunds_due_idx ON ticket_refunds (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS ticket_refunds_giveaway_idx ON ticket_refunds (giveaway_id);
+CREATE INDEX IF NOT EXISTS ticket_refunds_user_idx ON ticket_refunds (user_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS ticket_refunds;
+ALTER TABLE ledger_entries DROP COLUMN IF EXISTS external_ref;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Participant support requests about a giveaway, answered by its creator and platform admins
+CREATE TABLE IF NOT EXISTS support_tickets (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    category TEXT NOT NULL CHECK (category IN ('prize_not_received','wrong_prize','other')),
+    subject TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open','answered','escalated','resolved')),
+    escalated_at TIMESTAMPTZ NULL,
+    escalated_by BIGINT NULL,
+    resolved_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- At most one unresolved ticket per participant and giveaway
+CREATE UNIQUE INDEX IF NOT EXISTS support_tickets_unresolved_uidx ON support_tickets (giveaway_id, user_id) WHERE status <> 'resolved';
+CREATE INDEX IF NOT EXISTS support_tickets_user_idx ON support_tickets (user_id, id);
+CREATE INDEX IF NOT EXISTS support_tickets_status_idx ON support_tickets (status, updated_at) WHERE status <> 'resolved';
+
+CREATE TABLE IF NOT EXISTS support_ticket_messages (
+    id BIGSERIAL PRIMARY KEY,
+    ticket_id BIGINT NOT NULL REFERENCES support_tickets(id) ON DELETE CASCADE,
+    author_id BIGINT NOT NULL,
+    author_role TEXT NOT NULL CHECK (author_role IN ('participant','creator','admin')),
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS support_ticket_messages_ticket_idx ON support_ticket_messages (ticket_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS support_ticket_messages;
+DROP TABLE IF EXISTS support_tickets;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Requirement sets channel owners publish for creators to attach to their giveaways
+CREATE TABLE IF NOT EXISTS requirement_bundles (
+    id BIGSERIAL PRIMARY KEY,
+    owner_id BIGINT NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- Enriched requirements copied into each giveaway that attaches the bundle
+    requirements JSONB NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS requirement_bundles_owner_idx ON requirement_bundles (owner_id, id);
+CREATE INDEX IF NOT EXISTS requirement_bundles_active_idx ON requirement_bundles (id) WHERE active;
+
+ALTER TABLE giveaway_requirements ADD COLUMN IF NOT EXISTS bundle_id BIGINT NULL REFERENCES requirement_bundles(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS giveaway_requirements_bundle_idx ON giveaway_requirements (bundle_id) WHERE bundle_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_requirements_bundle_idx;
+ALTER TABLE giveaway_requirements DROP COLUMN IF EXISTS bundle_id;
+DROP TABLE IF EXISTS requirement_bundles;
+-- +goose StatementEnd
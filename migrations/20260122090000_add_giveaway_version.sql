@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Edit version for optimistic concurrency; clients send it back in If-Match
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- Status changes by workers (completion, expiry, cancellation) also invalidate stale edits
+CREATE OR REPLACE FUNCTION giveaways_bump_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.status IS DISTINCT FROM OLD.status AND NEW.version = OLD.version THEN
+    NEW.version = OLD.version + 1;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS giveaways_bump_version ON giveaways;
+CREATE TRIGGER giveaways_bump_version
+  BEFORE UPDATE ON giveaways
+  FOR EACH ROW
+  EXECUTE FUNCTION giveaways_bump_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS giveaways_bump_version ON giveaways;
+DROP FUNCTION IF EXISTS giveaways_bump_version();
+ALTER TABLE giveaways DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-giveaway bot message sent to users right after they join
+CREATE TABLE IF NOT EXISTS giveaway_join_confirmations (
+    giveaway_id TEXT PRIMARY KEY REFERENCES giveaways(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    rules TEXT NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- Set once the confirmation was handed to the bot, so redelivered join events do not repeat it
+ALTER TABLE giveaway_participants ADD COLUMN IF NOT EXISTS confirmation_sent_at TIMESTAMPTZ;
+-- Users can opt out of join confirmations in the app settings
+ALTER TABLE users ADD COLUMN IF NOT EXISTS notify_join_confirmations BOOLEAN NOT NULL DEFAULT TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN IF EXISTS notify_join_confirmations;
+ALTER TABLE giveaway_participants DROP COLUMN IF EXISTS confirmation_sent_at;
+DROP TABLE IF EXISTS giveaway_join_confirmations;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rules and FAQ shown on the giveaway page, as [{"question":"...","answer":"..."}] for the FAQ
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS rules TEXT NULL;
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS faq JSONB NULL;
+-- Append the rules to the channel announcement
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS announce_rules BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS announce_rules;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS faq;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS rules;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bot posts in sponsor channels about a giveaway, kept after the giveaway is deleted so the
+-- deletion cleanup can still remove them
+CREATE TABLE IF NOT EXISTS giveaway_channel_posts (
+    id BIGSERIAL PRIMARY KEY,
+    giveaway_id TEXT NOT NULL,
+    channel_id BIGINT NOT NULL,
+    message_id BIGINT NOT NULL,
+    kind TEXT NOT NULL,
+    media BOOLEAN NOT NULL DEFAULT FALSE,
+    posted_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS giveaway_channel_posts_giveaway_idx ON giveaway_channel_posts (giveaway_id);
+
+-- Cleanup after a giveaway is deleted; each step is stamped once done and retried until then
+CREATE TABLE IF NOT EXISTS giveaway_deletions (
+    giveaway_id TEXT PRIMARY KEY,
+    creator_id BIGINT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    tokens_revoked_at TIMESTAMPTZ,
+    keys_cleared_at TIMESTAMPTZ,
+    posts_cleaned_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS giveaway_deletions_due_idx ON giveaway_deletions (next_attempt_at) WHERE completed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_deletions;
+DROP TABLE IF EXISTS giveaway_channel_posts;
+-- +goose StatementEnd
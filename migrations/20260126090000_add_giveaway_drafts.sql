@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Escrow amount a draft will ask for; the deposit address and memo are issued when it is published
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS draft_escrow_nano BIGINT NULL;
+CREATE INDEX IF NOT EXISTS giveaways_drafts_idx ON giveaways (creator_id, updated_at DESC) WHERE status = 'draft';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaways_drafts_idx;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS draft_escrow_nano;
+-- +goose StatementEnd
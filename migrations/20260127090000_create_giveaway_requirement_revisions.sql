@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Requirement lists of a giveaway after each edit; revision 1 is the list it was created with
+CREATE TABLE IF NOT EXISTS giveaway_requirement_revisions (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    revision INT NOT NULL,
+    requirements JSONB NOT NULL,
+    changed_by BIGINT NOT NULL,
+    revoked_claims INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, revision)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_requirement_revisions;
+-- +goose StatementEnd
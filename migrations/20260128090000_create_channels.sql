@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Canonical channel records: the id, the current username and the owner confirmed as admin
+CREATE TABLE IF NOT EXISTS channels (
+    id BIGINT PRIMARY KEY,
+    username TEXT NOT NULL DEFAULT '',
+    owner_id BIGINT NULL,
+    owner_verified_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS idx_channels_username ON channels (lower(username)) WHERE username <> '';
+-- Display data copied from Telegram, each part with the time it was fetched
+CREATE TABLE IF NOT EXISTS channel_cache (
+    channel_id BIGINT PRIMARY KEY REFERENCES channels(id) ON DELETE CASCADE,
+    title TEXT NOT NULL DEFAULT '',
+    title_fetched_at TIMESTAMPTZ NULL,
+    member_count INT NULL,
+    member_count_fetched_at TIMESTAMPTZ NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS channel_cache;
+DROP TABLE IF EXISTS channels;
+-- +goose StatementEnd
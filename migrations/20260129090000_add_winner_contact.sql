@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How a winner wants to be reached for prize delivery; NULL until they choose
+ALTER TABLE giveaway_winners
+    ADD COLUMN IF NOT EXISTS contact_method TEXT NULL,
+    ADD COLUMN IF NOT EXISTS contact_email TEXT NULL,
+    ADD COLUMN IF NOT EXISTS contact_wallet TEXT NULL,
+    ADD COLUMN IF NOT EXISTS contact_updated_at TIMESTAMPTZ NULL;
+
+ALTER TABLE giveaway_winners
+    DROP CONSTRAINT IF EXISTS giveaway_winners_contact_method_check;
+ALTER TABLE giveaway_winners
+    ADD CONSTRAINT giveaway_winners_contact_method_check CHECK (contact_method IN ('bot_dm','email','wallet'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_winners
+    DROP CONSTRAINT IF EXISTS giveaway_winners_contact_method_check;
+ALTER TABLE giveaway_winners
+    DROP COLUMN IF EXISTS contact_updated_at,
+    DROP COLUMN IF EXISTS contact_wallet,
+    DROP COLUMN IF EXISTS contact_email,
+    DROP COLUMN IF EXISTS contact_method;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS giveaway_export_jobs (
+    id TEXT PRIMARY KEY,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    requested_by BIGINT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('winners','stats')),
+    locale TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued' CHECK (status IN ('queued','running','done','failed','cancelled')),
+    total INT NOT NULL DEFAULT 0,
+    processed INT NOT NULL DEFAULT 0,
+    file_key TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at TIMESTAMPTZ NULL,
+    -- Running jobs touch this on every progress update; stale ones are picked up again
+    heartbeat_at TIMESTAMPTZ NULL,
+    finished_at TIMESTAMPTZ NULL
+);
+-- One unfinished job per giveaway and kind
+CREATE UNIQUE INDEX IF NOT EXISTS giveaway_export_jobs_active_idx ON giveaway_export_jobs (giveaway_id, kind) WHERE status IN ('queued','running');
+CREATE INDEX IF NOT EXISTS giveaway_export_jobs_giveaway_idx ON giveaway_export_jobs (giveaway_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_export_jobs;
+-- +goose StatementEnd
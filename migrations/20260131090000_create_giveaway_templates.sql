@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Template names are unique per creator
+CREATE TABLE IF NOT EXISTS giveaway_templates (
+    id TEXT PRIMARY KEY,
+    creator_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    config JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT giveaway_templates_name_uidx UNIQUE (creator_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_templates;
+-- +goose StatementEnd
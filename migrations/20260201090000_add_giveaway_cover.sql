@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Creator-uploaded cover kept in file storage under cover_key
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS cover_key TEXT;
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS cover_content_type TEXT;
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS cover_size BIGINT;
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS cover_updated_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS cover_updated_at;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS cover_size;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS cover_content_type;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS cover_key;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Title and description by language: {"ru": {"title": "…", "description": "…"}}
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS translations JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS translations;
+-- +goose StatementEnd
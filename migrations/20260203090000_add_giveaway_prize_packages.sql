@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Prize bundles per place range: [{"label": "Gold", "from_place": 1, "to_place": 3, "items": [...]}]
+ALTER TABLE giveaways ADD COLUMN IF NOT EXISTS prize_packages JSONB;
+-- Label of the package a winner prize was expanded from; empty for single prizes
+ALTER TABLE giveaway_winner_prizes ADD COLUMN IF NOT EXISTS package_label TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaway_winner_prizes DROP COLUMN IF EXISTS package_label;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS prize_packages;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaways
+    ADD COLUMN IF NOT EXISTS category TEXT,
+    ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+-- Feed filters only look at active giveaways
+CREATE INDEX IF NOT EXISTS giveaways_active_category_idx ON giveaways (category) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS giveaways_tags_idx ON giveaways USING GIN (tags);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaways_tags_idx;
+DROP INDEX IF EXISTS giveaways_active_category_idx;
+ALTER TABLE giveaways DROP COLUMN IF EXISTS tags, DROP COLUMN IF EXISTS category;
+-- +goose StatementEnd
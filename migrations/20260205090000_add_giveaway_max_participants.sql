@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaways
+    ADD COLUMN IF NOT EXISTS max_participants INT CHECK (max_participants > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE giveaways DROP COLUMN IF EXISTS max_participants;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Display overrides set by the creator; title and avatar_url keep the data cached from Telegram
+ALTER TABLE giveaway_sponsors
+    ADD COLUMN IF NOT EXISTS title_override TEXT,
+    ADD COLUMN IF NOT EXISTS avatar_override TEXT,
+    ADD COLUMN IF NOT EXISTS override_by BIGINT,
+    ADD COLUMN IF NOT EXISTS override_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS giveaway_sponsors_override_idx ON giveaway_sponsors (override_at DESC) WHERE override_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_sponsors_override_idx;
+ALTER TABLE giveaway_sponsors
+    DROP COLUMN IF EXISTS override_at,
+    DROP COLUMN IF EXISTS override_by,
+    DROP COLUMN IF EXISTS avatar_override,
+    DROP COLUMN IF EXISTS title_override;
+-- +goose StatementEnd
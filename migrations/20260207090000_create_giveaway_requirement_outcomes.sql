@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per user and requirement they were checked against; failed and passed record
+-- whether any check failed or passed, so users who fixed a failure count in both
+CREATE TABLE IF NOT EXISTS giveaway_requirement_outcomes (
+    requirement_id BIGINT NOT NULL REFERENCES giveaway_requirements(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    requirement_type TEXT NOT NULL,
+    failed BOOLEAN NOT NULL DEFAULT false,
+    passed BOOLEAN NOT NULL DEFAULT false,
+    first_checked_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (requirement_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS giveaway_requirement_outcomes_giveaway_idx ON giveaway_requirement_outcomes (giveaway_id);
+CREATE INDEX IF NOT EXISTS giveaway_requirement_outcomes_checked_idx ON giveaway_requirement_outcomes (first_checked_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_requirement_outcomes;
+-- +goose StatementEnd
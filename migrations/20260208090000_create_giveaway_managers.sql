@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users the creator granted the manager role on one giveaway
+CREATE TABLE IF NOT EXISTS giveaway_managers (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    added_by BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS giveaway_managers_user_idx ON giveaway_managers (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_managers;
+-- +goose StatementEnd
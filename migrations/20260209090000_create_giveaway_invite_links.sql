@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Startapp links a creator generated for one source, e.g. a partner channel; participants
+-- joining through one are recorded with source 'invite' and the link name as source_ref
+CREATE TABLE IF NOT EXISTS giveaway_invite_links (
+    giveaway_id TEXT NOT NULL REFERENCES giveaways(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, name)
+);
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  ADD CONSTRAINT giveaway_participants_source_check CHECK (source IN ('announcement','inline','explore','direct','unknown','import','invite'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE giveaway_participants SET source = 'direct', source_ref = NULL WHERE source = 'invite';
+ALTER TABLE giveaway_participants
+  DROP CONSTRAINT IF EXISTS giveaway_participants_source_check;
+ALTER TABLE giveaway_participants
+  ADD CONSTRAINT giveaway_participants_source_check CHECK (source IN ('announcement','inline','explore','direct','unknown','import'));
+DROP TABLE IF EXISTS giveaway_invite_links;
+-- +goose StatementEnd
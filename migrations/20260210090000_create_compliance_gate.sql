@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Jurisdictions where giveaways with prizes of a kind are restricted, set by platform admins
+CREATE TABLE IF NOT EXISTS compliance_rules (
+    prize_kind TEXT PRIMARY KEY,
+    countries TEXT[] NOT NULL DEFAULT '{}',
+    notice TEXT NOT NULL DEFAULT '',
+    updated_by BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- Eligibility acknowledgments of users joining restricted giveaways, kept for audit after the
+-- giveaway is deleted
+CREATE TABLE IF NOT EXISTS giveaway_compliance_acks (
+    giveaway_id TEXT NOT NULL,
+    user_id BIGINT NOT NULL,
+    prize_kinds TEXT[] NOT NULL DEFAULT '{}',
+    countries TEXT[] NOT NULL DEFAULT '{}',
+    notice TEXT NOT NULL DEFAULT '',
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    acknowledged_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (giveaway_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS giveaway_compliance_acks;
+DROP TABLE IF EXISTS compliance_rules;
+-- +goose StatementEnd
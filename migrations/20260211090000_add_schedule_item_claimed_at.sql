@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE giveaway_schedule_items ADD COLUMN IF NOT EXISTS claimed_at TIMESTAMPTZ NULL;
+
+-- Items left processing before this column existed are reclaimed on the next tick
+UPDATE giveaway_schedule_items SET claimed_at = updated_at WHERE status = 'processing';
+
+CREATE INDEX IF NOT EXISTS giveaway_schedule_items_claimed_idx ON giveaway_schedule_items (claimed_at) WHERE status = 'processing';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS giveaway_schedule_items_claimed_idx;
+ALTER TABLE giveaway_schedule_items DROP COLUMN IF EXISTS claimed_at;
+-- +goose StatementEnd